@@ -18,6 +18,11 @@ const docTemplate = `{
     "paths": {
         "/v1/aparat-analysis-create": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can registr Aparat analysis",
                 "consumes": [
                     "application/json"
@@ -64,6 +69,11 @@ const docTemplate = `{
         },
         "/v1/aparat-analysis-delete/{id}": {
             "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can delete Aparat analysis",
                 "consumes": [
                     "application/json"
@@ -108,6 +118,11 @@ const docTemplate = `{
         },
         "/v1/aparat-analysis-get": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can get Aparat analysis",
                 "consumes": [
                     "application/json"
@@ -157,6 +172,11 @@ const docTemplate = `{
         },
         "/v1/aparat-category-create": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can registr aparat categoty",
                 "consumes": [
                     "application/json"
@@ -203,6 +223,11 @@ const docTemplate = `{
         },
         "/v1/aparat-category-delete/{id}": {
             "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can delete aparat category",
                 "consumes": [
                     "application/json"
@@ -247,6 +272,11 @@ const docTemplate = `{
         },
         "/v1/aparat-category-find": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can find aparat categories",
                 "consumes": [
                     "application/json"
@@ -303,6 +333,11 @@ const docTemplate = `{
         },
         "/v1/aparat-category-get": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can get aparat category",
                 "consumes": [
                     "application/json"
@@ -352,6 +387,11 @@ const docTemplate = `{
         },
         "/v1/aparat-category-update/{id}": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can update aparat catigory",
                 "consumes": [
                     "application/json"
@@ -405,6 +445,11 @@ const docTemplate = `{
         },
         "/v1/aparat-create": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can registr aparat",
                 "consumes": [
                     "application/json"
@@ -451,6 +496,11 @@ const docTemplate = `{
         },
         "/v1/aparat-delete/{id}": {
             "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can delete lab",
                 "consumes": [
                     "application/json"
@@ -495,6 +545,11 @@ const docTemplate = `{
         },
         "/v1/aparat-find": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can find labs",
                 "consumes": [
                     "application/json"
@@ -551,6 +606,11 @@ const docTemplate = `{
         },
         "/v1/aparat-get": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can get lab",
                 "consumes": [
                     "application/json"
@@ -600,6 +660,11 @@ const docTemplate = `{
         },
         "/v1/aparat-sub-category-create": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can registr Aparat sub category create",
                 "consumes": [
                     "application/json"
@@ -646,6 +711,11 @@ const docTemplate = `{
         },
         "/v1/aparat-sub-category-delete/{id}": {
             "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can delete Aparat sub category",
                 "consumes": [
                     "application/json"
@@ -690,6 +760,11 @@ const docTemplate = `{
         },
         "/v1/aparat-sub-category-find": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can find Aparat sub categories",
                 "consumes": [
                     "application/json"
@@ -746,6 +821,11 @@ const docTemplate = `{
         },
         "/v1/aparat-sub-category-get": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can get Aparat sub category create",
                 "consumes": [
                     "application/json"
@@ -795,6 +875,11 @@ const docTemplate = `{
         },
         "/v1/aparat-sub-category-update/{id}": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can update Aparat sub category",
                 "consumes": [
                     "application/json"
@@ -848,6 +933,11 @@ const docTemplate = `{
         },
         "/v1/aparat-update/{id}": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "description": "This api can update lab",
                 "consumes": [
                     "application/json"
@@ -899,9 +989,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/cashbo-find": {
-            "get": {
-                "description": "This api can find cashbox",
+        "/v1/appointment-cancel/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can cancel the booked appointment, its slot becomes free",
                 "consumes": [
                     "application/json"
                 ],
@@ -909,66 +1004,115 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cashbox"
+                    "Appointment"
                 ],
-                "summary": "Find cashbox",
+                "summary": "cancel appointment",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "name": "client_id",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "name": "from_date",
-                        "in": "query"
+                        "description": "Appointment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AppointmentCancelReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Appointment"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "name": "search",
-                        "in": "query"
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/appointment-check-in/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can check in the patient of today's appointment, the patient gets a queue number of the doctor",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Appointment"
+                ],
+                "summary": "check in appointment",
+                "parameters": [
                     {
                         "type": "string",
-                        "name": "to_date",
-                        "in": "query"
+                        "description": "Appointment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.FindCashboxResp"
+                            "$ref": "#/definitions/models.Appointment"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cashbox-create": {
+        "/v1/appointment-create": {
             "post": {
-                "description": "create cashbox",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can book a free slot of the doctor for the patient, starts_at is clinic local like 2006-01-02 15:04",
                 "consumes": [
                     "application/json"
                 ],
@@ -976,9 +1120,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cashbox"
+                    "Appointment"
                 ],
-                "summary": "create cashbox",
+                "summary": "create appointment",
                 "parameters": [
                     {
                         "description": "Body",
@@ -986,7 +1130,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateCashboxReq"
+                            "$ref": "#/definitions/models.AppointmentCreateReq"
                         }
                     }
                 ],
@@ -994,113 +1138,164 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.CashboxResp"
+                            "$ref": "#/definitions/models.Appointment"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cashbox-delete/{id}": {
-            "delete": {
-                "description": "This api can delete cashbox",
-                "consumes": [
-                    "application/json"
+        "/v1/appointment-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can find appointments by doctor, patient, status and days (2006-01-02), earliest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cashbox"
+                    "Appointment"
                 ],
-                "summary": "Delete cashbox",
+                "summary": "find appointments",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "doctor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "from_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseOK"
+                            "$ref": "#/definitions/models.AppointmentsResp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cashbox-get": {
+        "/v1/appointment-get/{id}": {
             "get": {
-                "description": "This api can get patient queue",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can get the appointment",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cashbox"
+                    "Appointment"
                 ],
-                "summary": "get patient cashbox",
+                "summary": "get appointment",
                 "parameters": [
                     {
                         "type": "string",
+                        "description": "Appointment ID",
                         "name": "id",
-                        "in": "query"
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CashboxResp"
+                            "$ref": "#/definitions/models.Appointment"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cashbox-print": {
-            "get": {
-                "description": "This api can get patient queue",
+        "/v1/appointment-reschedule/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can move the booked appointment to another free slot of its doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -1108,87 +1303,128 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Cashbox"
+                    "Appointment"
                 ],
-                "summary": "get patient cashbox print",
+                "summary": "reschedule appointment",
                 "parameters": [
                     {
                         "type": "string",
+                        "description": "Appointment ID",
                         "name": "id",
-                        "in": "query"
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AppointmentRescheduleReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CashboxesPrinterResp"
+                            "$ref": "#/definitions/models.Appointment"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/cashbox-update/{id}": {
-            "post": {
-                "description": "This api can update cashbox",
-                "consumes": [
-                    "application/json"
+        "/v1/appointment-slots": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can get the time slots of the doctor on the date (2006-01-02), booked and past ones are not free",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Cashbox"
+                    "Appointment"
                 ],
-                "summary": "update cashbox",
+                "summary": "appointment slots",
                 "parameters": [
                     {
-                        "description": "UpdatePatientModel",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateCashboxReq"
-                        }
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "doctor_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CashboxResp"
+                            "$ref": "#/definitions/models.AppointmentSlotsResp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-create": {
+        "/v1/auth/change-password": {
             "post": {
-                "description": "This api can registr doctor",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can change password of the signed in staff, all sessions are logged out",
                 "consumes": [
                     "application/json"
                 ],
@@ -1196,9 +1432,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Auth"
                 ],
-                "summary": "Create doctor",
+                "summary": "change password",
                 "parameters": [
                     {
                         "description": "Body",
@@ -1206,35 +1442,41 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateDoctorModel"
+                            "$ref": "#/definitions/models.ChangePasswordReq"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorResp"
+                            "$ref": "#/definitions/models.ResponseOK"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-delete/{id}": {
-            "delete": {
-                "description": "This api can delete doctor",
+        "/v1/auth/login": {
+            "post": {
+                "description": "This api can login staff and get access and refresh tokens",
                 "consumes": [
                     "application/json"
                 ],
@@ -1242,99 +1484,125 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Auth"
                 ],
-                "summary": "Delete doctor",
+                "summary": "login",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoginReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseOK"
+                            "$ref": "#/definitions/models.TokenResp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-find": {
-            "get": {
-                "description": "This api can find doctors",
-                "consumes": [
-                    "application/json"
+        "/v1/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can logout staff, the refresh token is revoked",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Auth"
                 ],
-                "summary": "Find doctors",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
+                "summary": "logout",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "name": "page",
-                        "in": "query",
-                        "required": true
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/me": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "name": "search",
-                        "in": "query"
+                        "BearerAuth": []
                     }
                 ],
+                "description": "This api can get the signed in staff",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "me",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorsResp"
+                            "$ref": "#/definitions/models.StaffModel"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-get": {
-            "get": {
-                "description": "This api can get doctor",
+        "/v1/auth/refresh": {
+            "post": {
+                "description": "This api can get new access and refresh tokens by refresh token",
                 "consumes": [
                     "application/json"
                 ],
@@ -1342,48 +1610,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Auth"
                 ],
-                "summary": "Get doctor",
+                "summary": "refresh token",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "id",
-                        "name": "field",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RefreshTokenReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorResp"
+                            "$ref": "#/definitions/models.TokenResp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-page-filter": {
+        "/v1/cashbo-find": {
             "get": {
-                "description": "This api can get doctor page",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find cashbox",
                 "consumes": [
                     "application/json"
                 ],
@@ -1391,15 +1667,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor-page"
+                    "Cashbox"
                 ],
-                "summary": "Get patients info for doctor page",
+                "summary": "Find cashbox",
                 "parameters": [
                     {
                         "type": "integer",
                         "name": "client_id",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "name": "from_date",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "name": "limit",
@@ -1412,12 +1693,12 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "name": "service_id",
+                        "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "service_type",
+                        "name": "to_date",
                         "in": "query"
                     }
                 ],
@@ -1425,7 +1706,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DocPageFilterResModel"
+                            "$ref": "#/definitions/models.FindCashboxResp"
                         }
                     },
                     "400": {
@@ -1443,19 +1724,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-report-create": {
+        "/v1/cashbox-create": {
             "post": {
-                "description": "This api can registr doctor report",
-                "consumes": [
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create cashbox",
+                "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor-report"
+                    "Cashbox"
                 ],
-                "summary": "Create doctor report",
+                "summary": "create cashbox",
                 "parameters": [
                     {
                         "description": "Body",
@@ -1463,7 +1749,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorReportsModel"
+                            "$ref": "#/definitions/models.CreateCashboxReq"
                         }
                     }
                 ],
@@ -1471,7 +1757,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorReportsModelRes"
+                            "$ref": "#/definitions/models.CashboxResp"
                         }
                     },
                     "400": {
@@ -1489,9 +1775,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-report-delete/{id}": {
+        "/v1/cashbox-delete/{id}": {
             "delete": {
-                "description": "This api can delete doctor",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete cashbox",
                 "consumes": [
                     "application/json"
                 ],
@@ -1499,9 +1790,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor-report"
+                    "Cashbox"
                 ],
-                "summary": "Delete doctor report",
+                "summary": "Delete cashbox",
                 "parameters": [
                     {
                         "type": "string",
@@ -1518,24 +1809,35 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ResponseOK"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-report-find": {
+        "/v1/cashbox-get": {
             "get": {
-                "description": "This api can find doctors",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get patient queue",
                 "consumes": [
                     "application/json"
                 ],
@@ -1543,27 +1845,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor-report"
+                    "Cashbox"
                 ],
-                "summary": "Find doctors report",
+                "summary": "get patient cashbox",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
                     {
                         "type": "string",
-                        "name": "search",
+                        "name": "id",
                         "in": "query"
                     }
                 ],
@@ -1571,7 +1859,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorReportsResp"
+                            "$ref": "#/definitions/models.CashboxResp"
                         }
                     },
                     "400": {
@@ -1589,9 +1877,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/doctor-report-get": {
-            "get": {
-                "description": "This api can get doctor reports",
+        "/v1/cashbox-pay/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can pay the cashbox at once split across cash, card and transfer payment types on the open shift of the cashier, paying more than the remaining summa is rejected",
                 "consumes": [
                     "application/json"
                 ],
@@ -1599,48 +1892,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor-report"
+                    "Cashbox"
                 ],
-                "summary": "Get doctor report",
+                "summary": "pay cashbox",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "id",
-                        "name": "field",
-                        "in": "query",
+                        "description": "Cashbox ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CashboxPayReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorReportsModelRes"
+                            "$ref": "#/definitions/models.CashboxResp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-type-get": {
-            "get": {
-                "description": "This api can get doctor type",
+        "/v1/cashbox-payment-link/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get a link the patient pays the remaining summa of the cashbox online on",
                 "consumes": [
                     "application/json"
                 ],
@@ -1648,67 +1962,98 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Cashbox"
+                ],
+                "summary": "cashbox payment link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cashbox ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PaymentLinkReq"
+                        }
+                    }
                 ],
-                "summary": "Get doctor type",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorTypes"
+                            "$ref": "#/definitions/models.PaymentLink"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/doctor-update/{id}": {
-            "post": {
-                "description": "This api can update doctor",
+        "/v1/cashbox-print": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get patient queue",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/pdf"
                 ],
                 "tags": [
-                    "Doctor"
+                    "Cashbox"
                 ],
-                "summary": "Update doctor",
+                "summary": "get patient cashbox print",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
                         "name": "id",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
                     },
                     {
-                        "description": "Body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateDoctorModel"
-                        }
+                        "type": "string",
+                        "description": "PDF receipt of the format: 58mm, 80mm or a4, JSON when empty",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DoctorResp"
+                            "$ref": "#/definitions/models.CashboxesPrinterResp"
                         }
                     },
                     "400": {
@@ -1717,6 +2062,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1726,9 +2077,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/lab-analysis-create": {
+        "/v1/cashbox-refund/{id}": {
             "post": {
-                "description": "This api can registr Lab analysis",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can refund the whole cashbox or, with service_type (doctor, lab, aparat) and service_id, one line of it.\nThe money paid over the rest is returned as a negative payment and not served queues of the services are cancelled.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1736,45 +2092,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab analysis"
+                    "Cashbox"
                 ],
-                "summary": "Create Lab analysis",
+                "summary": "refund cashbox",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cashbox ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "Body",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.AnalysisReqModel"
+                            "$ref": "#/definitions/models.CashboxRefundReq"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.AnalysisRespModel"
+                            "$ref": "#/definitions/models.CashboxResp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-analysis-delete/{id}": {
-            "delete": {
-                "description": "This api can delete Lab analysis",
+        "/v1/cashbox-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update cashbox",
                 "consumes": [
                     "application/json"
                 ],
@@ -1782,23 +2162,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab analysis"
+                    "Cashbox"
                 ],
-                "summary": "Delete Lab analysis",
+                "summary": "update cashbox",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "UpdatePatientModel",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateCashboxReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseOK"
+                            "$ref": "#/definitions/models.CashboxResp"
                         }
                     },
                     "400": {
@@ -1816,30 +2198,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/lab-analysis-get": {
+        "/v1/consumption-report": {
             "get": {
-                "description": "This api can get Lab analysis",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api compares what the lab and aparat services performed in the dates should have consumed by their bills of materials with what was issued to them. Dates are like 2006-01-02, both included",
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "Lab analysis"
+                    "Sqlad"
                 ],
-                "summary": "Get Lab analysis",
+                "summary": "get sqlad consumption report",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "id",
-                        "name": "field",
+                        "description": "From date",
+                        "name": "from_date",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "name": "value",
+                        "description": "To date",
+                        "name": "to_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "lab or aparat",
+                        "name": "service_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service ID",
+                        "name": "service_id",
                         "in": "query"
                     }
                 ],
@@ -1847,27 +2245,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.AnalysisRespModel"
+                            "$ref": "#/definitions/models.ConsumptionReport"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-category-create": {
+        "/v1/discount-create": {
             "post": {
-                "description": "This api can registr lab categoty",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can create a discount, kind is percent or fixed, cap is the most it takes off one cashbox (0 is no cap).\nEmpty client_id, service_type (doctor, lab, aparat), advertising_channel and validity dates match anything.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1875,9 +2278,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab Category"
+                    "Discount"
                 ],
-                "summary": "Create lab category",
+                "summary": "create discount",
                 "parameters": [
                     {
                         "description": "Body",
@@ -1885,7 +2288,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryModel"
+                            "$ref": "#/definitions/models.DiscountReq"
                         }
                     }
                 ],
@@ -1893,37 +2296,39 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryModelResp"
+                            "$ref": "#/definitions/models.Discount"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-category-delete/{id}": {
+        "/v1/discount-delete/{id}": {
             "delete": {
-                "description": "This api can delete lab category",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can delete discount",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab Category"
+                    "Discount"
                 ],
-                "summary": "Delete lab category",
+                "summary": "delete discount",
                 "parameters": [
                     {
                         "type": "string",
@@ -1940,129 +2345,139 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ResponseOK"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-category-find": {
+        "/v1/discount-find": {
             "get": {
-                "description": "This api can find lab categories",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can find discounts, date (YYYY-MM-DD) keeps the ones valid on it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab Category"
+                    "Discount"
                 ],
-                "summary": "Find lab category",
+                "summary": "find discounts",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "name": "category_id",
+                        "name": "date",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "default": 10,
                         "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "default": 1,
                         "name": "page",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CategoriesResp"
+                            "$ref": "#/definitions/models.DiscountsResp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-category-get": {
+        "/v1/discount-get/{id}": {
             "get": {
-                "description": "This api can get lab category",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can get discount by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab Category"
+                    "Discount"
                 ],
-                "summary": "Get lab category",
+                "summary": "get discount",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "id",
-                        "name": "field",
-                        "in": "query",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryModelResp"
+                            "$ref": "#/definitions/models.Discount"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-category-update/{id}": {
+        "/v1/discount-update/{id}": {
             "post": {
-                "description": "This api can update lab catigory",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update discount, cashboxes created before keep the discount they got",
                 "consumes": [
                     "application/json"
                 ],
@@ -2070,9 +2485,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab Category"
+                    "Discount"
                 ],
-                "summary": "Update lab category",
+                "summary": "update discount",
                 "parameters": [
                     {
                         "type": "string",
@@ -2087,7 +2502,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryModel"
+                            "$ref": "#/definitions/models.DiscountReq"
                         }
                     }
                 ],
@@ -2095,83 +2510,104 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryModelResp"
+                            "$ref": "#/definitions/models.Discount"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-create": {
-            "post": {
-                "description": "This api can registr lab",
-                "consumes": [
-                    "application/json"
+        "/v1/doctor-availability/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api tells whether the doctor works at the clinic local time like 2006-01-02 15:04, why not and who substitutes the doctor",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab"
+                    "Schedule"
                 ],
-                "summary": "Create lab",
+                "summary": "get doctor availability",
                 "parameters": [
                     {
-                        "description": "Body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.LabModel"
-                        }
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time",
+                        "name": "at",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LabModelResp"
+                            "$ref": "#/definitions/models.DoctorAvailability"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-delete/{id}": {
+        "/v1/doctor-commission-delete/{id}": {
             "delete": {
-                "description": "This api can delete lab",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can delete the commission rule, a less specific rule of the doctor applies again",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab"
+                    "Payroll"
                 ],
-                "summary": "Delete lab",
+                "summary": "delete doctor commission",
                 "parameters": [
                     {
                         "type": "string",
@@ -2188,52 +2624,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ResponseOK"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-find": {
+        "/v1/doctor-commission-find": {
             "get": {
-                "description": "This api can find labs",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can find the commission rules of the doctor, of all doctors when doctor_id is empty",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab"
+                    "Payroll"
                 ],
-                "summary": "Find labs",
+                "summary": "find doctor commissions",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
                     {
                         "type": "string",
-                        "name": "search",
+                        "description": "Doctor ID",
+                        "name": "doctor_id",
                         "in": "query"
                     }
                 ],
@@ -2241,27 +2666,26 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LabsResp"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DoctorCommissions"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-get": {
-            "get": {
-                "description": "This api can get lab",
+        "/v1/doctor-commission-set": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can set what the doctor earns from a service: percent of what the clients paid or a fixed amount for each service. Empty service_id covers every service of the type, empty service_type every service of the doctor; the most specific rule applies. A rule the doctor already has for the service is replaced",
                 "consumes": [
                     "application/json"
                 ],
@@ -2269,48 +2693,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab"
+                    "Payroll"
                 ],
-                "summary": "Get lab",
+                "summary": "set doctor commission",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "id",
-                        "name": "field",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
-                    }
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DoctorCommissionReq"
+                        }
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LabModelResp"
+                            "$ref": "#/definitions/models.DoctorCommission"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-sub-category-create": {
+        "/v1/doctor-create": {
             "post": {
-                "description": "This api can registr Lab sub category create",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can registr doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2318,9 +2750,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab sub category"
+                    "Doctor"
                 ],
-                "summary": "Create Lab sub category",
+                "summary": "Create doctor",
                 "parameters": [
                     {
                         "description": "Body",
@@ -2328,7 +2760,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SubCategoryModel"
+                            "$ref": "#/definitions/models.CreateDoctorModel"
                         }
                     }
                 ],
@@ -2336,7 +2768,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.SubCategoryResModel"
+                            "$ref": "#/definitions/models.DoctorResp"
                         }
                     },
                     "400": {
@@ -2354,9 +2786,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/lab-sub-category-delete/{id}": {
+        "/v1/doctor-delete/{id}": {
             "delete": {
-                "description": "This api can delete Lab sub category",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2364,9 +2801,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab sub category"
+                    "Doctor"
                 ],
-                "summary": "Delete Lab sub category",
+                "summary": "Delete doctor",
                 "parameters": [
                     {
                         "type": "string",
@@ -2398,9 +2835,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/lab-sub-category-find": {
-            "get": {
-                "description": "This api can find lab sub categories",
+        "/v1/doctor-exception-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can record a vacation, sick leave or absence of the doctor with an optional substitute, or custom hours replacing the weekly ones on the days. Dates are like 2006-01-02, times like 15:04; vacation and sick take whole days",
                 "consumes": [
                     "application/json"
                 ],
@@ -2408,157 +2850,161 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab sub category"
+                    "Schedule"
                 ],
-                "summary": "Find Lab sub category",
+                "summary": "create doctor schedule exception",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "name": "page",
-                        "in": "query",
-                        "required": true
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DoctorExceptionReq"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.SubCategoriesResp"
+                            "$ref": "#/definitions/models.DoctorException"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-sub-category-get": {
-            "get": {
-                "description": "This api can get Lab sub category create",
-                "consumes": [
-                    "application/json"
+        "/v1/doctor-exception-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can delete the exception, the weekly hours apply again",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab sub category"
+                    "Schedule"
                 ],
-                "summary": "Get Lab sub category",
+                "summary": "delete doctor schedule exception",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "id",
-                        "name": "field",
-                        "in": "query",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SubCategoryResModel"
+                            "$ref": "#/definitions/models.ResponseOK"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-sub-category-update/{id}": {
-            "post": {
-                "description": "This api can update Lab sub category",
-                "consumes": [
-                    "application/json"
+        "/v1/doctor-exception-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can find the exceptions overlapping the dates, of all doctors when doctor_id is empty",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lab sub category"
+                    "Schedule"
                 ],
-                "summary": "Update Lab sub category",
+                "summary": "find doctor schedule exceptions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Doctor ID",
+                        "name": "doctor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from_date",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateSubCategory"
-                        }
+                        "type": "string",
+                        "description": "To date",
+                        "name": "to_date",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CategoryModelResp"
+                            "$ref": "#/definitions/models.DoctorExceptions"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/lab-update/{id}": {
-            "post": {
-                "description": "This api can update lab",
+        "/v1/doctor-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find doctors",
                 "consumes": [
                     "application/json"
                 ],
@@ -2566,32 +3012,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Lab"
+                    "Doctor"
                 ],
-                "summary": "Update lab",
+                "summary": "Find doctors",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.LabModel"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "specialty_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LabModelResp"
+                            "$ref": "#/definitions/models.DoctorsResp"
                         }
                     },
                     "400": {
@@ -2609,9 +3063,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/low-stock": {
+        "/v1/doctor-get": {
             "get": {
-                "description": "Retrieves a list of low stock",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2619,14 +3078,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sqlad"
+                    "Doctor"
+                ],
+                "summary": "Get doctor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "name": "field",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Find low stochs",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.LowStocksRespModel"
+                            "$ref": "#/definitions/models.DoctorResp"
                         }
                     },
                     "400": {
@@ -2644,62 +3117,86 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/media/photo/": {
-            "post": {
+        "/v1/doctor-page-filter": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Through this api frontent can upload photo and get the link to the media.",
+                "description": "This api can get doctor page: patients queued to the doctor in the date range with their reports.\nFor a doctor the doctor_id is taken from the token.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Media"
+                    "Doctor-page"
                 ],
-                "summary": "Upload media",
+                "summary": "Get patients info for doctor page",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "name": "analysis_type",
+                        "name": "doctor_id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "phone_number",
+                        "name": "from_date",
                         "in": "query"
                     },
                     {
-                        "type": "file",
-                        "description": "File",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_date",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.MediaResponse"
+                            "$ref": "#/definitions/models.DocPageFilterResModel"
                         }
                     },
-                    "default": {
-                        "description": "",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.DefaultResponse"
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
                         }
                     }
                 }
             }
         },
-        "/v1/patient-create": {
+        "/v1/doctor-report-create": {
             "post": {
-                "description": "This api can patient registr",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can registr doctor report",
                 "consumes": [
                     "application/json"
                 ],
@@ -2707,9 +3204,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor-report"
                 ],
-                "summary": "create patient",
+                "summary": "Create doctor report",
                 "parameters": [
                     {
                         "description": "Body",
@@ -2717,7 +3214,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePatientModel"
+                            "$ref": "#/definitions/models.DoctorReportsModel"
                         }
                     }
                 ],
@@ -2725,7 +3222,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.PatientModel"
+                            "$ref": "#/definitions/models.DoctorReportsModelRes"
                         }
                     },
                     "400": {
@@ -2743,9 +3240,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/patient-delete/{id}": {
+        "/v1/doctor-report-delete/{id}": {
             "delete": {
-                "description": "This api can delete patient",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2753,14 +3255,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor-report"
                 ],
-                "summary": "delete patient",
+                "summary": "Delete doctor report",
                 "parameters": [
                     {
-                        "type": "integer",
+                        "type": "string",
+                        "description": "ID",
                         "name": "id",
-                        "in": "query"
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2785,9 +3289,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/patient-find": {
+        "/v1/doctor-report-find": {
             "get": {
-                "description": "This api can find patient",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find doctors",
                 "consumes": [
                     "application/json"
                 ],
@@ -2795,19 +3304,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor-report"
                 ],
-                "summary": "find patients",
+                "summary": "Find doctors report",
                 "parameters": [
                     {
                         "type": "integer",
+                        "default": 10,
                         "name": "limit",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "integer",
+                        "default": 1,
                         "name": "page",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
@@ -2819,7 +3332,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PatientsResp"
+                            "$ref": "#/definitions/models.DoctorReportsResp"
                         }
                     },
                     "400": {
@@ -2837,9 +3350,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/patient-get": {
+        "/v1/doctor-report-get": {
             "get": {
-                "description": "This api can get patient",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get doctor reports",
                 "consumes": [
                     "application/json"
                 ],
@@ -2847,14 +3365,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
+                    "Doctor-report"
                 ],
-                "summary": "get patient",
+                "summary": "Get doctor report",
                 "parameters": [
                     {
                         "type": "string",
+                        "default": "id",
                         "name": "field",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
@@ -2866,7 +3386,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PatientModel"
+                            "$ref": "#/definitions/models.DoctorReportsModelRes"
                         }
                     },
                     "400": {
@@ -2884,9 +3404,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/patient-update/{id}": {
-            "post": {
-                "description": "This api can update patient",
+        "/v1/doctor-type-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get doctor type",
                 "consumes": [
                     "application/json"
                 ],
@@ -2894,32 +3419,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patient"
-                ],
-                "summary": "update patient",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "UpdatePatientModel",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreatePatientModel"
-                        }
-                    }
+                    "Doctor"
                 ],
+                "summary": "Get doctor type",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PatientModel"
+                            "$ref": "#/definitions/models.DoctorTypes"
                         }
                     },
                     "400": {
@@ -2937,9 +3444,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/payment-create": {
+        "/v1/doctor-update/{id}": {
             "post": {
-                "description": "create payment history",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update doctor",
                 "consumes": [
                     "application/json"
                 ],
@@ -2947,25 +3459,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payment history"
+                    "Doctor"
                 ],
-                "summary": "create payment history",
+                "summary": "Update doctor",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "Body",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePaymentHistoryReq"
+                            "$ref": "#/definitions/models.CreateDoctorModel"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PaymentHistoryResp"
+                            "$ref": "#/definitions/models.DoctorResp"
                         }
                     },
                     "400": {
@@ -2983,23 +3502,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/payment-delete/{id}": {
-            "delete": {
-                "description": "This api can delete payment history",
-                "consumes": [
-                    "application/json"
+        "/v1/doctor-work-hours-get/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can get the weekly working hours and breaks of the doctor",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Payment history"
+                    "Schedule"
                 ],
-                "summary": "Delete payment history",
+                "summary": "get doctor working hours",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "Doctor ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3009,27 +3530,26 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseOK"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DoctorWorkHours"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/payment-find": {
-            "get": {
-                "description": "This api can find cashbox",
+        "/v1/doctor-work-hours-set/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can replace the weekly working hours and breaks of the doctor, times are clinic local like 15:04 and weekdays go from 1 (monday) to 7 (sunday)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3037,122 +3557,128 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payment history"
+                    "Schedule"
                 ],
-                "summary": "Find cashbox",
+                "summary": "set doctor working hours",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "name": "client_id",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "name": "from_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Doctor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "to_date",
-                        "in": "query"
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DoctorWorkHoursReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PaymentHistoriesResp"
+                            "$ref": "#/definitions/models.DoctorWorkHours"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/payment-get": {
+        "/v1/doctors-working": {
             "get": {
-                "description": "This api can get payment history",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can find the doctors of the specialty working on the date with their hours, substitutes included",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Payment history"
+                    "Schedule"
                 ],
-                "summary": "get payment history",
+                "summary": "find working doctors",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "id",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "description": "Specialty ID",
+                        "name": "specialty_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PaymentHistoryResp"
+                            "$ref": "#/definitions/models.DoctorsWorking"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/queue-check-get": {
+        "/v1/expiring-lots": {
             "get": {
-                "description": "This api can check patient queue",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This api can find the lots in stock expiring within the days, 30 when empty, the expired ones to write off included",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Queue"
+                    "Sqlad"
                 ],
-                "summary": "check patient queue",
+                "summary": "expiring lots",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "service_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "service_type",
+                        "type": "integer",
+                        "description": "Days",
+                        "name": "days",
                         "in": "query"
                     }
                 ],
@@ -3160,27 +3686,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.QueueNumber"
+                            "$ref": "#/definitions/models.SqladLots"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.DefaultResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/queue-create": {
+        "/v1/lab-analysis-create": {
             "post": {
-                "description": "create patient queue",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can registr Lab analysis",
                 "consumes": [
                     "application/json"
                 ],
@@ -3188,9 +3719,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Queue"
+                    "Lab analysis"
                 ],
-                "summary": "create patient queue",
+                "summary": "Create Lab analysis",
                 "parameters": [
                     {
                         "description": "Body",
@@ -3198,7 +3729,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreatePatientQueueReq"
+                            "$ref": "#/definitions/models.AnalysisReqModel"
                         }
                     }
                 ],
@@ -3206,7 +3737,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.PatientQueueResp"
+                            "$ref": "#/definitions/models.AnalysisRespModel"
                         }
                     },
                     "400": {
@@ -3224,9 +3755,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/queue-find": {
-            "get": {
-                "description": "This api can find patient queues",
+        "/v1/lab-analysis-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete Lab analysis",
                 "consumes": [
                     "application/json"
                 ],
@@ -3234,41 +3770,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Queue"
+                    "Lab analysis"
                 ],
-                "summary": "Find patient queues",
+                "summary": "Delete Lab analysis",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "name": "client_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "name": "service_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "service_type",
-                        "in": "query"
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.QueuesResp"
+                            "$ref": "#/definitions/models.ResponseOK"
                         }
                     },
                     "400": {
@@ -3286,9 +3804,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/queue-get": {
+        "/v1/lab-analysis-get": {
             "get": {
-                "description": "This api can get patient queue",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get Lab analysis",
                 "consumes": [
                     "application/json"
                 ],
@@ -3296,13 +3819,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Queue"
+                    "Lab analysis"
                 ],
-                "summary": "get patient queue",
+                "summary": "Get Lab analysis",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "id",
+                        "default": "id",
+                        "name": "field",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
                         "in": "query"
                     }
                 ],
@@ -3310,7 +3840,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.PatientQueueResp"
+                            "$ref": "#/definitions/models.AnalysisRespModel"
                         }
                     },
                     "400": {
@@ -3328,9 +3858,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/queue-update/{id}": {
+        "/v1/lab-category-create": {
             "post": {
-                "description": "This api can update patient queue",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can registr lab categoty",
                 "consumes": [
                     "application/json"
                 ],
@@ -3338,25 +3873,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Queue"
+                    "Lab Category"
                 ],
-                "summary": "update patient queue",
+                "summary": "Create lab category",
                 "parameters": [
                     {
-                        "description": "UpdatePatientModel",
+                        "description": "Body",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateQueueReq"
+                            "$ref": "#/definitions/models.CategoryModel"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.PatientQueueResp"
+                            "$ref": "#/definitions/models.CategoryModelResp"
                         }
                     },
                     "400": {
@@ -3374,9 +3909,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/sqlad-create": {
-            "post": {
-                "description": "This api can registr sqlad product info",
+        "/v1/lab-category-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete lab category",
                 "consumes": [
                     "application/json"
                 ],
@@ -3384,25 +3924,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sqlad"
+                    "Lab Category"
                 ],
-                "summary": "Create sqlad product info",
+                "summary": "Delete lab category",
                 "parameters": [
                     {
-                        "description": "Body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SqladReqModel"
-                        }
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SqladRespModel"
+                            "$ref": "#/definitions/models.ResponseOK"
                         }
                     },
                     "400": {
@@ -3420,9 +3958,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/sqlad-delete/{id}": {
-            "delete": {
-                "description": "This api can delete product in sqlad",
+        "/v1/lab-category-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find lab categories",
                 "consumes": [
                     "application/json"
                 ],
@@ -3430,15 +3973,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sqlad"
+                    "Lab Category"
                 ],
-                "summary": "Delete sqlad info",
+                "summary": "Find lab category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -3446,7 +4001,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseOK"
+                            "$ref": "#/definitions/models.CategoriesResp"
                         }
                     },
                     "400": {
@@ -3464,9 +4019,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/sqlad-get": {
+        "/v1/lab-category-get": {
             "get": {
-                "description": "This api can get sqlad",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get lab category",
                 "consumes": [
                     "application/json"
                 ],
@@ -3474,9 +4034,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sqlad"
+                    "Lab Category"
                 ],
-                "summary": "Get sqlad product info",
+                "summary": "Get lab category",
                 "parameters": [
                     {
                         "type": "string",
@@ -3495,7 +4055,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SqladRespModel"
+                            "$ref": "#/definitions/models.CategoryModelResp"
                         }
                     },
                     "400": {
@@ -3513,9 +4073,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/sqlad-update/{id}": {
+        "/v1/lab-category-update/{id}": {
             "post": {
-                "description": "This api can update doctor report",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update lab catigory",
                 "consumes": [
                     "application/json"
                 ],
@@ -3523,9 +4088,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sqlad"
+                    "Lab Category"
                 ],
-                "summary": "Update sqlad product info",
+                "summary": "Update lab category",
                 "parameters": [
                     {
                         "type": "string",
@@ -3540,7 +4105,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SqladReqModel"
+                            "$ref": "#/definitions/models.CategoryModel"
                         }
                     }
                 ],
@@ -3548,7 +4113,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.SqladRespModel"
+                            "$ref": "#/definitions/models.CategoryModelResp"
                         }
                     },
                     "400": {
@@ -3565,208 +4130,6736 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.AnalysisReqModel": {
-            "type": "object",
-            "properties": {
-                "analysis_url": {
-                    "type": "string"
-                },
-                "aparat_id": {
-                    "type": "string"
-                },
-                "client_id": {
-                    "type": "integer"
+        },
+        "/v1/lab-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can registr lab",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab"
+                ],
+                "summary": "Create lab",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LabModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.LabModelResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete lab",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab"
+                ],
+                "summary": "Delete lab",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find labs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab"
+                ],
+                "summary": "Find labs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LabsResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get lab",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab"
+                ],
+                "summary": "Get lab",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "name": "field",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LabModelResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-sub-category-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can registr Lab sub category create",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab sub category"
+                ],
+                "summary": "Create Lab sub category",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SubCategoryModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubCategoryResModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-sub-category-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete Lab sub category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab sub category"
+                ],
+                "summary": "Delete Lab sub category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-sub-category-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find lab sub categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab sub category"
+                ],
+                "summary": "Find Lab sub category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubCategoriesResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-sub-category-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get Lab sub category create",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab sub category"
+                ],
+                "summary": "Get Lab sub category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "name": "field",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubCategoryResModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-sub-category-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update Lab sub category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab sub category"
+                ],
+                "summary": "Update Lab sub category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateSubCategory"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CategoryModelResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/lab-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update lab",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lab"
+                ],
+                "summary": "Update lab",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LabModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LabModelResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/low-stock": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a list of low stock",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "Find low stochs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LowStocksRespModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/media/photo/": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Through this api frontent can upload photo and get the link to the media.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Upload media",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "analysis_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "phone_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MediaResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/online-transaction-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get an online transaction by the provider and its id at the provider",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "online transaction get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider",
+                        "name": "provider",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID at the provider",
+                        "name": "transaction_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OnlineTransaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can patient registr",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "create patient",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePatientModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-debt-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can create a debt of the patient to be paid until term_date (YYYY-MM-DD)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debt"
+                ],
+                "summary": "create patient debt",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientDebtCreateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientDebt"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-debt-info": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get the not paid debts of the patient and their total amount",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debt"
+                ],
+                "summary": "patient debt info",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientDebtInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-debts-overdue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can list the not paid debts whose term_date is before date (YYYY-MM-DD, today by default)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Debt"
+                ],
+                "summary": "overdue patient debts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientDebtsResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "delete patient",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "find patients",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientsResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "get patient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/patient-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update patient",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patient"
+                ],
+                "summary": "update patient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdatePatientModel",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePatientModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payment-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create payment history on the open shift of the cashier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment history"
+                ],
+                "summary": "create payment history",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePaymentHistoryReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PaymentHistoryResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payment-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find cashbox",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment history"
+                ],
+                "summary": "Find cashbox",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "from_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "refunds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PaymentHistoriesResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payment-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get payment history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment history"
+                ],
+                "summary": "get payment history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PaymentHistoryResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payments/simulator/pay": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api is the payment page of the simulator provider, it pays the link at once by sending the signed prepare and complete callbacks, with cancel=true the complete cancels the transaction. It is served to admins only when PAYMENT_SIMULATOR is on, which is refused in production",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "simulator payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cashbox ID",
+                        "name": "transaction_param",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Amount",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Cancel the payment",
+                        "name": "cancel",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SimulatorPayment"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payments/{provider}/callback": {
+            "post": {
+                "description": "This api is called by the payment provider to check, perform and cancel its transactions, the request is signed by the provider",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "payment provider callback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider: click or simulator",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll-report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api computes what the doctors earned from the services paid in the dates, doctor, lab and aparat services alike. Dates are like 2006-01-02, both included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "get payroll report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "to_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "doctor_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PayrollReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll-report-export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api exports the payroll report as csv, a row for each service of each doctor",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "export payroll report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "to_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "doctor_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/purchase-order-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can draft an order of sqlad from the supplier, items without a unit_cost cost the price of the sqlad",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "create purchase order",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrder"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/purchase-order-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find the purchase orders newest first. Dates are like 2006-01-02, both included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "find purchase orders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "dates like 2006-01-02 the orders were created in, both included",
+                        "name": "from_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "supplier_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrders"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/purchase-order-get/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get purchase order by id with its items and what was received of them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "get purchase order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrder"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/purchase-order-low-stock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api drafts an order for each supplier of the sqlad low on stock, bringing the stock up to twice the low stock level less what the open orders are still to bring. The sqlad without a supplier is listed to order by hand",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "order low stock",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LowStockOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.LowStockOrders"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/purchase-order-receive/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api adds what arrived of an ordered order to the stock, each item as a new lot. Items can arrive in parts up to the quantity ordered, the order becomes received when everything arrived",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "receive purchase order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrderReceipt"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrder"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/purchase-order-status/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can send a draft order to the supplier (ordered), cancel an order nothing was received of yet (cancelled) or close an order received short (received)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "set purchase order status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrderStatusReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrder"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/purchase-order-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can replace the supplier, note and items of a draft order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "update purchase order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PurchaseOrder"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-board": {
+            "get": {
+                "description": "This api streams the queue board of the service for waiting room screens as server-sent events.\nA \"queue\" event with who is called, the room and who is next is sent on connect and after every queue change.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "live queue board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service type (doctor, lab, aparat)",
+                        "name": "service_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "How many next patients to show, 5 by default",
+                        "name": "next_limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.QueueBoard"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-call-next": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can call the next waiting (recalled first) patient of the service for today",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "call next patient",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CallNextReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-check-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can check patient queue",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "check patient queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "service_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.QueueNumber"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-complete/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can move an in_service queue to done",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "complete patient queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create patient queue",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "create patient queue",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePatientQueueReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find patient queues",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "Find patient queues",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "service_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "service_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.QueuesResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get patient queue",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "get patient queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-no-show/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can mark a not served queue as no_show",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "mark patient queue as no show",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-recall/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can put a skipped or no_show queue back in line, it is called before waiting ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "recall patient queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-skip/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can skip a waiting, called or recalled queue",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "skip patient queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/queue-start/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can move a called queue to in_service",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queue"
+                ],
+                "summary": "start serving patient queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PatientQueueResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/receipt": {
+            "get": {
+                "description": "The QR code of a printed receipt links here, the link is signed instead of the staff signing in",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Cashbox"
+                ],
+                "summary": "get cashbox receipt by its public link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cashbox id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the link",
+                        "name": "sig",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "58mm, 80mm or a4, a4 when empty",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/shift-close": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can close the open shift of the logged in cashier with the counted cash, the response is the Z-report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shift"
+                ],
+                "summary": "close shift",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ShiftCloseReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/shift-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find shifts newest first, a cashier finds only own shifts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shift"
+                ],
+                "summary": "find shifts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "from_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "staff_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ShiftsResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/shift-get/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get the shift to reprint its Z-report, an open shift has the report up to now",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shift"
+                ],
+                "summary": "get shift",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/shift-open": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can open the shift of the logged in cashier, payments are taken on it until it is closed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shift"
+                ],
+                "summary": "open shift",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ShiftOpenReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Shift"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialty-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can add a specialty to the catalog, its price is the default consultation price of its doctors and slot_minutes the length of their appointments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialty"
+                ],
+                "summary": "create specialty",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SpecialtyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Specialty"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialty-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete specialty no doctor has",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialty"
+                ],
+                "summary": "delete specialty",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialty-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find the specialties of the catalog by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialty"
+                ],
+                "summary": "find specialties",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Specialties"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialty-get/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get specialty by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialty"
+                ],
+                "summary": "get specialty",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Specialty"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/specialty-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update specialty, a new name shows on all its doctors, prices already set on the doctors stay",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Specialty"
+                ],
+                "summary": "update specialty",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SpecialtyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Specialty"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-balance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api tells the stock at the end of the date like 2006-01-02, today when empty, of every sqlad when sqlad_id is empty",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "get sqlad balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sqlad ID",
+                        "name": "sqlad_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladBalances"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-bom-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api tells the sqlad a lab or aparat service consumes each time it is performed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "get sqlad bill of materials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "lab or aparat",
+                        "name": "service_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service ID",
+                        "name": "service_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladBom"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-bom-set": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can set the sqlad a lab or aparat service consumes each time it is performed, recording its analysis takes them off the stock. The list replaces the one the service had, an empty list stops the service consuming",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "set sqlad bill of materials",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladBom"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladBom"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can registr sqlad product info, its count is received as the opening stock",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "Create sqlad product info",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladReqModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladRespModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete product in sqlad",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "Delete sqlad info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-get": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get sqlad",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "Get sqlad product info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "name": "field",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladRespModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-lots/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find the lots of the sqlad first expiring first, the used up ones too when with_empty",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "find sqlad lots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sqlad ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "With empty",
+                        "name": "with_empty",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladLots"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-movement-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can move the stock of the sqlad: receipt from a supplier, issue to a doctor, lab or aparat, write-off or adjustment. The stock can not go below zero. Stock added makes a new lot unless lot_id is given, stock taken comes from the lot_id or from the lots expiring first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "create sqlad movement",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladMovementReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladMovement"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-movement-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find the movements of the stock newest first, with the stock after each of them. Dates are like 2006-01-02, both included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "find sqlad movements",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "from_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sqlad_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladMovements"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sqlad-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update sqlad product info, the count is left as is: the stock changes by movements only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sqlad"
+                ],
+                "summary": "Update sqlad product info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladReqModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SqladRespModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/staff-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can create staff (receptionist, cashier, doctor, lab_technician, admin)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Staff"
+                ],
+                "summary": "create staff",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateStaffModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.StaffModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/staff-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete staff",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Staff"
+                ],
+                "summary": "delete staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/staff-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find staffs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Staff"
+                ],
+                "summary": "find staffs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StaffsResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/staff-get/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get staff by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Staff"
+                ],
+                "summary": "get staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StaffModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/staff-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update staff",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Staff"
+                ],
+                "summary": "update staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateStaffModel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StaffModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktake-cancel/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can cancel an open stocktake, the stock is left as is",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktake"
+                ],
+                "summary": "cancel stocktake",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Stocktake"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktake-count/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can enter what the staff member counted, counting a sqlad or lot again replaces their count and the counts of several staff members add up. A sqlad is counted either by lots or as a whole",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktake"
+                ],
+                "summary": "enter stocktake counts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.StocktakeCountReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Stocktake"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktake-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find the stocktakes newest first, without their lines and counts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktake"
+                ],
+                "summary": "find stocktakes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Stocktakes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktake-get/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get stocktake to review the counts against the balance, with only_discrepancies only the counted lines differing from it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktake"
+                ],
+                "summary": "get stocktake",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only discrepancies",
+                        "name": "only_discrepancies",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Stocktake"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktake-post/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api adjusts the stock of everything counted to the count by adjustment movements and closes the stocktake, the sqlad nobody counted is left as is",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktake"
+                ],
+                "summary": "post stocktake",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Stocktake"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktake-report/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api prints the counted lines of the stocktake differing from the balance as an A4 PDF",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Stocktake"
+                ],
+                "summary": "print stocktake discrepancies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stocktake-start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can start counting the storeroom, only one stocktake is open at a time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stocktake"
+                ],
+                "summary": "start stocktake",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.StocktakeStartReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Stocktake"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier-create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can add a supplier to the directory, sqlad is ordered from its supplier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "create supplier",
+                "parameters": [
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SupplierReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Supplier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier-delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can delete supplier without open purchase orders, its sqlad is left without a supplier",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "delete supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseOK"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier-find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can find the suppliers of the directory by name, contact person, phone number or email",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "find suppliers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Suppliers"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier-get/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can get supplier by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "get supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Supplier"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier-spend-report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api sums what was received from the suppliers in the dates at the cost of the orders, per sqlad. Dates are like 2006-01-02, both included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Purchase"
+                ],
+                "summary": "get supplier spend report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "to_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Supplier ID",
+                        "name": "supplier_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SupplierSpendReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/supplier-update/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This api can update supplier, a new name shows on all its sqlad",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Supplier"
+                ],
+                "summary": "update supplier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SupplierReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Supplier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.DefaultResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AnalysisReqModel": {
+            "type": "object",
+            "properties": {
+                "analysis_url": {
+                    "type": "string"
+                },
+                "aparat_id": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AnalysisRespModel": {
+            "type": "object",
+            "properties": {
+                "analysis_url": {
+                    "type": "string"
+                },
+                "aparat_id": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AparatModelResp": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "room_number": {
+                    "type": "string"
+                },
+                "sub_category_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AparatsResp": {
+            "type": "object",
+            "properties": {
+                "aparats": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AparatModelResp"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Appointment": {
+            "type": "object",
+            "properties": {
+                "cancel_reason": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "checked_in_at": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "queue": {
+                    "$ref": "#/definitions/models.PatientQueueResp"
+                },
+                "queue_id": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AppointmentCancelReq": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AppointmentCreateReq": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "integer"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "description": "clinic local time of a free slot like 2006-01-02 15:04",
+                    "type": "string"
+                }
+            }
+        },
+        "models.AppointmentRescheduleReq": {
+            "type": "object",
+            "properties": {
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AppointmentSlot": {
+            "type": "object",
+            "properties": {
+                "appointment_id": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "free": {
+                    "type": "boolean"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AppointmentSlotsResp": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "slot_minutes": {
+                    "type": "integer"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AppointmentSlot"
+                    }
+                }
+            }
+        },
+        "models.AppointmentsResp": {
+            "type": "object",
+            "properties": {
+                "appointments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Appointment"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CallNextReq": {
+            "type": "object",
+            "properties": {
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CashboxDiscount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "gross": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CashboxItem": {
+            "type": "object",
+            "properties": {
+                "discount": {
+                    "type": "integer"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "refunded_quantity": {
+                    "type": "integer"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CashboxPayReq": {
+            "type": "object",
+            "properties": {
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CashboxPayment"
+                    }
+                }
+            }
+        },
+        "models.CashboxPayment": {
+            "type": "object",
+            "properties": {
+                "payment_type": {
+                    "type": "string"
+                },
+                "summa": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CashboxPrinterResp": {
+            "type": "object",
+            "properties": {
+                "cash_count": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "doctor_name": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "room_number": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                },
+                "summa": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CashboxRefundReq": {
+            "type": "object",
+            "properties": {
+                "payment_type": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CashboxResp": {
+            "type": "object",
+            "properties": {
+                "aparats_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cash_count": {
+                    "type": "integer"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "integer"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CashboxDiscount"
+                    }
+                },
+                "doctors_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "gross": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_payed": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CashboxItem"
+                    }
+                },
+                "labs_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "paid": {
+                    "type": "integer"
+                },
+                "payment_type": {
+                    "type": "string"
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaymentHistoryResp"
+                    }
+                },
+                "refunded": {
+                    "type": "integer"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "staff_id": {
+                    "type": "string"
+                },
+                "summa": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CashboxesPrinterResp": {
+            "type": "object",
+            "properties": {
+                "cashboxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CashboxPrinterResp"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "discount": {
+                    "type": "integer"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CashboxDiscount"
+                    }
+                },
+                "gross": {
+                    "type": "integer"
+                },
+                "net": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CategoriesResp": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryModelResp"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CategoryModel": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CategoryModelResp": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ChangePasswordReq": {
+            "type": "object",
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "old_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConsumptionLine": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "description": "issued to the service, by hand too",
+                    "type": "integer"
+                },
+                "difference": {
+                    "description": "actual less expected, positive when more was used than planned",
+                    "type": "integer"
+                },
+                "expected": {
+                    "description": "by the bill of materials of the analyses recorded",
+                    "type": "integer"
+                },
+                "performed": {
+                    "description": "analyses recorded for the service",
+                    "type": "integer"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_name": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                },
+                "sqlad_id": {
+                    "type": "string"
+                },
+                "sqlad_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ConsumptionReport": {
+            "type": "object",
+            "properties": {
+                "from_date": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ConsumptionLine"
+                    }
+                },
+                "to_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreateAparat": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "room_number": {
+                    "type": "string"
+                },
+                "sub_category_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreateCashboxReq": {
+            "type": "object",
+            "properties": {
+                "aparats_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "doctors_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "labs_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "payment_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreateDoctorModel": {
+            "type": "object",
+            "properties": {
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "room_number": {
+                    "type": "string"
+                },
+                "specialty": {
+                    "description": "name of a catalog specialty, used when specialty_ids are empty",
+                    "type": "string"
+                },
+                "specialty_ids": {
+                    "description": "the first one is the primary specialty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "work_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreatePatientModel": {
+            "type": "object",
+            "properties": {
+                "advertising_chanel": {
+                    "type": "string"
+                },
+                "condition": {
+                    "type": "string"
+                },
+                "date_of_birth": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "string"
+                },
+                "district": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "main_phone_number": {
+                    "type": "string"
+                },
+                "other_phone_number": {
+                    "type": "string"
+                },
+                "passport_info": {
+                    "type": "string"
+                },
+                "patronomic": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "respublic": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreatePatientQueueReq": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "integer"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreatePaymentHistoryReq": {
+            "type": "object",
+            "properties": {
+                "cashbox_id": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "payment_type": {
+                    "type": "string"
+                },
+                "summa": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CreateStaffModel": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "login": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DefaultResponse": {
+            "type": "object",
+            "properties": {
+                "error_code": {
+                    "type": "integer"
+                },
+                "error_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Discount": {
+            "type": "object",
+            "properties": {
+                "advertising_channel": {
+                    "type": "string"
+                },
+                "cap": {
+                    "type": "integer"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_to": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DiscountReq": {
+            "type": "object",
+            "properties": {
+                "advertising_channel": {
+                    "type": "string"
+                },
+                "cap": {
+                    "type": "integer"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_to": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DiscountsResp": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Discount"
+                    }
+                }
+            }
+        },
+        "models.DocPageFilterResModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "patient_info": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PatientInfo"
+                    }
+                }
+            }
+        },
+        "models.DoctorAvailability": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "available": {
+                    "type": "boolean"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "next_start": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "vacation, sick, absent, off_hours or no_schedule",
+                    "type": "string"
+                },
+                "substitute": {
+                    "$ref": "#/definitions/models.DoctorResp"
+                },
+                "substitute_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DoctorCommission": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DoctorCommissionReq": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "percent of what the clients paid, or fixed amount for each service",
+                    "type": "string"
+                },
+                "service_id": {
+                    "description": "the doctor, lab or aparat id, empty for any service of the type",
+                    "type": "string"
+                },
+                "service_type": {
+                    "description": "doctor, lab or aparat, empty for any service of the doctor",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.DoctorCommissions": {
+            "type": "object",
+            "properties": {
+                "commissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DoctorCommission"
+                    }
+                }
+            }
+        },
+        "models.DoctorException": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "date_from": {
+                    "type": "string"
+                },
+                "date_to": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "substitute_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DoctorExceptionReq": {
+            "type": "object",
+            "properties": {
+                "date_from": {
+                    "type": "string"
+                },
+                "date_to": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "vacation, sick, absent or hours",
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "description": "the absence or the custom hours within the days, whole days when empty",
+                    "type": "string"
+                },
+                "substitute_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DoctorExceptions": {
+            "type": "object",
+            "properties": {
+                "exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DoctorException"
+                    }
+                }
+            }
+        },
+        "models.DoctorReportsModel": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DoctorReportsModelRes": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DoctorReportsResp": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DoctorReportsModelRes"
+                    }
+                }
+            }
+        },
+        "models.DoctorResp": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "room_number": {
+                    "type": "string"
+                },
+                "specialties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Specialty"
+                    }
+                },
+                "specialty": {
+                    "type": "string"
+                },
+                "specialty_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "work_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DoctorType": {
+            "type": "object",
+            "properties": {
+                "doctor_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DoctorTypes": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "doctor_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DoctorType"
+                    }
+                }
+            }
+        },
+        "models.DoctorWorkHours": {
+            "type": "object",
+            "properties": {
+                "breaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkInterval"
+                    }
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkInterval"
+                    }
+                }
+            }
+        },
+        "models.DoctorWorkHoursReq": {
+            "type": "object",
+            "properties": {
+                "breaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkInterval"
+                    }
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkInterval"
+                    }
+                }
+            }
+        },
+        "models.DoctorsResp": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "doctors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DoctorResp"
+                    }
+                }
+            }
+        },
+        "models.DoctorsWorking": {
+            "type": "object",
+            "properties": {
+                "doctors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WorkingDoctor"
+                    }
+                }
+            }
+        },
+        "models.FindCashboxResp": {
+            "type": "object",
+            "properties": {
+                "cashboxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CashboxResp"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "discount": {
+                    "type": "integer"
+                },
+                "gross": {
+                    "type": "integer"
+                },
+                "net": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LabModel": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "room_number": {
+                    "type": "string"
+                },
+                "sub_category_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "models.AnalysisRespModel": {
+        "models.LabModelResp": {
             "type": "object",
             "properties": {
-                "analysis_url": {
+                "created_at": {
                     "type": "string"
                 },
-                "aparat_id": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "room_number": {
+                    "type": "string"
+                },
+                "sub_category_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LabsResp": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "labs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LabModelResp"
+                    }
+                }
+            }
+        },
+        "models.LoginReq": {
+            "type": "object",
+            "properties": {
+                "login": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LowStockOrderReq": {
+            "type": "object",
+            "properties": {
+                "supplier_id": {
+                    "description": "orders only the sqlad of the supplier when given",
+                    "type": "string"
+                }
+            }
+        },
+        "models.LowStockOrders": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "description": "one draft order per supplier",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PurchaseOrder"
+                    }
+                },
+                "without_supplier": {
+                    "description": "sqlad low on stock without a supplier to order from",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SqladRespModel"
+                    }
+                }
+            }
+        },
+        "models.LowStocksRespModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "low_stocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SqladRespModel"
+                    }
+                }
+            }
+        },
+        "models.MediaResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "$ref": "#/definitions/models.UploadPhotoRes"
+                },
+                "error_code": {
+                    "type": "integer"
+                },
+                "error_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.OnlineTransaction": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "cancel_reason": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "cashbox_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payment_id": {
+                    "type": "string"
+                },
+                "performed_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PatientDebt": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "cashbox_id": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "initial_amount": {
+                    "type": "number"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "term_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PatientDebtCreateReq": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "cashbox_id": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "term_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PatientDebtInfo": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "debts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PatientDebt"
+                    }
+                }
+            }
+        },
+        "models.PatientDebtsResp": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "debts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PatientDebt"
+                    }
+                }
+            }
+        },
+        "models.PatientInfo": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "integer"
+                },
+                "date_last_visit": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "patient_id": {
+                    "type": "string"
+                },
+                "patient_reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DoctorReportsModelRes"
+                    }
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "queue_number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PatientModel": {
+            "type": "object",
+            "properties": {
+                "advertising_chanel": {
                     "type": "string"
                 },
-                "client_id": {
+                "clientId": {
                     "type": "integer"
                 },
+                "condition": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "date_of_birth": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "string"
+                },
+                "district": {
+                    "type": "string"
+                },
+                "doctor_id": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "last_name": {
+                    "type": "string"
+                },
+                "main_phone_number": {
+                    "type": "string"
+                },
+                "other_phone_number": {
+                    "type": "string"
+                },
+                "passport_info": {
+                    "type": "string"
+                },
+                "patronomic": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "respublic": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.AparatModelResp": {
+        "models.PatientQueueResp": {
             "type": "object",
             "properties": {
+                "called_at": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "done_at": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "in_service_at": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "no_show_at": {
+                    "type": "string"
                 },
-                "sub_category_id": {
+                "queue_day": {
                     "type": "string"
                 },
-                "type": {
+                "queue_number": {
+                    "type": "integer"
+                },
+                "recalled_at": {
+                    "type": "string"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                },
+                "skipped_at": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 },
+                "turn_passed": {
+                    "type": "boolean"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.AparatsResp": {
+        "models.PatientsResp": {
             "type": "object",
             "properties": {
-                "aparats": {
+                "count": {
+                    "type": "integer"
+                },
+                "patients": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.AparatModelResp"
+                        "$ref": "#/definitions/models.PatientModel"
                     }
-                },
+                }
+            }
+        },
+        "models.PaymentHistoriesResp": {
+            "type": "object",
+            "properties": {
                 "count": {
                     "type": "integer"
+                },
+                "income": {
+                    "type": "integer"
+                },
+                "payment_histories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PaymentHistoryResp"
+                    }
+                },
+                "refunds": {
+                    "type": "integer"
                 }
             }
         },
-        "models.CashboxPrinterResp": {
+        "models.PaymentHistoryResp": {
             "type": "object",
             "properties": {
-                "cash_count": {
+                "cashbox_id": {
+                    "type": "string"
+                },
+                "client_id": {
                     "type": "integer"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "doctor_name": {
+                "fiscal_receipt_number": {
                     "type": "string"
                 },
-                "first_name": {
+                "fiscal_sign": {
                     "type": "string"
                 },
-                "image_url": {
+                "fiscal_status": {
+                    "description": "pending until the fiscal module registers the payment, then registered",
                     "type": "string"
                 },
-                "last_name": {
+                "fiscalized_at": {
                     "type": "string"
                 },
-                "room_number": {
+                "id": {
+                    "type": "string"
+                },
+                "payment_type": {
+                    "type": "string"
+                },
+                "refund_reason": {
+                    "type": "string"
+                },
+                "service_id": {
                     "type": "string"
                 },
                 "service_type": {
                     "type": "string"
                 },
+                "staff_id": {
+                    "type": "string"
+                },
                 "summa": {
                     "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PaymentLink": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "cashbox_id": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "models.CashboxResp": {
+        "models.PaymentLinkReq": {
             "type": "object",
             "properties": {
-                "aparats_ids": {
+                "provider": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PayrollDoctor": {
+            "type": "object",
+            "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
+                "earnings": {
+                    "type": "integer"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "lines": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.PayrollLine"
                     }
                 },
-                "cash_count": {
+                "revenue": {
                     "type": "integer"
+                }
+            }
+        },
+        "models.PayrollLine": {
+            "type": "object",
+            "properties": {
+                "commission_id": {
+                    "description": "the rule applied, empty when the doctor has none for the service",
+                    "type": "string"
                 },
-                "client_id": {
+                "earnings": {
                     "type": "integer"
                 },
-                "created_at": {
+                "kind": {
                     "type": "string"
                 },
-                "doctors_ids": {
+                "name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "revenue": {
+                    "type": "integer"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PayrollReport": {
+            "type": "object",
+            "properties": {
+                "doctors": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.PayrollDoctor"
                     }
                 },
-                "id": {
+                "earnings": {
+                    "type": "integer"
+                },
+                "from_date": {
                     "type": "string"
                 },
-                "is_payed": {
-                    "type": "boolean"
+                "revenue": {
+                    "type": "integer"
                 },
-                "labs_ids": {
+                "to_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PurchaseOrder": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.PurchaseOrderItem"
                     }
                 },
-                "summa": {
+                "note": {
+                    "type": "string"
+                },
+                "number": {
+                    "description": "sequential number printed on the order",
+                    "type": "integer"
+                },
+                "ordered_at": {
+                    "type": "string"
+                },
+                "received_total": {
+                    "description": "cost of the quantities received",
+                    "type": "number"
+                },
+                "status": {
+                    "description": "draft, ordered, partially_received, received or cancelled",
+                    "type": "string"
+                },
+                "supplier_id": {
+                    "type": "string"
+                },
+                "supplier_name": {
+                    "type": "string"
+                },
+                "total": {
+                    "description": "cost of the quantities ordered",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PurchaseOrderItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "received": {
+                    "type": "integer"
+                },
+                "sqlad_id": {
+                    "type": "string"
+                },
+                "sqlad_name": {
+                    "type": "string"
+                },
+                "unit_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.PurchaseOrderItemReq": {
+            "type": "object",
+            "properties": {
+                "quantity": {
                     "type": "integer"
                 },
-                "updated_at": {
+                "sqlad_id": {
                     "type": "string"
+                },
+                "unit_cost": {
+                    "description": "the price of the sqlad when empty",
+                    "type": "number"
                 }
             }
         },
-        "models.CashboxesPrinterResp": {
+        "models.PurchaseOrderReceipt": {
             "type": "object",
             "properties": {
-                "cashboxes": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CashboxPrinterResp"
+                        "$ref": "#/definitions/models.PurchaseOrderReceiptItem"
                     }
+                }
+            }
+        },
+        "models.PurchaseOrderReceiptItem": {
+            "type": "object",
+            "properties": {
+                "expiration_date": {
+                    "type": "string"
                 },
-                "count": {
+                "item_id": {
+                    "type": "string"
+                },
+                "lot_number": {
+                    "description": "lot of the stock received",
+                    "type": "string"
+                },
+                "quantity": {
                     "type": "integer"
                 }
             }
         },
-        "models.CategoriesResp": {
+        "models.PurchaseOrderReq": {
             "type": "object",
             "properties": {
-                "category": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CategoryModelResp"
+                        "$ref": "#/definitions/models.PurchaseOrderItemReq"
                     }
                 },
-                "count": {
-                    "type": "integer"
+                "note": {
+                    "type": "string"
+                },
+                "supplier_id": {
+                    "type": "string"
                 }
             }
         },
-        "models.CategoryModel": {
+        "models.PurchaseOrderStatusReq": {
             "type": "object",
             "properties": {
-                "name": {
+                "status": {
+                    "description": "ordered, cancelled or received to close an order received short",
                     "type": "string"
                 }
             }
         },
-        "models.CategoryModelResp": {
+        "models.PurchaseOrders": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "count": {
+                    "type": "integer"
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PurchaseOrder"
+                    }
+                }
+            }
+        },
+        "models.QueueBoard": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.QueueBoardEntry"
+                    }
+                },
+                "next": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.QueueBoardEntry"
+                    }
+                },
+                "queue_day": {
                     "type": "string"
                 },
-                "id": {
+                "room_number": {
                     "type": "string"
                 },
-                "name": {
+                "service_id": {
+                    "type": "string"
+                },
+                "service_name": {
+                    "type": "string"
+                },
+                "service_type": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -3774,726 +10867,986 @@ const docTemplate = `{
                 }
             }
         },
-        "models.CreateAparat": {
+        "models.QueueBoardEntry": {
             "type": "object",
             "properties": {
-                "name": {
+                "called_at": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
-                },
-                "sub_category_id": {
+                "queue_id": {
                     "type": "string"
                 },
-                "type": {
+                "queue_number": {
+                    "type": "integer"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "models.CreateCashboxReq": {
+        "models.QueueNumber": {
             "type": "object",
             "properties": {
-                "aparats_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "client_id": {
+                "queue_number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.QueuesResp": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer"
                 },
-                "doctors_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "is_payed": {
-                    "type": "boolean"
-                },
-                "labs_ids": {
+                "queues": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.PatientQueueResp"
                     }
-                },
-                "payment_type": {
-                    "type": "string"
                 }
             }
         },
-        "models.CreateDoctorModel": {
+        "models.RefreshTokenReq": {
             "type": "object",
             "properties": {
-                "first_name": {
-                    "type": "string"
-                },
-                "gender": {
-                    "type": "string"
-                },
-                "last_name": {
-                    "type": "string"
-                },
-                "phone_number": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "number"
-                },
-                "room_number": {
+                "refresh_token": {
                     "type": "string"
-                },
-                "specialty": {
+                }
+            }
+        },
+        "models.ResponseError": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string"
-                },
-                "work_time": {
+                }
+            }
+        },
+        "models.ResponseOK": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "models.CreatePatientModel": {
+        "models.Shift": {
             "type": "object",
             "properties": {
-                "advertising_chanel": {
+                "closed_at": {
                     "type": "string"
                 },
-                "condition": {
-                    "type": "string"
+                "counted_cash": {
+                    "type": "integer"
                 },
-                "date_of_birth": {
-                    "type": "string"
+                "expected_cash": {
+                    "type": "integer"
                 },
-                "discount": {
+                "id": {
                     "type": "string"
                 },
-                "district": {
+                "opened_at": {
                     "type": "string"
                 },
-                "doctor_id": {
-                    "type": "string"
+                "opening_cash": {
+                    "type": "integer"
                 },
-                "first_name": {
-                    "type": "string"
+                "receipts": {
+                    "type": "integer"
                 },
-                "gender": {
-                    "type": "string"
+                "refunds": {
+                    "type": "integer"
                 },
-                "last_name": {
-                    "type": "string"
+                "refunds_count": {
+                    "type": "integer"
                 },
-                "main_phone_number": {
+                "staff_id": {
                     "type": "string"
                 },
-                "other_phone_number": {
-                    "type": "string"
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ShiftPaymentTotal"
+                    }
                 },
-                "passport_info": {
-                    "type": "string"
+                "unpaid_cashboxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ShiftUnpaidCashbox"
+                    }
+                }
+            }
+        },
+        "models.ShiftCloseReq": {
+            "type": "object",
+            "properties": {
+                "counted_cash": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ShiftOpenReq": {
+            "type": "object",
+            "properties": {
+                "opening_cash": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ShiftPaymentTotal": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "patronomic": {
+                "payment_type": {
                     "type": "string"
                 },
-                "region": {
+                "summa": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ShiftUnpaidCashbox": {
+            "type": "object",
+            "properties": {
+                "cashbox_id": {
                     "type": "string"
                 },
-                "respublic": {
+                "client_id": {
+                    "type": "integer"
+                },
+                "paid": {
+                    "type": "integer"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "summa": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ShiftsResp": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Shift"
+                    }
+                }
+            }
+        },
+        "models.SimulatorPayment": {
+            "type": "object",
+            "properties": {
+                "complete": {},
+                "prepare": {},
+                "transaction_id": {
                     "type": "string"
                 }
             }
         },
-        "models.CreatePatientQueueReq": {
+        "models.Specialties": {
             "type": "object",
             "properties": {
-                "client_id": {
+                "count": {
                     "type": "integer"
                 },
-                "service_id": {
+                "specialties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Specialty"
+                    }
+                }
+            }
+        },
+        "models.Specialty": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "service_type": {
+                "doctors_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "slot_minutes": {
+                    "type": "integer"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.CreatePaymentHistoryReq": {
+        "models.SpecialtyReq": {
             "type": "object",
             "properties": {
-                "cashbox_id": {
+                "name": {
                     "type": "string"
                 },
-                "client_id": {
-                    "type": "integer"
-                },
-                "payment_type": {
-                    "type": "string"
+                "price": {
+                    "description": "default consultation price of the doctors of the specialty",
+                    "type": "number"
                 },
-                "summa": {
+                "slot_minutes": {
+                    "description": "appointment length, 30 when empty",
                     "type": "integer"
                 }
             }
         },
-        "models.DefaultResponse": {
+        "models.SqladBalance": {
             "type": "object",
             "properties": {
-                "error_code": {
+                "count": {
                     "type": "integer"
                 },
-                "error_message": {
+                "name": {
                     "type": "string"
+                },
+                "sqlad_id": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
                 }
             }
         },
-        "models.DocPageFilterResModel": {
+        "models.SqladBalances": {
             "type": "object",
             "properties": {
-                "patientInfo": {
+                "balances": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PatientInfo"
+                        "$ref": "#/definitions/models.SqladBalance"
                     }
-                }
-            }
-        },
-        "models.DoctorReportsModel": {
-            "type": "object",
-            "properties": {
-                "client_id": {
-                    "type": "string"
-                },
-                "doctor_id": {
-                    "type": "string"
                 },
-                "text": {
+                "date": {
                     "type": "string"
                 }
             }
         },
-        "models.DoctorReportsModelRes": {
+        "models.SqladBom": {
             "type": "object",
             "properties": {
-                "client_id": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "doctor_id": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SqladBomItem"
+                    }
                 },
-                "text": {
+                "service_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "service_type": {
+                    "description": "lab or aparat",
                     "type": "string"
                 }
             }
         },
-        "models.DoctorReportsResp": {
+        "models.SqladBomItem": {
             "type": "object",
             "properties": {
-                "count": {
+                "quantity": {
+                    "description": "taken off the stock each time the service is performed",
                     "type": "integer"
                 },
-                "reports": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DoctorReportsModelRes"
-                    }
+                "sqlad_id": {
+                    "type": "string"
+                },
+                "sqlad_name": {
+                    "type": "string"
                 }
             }
         },
-        "models.DoctorResp": {
+        "models.SqladLot": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "first_name": {
-                    "type": "string"
+                "days_left": {
+                    "description": "negative when expired",
+                    "type": "integer"
                 },
-                "gender": {
+                "expiration_date": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "last_name": {
-                    "type": "string"
-                },
-                "phone_number": {
+                "number": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "received": {
+                    "type": "integer"
                 },
-                "room_number": {
-                    "type": "string"
+                "remaining": {
+                    "type": "integer"
                 },
-                "specialty": {
+                "sqlad_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "sqlad_name": {
                     "type": "string"
                 },
-                "work_time": {
-                    "type": "string"
+                "unit_cost": {
+                    "type": "number"
                 }
             }
         },
-        "models.DoctorType": {
+        "models.SqladLotTake": {
             "type": "object",
             "properties": {
-                "doctor_type": {
+                "expiration_date": {
+                    "type": "string"
+                },
+                "lot_id": {
+                    "type": "string"
+                },
+                "lot_number": {
                     "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
                 }
             }
         },
-        "models.DoctorTypes": {
+        "models.SqladLots": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "doctor_types": {
+                "lots": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.DoctorType"
+                        "$ref": "#/definitions/models.SqladLot"
                     }
                 }
             }
         },
-        "models.DoctorsResp": {
+        "models.SqladMovement": {
             "type": "object",
             "properties": {
-                "count": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "balance": {
+                    "description": "stock after the movement",
                     "type": "integer"
                 },
-                "doctors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DoctorResp"
-                    }
-                }
-            }
-        },
-        "models.FindCashboxResp": {
-            "type": "object",
-            "properties": {
-                "cashboxes": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "lots": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CashboxResp"
+                        "$ref": "#/definitions/models.SqladLotTake"
                     }
                 },
-                "count": {
-                    "type": "integer"
-                }
-            }
-        },
-        "models.LabModel": {
-            "type": "object",
-            "properties": {
-                "name": {
+                "note": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "quantity": {
+                    "type": "integer"
                 },
-                "sub_category_id": {
+                "sqlad_id": {
                     "type": "string"
                 },
-                "type": {
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
                     "type": "string"
+                },
+                "unit_cost": {
+                    "type": "number"
                 }
             }
         },
-        "models.LabModelResp": {
+        "models.SqladMovementReq": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "expiration_date": {
                     "type": "string"
                 },
-                "id": {
+                "kind": {
+                    "description": "receipt, issue, write_off or adjustment",
                     "type": "string"
                 },
-                "name": {
+                "lot_id": {
+                    "description": "lot to take from or to add to, first expiring lots or a new lot when empty",
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "lot_number": {
+                    "description": "batch number and expiry like 2006-01-02 of the new lot",
+                    "type": "string"
                 },
-                "sub_category_id": {
+                "note": {
                     "type": "string"
                 },
-                "type": {
+                "quantity": {
+                    "description": "signed for adjustments, issues and write-offs are taken off the stock",
+                    "type": "integer"
+                },
+                "sqlad_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "target_id": {
                     "type": "string"
+                },
+                "target_type": {
+                    "description": "doctor, lab or aparat the stock is issued to",
+                    "type": "string"
+                },
+                "unit_cost": {
+                    "description": "the price of the sqlad when empty",
+                    "type": "number"
                 }
             }
         },
-        "models.LabsResp": {
+        "models.SqladMovements": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "labs": {
+                "movements": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.LabModelResp"
+                        "$ref": "#/definitions/models.SqladMovement"
                     }
                 }
             }
         },
-        "models.LowStocksRespModel": {
+        "models.SqladReqModel": {
             "type": "object",
             "properties": {
                 "count": {
+                    "description": "opening stock, ignored on update",
                     "type": "integer"
                 },
-                "low_stocks": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.SqladRespModel"
-                    }
-                }
-            }
-        },
-        "models.MediaResponse": {
-            "type": "object",
-            "properties": {
-                "body": {
-                    "$ref": "#/definitions/models.UploadPhotoRes"
+                "expiration_date": {
+                    "type": "string"
                 },
-                "error_code": {
+                "low_stock": {
                     "type": "integer"
                 },
-                "error_message": {
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "provider": {
+                    "description": "name of a supplier in the directory, used when supplier_id is empty",
+                    "type": "string"
+                },
+                "supplier_id": {
                     "type": "string"
                 }
             }
         },
-        "models.PatientInfo": {
+        "models.SqladRespModel": {
             "type": "object",
             "properties": {
-                "date_last_visit": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "full_name": {
+                "created_at": {
                     "type": "string"
                 },
-                "patientReports": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DoctorReportsModelRes"
-                    }
+                "expiration_date": {
+                    "type": "string"
                 },
-                "phone_number": {
+                "id": {
                     "type": "string"
                 },
-                "queue_number": {
+                "low_stock": {
                     "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "supplier_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "models.PatientModel": {
+        "models.StaffModel": {
             "type": "object",
             "properties": {
-                "advertising_chanel": {
+                "created_at": {
                     "type": "string"
                 },
-                "clientId": {
-                    "type": "integer"
+                "doctor_id": {
+                    "type": "string"
                 },
-                "condition": {
+                "first_name": {
                     "type": "string"
                 },
-                "created_at": {
+                "id": {
                     "type": "string"
                 },
-                "date_of_birth": {
+                "last_name": {
                     "type": "string"
                 },
-                "discount": {
+                "login": {
                     "type": "string"
                 },
-                "district": {
+                "phone_number": {
                     "type": "string"
                 },
-                "doctor_id": {
+                "role": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.StaffsResp": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "staffs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StaffModel"
+                    }
+                }
+            }
+        },
+        "models.Stocktake": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
                     "type": "string"
                 },
-                "first_name": {
-                    "type": "string"
+                "counts": {
+                    "description": "what each staff member counted",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StocktakeCount"
+                    }
                 },
-                "gender": {
+                "created_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "last_name": {
-                    "type": "string"
-                },
-                "main_phone_number": {
-                    "type": "string"
+                "lines": {
+                    "description": "counted and not counted stock, against the balance now while open and at posting once posted",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StocktakeLine"
+                    }
                 },
-                "other_phone_number": {
+                "note": {
                     "type": "string"
                 },
-                "passport_info": {
-                    "type": "string"
+                "number": {
+                    "description": "sequential number printed on the report",
+                    "type": "integer"
                 },
-                "patronomic": {
+                "posted_at": {
                     "type": "string"
                 },
-                "region": {
+                "posted_by": {
                     "type": "string"
                 },
-                "respublic": {
+                "status": {
+                    "description": "open, posted or cancelled",
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "value_difference": {
+                    "description": "value of the differences at the unit costs",
+                    "type": "number"
                 }
             }
         },
-        "models.PatientQueueResp": {
+        "models.StocktakeCount": {
             "type": "object",
             "properties": {
-                "client_id": {
-                    "type": "integer"
-                },
-                "created_at": {
+                "counter_id": {
                     "type": "string"
                 },
-                "id": {
+                "lot_id": {
+                    "description": "empty when the sqlad is counted as a whole",
                     "type": "string"
                 },
-                "queue_number": {
+                "quantity": {
                     "type": "integer"
                 },
-                "service_id": {
+                "sqlad_id": {
                     "type": "string"
                 },
-                "service_type": {
+                "sqlad_name": {
                     "type": "string"
                 },
-                "turn_passed": {
-                    "type": "boolean"
-                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.PatientsResp": {
+        "models.StocktakeCountItem": {
             "type": "object",
             "properties": {
-                "count": {
+                "lot_id": {
+                    "description": "counts the sqlad by lots when given, the lots not counted are counted zero",
+                    "type": "string"
+                },
+                "quantity": {
                     "type": "integer"
                 },
-                "patients": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.PatientModel"
-                    }
+                "sqlad_id": {
+                    "type": "string"
                 }
             }
         },
-        "models.PaymentHistoriesResp": {
+        "models.StocktakeCountReq": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "payment_histories": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PaymentHistoryResp"
+                        "$ref": "#/definitions/models.StocktakeCountItem"
                     }
                 }
             }
         },
-        "models.PaymentHistoryResp": {
+        "models.StocktakeLine": {
             "type": "object",
             "properties": {
-                "cashbox_id": {
-                    "type": "string"
+                "counted": {
+                    "description": "what was counted by everyone",
+                    "type": "integer"
                 },
-                "client_id": {
+                "difference": {
+                    "description": "counted less expected",
                     "type": "integer"
                 },
-                "created_at": {
+                "expected": {
+                    "description": "the balance of the system when the sqlad or lot was first counted, the balance now when nobody counted it",
+                    "type": "integer"
+                },
+                "expiration_date": {
                     "type": "string"
                 },
-                "id": {
+                "is_counted": {
+                    "description": "false when nobody counted the sqlad, posting leaves it as is",
+                    "type": "boolean"
+                },
+                "lot_id": {
                     "type": "string"
                 },
-                "payment_type": {
+                "lot_number": {
                     "type": "string"
                 },
-                "summa": {
-                    "type": "integer"
+                "movement_id": {
+                    "description": "adjustment movement of the difference once posted",
+                    "type": "string"
                 },
-                "updated_at": {
+                "sqlad_id": {
+                    "type": "string"
+                },
+                "sqlad_name": {
                     "type": "string"
+                },
+                "unit_cost": {
+                    "type": "number"
+                },
+                "value_difference": {
+                    "type": "number"
                 }
             }
         },
-        "models.QueueNumber": {
+        "models.StocktakeStartReq": {
             "type": "object",
             "properties": {
-                "queue_number": {
-                    "type": "integer"
+                "note": {
+                    "type": "string"
                 }
             }
         },
-        "models.QueuesResp": {
+        "models.Stocktakes": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "queues": {
+                "stocktakes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.PatientQueueResp"
+                        "$ref": "#/definitions/models.Stocktake"
                     }
                 }
             }
         },
-        "models.ResponseError": {
+        "models.SubCategoriesResp": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "category": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SubCategoryResModel"
+                    }
+                },
+                "count": {
+                    "type": "integer"
                 }
             }
         },
-        "models.ResponseOK": {
+        "models.SubCategoryModel": {
             "type": "object",
             "properties": {
-                "message": {
+                "category_id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "models.SqladReqModel": {
+        "models.SubCategoryResModel": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "category_id": {
+                    "type": "string"
                 },
-                "expiration_date": {
+                "created_at": {
                     "type": "string"
                 },
-                "low_stock": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
-                },
-                "provider": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.SqladRespModel": {
+        "models.Supplier": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "address": {
+                    "type": "string"
+                },
+                "contact_person": {
+                    "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "expiration_date": {
+                "email": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "low_stock": {
+                "name": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "payment_days": {
+                    "type": "integer"
+                },
+                "payment_terms": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "sqlad_count": {
+                    "description": "sqlad ordered from the supplier",
                     "type": "integer"
                 },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SupplierReq": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "contact_person": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "note": {
+                    "type": "string"
                 },
-                "provider": {
+                "payment_days": {
+                    "description": "days the clinic has to pay a receipt in",
+                    "type": "integer"
+                },
+                "payment_terms": {
+                    "description": "terms agreed with the supplier, like \"50% upfront\"",
                     "type": "string"
                 },
-                "updated_at": {
+                "phone_number": {
                     "type": "string"
                 }
             }
         },
-        "models.SubCategoriesResp": {
+        "models.SupplierSpend": {
             "type": "object",
             "properties": {
-                "category": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.SubCategoryResModel"
+                        "$ref": "#/definitions/models.SupplierSpendItem"
                     }
                 },
-                "count": {
+                "orders": {
+                    "description": "orders the stock was received by",
                     "type": "integer"
+                },
+                "spent": {
+                    "type": "number"
+                },
+                "supplier_id": {
+                    "type": "string"
+                },
+                "supplier_name": {
+                    "type": "string"
                 }
             }
         },
-        "models.SubCategoryModel": {
+        "models.SupplierSpendItem": {
             "type": "object",
             "properties": {
-                "category_id": {
+                "quantity": {
+                    "type": "integer"
+                },
+                "spent": {
+                    "type": "number"
+                },
+                "sqlad_id": {
                     "type": "string"
                 },
-                "name": {
+                "sqlad_name": {
                     "type": "string"
                 }
             }
         },
-        "models.SubCategoryResModel": {
+        "models.SupplierSpendReport": {
             "type": "object",
             "properties": {
-                "category_id": {
+                "from_date": {
                     "type": "string"
                 },
-                "created_at": {
+                "suppliers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SupplierSpend"
+                    }
+                },
+                "to_date": {
                     "type": "string"
                 },
-                "id": {
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Suppliers": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "suppliers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Supplier"
+                    }
+                }
+            }
+        },
+        "models.TimeSlot": {
+            "type": "object",
+            "properties": {
+                "end_time": {
                     "type": "string"
                 },
-                "name": {
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TokenResp": {
+            "type": "object",
+            "properties": {
+                "access_token": {
                     "type": "string"
                 },
-                "updated_at": {
+                "refresh_token": {
                     "type": "string"
+                },
+                "staff": {
+                    "$ref": "#/definitions/models.StaffModel"
                 }
             }
         },
         "models.UpdateAparat": {
             "type": "object",
             "properties": {
+                "doctor_id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
                 "price": {
                     "type": "number"
                 },
+                "room_number": {
+                    "type": "string"
+                },
                 "type": {
                     "type": "string"
                 }
@@ -4513,16 +11866,25 @@ const docTemplate = `{
                 }
             }
         },
-        "models.UpdateQueueReq": {
+        "models.UpdateStaffModel": {
             "type": "object",
             "properties": {
-                "client_id": {
-                    "type": "integer"
+                "doctor_id": {
+                    "type": "string"
                 },
-                "service_id": {
+                "first_name": {
                     "type": "string"
                 },
-                "service_type": {
+                "last_name": {
+                    "type": "string"
+                },
+                "login": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                },
+                "role": {
                     "type": "string"
                 }
             }
@@ -4542,6 +11904,45 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "models.WorkInterval": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "description": "1 is monday ... 7 is sunday",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.WorkingDoctor": {
+            "type": "object",
+            "properties": {
+                "doctor": {
+                    "$ref": "#/definitions/models.DoctorResp"
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TimeSlot"
+                    }
+                },
+                "substitute_for": {
+                    "type": "string"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
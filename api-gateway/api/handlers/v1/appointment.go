@@ -0,0 +1,329 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	create appointment
+// @Description This api can book a free slot of the doctor for the patient, starts_at is clinic local like 2006-01-02 15:04
+// @Tags 		Appointment
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.AppointmentCreateReq true "Body"
+// @Success 	201 {object} models.Appointment
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/appointment-create [post]
+func (h *handlerV1) AppointmentCreate(c *gin.Context) {
+	var body models.AppointmentCreateReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().AppointmentCreate(ctx, &p.Appointment{
+		Id:        uuid.New().String(),
+		ClientId:  body.ClientId,
+		DoctorId:  body.DoctorId,
+		StartsAt:  body.StartsAt,
+		Note:      body.Note,
+		CreatedBy: c.GetString(ctxStaffId),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "AppointmentCreate") {
+		h.log.Error("Error creating appointment", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, appointmentModel(response))
+}
+
+// @Summary 	get appointment
+// @Description This api can get the appointment
+// @Tags 		Appointment
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id 		path string true "Appointment ID"
+// @Success 	200 {object} models.Appointment
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/appointment-get/{id} [get]
+func (h *handlerV1) AppointmentGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().AppointmentGet(ctx, &p.AppointmentId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "AppointmentGet") {
+		h.log.Error("Error getting appointment", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, appointmentModel(response))
+}
+
+// @Summary 	find appointments
+// @Description This api can find appointments by doctor, patient, status and days (2006-01-02), earliest first
+// @Tags 		Appointment
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.AppointmentsFindReq false "Filter"
+// @Success 	200 {object} models.AppointmentsResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/appointment-find [get]
+func (h *handlerV1) AppointmentsFind(c *gin.Context) {
+	req, err := appointmentsParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "appointmentsParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().AppointmentsFind(ctx, &p.AppointmentsFindReq{
+		Limit:    req.Limit,
+		Page:     req.Page,
+		DoctorId: req.DoctorId,
+		ClientId: req.ClientId,
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		Status:   req.Status,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "AppointmentsFind") {
+		h.log.Error("Error finding appointments", logger.Error(err))
+		return
+	}
+
+	result := models.AppointmentsResp{
+		Appointments: make([]*models.Appointment, 0, len(response.Appointments)),
+		Count:        response.Count,
+	}
+	for _, appointment := range response.Appointments {
+		result.Appointments = append(result.Appointments, appointmentModel(appointment))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	reschedule appointment
+// @Description This api can move the booked appointment to another free slot of its doctor
+// @Tags 		Appointment
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id 		path string true "Appointment ID"
+// @Param body 	body models.AppointmentRescheduleReq true "Body"
+// @Success 	200 {object} models.Appointment
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/appointment-reschedule/{id} [post]
+func (h *handlerV1) AppointmentReschedule(c *gin.Context) {
+	var body models.AppointmentRescheduleReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().AppointmentReschedule(ctx, &p.AppointmentRescheduleReq{
+		Id:       c.Param("id"),
+		StartsAt: body.StartsAt,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "AppointmentReschedule") {
+		h.log.Error("Error rescheduling appointment", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, appointmentModel(response))
+}
+
+// @Summary 	cancel appointment
+// @Description This api can cancel the booked appointment, its slot becomes free
+// @Tags 		Appointment
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id 		path string true "Appointment ID"
+// @Param body 	body models.AppointmentCancelReq true "Body"
+// @Success 	200 {object} models.Appointment
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/appointment-cancel/{id} [post]
+func (h *handlerV1) AppointmentCancel(c *gin.Context) {
+	var body models.AppointmentCancelReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().AppointmentCancel(ctx, &p.AppointmentCancelReq{
+		Id:     c.Param("id"),
+		Reason: body.Reason,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "AppointmentCancel") {
+		h.log.Error("Error cancelling appointment", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, appointmentModel(response))
+}
+
+// @Summary 	check in appointment
+// @Description This api can check in the patient of today's appointment, the patient gets a queue number of the doctor
+// @Tags 		Appointment
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id 		path string true "Appointment ID"
+// @Success 	200 {object} models.Appointment
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/appointment-check-in/{id} [post]
+func (h *handlerV1) AppointmentCheckIn(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().AppointmentCheckIn(ctx, &p.AppointmentId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "AppointmentCheckIn") {
+		h.log.Error("Error checking in appointment", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, appointmentModel(response))
+}
+
+// @Summary 	appointment slots
+// @Description This api can get the time slots of the doctor on the date (2006-01-02), booked and past ones are not free
+// @Tags 		Appointment
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		doctor_id 	query string true "Doctor ID"
+// @Param 		date 		query string true "Date"
+// @Success 	200 {object} models.AppointmentSlotsResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/appointment-slots [get]
+func (h *handlerV1) AppointmentSlots(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().AppointmentSlots(ctx, &p.AppointmentSlotsReq{
+		DoctorId: c.Query("doctor_id"),
+		Date:     c.Query("date"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "AppointmentSlots") {
+		h.log.Error("Error getting appointment slots", logger.Error(err))
+		return
+	}
+
+	result := models.AppointmentSlotsResp{
+		DoctorId:    response.DoctorId,
+		Date:        response.Date,
+		SlotMinutes: response.SlotMinutes,
+		Slots:       make([]*models.AppointmentSlot, 0, len(response.Slots)),
+	}
+	for _, slot := range response.Slots {
+		result.Slots = append(result.Slots, &models.AppointmentSlot{
+			StartsAt:      slot.StartsAt,
+			EndsAt:        slot.EndsAt,
+			Free:          slot.Free,
+			AppointmentId: slot.AppointmentId,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func appointmentsParams(c *gin.Context) (*models.AppointmentsFindReq, error) {
+	var (
+		limit    int = 10
+		page     int = 1
+		clientId int64
+		err      error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("client_id") != "" {
+		clientId, err = strconv.ParseInt(c.Query("client_id"), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.AppointmentsFindReq{
+		Limit:    int64(limit),
+		Page:     int64(page),
+		DoctorId: c.Query("doctor_id"),
+		ClientId: clientId,
+		FromDate: c.Query("from_date"),
+		ToDate:   c.Query("to_date"),
+		Status:   c.Query("status"),
+	}, nil
+}
+
+func appointmentModel(appointment *p.Appointment) *models.Appointment {
+	result := &models.Appointment{
+		Id:           appointment.Id,
+		ClientId:     appointment.ClientId,
+		DoctorId:     appointment.DoctorId,
+		StartsAt:     appointment.StartsAt,
+		EndsAt:       appointment.EndsAt,
+		Status:       appointment.Status,
+		Note:         appointment.Note,
+		QueueId:      appointment.QueueId,
+		CancelReason: appointment.CancelReason,
+		CreatedBy:    appointment.CreatedBy,
+		CreatedAt:    appointment.CreatedAt,
+		UpdatedAt:    appointment.UpdatedAt,
+		CheckedInAt:  appointment.CheckedInAt,
+		CancelledAt:  appointment.CancelledAt,
+	}
+	if appointment.Queue != nil {
+		result.Queue = queueModel(appointment.Queue)
+	}
+	return result
+}
@@ -0,0 +1,312 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/api/tokens"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Keys set on gin context by Authorize
+const (
+	ctxStaffId  = "staff_id"
+	ctxRole     = "role"
+	ctxDoctorId = "doctor_id"
+)
+
+// Authorize checks the bearer access token and lets through only the given roles.
+// Admin passes every check, no roles means any signed in staff.
+func (h *handlerV1) Authorize(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer"))
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.DefaultResponse{
+				ErrorCode:    ErrorCodeUnauthorized,
+				ErrorMessage: "authorization token is required",
+			})
+			return
+		}
+
+		claims, err := h.jwtHandler.ParseToken(token, tokens.AccessToken)
+		if errors.Is(err, tokens.ErrTokenExpired) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.DefaultResponse{
+				ErrorCode:    ErrorCodeAccessTokenExpired,
+				ErrorMessage: "access token is expired",
+			})
+			return
+		} else if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.DefaultResponse{
+				ErrorCode:    ErrorCodeUnauthorized,
+				ErrorMessage: "access token is invalid",
+			})
+			return
+		}
+
+		// the token lives on after the staff is deleted or given another role, so the staff is checked as it is now
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+		defer cancel()
+
+		staff, err := h.serviceManager.PatientService().StaffGet(ctx, &p.StaffId{
+			Id: claims.Subject,
+		})
+		if status.Code(err) == codes.NotFound {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.DefaultResponse{
+				ErrorCode:    ErrorCodeUnauthorized,
+				ErrorMessage: "staff is not found, please sign in again",
+			})
+			return
+		} else if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffGet") {
+			h.log.Error("Error getting staff", logger.Error(err))
+			c.Abort()
+			return
+		}
+		if staff.Role != claims.Role || staff.DoctorId != claims.DoctorId {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.DefaultResponse{
+				ErrorCode:    ErrorCodeUnauthorized,
+				ErrorMessage: "access token is outdated, please sign in again",
+			})
+			return
+		}
+
+		if !roleAllowed(staff.Role, roles) {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.DefaultResponse{
+				ErrorCode:    ErrorCodeNotAllowed,
+				ErrorMessage: "you are not allowed to do this action",
+			})
+			return
+		}
+
+		c.Set(ctxStaffId, staff.Id)
+		c.Set(ctxRole, staff.Role)
+		c.Set(ctxDoctorId, staff.DoctorId)
+		c.Next()
+	}
+}
+
+func roleAllowed(role string, roles []string) bool {
+	if len(roles) == 0 || role == models.RoleAdmin {
+		return true
+	}
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// @Summary 	login
+// @Description This api can login staff and get access and refresh tokens
+// @Tags 		Auth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.LoginReq true "Body"
+// @Success 	200 {object} models.TokenResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	401 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/auth/login [post]
+func (h *handlerV1) Login(c *gin.Context) {
+	var body models.LoginReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	staff, err := h.serviceManager.PatientService().StaffLogin(ctx, &p.StaffLoginReq{
+		Login:    body.Login,
+		Password: body.Password,
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		c.JSON(http.StatusUnauthorized, models.DefaultResponse{
+			ErrorCode:    ErrorCodeWrongPassword,
+			ErrorMessage: "wrong login or password",
+		})
+		return
+	} else if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffLogin") {
+		h.log.Error("Error login staff", logger.Error(err))
+		return
+	}
+
+	h.issueTokens(c, ctx, staff)
+}
+
+// @Summary 	refresh token
+// @Description This api can get new access and refresh tokens by refresh token
+// @Tags 		Auth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.RefreshTokenReq true "Body"
+// @Success 	200 {object} models.TokenResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	401 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/auth/refresh [post]
+func (h *handlerV1) RefreshToken(c *gin.Context) {
+	var body models.RefreshTokenReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	claims, err := h.jwtHandler.ParseToken(body.RefreshToken, tokens.RefreshToken)
+	if errors.Is(err, tokens.ErrTokenExpired) {
+		c.JSON(http.StatusUnauthorized, models.DefaultResponse{
+			ErrorCode:    ErrorCodeRefreshTokenExpired,
+			ErrorMessage: "refresh token is expired",
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusUnauthorized, models.DefaultResponse{
+			ErrorCode:    ErrorCodeUnauthorized,
+			ErrorMessage: "refresh token is invalid",
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	staff, err := h.serviceManager.PatientService().StaffRefreshTokenCheck(ctx, &p.StaffRefreshToken{
+		Id:      claims.Subject,
+		TokenId: claims.ID,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffRefreshTokenCheck") {
+		h.log.Error("Error refreshing token", logger.Error(err))
+		return
+	}
+
+	h.issueTokens(c, ctx, staff)
+}
+
+// @Summary 	logout
+// @Description This api can logout staff, the refresh token is revoked
+// @Tags 		Auth
+// @Security    BearerAuth
+// @Produce 	json
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	401 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/auth/logout [post]
+func (h *handlerV1) Logout(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err := h.serviceManager.PatientService().StaffRefreshTokenSet(ctx, &p.StaffRefreshToken{
+		Id: c.GetString(ctxStaffId),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffRefreshTokenSet") {
+		h.log.Error("Error logout staff", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+// @Summary 	change password
+// @Description This api can change password of the signed in staff, all sessions are logged out
+// @Tags 		Auth
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.ChangePasswordReq true "Body"
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	401 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/auth/change-password [post]
+func (h *handlerV1) ChangePassword(c *gin.Context) {
+	var body models.ChangePasswordReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err = h.serviceManager.PatientService().StaffChangePassword(ctx, &p.StaffChangePasswordReq{
+		Id:          c.GetString(ctxStaffId),
+		OldPassword: body.OldPassword,
+		NewPassword: body.NewPassword,
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		c.JSON(http.StatusBadRequest, models.DefaultResponse{
+			ErrorCode:    ErrorCodeWrongPassword,
+			ErrorMessage: "wrong password",
+		})
+		return
+	} else if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffChangePassword") {
+		h.log.Error("Error changing password", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+// @Summary 	me
+// @Description This api can get the signed in staff
+// @Tags 		Auth
+// @Security    BearerAuth
+// @Produce 	json
+// @Success 	200 {object} models.StaffModel
+// @Failure 	401 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/auth/me [get]
+func (h *handlerV1) Me(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().StaffGet(ctx, &p.StaffId{
+		Id: c.GetString(ctxStaffId),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffGet") {
+		h.log.Error("Error getting staff", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, staffModel(response))
+}
+
+func (h *handlerV1) issueTokens(c *gin.Context, ctx context.Context, staff *p.Staff) {
+	tokenPair, err := h.jwtHandler.GenerateTokens(staff.Id, staff.Role, staff.DoctorId)
+	if HandleInternalWithMessage(c, &h.log, err, "GenerateTokens") {
+		h.log.Error("Error generating tokens", logger.Error(err))
+		return
+	}
+
+	_, err = h.serviceManager.PatientService().StaffRefreshTokenSet(ctx, &p.StaffRefreshToken{
+		Id:      staff.Id,
+		TokenId: tokenPair.RefreshTokenId,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffRefreshTokenSet") {
+		h.log.Error("Error saving refresh token", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TokenResp{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		Staff:        staffModel(staff),
+	})
+}
@@ -0,0 +1,247 @@
+package v1
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 	set doctor commission
+// @Description This api can set what the doctor earns from a service: percent of what the clients paid or a fixed amount for each service. Empty service_id covers every service of the type, empty service_type every service of the doctor; the most specific rule applies. A rule the doctor already has for the service is replaced
+// @Tags 		Payroll
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.DoctorCommissionReq true "Body"
+// @Success 	200 {object} models.DoctorCommission
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-commission-set [post]
+func (h *handlerV1) DoctorCommissionSet(c *gin.Context) {
+	var body models.DoctorCommissionReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorCommissionSet(ctx, &doctor.DoctorCommission{
+		DoctorId:    body.DoctorId,
+		ServiceType: body.ServiceType,
+		ServiceId:   body.ServiceId,
+		Kind:        body.Kind,
+		Value:       body.Value,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorCommissionSet") {
+		h.log.Error("Error setting doctor commission", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, commissionModel(response))
+}
+
+// @Summary 	find doctor commissions
+// @Description This api can find the commission rules of the doctor, of all doctors when doctor_id is empty
+// @Tags 		Payroll
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		doctor_id 	query string false "Doctor ID"
+// @Success 	200 {object} models.DoctorCommissions
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-commission-find [get]
+func (h *handlerV1) DoctorCommissionsFind(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorCommissionsFind(ctx, &doctor.DoctorCommissionsFindReq{
+		DoctorId: c.Query("doctor_id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorCommissionsFind") {
+		h.log.Error("Error finding doctor commissions", logger.Error(err))
+		return
+	}
+
+	result := models.DoctorCommissions{
+		Commissions: make([]*models.DoctorCommission, 0, len(response.Commissions)),
+	}
+	for _, commission := range response.Commissions {
+		result.Commissions = append(result.Commissions, commissionModel(commission))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	delete doctor commission
+// @Description This api can delete the commission rule, a less specific rule of the doctor applies again
+// @Tags 		Payroll
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-commission-delete/{id} [delete]
+func (h *handlerV1) DoctorCommissionDelete(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err := h.serviceManager.DoctorService().DoctorCommissionDelete(ctx, &doctor.DoctorCommissionId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorCommissionDelete") {
+		h.log.Error("Error deleting doctor commission", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+// @Summary 	get payroll report
+// @Description This api computes what the doctors earned from the services paid in the dates, doctor, lab and aparat services alike. Dates are like 2006-01-02, both included
+// @Tags 		Payroll
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		from_date 	query string true "From date"
+// @Param 		to_date 	query string true "To date"
+// @Param 		doctor_id 	query string false "Doctor ID"
+// @Success 	200 {object} models.PayrollReport
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/payroll-report [get]
+func (h *handlerV1) PayrollReportGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PayrollReportGet(ctx, payrollParams(c))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PayrollReportGet") {
+		h.log.Error("Error getting payroll report", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, payrollModel(response))
+}
+
+// @Summary 	export payroll report
+// @Description This api exports the payroll report as csv, a row for each service of each doctor
+// @Tags 		Payroll
+// @Security    BearerAuth
+// @Produce 	text/csv
+// @Param 		from_date 	query string true "From date"
+// @Param 		to_date 	query string true "To date"
+// @Param 		doctor_id 	query string false "Doctor ID"
+// @Success 	200 {file} file
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/payroll-report-export [get]
+func (h *handlerV1) PayrollReportExport(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PayrollReportGet(ctx, payrollParams(c))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PayrollReportExport") {
+		h.log.Error("Error exporting payroll report", logger.Error(err))
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", `attachment; filename="payroll-`+response.FromDate+`-`+response.ToDate+`.csv"`)
+
+	w := csv.NewWriter(c.Writer)
+	rows := [][]string{{"doctor_id", "first_name", "last_name", "service_type", "service_id", "service", "quantity", "revenue", "kind", "value", "earnings"}}
+	for _, payroll := range response.Doctors {
+		for _, line := range payroll.Lines {
+			rows = append(rows, []string{
+				payroll.DoctorId,
+				payroll.FirstName,
+				payroll.LastName,
+				line.ServiceType,
+				line.ServiceId,
+				line.Name,
+				strconv.FormatInt(line.Quantity, 10),
+				strconv.FormatInt(line.Revenue, 10),
+				line.Kind,
+				strconv.FormatFloat(line.Value, 'f', -1, 64),
+				strconv.FormatInt(line.Earnings, 10),
+			})
+		}
+		rows = append(rows, []string{payroll.DoctorId, payroll.FirstName, payroll.LastName, "", "", "total", "",
+			strconv.FormatInt(payroll.Revenue, 10), "", "", strconv.FormatInt(payroll.Earnings, 10)})
+	}
+	rows = append(rows, []string{"", "", "", "", "", "total", "",
+		strconv.FormatInt(response.Revenue, 10), "", "", strconv.FormatInt(response.Earnings, 10)})
+
+	if err := w.WriteAll(rows); err != nil {
+		h.log.Error("Error writing payroll report", logger.Error(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+func payrollParams(c *gin.Context) *doctor.PayrollReportReq {
+	return &doctor.PayrollReportReq{
+		FromDate: c.Query("from_date"),
+		ToDate:   c.Query("to_date"),
+		DoctorId: c.Query("doctor_id"),
+	}
+}
+
+func commissionModel(commission *doctor.DoctorCommission) *models.DoctorCommission {
+	return &models.DoctorCommission{
+		Id:          commission.Id,
+		DoctorId:    commission.DoctorId,
+		ServiceType: commission.ServiceType,
+		ServiceId:   commission.ServiceId,
+		Kind:        commission.Kind,
+		Value:       commission.Value,
+		CreatedAt:   commission.CreatedAt,
+		UpdatedAt:   commission.UpdatedAt,
+	}
+}
+
+func payrollModel(report *doctor.PayrollReport) *models.PayrollReport {
+	result := models.PayrollReport{
+		FromDate: report.FromDate,
+		ToDate:   report.ToDate,
+		Doctors:  make([]*models.PayrollDoctor, 0, len(report.Doctors)),
+		Revenue:  report.Revenue,
+		Earnings: report.Earnings,
+	}
+	for _, payroll := range report.Doctors {
+		temp := &models.PayrollDoctor{
+			DoctorId:  payroll.DoctorId,
+			FirstName: payroll.FirstName,
+			LastName:  payroll.LastName,
+			Revenue:   payroll.Revenue,
+			Earnings:  payroll.Earnings,
+			Lines:     make([]*models.PayrollLine, 0, len(payroll.Lines)),
+		}
+		for _, line := range payroll.Lines {
+			temp.Lines = append(temp.Lines, &models.PayrollLine{
+				ServiceType:  line.ServiceType,
+				ServiceId:    line.ServiceId,
+				Name:         line.Name,
+				Quantity:     line.Quantity,
+				Revenue:      line.Revenue,
+				CommissionId: line.CommissionId,
+				Kind:         line.Kind,
+				Value:        line.Value,
+				Earnings:     line.Earnings,
+			})
+		}
+		result.Doctors = append(result.Doctors, temp)
+	}
+	return &result
+}
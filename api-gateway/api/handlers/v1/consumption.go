@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/genproto/lab"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 	set sqlad bill of materials
+// @Description This api can set the sqlad a lab or aparat service consumes each time it is performed, recording its analysis takes them off the stock. The list replaces the one the service had, an empty list stops the service consuming
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.SqladBom true "Body"
+// @Success 	200 {object} models.SqladBom
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/sqlad-bom-set [post]
+func (h *handlerV1) SqladBomSet(c *gin.Context) {
+	var body models.SqladBom
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	req := doctor.SqladBom{
+		ServiceType: body.ServiceType,
+		ServiceId:   body.ServiceId,
+	}
+	for _, item := range body.Items {
+		req.Items = append(req.Items, &doctor.SqladBomItem{
+			SqladId:  item.SqladId,
+			Quantity: item.Quantity,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SqladBomSet(ctx, &req)
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SqladBomSet") {
+		h.log.Error("Error setting sqlad bill of materials", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, bomModel(response))
+}
+
+// @Summary 	get sqlad bill of materials
+// @Description This api tells the sqlad a lab or aparat service consumes each time it is performed
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		service_type 	query string true "lab or aparat"
+// @Param 		service_id 		query string true "Service ID"
+// @Success 	200 {object} models.SqladBom
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/sqlad-bom-get [get]
+func (h *handlerV1) SqladBomGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SqladBomGet(ctx, &doctor.SqladBomReq{
+		ServiceType: c.Query("service_type"),
+		ServiceId:   c.Query("service_id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SqladBomGet") {
+		h.log.Error("Error getting sqlad bill of materials", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, bomModel(response))
+}
+
+// @Summary 	get sqlad consumption report
+// @Description This api compares what the lab and aparat services performed in the dates should have consumed by their bills of materials with what was issued to them. Dates are like 2006-01-02, both included
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		from_date 		query string true "From date"
+// @Param 		to_date 		query string true "To date"
+// @Param 		service_type 	query string false "lab or aparat"
+// @Param 		service_id 		query string false "Service ID"
+// @Success 	200 {object} models.ConsumptionReport
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/consumption-report [get]
+func (h *handlerV1) ConsumptionReportGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().ConsumptionReportGet(ctx, &doctor.ConsumptionReportReq{
+		FromDate:    c.Query("from_date"),
+		ToDate:      c.Query("to_date"),
+		ServiceType: c.Query("service_type"),
+		ServiceId:   c.Query("service_id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ConsumptionReportGet") {
+		h.log.Error("Error getting consumption report", logger.Error(err))
+		return
+	}
+
+	var labsIds, aparatsIds []string
+	for _, line := range response.Lines {
+		if line.ServiceType == "lab" {
+			labsIds = append(labsIds, line.ServiceId)
+		} else {
+			aparatsIds = append(aparatsIds, line.ServiceId)
+		}
+	}
+
+	// services deleted since are left without a name
+	names := make(map[string]string)
+	if len(labsIds) != 0 {
+		labs, err := h.serviceManager.LabService().LabsGetByIds(ctx, &lab.ServiceIds{Ids: labsIds})
+		if HandleDatabaseLevelWithMessage(c, &h.log, err, "ConsumptionReportGet") {
+			h.log.Error("Error getting labs", logger.Error(err))
+			return
+		}
+		for _, lab := range labs.Labs {
+			names["lab"+lab.Id] = lab.Name
+		}
+	}
+	if len(aparatsIds) != 0 {
+		aparats, err := h.serviceManager.LabService().AparatsGetByIds(ctx, &lab.ServiceIds{Ids: aparatsIds})
+		if HandleDatabaseLevelWithMessage(c, &h.log, err, "ConsumptionReportGet") {
+			h.log.Error("Error getting aparats", logger.Error(err))
+			return
+		}
+		for _, aparat := range aparats.Aparats {
+			names["aparat"+aparat.Id] = aparat.Name
+		}
+	}
+
+	result := models.ConsumptionReport{
+		FromDate: response.FromDate,
+		ToDate:   response.ToDate,
+		Lines:    make([]*models.ConsumptionLine, 0, len(response.Lines)),
+	}
+	for _, line := range response.Lines {
+		result.Lines = append(result.Lines, &models.ConsumptionLine{
+			ServiceType: line.ServiceType,
+			ServiceId:   line.ServiceId,
+			ServiceName: names[line.ServiceType+line.ServiceId],
+			SqladId:     line.SqladId,
+			SqladName:   line.SqladName,
+			Performed:   line.Performed,
+			Expected:    line.Expected,
+			Actual:      line.Actual,
+			Difference:  line.Difference,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func bomModel(bom *doctor.SqladBom) *models.SqladBom {
+	result := models.SqladBom{
+		ServiceType: bom.ServiceType,
+		ServiceId:   bom.ServiceId,
+		Items:       make([]*models.SqladBomItem, 0, len(bom.Items)),
+	}
+	for _, item := range bom.Items {
+		result.Items = append(result.Items, &models.SqladBomItem{
+			SqladId:   item.SqladId,
+			SqladName: item.SqladName,
+			Quantity:  item.Quantity,
+		})
+	}
+	return &result
+}
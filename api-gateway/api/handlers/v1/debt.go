@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	create patient debt
+// @Description This api can create a debt of the patient to be paid until term_date (YYYY-MM-DD)
+// @Tags 		Debt
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.PatientDebtCreateReq true "Body"
+// @Success 	201 {object} models.PatientDebt
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/patient-debt-create [post]
+func (h *handlerV1) PatientDebtCreate(c *gin.Context) {
+	var body models.PatientDebtCreateReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().PatientDebtCreate(ctx, &p.PatientDebtCreateReq{
+		Id:        uuid.New().String(),
+		PatientId: body.PatientId,
+		CashboxId: body.CashboxId,
+		Amount:    body.Amount,
+		TermDate:  body.TermDate,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PatientDebtCreate") {
+		h.log.Error("Error creating patient debt", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, debtModel(response))
+}
+
+// @Summary 	patient debt info
+// @Description This api can get the not paid debts of the patient and their total amount
+// @Tags 		Debt
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		client_id query int true "Client ID"
+// @Success 	200 {object} models.PatientDebtInfo
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/patient-debt-info [get]
+func (h *handlerV1) PatientDebtInfo(c *gin.Context) {
+	clientId, err := strconv.ParseInt(c.Query("client_id"), 10, 64)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "strconv.ParseInt(client_id)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().PatientsDebtInfo(ctx, &p.PatientId{
+		ClientId: clientId,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PatientsDebtInfo") {
+		h.log.Error("Error getting patient debt info", logger.Error(err))
+		return
+	}
+
+	result := models.PatientDebtInfo{
+		ClientId: response.ClientId,
+		Amount:   response.Amount,
+		Debts:    make([]*models.PatientDebt, 0, len(response.Debts)),
+	}
+	for _, debt := range response.Debts {
+		result.Debts = append(result.Debts, debtModel(debt))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	overdue patient debts
+// @Description This api can list the not paid debts whose term_date is before date (YYYY-MM-DD, today by default)
+// @Tags 		Debt
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.PatientDebtsOverdueReq false "Filter"
+// @Success 	200 {object} models.PatientDebtsResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/patient-debts-overdue [get]
+func (h *handlerV1) PatientDebtsOverdue(c *gin.Context) {
+	req, err := debtsOverdueParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "debtsOverdueParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().PatientDebtsOverdue(ctx, &p.PatientDebtsOverdueReq{
+		Limit: req.Limit,
+		Page:  req.Page,
+		Date:  req.Date,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PatientDebtsOverdue") {
+		h.log.Error("Error finding overdue debts", logger.Error(err))
+		return
+	}
+
+	result := models.PatientDebtsResp{
+		Debts:  make([]*models.PatientDebt, 0, len(response.Debts)),
+		Count:  response.Count,
+		Amount: response.Amount,
+	}
+	for _, debt := range response.Debts {
+		result.Debts = append(result.Debts, debtModel(debt))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func debtModel(debt *p.PatientDebt) *models.PatientDebt {
+	return &models.PatientDebt{
+		Id:            debt.Id,
+		PatientId:     debt.PatientId,
+		ClientId:      debt.ClientId,
+		CashboxId:     debt.CashboxId,
+		FirstName:     debt.FirstName,
+		LastName:      debt.LastName,
+		PhoneNumber:   debt.PhoneNumber,
+		InitialAmount: debt.InitialAmount,
+		Amount:        debt.Amount,
+		TermDate:      debt.TermDate,
+		PaidAt:        debt.PaidAt,
+		CreatedAt:     debt.CreatedAt,
+		UpdatedAt:     debt.UpdatedAt,
+	}
+}
+
+func debtsOverdueParams(c *gin.Context) (*models.PatientDebtsOverdueReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.PatientDebtsOverdueReq{
+		Limit: int64(limit),
+		Page:  int64(page),
+		Date:  c.Query("date"),
+	}, nil
+}
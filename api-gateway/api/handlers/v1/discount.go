@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	create discount
+// @Description This api can create a discount, kind is percent or fixed, cap is the most it takes off one cashbox (0 is no cap).
+// @Description Empty client_id, service_type (doctor, lab, aparat), advertising_channel and validity dates match anything.
+// @Tags 		Discount
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.DiscountReq true "Body"
+// @Success 	201 {object} models.Discount
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/discount-create [post]
+func (h *handlerV1) DiscountCreate(c *gin.Context) {
+	var body models.DiscountReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().DiscountCreate(ctx, discountProto(uuid.New().String(), &body))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DiscountCreate") {
+		h.log.Error("Error creating discount", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, discountModel(response))
+}
+
+// @Summary 	get discount
+// @Description This api can get discount by id
+// @Tags 		Discount
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.Discount
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/discount-get/{id} [get]
+func (h *handlerV1) DiscountGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().DiscountGet(ctx, &p.DiscountId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DiscountGet") {
+		h.log.Error("Error getting discount", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, discountModel(response))
+}
+
+// @Summary 	find discounts
+// @Description This api can find discounts, date (YYYY-MM-DD) keeps the ones valid on it
+// @Tags 		Discount
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.DiscountsFindReq false "Filter"
+// @Success 	200 {object} models.DiscountsResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/discount-find [get]
+func (h *handlerV1) DiscountsFind(c *gin.Context) {
+	req, err := discountsParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "discountsParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().DiscountsFind(ctx, &p.DiscountsFindReq{
+		Limit:    req.Limit,
+		Page:     req.Page,
+		Search:   req.Search,
+		ClientId: req.ClientId,
+		Date:     req.Date,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DiscountsFind") {
+		h.log.Error("Error finding discounts", logger.Error(err))
+		return
+	}
+
+	result := models.DiscountsResp{
+		Discounts: make([]*models.Discount, 0, len(response.Discounts)),
+		Count:     response.Count,
+	}
+	for _, discount := range response.Discounts {
+		result.Discounts = append(result.Discounts, discountModel(discount))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	update discount
+// @Description This api can update discount, cashboxes created before keep the discount they got
+// @Tags 		Discount
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.DiscountReq true "Body"
+// @Success 	200 {object} models.Discount
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/discount-update/{id} [post]
+func (h *handlerV1) DiscountUpdate(c *gin.Context) {
+	var body models.DiscountReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().DiscountUpdate(ctx, discountProto(c.Param("id"), &body))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DiscountUpdate") {
+		h.log.Error("Error updating discount", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, discountModel(response))
+}
+
+// @Summary 	delete discount
+// @Description This api can delete discount
+// @Tags 		Discount
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/discount-delete/{id} [delete]
+func (h *handlerV1) DiscountDelete(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err := h.serviceManager.PatientService().DiscountDelete(ctx, &p.DiscountId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DiscountDelete") {
+		h.log.Error("Error deleting discount", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+func discountProto(id string, body *models.DiscountReq) *p.Discount {
+	return &p.Discount{
+		Id:                 id,
+		Name:               body.Name,
+		Kind:               body.Kind,
+		Value:              body.Value,
+		Cap:                body.Cap,
+		ClientId:           body.ClientId,
+		ServiceType:        body.ServiceType,
+		AdvertisingChannel: body.AdvertisingChannel,
+		ValidFrom:          body.ValidFrom,
+		ValidTo:            body.ValidTo,
+	}
+}
+
+func discountModel(discount *p.Discount) *models.Discount {
+	return &models.Discount{
+		Id:                 discount.Id,
+		Name:               discount.Name,
+		Kind:               discount.Kind,
+		Value:              discount.Value,
+		Cap:                discount.Cap,
+		ClientId:           discount.ClientId,
+		ServiceType:        discount.ServiceType,
+		AdvertisingChannel: discount.AdvertisingChannel,
+		ValidFrom:          discount.ValidFrom,
+		ValidTo:            discount.ValidTo,
+		CreatedAt:          discount.CreatedAt,
+		UpdatedAt:          discount.UpdatedAt,
+	}
+}
+
+func discountsParams(c *gin.Context) (*models.DiscountsFindReq, error) {
+	var (
+		limit    int = 10
+		page     int = 1
+		clientId int64
+		err      error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("client_id") != "" {
+		clientId, err = strconv.ParseInt(c.Query("client_id"), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.DiscountsFindReq{
+		Limit:    int64(limit),
+		Page:     int64(page),
+		Search:   c.Query("search"),
+		ClientId: clientId,
+		Date:     c.Query("date"),
+	}, nil
+}
@@ -8,7 +8,6 @@ import (
 
 	"gitlab.com/clinic-crm/api-gateway/api/models"
 	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
-	patient "gitlab.com/clinic-crm/api-gateway/genproto/patient"
 	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
 
 	"github.com/gin-gonic/gin"
@@ -19,6 +18,7 @@ import (
 // @Summary 	Create doctor
 // @Description This api can registr doctor
 // @Tags 		Doctor
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CreateDoctorModel true "Body"
@@ -42,15 +42,16 @@ func (h *handlerV1) DoctorCreate(c *gin.Context) {
 	defer cancel()
 
 	response, err := h.serviceManager.DoctorService().DoctorCreate(ctx, &doctor.Doctor{
-		Id:          uuid.New().String(),
-		FirstName:   body.FirstName,
-		LastName:    body.LastName,
-		Gender:      body.Gender,
-		WorkTime:    body.WorkTime,
-		Price:       body.Price,
-		Cpecialety:  body.Specialty,
-		RoomNumber:  body.RoomNumber,
-		PhoneNumber: body.PhoneNumber,
+		Id:           uuid.New().String(),
+		FirstName:    body.FirstName,
+		LastName:     body.LastName,
+		Gender:       body.Gender,
+		WorkTime:     body.WorkTime,
+		Price:        body.Price,
+		Cpecialety:   body.Specialty,
+		RoomNumber:   body.RoomNumber,
+		PhoneNumber:  body.PhoneNumber,
+		SpecialtyIds: body.SpecialtyIds,
 	})
 	if err != nil {
 		h.log.Error("Error creating doctor", logger.Error(err))
@@ -60,24 +61,13 @@ func (h *handlerV1) DoctorCreate(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.DoctorResp{
-		Id:          response.Id,
-		FirstName:   response.FirstName,
-		LastName:    response.LastName,
-		Gender:      response.Gender,
-		WorkTime:    response.WorkTime,
-		Price:       response.Price,
-		Specialty:   response.Cpecialety,
-		RoomNumber:  response.RoomNumber,
-		PhoneNumber: response.PhoneNumber,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, doctorModel(response))
 }
 
 // @Summary 		Get doctor
 // @Description 	This api can get doctor
 // @Tags 			Doctor
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.GetDoctorReq false "Filter"
@@ -103,24 +93,13 @@ func (h *handlerV1) DoctorGet(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, models.DoctorResp{
-		Id:          response.Id,
-		FirstName:   response.FirstName,
-		LastName:    response.LastName,
-		Gender:      response.Gender,
-		WorkTime:    response.WorkTime,
-		Price:       response.Price,
-		Specialty:   response.Cpecialety,
-		RoomNumber:  response.RoomNumber,
-		PhoneNumber: response.PhoneNumber,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusOK, doctorModel(response))
 }
 
 // @Summary 	Find doctors
 // @Description This api can find doctors
 // @Tags 		Doctor
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.DoctorsFindReq false "Filter"
@@ -145,9 +124,10 @@ func (h *handlerV1) DoctorsFind(c *gin.Context) {
 	defer cancel()
 
 	response, err := h.serviceManager.DoctorService().DoctorsFind(ctx, &doctor.DoctorsFindReq{
-		Limit:  req.Limit,
-		Page:   req.Page,
-		Search: req.Search,
+		Limit:       req.Limit,
+		Page:        req.Page,
+		Search:      req.Search,
+		SpecialtyId: req.SpecialtyId,
 	})
 	if err != nil {
 		h.log.Error("Error finding doctors", logger.Error(err))
@@ -158,19 +138,7 @@ func (h *handlerV1) DoctorsFind(c *gin.Context) {
 	}
 
 	for _, doctor := range response.Doctors {
-		DoctorsResp.Doctors = append(DoctorsResp.Doctors, &models.DoctorResp{
-			Id:          doctor.Id,
-			FirstName:   doctor.FirstName,
-			LastName:    doctor.LastName,
-			Gender:      doctor.Gender,
-			WorkTime:    doctor.WorkTime,
-			Price:       doctor.Price,
-			Specialty:   doctor.Cpecialety,
-			RoomNumber:  doctor.RoomNumber,
-			PhoneNumber: doctor.PhoneNumber,
-			CreatedAt:   doctor.CreatedAt,
-			UpdatedAt:   doctor.UpdatedAt,
-		})
+		DoctorsResp.Doctors = append(DoctorsResp.Doctors, doctorModel(doctor))
 	}
 	DoctorsResp.Count = response.Count
 
@@ -199,15 +167,17 @@ func doctorParams(c *gin.Context) (*models.DoctorsFindReq, error) {
 	}
 
 	return &models.DoctorsFindReq{
-		Limit:  int64(limit),
-		Page:   int64(page),
-		Search: c.Query("search"),
+		Limit:       int64(limit),
+		Page:        int64(page),
+		Search:      c.Query("search"),
+		SpecialtyId: c.Query("specialty_id"),
 	}, nil
 }
 
 // @Summary 	Update doctor
 // @Description This api can update doctor
 // @Tags 		Doctor
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -232,15 +202,16 @@ func (h *handlerV1) DoctorUpdate(c *gin.Context) {
 	defer cancel()
 
 	response, err := h.serviceManager.DoctorService().DoctorUpdate(ctx, &doctor.Doctor{
-		Id:          c.Param("id"),
-		FirstName:   body.FirstName,
-		LastName:    body.LastName,
-		Gender:      body.Gender,
-		WorkTime:    body.WorkTime,
-		Price:       body.Price,
-		Cpecialety:  body.Specialty,
-		RoomNumber:  body.RoomNumber,
-		PhoneNumber: body.PhoneNumber,
+		Id:           c.Param("id"),
+		FirstName:    body.FirstName,
+		LastName:     body.LastName,
+		Gender:       body.Gender,
+		WorkTime:     body.WorkTime,
+		Price:        body.Price,
+		Cpecialety:   body.Specialty,
+		RoomNumber:   body.RoomNumber,
+		PhoneNumber:  body.PhoneNumber,
+		SpecialtyIds: body.SpecialtyIds,
 	})
 	if err != nil {
 		h.log.Error("Error updating doctor", logger.Error(err))
@@ -250,24 +221,13 @@ func (h *handlerV1) DoctorUpdate(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, models.DoctorResp{
-		Id:          response.Id,
-		FirstName:   response.FirstName,
-		LastName:    response.LastName,
-		Gender:      response.Gender,
-		WorkTime:    response.WorkTime,
-		Price:       response.Price,
-		Specialty:   response.Cpecialety,
-		RoomNumber:  response.RoomNumber,
-		PhoneNumber: response.PhoneNumber,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusOK, doctorModel(response))
 }
 
 // @Summary 	Delete doctor
 // @Description This api can delete doctor
 // @Tags 		Doctor
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -299,6 +259,7 @@ func (h *handlerV1) DoctorDelete(c *gin.Context) {
 // @Summary 	Create doctor report
 // @Description This api can registr doctor report
 // @Tags 		Doctor-report
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.DoctorReportsModel true "Body"
@@ -348,6 +309,7 @@ func (h *handlerV1) DoctorReportCreate(c *gin.Context) {
 // @Summary 		Get doctor report
 // @Description 	This api can get doctor reports
 // @Tags 			Doctor-report
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.GetDoctorReportReq false "Filter"
@@ -386,6 +348,7 @@ func (h *handlerV1) DoctorReportGet(c *gin.Context) {
 // @Summary 	Find doctors report
 // @Description This api can find doctors
 // @Tags 		Doctor-report
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.DoctorReportsFindReq false "Filter"
@@ -440,6 +403,7 @@ func (h *handlerV1) DoctorReportsFind(c *gin.Context) {
 // @Summary 	Delete doctor report
 // @Description This api can delete doctor
 // @Tags 		Doctor-report
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -469,8 +433,9 @@ func (h *handlerV1) DoctorReportDelete(c *gin.Context) {
 }
 
 // @Summary 	Create sqlad product info
-// @Description This api can registr sqlad product info
+// @Description This api can registr sqlad product info, its count is received as the opening stock
 // @Tags 		Sqlad
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.SqladReqModel true "Body"
@@ -501,6 +466,8 @@ func (h *handlerV1) SqladCreate(c *gin.Context) {
 		LowStock:       body.LowStock,
 		ExpirationDate: body.ExpirationDate,
 		Provider:       body.Provider,
+		SupplierId:     body.SupplierId,
+		ActorId:        c.GetString(ctxStaffId),
 	})
 	if err != nil {
 		h.log.Error("Error creating sqlad info", logger.Error(err))
@@ -518,6 +485,7 @@ func (h *handlerV1) SqladCreate(c *gin.Context) {
 		LowStock:       response.LowStock,
 		ExpirationDate: response.ExpirationDate,
 		Provider:       response.Provider,
+		SupplierId:     response.SupplierId,
 		CreatedAt:      response.CreatedAt,
 		UpdatedAt:      response.UpdatedAt,
 	})
@@ -526,6 +494,7 @@ func (h *handlerV1) SqladCreate(c *gin.Context) {
 // @Summary 		Get sqlad product info
 // @Description 	This api can get sqlad
 // @Tags 			Sqlad
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.SqladGetReqModel false "Filter"
@@ -559,14 +528,16 @@ func (h *handlerV1) SqladGet(c *gin.Context) {
 		LowStock:       response.LowStock,
 		ExpirationDate: response.ExpirationDate,
 		Provider:       response.Provider,
+		SupplierId:     response.SupplierId,
 		CreatedAt:      response.CreatedAt,
 		UpdatedAt:      response.UpdatedAt,
 	})
 }
 
 // @Summary 	Update sqlad product info
-// @Description This api can update doctor report
+// @Description This api can update sqlad product info, the count is left as is: the stock changes by movements only
 // @Tags 		Sqlad
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -593,11 +564,11 @@ func (h *handlerV1) SqladUpdate(c *gin.Context) {
 	response, err := h.serviceManager.DoctorService().SqladUpdate(ctx, &doctor.SqladReq{
 		Id:             c.Param("id"),
 		Name:           body.Name,
-		Count:          body.Count,
 		Price:          body.Price,
 		LowStock:       body.LowStock,
 		ExpirationDate: body.ExpirationDate,
 		Provider:       body.Provider,
+		SupplierId:     body.SupplierId,
 	})
 	if err != nil {
 		h.log.Error("Error updating sqlad info", logger.Error(err))
@@ -615,6 +586,7 @@ func (h *handlerV1) SqladUpdate(c *gin.Context) {
 		LowStock:       response.LowStock,
 		ExpirationDate: response.ExpirationDate,
 		Provider:       response.Provider,
+		SupplierId:     response.SupplierId,
 		CreatedAt:      response.CreatedAt,
 		UpdatedAt:      response.UpdatedAt,
 	})
@@ -623,6 +595,7 @@ func (h *handlerV1) SqladUpdate(c *gin.Context) {
 // @Summary 	Delete sqlad info
 // @Description This api can delete product in sqlad
 // @Tags 		Sqlad
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -654,6 +627,7 @@ func (h *handlerV1) SqladDelete(c *gin.Context) {
 // @Summary 	Find low stochs
 // @Description Retrieves a list of low stock
 // @Tags 		Sqlad
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Success 	200 {object} models.LowStocksRespModel
@@ -686,6 +660,7 @@ func (h *handlerV1) LowStock(c *gin.Context) {
 			LowStock:       product.LowStock,
 			ExpirationDate: product.ExpirationDate,
 			Provider:       product.Provider,
+			SupplierId:     product.SupplierId,
 			CreatedAt:      product.CreatedAt,
 			UpdatedAt:      product.UpdatedAt,
 		})
@@ -696,8 +671,10 @@ func (h *handlerV1) LowStock(c *gin.Context) {
 }
 
 // @Summary 		Get patients info for doctor page
-// @Description 	This api can get doctor page
+// @Description 	This api can get doctor page: patients queued to the doctor in the date range with their reports.
+// @Description 	For a doctor the doctor_id is taken from the token.
 // @Tags 			Doctor-page
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.DocPageFilterReq false "Filter"
@@ -706,53 +683,108 @@ func (h *handlerV1) LowStock(c *gin.Context) {
 // @Failure         500         {object}  models.ResponseError
 // @Router          /v1/doctor-page-filter [get]
 func (h *handlerV1) DoctorPageFilter(c *gin.Context) {
-	var body models.DocPageFilterReq
-	var resp models.DocPageFilterResModel
+	req, err := doctorPageParams(c)
+	if err != nil {
+		h.log.Error("Error parsing doctor page params", logger.Error(err))
+		c.JSON(http.StatusBadRequest, models.ResponseError{
+			Message: err.Error(),
+		})
+		return
+	}
+	if c.GetString(ctxRole) == models.RoleDoctor {
+		req.DoctorId = c.GetString(ctxDoctorId)
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(7))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
 	defer cancel()
 
-	respQueue, err := h.serviceManager.PatientService().FindQueue(ctx, &patient.QueueFilter{
-		ServiceId:   body.ServiceId,
-		ServiceType: body.ServiceType,
-		Page:        body.Page,
-		Limit:       body.Limit,
+	response, err := h.serviceManager.DoctorService().DoctorPageFilter(ctx, &doctor.DocPageFilter{
+		DoctorId: req.DoctorId,
+		ClientId: req.ClientId,
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		Page:     req.Page,
+		Limit:    req.Limit,
 	})
-	if err != nil {
-		h.log.Error("Error gitting patient for doctor page", logger.Error(err))
-		c.JSON(http.StatusInternalServerError, models.ResponseError{
-			Message: err.Error(),
-		})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorPageFilter") {
+		h.log.Error("Error getting doctor page", logger.Error(err))
 		return
 	}
 
-	for _, patientResponse := range respQueue.Queues {
+	reports := make(map[string][]*models.DoctorReportsModelRes)
+	for _, report := range response.DoctorReport {
+		reports[report.ClientId] = append(reports[report.ClientId], &models.DoctorReportsModelRes{
+			Id:        report.Id,
+			ClientId:  report.ClientId,
+			DoctorId:  report.DoctorId,
+			Text:      report.Text,
+			CreatedAt: report.CreatedAt,
+			UpdatedAt: report.UpdatedAt,
+		})
+	}
 
-		respPatient, err := h.serviceManager.PatientService().PatientGet(ctx, &patient.GetPatientReq{
-			Field: "client_id",
-			Value: strconv.Itoa(int(patientResponse.ClientId)),
+	resp := models.DocPageFilterResModel{
+		PatientInfo: make([]*models.PatientInfo, 0, len(response.PatientInfo)),
+		Count:       response.Count,
+	}
+	for _, info := range response.PatientInfo {
+		resp.PatientInfo = append(resp.PatientInfo, &models.PatientInfo{
+			PatientId:      info.PatientId,
+			ClientId:       info.ClientId,
+			QueueNumber:    info.QueueNumber,
+			FullName:       info.FullName,
+			PhoneNumber:    info.PhoneNumber,
+			DateLastVisit:  info.DateLastVisit,
+			PatientReports: reports[info.PatientId],
 		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func doctorPageParams(c *gin.Context) (*models.DocPageFilterReq, error) {
+	var (
+		limit    int = 10
+		page     int = 1
+		clientId int = 0
+		err      error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
 		if err != nil {
-			h.log.Error("Error gitting patient for doctor page", logger.Error(err))
-			c.JSON(http.StatusInternalServerError, models.ResponseError{
-				Message: err.Error(),
-			})
-			return
+			return nil, err
 		}
+	}
 
-		fullName := respPatient.FirstName + respPatient.LastName
-		resp.PatientInfo = append(resp.PatientInfo, &models.PatientInfo{
-			QueueNumber:   patientResponse.QueueNumber,
-			FullName:      fullName,
-			PhoneNumber:   respPatient.MainPhoneNumber,
-			DateLastVisit: respPatient.UpdatedAt,
-		})
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("client_id") != "" {
+		clientId, err = strconv.Atoi(c.Query("client_id"))
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	return &models.DocPageFilterReq{
+		DoctorId: c.Query("doctor_id"),
+		ClientId: int64(clientId),
+		FromDate: c.Query("from_date"),
+		ToDate:   c.Query("to_date"),
+		Page:     int64(page),
+		Limit:    int64(limit),
+	}, nil
 }
 
 // @Summary 		Get doctor type
 // @Description 	This api can get doctor type
 // @Tags 			Doctor
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Success         200			{object}  models.DoctorTypes
@@ -786,3 +818,25 @@ func (h *handlerV1) DoctorTypeGet(c *gin.Context) {
 
 	c.JSON(http.StatusOK, DoctorTypes)
 }
+
+func doctorModel(doc *doctor.Doctor) *models.DoctorResp {
+	result := &models.DoctorResp{
+		Id:           doc.Id,
+		FirstName:    doc.FirstName,
+		LastName:     doc.LastName,
+		Gender:       doc.Gender,
+		WorkTime:     doc.WorkTime,
+		Price:        doc.Price,
+		Specialty:    doc.Cpecialety,
+		RoomNumber:   doc.RoomNumber,
+		PhoneNumber:  doc.PhoneNumber,
+		CreatedAt:    doc.CreatedAt,
+		UpdatedAt:    doc.UpdatedAt,
+		SpecialtyIds: doc.SpecialtyIds,
+		Specialties:  make([]*models.Specialty, 0, len(doc.Specialties)),
+	}
+	for _, specialty := range doc.Specialties {
+		result.Specialties = append(result.Specialties, specialtyModel(specialty))
+	}
+	return result
+}
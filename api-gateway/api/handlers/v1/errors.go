@@ -59,6 +59,20 @@ func HandleDatabaseLevelWithMessage(c *gin.Context, l *logger.Logger, err error,
 		case codes.InvalidArgument:
 			errorCode = ErrorCodeBadRequest
 			statuscode = http.StatusBadRequest
+		case codes.AlreadyExists:
+			errorCode = ErrorCodeBadRequest
+			statuscode = http.StatusConflict
+		case codes.Unauthenticated:
+			errorCode = ErrorCodeUnauthorized
+			statuscode = http.StatusUnauthorized
+		case codes.FailedPrecondition:
+			errorCode = ErrorCodeBadRequest
+			statuscode = http.StatusConflict
+		case codes.PermissionDenied:
+			errorCode = ErrorCodeNotAllowed
+			statuscode = http.StatusForbidden
+		case codes.DeadlineExceeded:
+			statuscode = http.StatusGatewayTimeout
 		}
 
 		c.AbortWithStatusJSON(statuscode, models.DefaultResponse{
@@ -1,8 +1,12 @@
 package v1
 
 import (
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/tokens"
 	"gitlab.com/clinic-crm/api-gateway/config"
 	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+	"gitlab.com/clinic-crm/api-gateway/pkg/payments"
 	"gitlab.com/clinic-crm/api-gateway/services"
 )
 
@@ -10,6 +14,9 @@ type handlerV1 struct {
 	log            logger.Logger
 	serviceManager services.IServiceManager
 	cfg            config.Config
+	jwtHandler     tokens.JWTHandler
+	payments       map[string]payments.Provider
+	logo           *logoCache
 }
 
 type HandlerV1Config struct {
@@ -23,5 +30,12 @@ func New(c *HandlerV1Config) *handlerV1 {
 		log:            c.Logger,
 		serviceManager: c.ServiceManager,
 		cfg:            c.Cfg,
+		jwtHandler: tokens.JWTHandler{
+			SigningKey:      c.Cfg.SigningKey,
+			AccessTokenTTL:  time.Minute * time.Duration(c.Cfg.AccessTokenTTL),
+			RefreshTokenTTL: time.Hour * time.Duration(c.Cfg.RefreshTokenTTL),
+		},
+		payments: paymentProviders(c.Cfg),
+		logo:     &logoCache{},
 	}
 }
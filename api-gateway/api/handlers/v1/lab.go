@@ -17,6 +17,7 @@ import (
 // @Summary 	Create lab
 // @Description This api can registr lab
 // @Tags 		Lab
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.LabModel true "Body"
@@ -45,6 +46,8 @@ func (h *handlerV1) LabCreate(c *gin.Context) {
 		Price:         body.Price,
 		Type:          body.Type,
 		SubCategoryId: body.SubCategoryId,
+		DoctorId:      body.DoctorId,
+		RoomNumber:    body.RoomNumber,
 	})
 	if err != nil {
 		h.log.Error("Error creating lab", logger.Error(err))
@@ -60,6 +63,8 @@ func (h *handlerV1) LabCreate(c *gin.Context) {
 		Price:         response.Price,
 		Type:          response.Type,
 		SubCategoryId: response.SubCategoryId,
+		DoctorId:      response.DoctorId,
+		RoomNumber:    response.RoomNumber,
 		CreatedAt:     response.CreatedAt,
 		UpdatedAt:     response.UpdatedAt,
 	})
@@ -68,6 +73,7 @@ func (h *handlerV1) LabCreate(c *gin.Context) {
 // @Summary 		Get lab
 // @Description 	This api can get lab
 // @Tags 			Lab
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.LabGetReq false "Filter"
@@ -97,6 +103,8 @@ func (h *handlerV1) LabGet(c *gin.Context) {
 		Price:         response.Price,
 		Type:          response.Type,
 		SubCategoryId: response.SubCategoryId,
+		DoctorId:      response.DoctorId,
+		RoomNumber:    response.RoomNumber,
 		CreatedAt:     response.CreatedAt,
 		UpdatedAt:     response.UpdatedAt,
 	})
@@ -105,6 +113,7 @@ func (h *handlerV1) LabGet(c *gin.Context) {
 // @Summary 	Find labs
 // @Description This api can find labs
 // @Tags 		Lab
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.LabsFindReq false "Filter"
@@ -147,6 +156,8 @@ func (h *handlerV1) LabsFind(c *gin.Context) {
 			Price:         lab.Price,
 			Type:          lab.Type,
 			SubCategoryId: lab.SubCategoryId,
+			DoctorId:      lab.DoctorId,
+			RoomNumber:    lab.RoomNumber,
 			CreatedAt:     lab.CreatedAt,
 			UpdatedAt:     lab.UpdatedAt,
 		})
@@ -187,6 +198,7 @@ func labParams(c *gin.Context) (*models.LabsFindReq, error) {
 // @Summary 	Update lab
 // @Description This api can update lab
 // @Tags 		Lab
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -211,10 +223,12 @@ func (h *handlerV1) LabUpdate(c *gin.Context) {
 	defer cancel()
 
 	response, err := h.serviceManager.LabService().LabUpdate(ctx, &lab.LabUpdateReq{
-		Id:    c.Param("id"),
-		Name:  body.Name,
-		Price: body.Price,
-		Type:  body.Type,
+		Id:         c.Param("id"),
+		Name:       body.Name,
+		Price:      body.Price,
+		Type:       body.Type,
+		DoctorId:   body.DoctorId,
+		RoomNumber: body.RoomNumber,
 	})
 	if err != nil {
 		h.log.Error("Error updating lab", logger.Error(err))
@@ -230,6 +244,8 @@ func (h *handlerV1) LabUpdate(c *gin.Context) {
 		Price:         response.Price,
 		Type:          response.Type,
 		SubCategoryId: response.SubCategoryId,
+		DoctorId:      response.DoctorId,
+		RoomNumber:    response.RoomNumber,
 		CreatedAt:     response.CreatedAt,
 		UpdatedAt:     response.UpdatedAt,
 	})
@@ -238,6 +254,7 @@ func (h *handlerV1) LabUpdate(c *gin.Context) {
 // @Summary 	Delete lab
 // @Description This api can delete lab
 // @Tags 		Lab
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -268,6 +285,7 @@ func (h *handlerV1) LabDelete(c *gin.Context) {
 // @Summary 	Create aparat
 // @Description This api can registr aparat
 // @Tags 		Aparat
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CreateAparat true "Body"
@@ -296,6 +314,8 @@ func (h *handlerV1) AparatCreate(c *gin.Context) {
 		Price:         body.Price,
 		Type:          body.Type,
 		SubCategoryId: body.SubCategoryId,
+		DoctorId:      body.DoctorId,
+		RoomNumber:    body.RoomNumber,
 	})
 	if err != nil {
 		h.log.Error("Error creating aparat", logger.Error(err))
@@ -311,6 +331,8 @@ func (h *handlerV1) AparatCreate(c *gin.Context) {
 		Price:         response.Price,
 		Type:          response.Type,
 		SubCategoryId: response.SubCategoryId,
+		DoctorId:      response.DoctorId,
+		RoomNumber:    response.RoomNumber,
 		CreatedAt:     response.CreatedAt,
 		UpdatedAt:     response.UpdatedAt,
 	})
@@ -319,6 +341,7 @@ func (h *handlerV1) AparatCreate(c *gin.Context) {
 // @Summary 		Get aparat
 // @Description 	This api can get lab
 // @Tags 			Aparat
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.AparatGetReq false "Filter"
@@ -349,6 +372,8 @@ func (h *handlerV1) AparatGet(c *gin.Context) {
 		Price:         response.Price,
 		Type:          response.Type,
 		SubCategoryId: response.SubCategoryId,
+		DoctorId:      response.DoctorId,
+		RoomNumber:    response.RoomNumber,
 		CreatedAt:     response.CreatedAt,
 		UpdatedAt:     response.UpdatedAt,
 	})
@@ -357,6 +382,7 @@ func (h *handlerV1) AparatGet(c *gin.Context) {
 // @Summary 	Find aparat
 // @Description This api can find labs
 // @Tags 		Aparat
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.AparatFindReq false "Filter"
@@ -399,6 +425,8 @@ func (h *handlerV1) AparatsFind(c *gin.Context) {
 			Price:         lab.Price,
 			Type:          lab.Type,
 			SubCategoryId: lab.SubCategoryId,
+			DoctorId:      lab.DoctorId,
+			RoomNumber:    lab.RoomNumber,
 			CreatedAt:     lab.CreatedAt,
 			UpdatedAt:     lab.UpdatedAt,
 		})
@@ -411,6 +439,7 @@ func (h *handlerV1) AparatsFind(c *gin.Context) {
 // @Summary 	Update aparat
 // @Description This api can update lab
 // @Tags 		Aparat
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -435,10 +464,12 @@ func (h *handlerV1) AparatsUpdate(c *gin.Context) {
 	defer cancel()
 
 	response, err := h.serviceManager.LabService().AparatsUpdate(ctx, &lab.AparatUpdateReq{
-		Id:    c.Param("id"),
-		Name:  body.Name,
-		Price: body.Price,
-		Type:  body.Type,
+		Id:         c.Param("id"),
+		Name:       body.Name,
+		Price:      body.Price,
+		Type:       body.Type,
+		DoctorId:   body.DoctorId,
+		RoomNumber: body.RoomNumber,
 	})
 	if err != nil {
 		h.log.Error("Error updating lab", logger.Error(err))
@@ -449,18 +480,21 @@ func (h *handlerV1) AparatsUpdate(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusCreated, models.LabModelResp{
-		Id:        response.Id,
-		Name:      response.Name,
-		Price:     response.Price,
-		Type:      response.Type,
-		CreatedAt: response.CreatedAt,
-		UpdatedAt: response.UpdatedAt,
+		Id:         response.Id,
+		Name:       response.Name,
+		Price:      response.Price,
+		Type:       response.Type,
+		DoctorId:   response.DoctorId,
+		RoomNumber: response.RoomNumber,
+		CreatedAt:  response.CreatedAt,
+		UpdatedAt:  response.UpdatedAt,
 	})
 }
 
 // @Summary 	Delete aparat
 // @Description This api can delete lab
 // @Tags 		Aparat
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -491,6 +525,7 @@ func (h *handlerV1) AparatsDelete(c *gin.Context) {
 // @Summary 	Create lab category
 // @Description This api can registr lab categoty
 // @Tags 		Lab Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CategoryModel true "Body"
@@ -536,6 +571,7 @@ func (h *handlerV1) LabCategoryCreate(c *gin.Context) {
 // @Summary 		Get lab category
 // @Description 	This api can get lab category
 // @Tags 			Lab Category
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.CategoryGetReqModel false "Filter"
@@ -571,6 +607,7 @@ func (h *handlerV1) LabCategoryGet(c *gin.Context) {
 // @Summary 	Find lab category
 // @Description This api can find lab categories
 // @Tags 		Lab Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.CategoryFindReqModel false "Filter"
@@ -622,6 +659,7 @@ func (h *handlerV1) LabCategoryFind(c *gin.Context) {
 // @Summary 	Update lab category
 // @Description This api can update lab catigory
 // @Tags 		Lab Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -668,6 +706,7 @@ func (h *handlerV1) LabCategoryUpdate(c *gin.Context) {
 // @Summary 	Delete lab category
 // @Description This api can delete lab category
 // @Tags 		Lab Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -699,6 +738,7 @@ func (h *handlerV1) LabCategoryDelete(c *gin.Context) {
 // @Summary 	Create aparat category
 // @Description This api can registr aparat categoty
 // @Tags 		Aparat Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CategoryModel true "Body"
@@ -744,6 +784,7 @@ func (h *handlerV1) AparatCategoryCreate(c *gin.Context) {
 // @Summary 		Get aparat category
 // @Description 	This api can get aparat category
 // @Tags 			Aparat Category
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.CategoryGetReqModel false "Filter"
@@ -779,6 +820,7 @@ func (h *handlerV1) AparatCategoryGet(c *gin.Context) {
 // @Summary 	Find aparat category
 // @Description This api can find aparat categories
 // @Tags 		Aparat Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.CategoryFindReqModel false "Filter"
@@ -830,6 +872,7 @@ func (h *handlerV1) AparatCategoryFind(c *gin.Context) {
 // @Summary 	Update aparat category
 // @Description This api can update aparat catigory
 // @Tags 		Aparat Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -876,6 +919,7 @@ func (h *handlerV1) AparatCategoryUpdate(c *gin.Context) {
 // @Summary 	Delete aparat category
 // @Description This api can delete aparat category
 // @Tags 		Aparat Category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -907,6 +951,7 @@ func (h *handlerV1) AparatCategoryDelete(c *gin.Context) {
 // @Summary 	Create Lab sub category
 // @Description This api can registr Lab sub category create
 // @Tags 		Lab sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.SubCategoryModel true "Body"
@@ -954,6 +999,7 @@ func (h *handlerV1) LabSubCategoryCreate(c *gin.Context) {
 // @Summary 		Get Lab sub category
 // @Description 	This api can get Lab sub category create
 // @Tags 			Lab sub category
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.CategoryGetReqModel false "Filter"
@@ -990,6 +1036,7 @@ func (h *handlerV1) LabSubCategoryGet(c *gin.Context) {
 // @Summary 	Find Lab sub category
 // @Description This api can find lab sub categories
 // @Tags 		Lab sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.CategoryFindReqModel false "Filter"
@@ -1042,6 +1089,7 @@ func (h *handlerV1) LabSubCategoryFind(c *gin.Context) {
 // @Summary 	Update Lab sub category
 // @Description This api can update Lab sub category
 // @Tags 		Lab sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -1088,6 +1136,7 @@ func (h *handlerV1) LabSubCategoryUpdate(c *gin.Context) {
 // @Summary 	Delete Lab sub category
 // @Description This api can delete Lab sub category
 // @Tags 		Lab sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -1119,6 +1168,7 @@ func (h *handlerV1) LabSubCategoryDelete(c *gin.Context) {
 // @Summary 	Create Aparat sub category
 // @Description This api can registr Aparat sub category create
 // @Tags 		Aparat sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.SubCategoryModel true "Body"
@@ -1166,6 +1216,7 @@ func (h *handlerV1) AparatSubCategoryCreate(c *gin.Context) {
 // @Summary 		Get Aparat sub category
 // @Description 	This api can get Aparat sub category create
 // @Tags 			Aparat sub category
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.CategoryGetReqModel false "Filter"
@@ -1202,6 +1253,7 @@ func (h *handlerV1) AparatSubCategoryGet(c *gin.Context) {
 // @Summary 	Find Aparat sub category
 // @Description This api can find Aparat sub categories
 // @Tags 		Aparat sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.CategoryFindReqModel false "Filter"
@@ -1254,6 +1306,7 @@ func (h *handlerV1) AparatSubCategoryFind(c *gin.Context) {
 // @Summary 	Update Aparat sub category
 // @Description This api can update Aparat sub category
 // @Tags 		Aparat sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -1300,6 +1353,7 @@ func (h *handlerV1) AparatSubCategoryUpdate(c *gin.Context) {
 // @Summary 	Delete Aparat sub category
 // @Description This api can delete Aparat sub category
 // @Tags 		Aparat sub category
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -1331,6 +1385,7 @@ func (h *handlerV1) AparatSubCategoryDelete(c *gin.Context) {
 // @Summary 	Create Aparat analysis
 // @Description This api can registr Aparat analysis
 // @Tags 		Aparat analysis
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.AnalysisReqModel true "Body"
@@ -1358,6 +1413,7 @@ func (h *handlerV1) AparatAnalysisCreate(c *gin.Context) {
 		ClientId:    body.ClientId,
 		AparatId:    body.AparatId,
 		AnalysisUrl: body.AnalysisUrl,
+		ActorId:     c.GetString(ctxStaffId),
 	})
 	if err != nil {
 		h.log.Error("Error creating aparat analysis", logger.Error(err))
@@ -1380,6 +1436,7 @@ func (h *handlerV1) AparatAnalysisCreate(c *gin.Context) {
 // @Summary 		Get Aparat analysis
 // @Description 	This api can get Aparat analysis
 // @Tags 			Aparat analysis
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.AnalysisGetReqModel false "Filter"
@@ -1417,6 +1474,7 @@ func (h *handlerV1) AparatAnalysisGet(c *gin.Context) {
 // @Summary 	Delete Aparat analysis
 // @Description This api can delete Aparat analysis
 // @Tags 		Aparat analysis
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -1448,6 +1506,7 @@ func (h *handlerV1) AparatAnalysisDelete(c *gin.Context) {
 // @Summary 	Create Lab analysis
 // @Description This api can registr Lab analysis
 // @Tags 		Lab analysis
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.AnalysisReqModel true "Body"
@@ -1475,6 +1534,7 @@ func (h *handlerV1) LabAnalysisCreate(c *gin.Context) {
 		ClientId:    body.ClientId,
 		AparatId:    body.AparatId,
 		AnalysisUrl: body.AnalysisUrl,
+		ActorId:     c.GetString(ctxStaffId),
 	})
 	if err != nil {
 		h.log.Error("Error creating lab analysis", logger.Error(err))
@@ -1497,6 +1557,7 @@ func (h *handlerV1) LabAnalysisCreate(c *gin.Context) {
 // @Summary 		Get Lab analysis
 // @Description 	This api can get Lab analysis
 // @Tags 			Lab analysis
+// @Security    BearerAuth
 // @Accept 			json
 // @Produce         json
 // @Param 			filter query models.AnalysisGetReqModel false "Filter"
@@ -1534,6 +1595,7 @@ func (h *handlerV1) LabAnalysisGet(c *gin.Context) {
 // @Summary 	Delete Lab analysis
 // @Description This api can delete Lab analysis
 // @Tags 		Lab analysis
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
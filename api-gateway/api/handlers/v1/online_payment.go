@@ -0,0 +1,286 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/config"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+	"gitlab.com/clinic-crm/api-gateway/pkg/payments"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Online payment providers
+const (
+	providerClick     = "click"
+	providerSimulator = "simulator"
+)
+
+func paymentProviders(cfg config.Config) map[string]payments.Provider {
+	providers := make(map[string]payments.Provider)
+	if cfg.ClickSecretKey != "" {
+		providers[providerClick] = &payments.Click{
+			ServiceId:  cfg.ClickServiceId,
+			MerchantId: cfg.ClickMerchantId,
+			SecretKey:  cfg.ClickSecretKey,
+			PayUrl:     cfg.ClickPayUrl,
+		}
+	}
+	// the simulator speaks click with its own secret, its payment page is served by the gateway
+	if cfg.PaymentSimulator {
+		providers[providerSimulator] = &payments.Click{
+			ServiceId:  providerSimulator,
+			MerchantId: providerSimulator,
+			SecretKey:  cfg.SimulatorSecret,
+			PayUrl:     cfg.BaseUrl + "payments/simulator/pay",
+		}
+	}
+	return providers
+}
+
+// @Summary 	cashbox payment link
+// @Description This api can get a link the patient pays the remaining summa of the cashbox online on
+// @Tags 		Cashbox
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id 		path string true "Cashbox ID"
+// @Param body 	body models.PaymentLinkReq true "Body"
+// @Success 	200 {object} models.PaymentLink
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/cashbox-payment-link/{id} [post]
+func (h *handlerV1) CashboxPaymentLink(c *gin.Context) {
+	var body models.PaymentLinkReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	provider, ok := h.payments[body.Provider]
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusBadRequest, models.DefaultResponse{
+			ErrorCode:    ErrorCodeBadRequest,
+			ErrorMessage: "unknown payment provider " + body.Provider,
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	cashbox, err := h.serviceManager.PatientService().GetCashbox(ctx, &p.GetCashboxReq{
+		CashboxId: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "GetCashbox") {
+		h.log.Error("Error getting cashbox", logger.Error(err))
+		return
+	}
+	if cashbox.Remaining <= 0 {
+		c.AbortWithStatusJSON(http.StatusConflict, models.DefaultResponse{
+			ErrorCode:    ErrorCodeBadRequest,
+			ErrorMessage: "cashbox is paid",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.PaymentLink{
+		CashboxId: cashbox.Id,
+		Provider:  body.Provider,
+		Amount:    cashbox.Remaining,
+		Url:       provider.Link(cashbox.Id, cashbox.Remaining),
+	})
+}
+
+// @Summary 	payment provider callback
+// @Description This api is called by the payment provider to check, perform and cancel its transactions, the request is signed by the provider
+// @Tags 		Payments
+// @Accept 		x-www-form-urlencoded
+// @Produce 	json
+// @Param 		provider 	path string true "Provider: click or simulator"
+// @Success 	200 {object} object
+// @Failure 	404 {object} models.DefaultResponse
+// @Router 		/v1/payments/{provider}/callback [post]
+func (h *handlerV1) PaymentCallback(c *gin.Context) {
+	name := c.Param("provider")
+	provider, ok := h.payments[name]
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusNotFound, models.DefaultResponse{
+			ErrorCode:    ErrorCodeNotFound,
+			ErrorMessage: "unknown payment provider " + name,
+		})
+		return
+	}
+
+	// the provider gets its answer even when the callback fails, in its own format
+	c.JSON(http.StatusOK, h.paymentCallback(c.Request, name, provider))
+}
+
+func (h *handlerV1) paymentCallback(r *http.Request, name string, provider payments.Provider) interface{} {
+	callback, err := provider.Parse(r)
+	if err != nil {
+		h.log.Error("Error parsing payment callback", logger.String("provider", name), logger.Error(err))
+		if callback == nil {
+			callback = &payments.Callback{}
+		}
+		return provider.Reply(callback, err)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	req := &p.OnlineTransactionReq{
+		Provider:      name,
+		TransactionId: callback.TransactionId,
+		CashboxId:     callback.CashboxId,
+		Amount:        callback.Amount,
+		Reason:        callback.Reason,
+	}
+	patientService := h.serviceManager.PatientService()
+	switch callback.Action {
+	case payments.ActionCheck:
+		_, err = patientService.OnlineTransactionCheck(ctx, req)
+	case payments.ActionPerform:
+		_, err = patientService.OnlineTransactionPerform(ctx, req)
+	case payments.ActionCancel:
+		_, err = patientService.OnlineTransactionCancel(ctx, req)
+	}
+	if err != nil {
+		h.log.Error("Error handling payment callback", logger.String("provider", name),
+			logger.String("action", callback.Action), logger.Error(err))
+	}
+
+	return provider.Reply(callback, callbackError(callback.Action, err))
+}
+
+// callbackError maps the error of the reception service to the callback error the provider is answered with.
+func callbackError(action string, err error) error {
+	if err == nil {
+		return nil
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return payments.ErrNotFound
+	case codes.InvalidArgument:
+		return payments.ErrRequest
+	case codes.AlreadyExists:
+		return payments.ErrAmount
+	case codes.FailedPrecondition:
+		// a check fails on the amount, a perform only on a cancelled transaction
+		if action == payments.ActionCheck {
+			return payments.ErrAmount
+		}
+		return payments.ErrCancelled
+	}
+	return payments.ErrInternal
+}
+
+// @Summary 	online transaction get
+// @Description This api can get an online transaction by the provider and its id at the provider
+// @Tags 		Payments
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		provider 		query string true "Provider"
+// @Param 		transaction_id 	query string true "Transaction ID at the provider"
+// @Success 	200 {object} models.OnlineTransaction
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/online-transaction-get [get]
+func (h *handlerV1) OnlineTransactionGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().OnlineTransactionGet(ctx, &p.OnlineTransactionReq{
+		Provider:      c.Query("provider"),
+		TransactionId: c.Query("transaction_id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "OnlineTransactionGet") {
+		h.log.Error("Error getting online transaction", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, onlineTransactionModel(response))
+}
+
+// @Summary 	simulator payment
+// @Description This api is the payment page of the simulator provider, it pays the link at once by sending the signed prepare and complete callbacks, with cancel=true the complete cancels the transaction. It is served to admins only when PAYMENT_SIMULATOR is on, which is refused in production
+// @Tags 		Payments
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		transaction_param 	query string true "Cashbox ID"
+// @Param 		amount 				query int true "Amount"
+// @Param 		cancel 				query bool false "Cancel the payment"
+// @Success 	200 {object} models.SimulatorPayment
+// @Failure 	404 {object} models.DefaultResponse
+// @Router 		/v1/payments/simulator/pay [get]
+func (h *handlerV1) PaymentSimulatorPay(c *gin.Context) {
+	provider, ok := h.payments[providerSimulator].(*payments.Click)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusNotFound, models.DefaultResponse{
+			ErrorCode:    ErrorCodeNotFound,
+			ErrorMessage: "payment simulator is disabled",
+		})
+		return
+	}
+
+	form := url.Values{
+		"click_trans_id":    {strconv.FormatInt(time.Now().UnixNano(), 10)},
+		"service_id":        {provider.ServiceId},
+		"merchant_trans_id": {c.Query("transaction_param")},
+		"amount":            {c.Query("amount")},
+		"action":            {"0"},
+		"error":             {"0"},
+		"sign_time":         {time.Now().Format("2006-01-02 15:04:05")},
+	}
+	result := models.SimulatorPayment{TransactionId: form.Get("click_trans_id")}
+
+	form.Set("sign_string", provider.Sign(form))
+	result.Prepare = h.paymentCallback(simulatorRequest(c, form), providerSimulator, provider)
+
+	form.Set("action", "1")
+	form.Set("merchant_prepare_id", form.Get("click_trans_id"))
+	if cancel, _ := strconv.ParseBool(c.Query("cancel")); cancel {
+		form.Set("error", "-5017")
+		form.Set("error_note", "cancelled in the simulator")
+	}
+	form.Set("sign_string", provider.Sign(form))
+	result.Complete = h.paymentCallback(simulatorRequest(c, form), providerSimulator, provider)
+
+	c.JSON(http.StatusOK, result)
+}
+
+// simulatorRequest builds the callback request the provider would post.
+func simulatorRequest(c *gin.Context, form url.Values) *http.Request {
+	r, _ := http.NewRequestWithContext(c.Request.Context(), http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func onlineTransactionModel(t *p.OnlineTransaction) *models.OnlineTransaction {
+	return &models.OnlineTransaction{
+		Id:            t.Id,
+		Provider:      t.Provider,
+		TransactionId: t.TransactionId,
+		CashboxId:     t.CashboxId,
+		Amount:        t.Amount,
+		State:         t.State,
+		PaymentId:     t.PaymentId,
+		CancelReason:  t.CancelReason,
+		CreatedAt:     t.CreatedAt,
+		PerformedAt:   t.PerformedAt,
+		CancelledAt:   t.CancelledAt,
+	}
+}
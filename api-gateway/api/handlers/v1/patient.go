@@ -2,25 +2,27 @@ package v1
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	"gitlab.com/clinic-crm/api-gateway/api/models"
-	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
-	"gitlab.com/clinic-crm/api-gateway/genproto/lab"
 	"gitlab.com/clinic-crm/api-gateway/genproto/patient"
 	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
 	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
 	l "gitlab.com/clinic-crm/api-gateway/pkg/logger"
+	"gitlab.com/clinic-crm/api-gateway/pkg/receipt"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"google.golang.org/grpc"
 )
 
 // @Summary 	create patient
 // @Description This api can patient registr
 // @Tags 		Patient
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CreatePatientModel true "Body"
@@ -95,6 +97,7 @@ func (h *handlerV1) PatientCreate(c *gin.Context) {
 // @Summary 	get patient
 // @Description This api can get patient
 // @Tags 		Patient
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.GetPatientReqModel false "Filter"
@@ -145,6 +148,7 @@ func (h *handlerV1) PatientGet(c *gin.Context) {
 // @Summary 	update patient
 // @Description This api can update patient
 // @Tags 		Patient
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
@@ -219,6 +223,7 @@ func (h *handlerV1) PatientUpdate(c *gin.Context) {
 // @Summary 	delete patient
 // @Description This api can delete patient
 // @Tags 		Patient
+// @Security    BearerAuth
 // @Accept		json
 // @Produce 	json
 // @Param 		filter query models.PatientId false "Filter"
@@ -258,6 +263,7 @@ func (h *handlerV1) PatientDelete(c *gin.Context) {
 // @Summary 	find patients
 // @Description This api can find patient
 // @Tags 		Patient
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.PatientsFindModel false "Filter"
@@ -351,6 +357,7 @@ func patientParams(c *gin.Context) (*models.PatientsFindModel, error) {
 // // @Summary 	filter patients
 // // @Description This api can filter patient
 // // @Tags 		Filter
+// @Security    BearerAuth
 // // @Accept 		json
 // // @Produce 	json
 // // @Param 		filter query models.GetPatientReqModel false "Filter"
@@ -431,6 +438,7 @@ func patientParams(c *gin.Context) (*models.PatientsFindModel, error) {
 // @Summary 	create patient queue
 // @Description create patient queue
 // @Tags 		Queue
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CreatePatientQueueReq true "Body"
@@ -466,22 +474,14 @@ func (h *handlerV1) PatientQueueCreate(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.PatientQueueResp{
-		Id:          response.Id,
-		ClientId:    response.ClientId,
-		QueueNumber: response.QueueNumber,
-		ServiceId:   response.ServiceId,
-		ServiceType: response.ServiceType,
-		TurnPassed:  response.TurnPassed,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, queueModel(response))
 }
 
 // @Router 		/v1/queue-get [get]
 // @Summary 	get patient queue
 // @Description This api can get patient queue
 // @Tags 		Queue
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter 		query models.PatientQueueId false "Filter"
@@ -504,22 +504,14 @@ func (h *handlerV1) PatientQueueGet(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.PatientQueueResp{
-		Id:          response.Id,
-		ClientId:    response.ClientId,
-		QueueNumber: response.QueueNumber,
-		ServiceId:   response.ServiceId,
-		ServiceType: response.ServiceType,
-		TurnPassed:  response.TurnPassed,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, queueModel(response))
 }
 
 // @Router 		/v1/queue-check-get [get]
 // @Summary 	check patient queue
 // @Description This api can check patient queue
 // @Tags 		Queue
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter 		query models.CheckQueueReq false "Filter"
@@ -548,60 +540,136 @@ func (h *handlerV1) CheckPatientQueue(c *gin.Context) {
 	})
 }
 
-// @Router 		/v1/queue-update/{id} [post]
-// @Summary 	update patient queue
-// @Description This api can update patient queue
+// @Router 		/v1/queue-call-next [post]
+// @Summary 	call next patient
+// @Description This api can call the next waiting (recalled first) patient of the service for today
 // @Tags 		Queue
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
-// @Param body 	body models.UpdateQueueReq true "UpdatePatientModel"
+// @Param body 	body models.CallNextReq true "Body"
 // @Success 	200 {object} models.PatientQueueResp
-// @Failure 	400 {object} models.ResponseError
-// @Failure 	500 {object} models.ResponseError
-func (h *handlerV1) PatientQueueUpdate(c *gin.Context) {
-	var body models.UpdateQueueReq
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) QueueCallNext(c *gin.Context) {
+	var body models.CallNextReq
 
 	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		h.log.Error("Error getting doctor", logger.Error(err))
-		c.JSON(http.StatusInternalServerError, models.ResponseError{
-			Message: err.Error(),
-		})
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(time.Duration(h.cfg.CtxTimeout)))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
 	defer cancel()
 
-	response, err := h.serviceManager.PatientService().UpdateQueue(ctx, &p.UpdateQueueReq{
-		ClientId:    body.ClientId,
+	response, err := h.serviceManager.PatientService().CallNext(ctx, &p.CallNextReq{
 		ServiceId:   body.ServiceId,
 		ServiceType: body.ServiceType,
 	})
-	if err != nil {
-		h.log.Error("Error getting doctor", logger.Error(err))
-		c.JSON(http.StatusInternalServerError, models.ResponseError{
-			Message: err.Error(),
-		})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "CallNext") {
+		h.log.Error("Error calling next queue", logger.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.PatientQueueResp{
-		Id:          response.Id,
-		ClientId:    response.ClientId,
-		QueueNumber: response.QueueNumber,
-		ServiceId:   response.ServiceId,
-		ServiceType: response.ServiceType,
-		TurnPassed:  response.TurnPassed,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
+	c.JSON(http.StatusOK, queueModel(response))
+}
+
+// @Router 		/v1/queue-start/{id} [post]
+// @Summary 	start serving patient queue
+// @Description This api can move a called queue to in_service
+// @Tags 		Queue
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.PatientQueueResp
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) QueueStart(c *gin.Context) {
+	h.queueTransition(c, h.serviceManager.PatientService().StartQueue)
+}
+
+// @Router 		/v1/queue-complete/{id} [post]
+// @Summary 	complete patient queue
+// @Description This api can move an in_service queue to done
+// @Tags 		Queue
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.PatientQueueResp
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) QueueComplete(c *gin.Context) {
+	h.queueTransition(c, h.serviceManager.PatientService().CompleteQueue)
+}
+
+// @Router 		/v1/queue-skip/{id} [post]
+// @Summary 	skip patient queue
+// @Description This api can skip a waiting, called or recalled queue
+// @Tags 		Queue
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.PatientQueueResp
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) QueueSkip(c *gin.Context) {
+	h.queueTransition(c, h.serviceManager.PatientService().SkipQueue)
+}
+
+// @Router 		/v1/queue-recall/{id} [post]
+// @Summary 	recall patient queue
+// @Description This api can put a skipped or no_show queue back in line, it is called before waiting ones
+// @Tags 		Queue
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.PatientQueueResp
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) QueueRecall(c *gin.Context) {
+	h.queueTransition(c, h.serviceManager.PatientService().RecallQueue)
+}
+
+// @Router 		/v1/queue-no-show/{id} [post]
+// @Summary 	mark patient queue as no show
+// @Description This api can mark a not served queue as no_show
+// @Tags 		Queue
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.PatientQueueResp
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) QueueNoShow(c *gin.Context) {
+	h.queueTransition(c, h.serviceManager.PatientService().NoShowQueue)
+}
+
+func (h *handlerV1) queueTransition(c *gin.Context, transition func(context.Context, *p.QueueId, ...grpc.CallOption) (*p.PatientQueueResp, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := transition(ctx, &p.QueueId{
+		Id: c.Param("id"),
 	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "queue transition") {
+		h.log.Error("Error changing queue status", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, queueModel(response))
 }
 
 // @Router 		/v1/queue-find [get]
 // @Summary 	Find patient queues
 // @Description This api can find patient queues
 // @Tags 		Queue
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.QueueFilter false "Filter"
@@ -630,6 +698,7 @@ func (h *handlerV1) PatientQueuesFind(c *gin.Context) {
 		ClientId:    req.ClientId,
 		ServiceId:   req.ServiceId,
 		ServiceType: req.ServiceType,
+		Status:      req.Status,
 	})
 	if err != nil {
 		h.log.Error("Error finding doctor reports", logger.Error(err))
@@ -640,22 +709,100 @@ func (h *handlerV1) PatientQueuesFind(c *gin.Context) {
 	}
 
 	for _, queue := range response.Queues {
-		QueuesResp.Queues = append(QueuesResp.Queues, &models.PatientQueueResp{
-			Id:          queue.Id,
-			ClientId:    queue.ClientId,
-			QueueNumber: queue.QueueNumber,
-			ServiceId:   queue.ServiceId,
-			ServiceType: queue.ServiceType,
-			TurnPassed:  queue.TurnPassed,
-			CreatedAt:   queue.CreatedAt,
-			UpdatedAt:   queue.UpdatedAt,
-		})
+		QueuesResp.Queues = append(QueuesResp.Queues, queueModel(queue))
 	}
 	QueuesResp.Count = int(response.Count)
 
 	c.JSON(http.StatusCreated, QueuesResp)
 }
 
+// @Router 		/v1/queue-board [get]
+// @Summary 	live queue board
+// @Description This api streams the queue board of the service for waiting room screens as server-sent events.
+// @Description A "queue" event with who is called, the room and who is next is sent on connect and after every queue change.
+// @Tags 		Queue
+// @Produce 	text/event-stream
+// @Param 		service_id query string true "Service ID"
+// @Param 		service_type query string true "Service type (doctor, lab, aparat)"
+// @Param 		next_limit query int false "How many next patients to show, 5 by default"
+// @Success 	200 {object} models.QueueBoard
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) QueueBoard(c *gin.Context) {
+	var nextLimit int
+	if c.Query("next_limit") != "" {
+		limit, err := strconv.Atoi(c.Query("next_limit"))
+		if HandleBadRequestErrWithMessage(c, &h.log, err, "strconv.Atoi(next_limit)") {
+			return
+		}
+		nextLimit = limit
+	}
+
+	// the stream lives as long as the screen is connected
+	stream, err := h.serviceManager.PatientService().WatchQueue(c.Request.Context(), &p.WatchQueueReq{
+		ServiceId:   c.Query("service_id"),
+		ServiceType: c.Query("service_type"),
+		NextLimit:   int64(nextLimit),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "WatchQueue") {
+		h.log.Error("Error watching queue", logger.Error(err))
+		return
+	}
+
+	// errors of the service come with the first message, answer them as usual
+	board, err := stream.Recv()
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "WatchQueue") {
+		h.log.Error("Error watching queue", logger.Error(err))
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Stream(func(w io.Writer) bool {
+		c.SSEvent("queue", queueBoardModel(board))
+
+		board, err = stream.Recv()
+		if err != nil {
+			if c.Request.Context().Err() == nil {
+				h.log.Error("Error watching queue", logger.Error(err))
+			}
+			return false
+		}
+		return true
+	})
+}
+
+func queueBoardModel(board *p.QueueBoard) *models.QueueBoard {
+	result := models.QueueBoard{
+		ServiceId:   board.ServiceId,
+		ServiceType: board.ServiceType,
+		ServiceName: board.ServiceName,
+		RoomNumber:  board.RoomNumber,
+		QueueDay:    board.QueueDay,
+		UpdatedAt:   board.UpdatedAt,
+		Current:     make([]*models.QueueBoardEntry, 0, len(board.Current)),
+		Next:        make([]*models.QueueBoardEntry, 0, len(board.Next)),
+	}
+	for _, entry := range board.Current {
+		result.Current = append(result.Current, queueBoardEntryModel(entry))
+	}
+	for _, entry := range board.Next {
+		result.Next = append(result.Next, queueBoardEntryModel(entry))
+	}
+
+	return &result
+}
+
+func queueBoardEntryModel(entry *p.QueueBoardEntry) *models.QueueBoardEntry {
+	return &models.QueueBoardEntry{
+		QueueId:     entry.QueueId,
+		QueueNumber: entry.QueueNumber,
+		Status:      entry.Status,
+		CalledAt:    entry.CalledAt,
+	}
+}
+
 func patientQueueParams(c *gin.Context) (*models.QueueFilter, error) {
 	var (
 		limit     int = 10
@@ -687,16 +834,39 @@ func patientQueueParams(c *gin.Context) (*models.QueueFilter, error) {
 	return &models.QueueFilter{
 		ServiceId:   c.Query("service_id"),
 		ServiceType: c.Query("service_type"),
+		Status:      c.Query("status"),
 		Limit:       int64(limit),
 		Page:        int64(page),
 		ClientId:    int64(client_id),
 	}, nil
 }
 
+func queueModel(queue *p.PatientQueueResp) *models.PatientQueueResp {
+	return &models.PatientQueueResp{
+		Id:          queue.Id,
+		ClientId:    queue.ClientId,
+		QueueNumber: queue.QueueNumber,
+		ServiceId:   queue.ServiceId,
+		ServiceType: queue.ServiceType,
+		TurnPassed:  queue.TurnPassed,
+		QueueDay:    queue.QueueDay,
+		Status:      queue.Status,
+		CalledAt:    queue.CalledAt,
+		InServiceAt: queue.InServiceAt,
+		DoneAt:      queue.DoneAt,
+		SkippedAt:   queue.SkippedAt,
+		NoShowAt:    queue.NoShowAt,
+		RecalledAt:  queue.RecalledAt,
+		CreatedAt:   queue.CreatedAt,
+		UpdatedAt:   queue.UpdatedAt,
+	}
+}
+
 // @Router 		/v1/cashbox-create [post]
 // @Summary 	create cashbox
 // @Description create cashbox
 // @Tags 		Cashbox
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CreateCashboxReq true "Body"
@@ -721,12 +891,12 @@ func (h *handlerV1) CashboxCreate(c *gin.Context) {
 	response, err := h.serviceManager.PatientService().CreateCashbox(ctx, &p.CreateCashboxReq{
 		Id:          uuid.New().String(),
 		ClientId:    body.ClientId,
-		IsPayed:     body.IsPayed,
 		CashCount:   0,
 		PaymentType: body.PaymentType,
 		DoctorsIds:  body.DoctorsIds,
 		LabsIds:     body.LabsIds,
 		AparatsIds:  body.AparatsIds,
+		StaffId:     c.GetString(ctxStaffId),
 	})
 
 	if err != nil {
@@ -737,24 +907,14 @@ func (h *handlerV1) CashboxCreate(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.CashboxResp{
-		Id:         response.Id,
-		ClientId:   int(response.ClientId),
-		Summa:      int(response.Summa),
-		IsPayed:    response.IsPayed,
-		CashCount:  int(response.CashCount),
-		DoctorsIds: response.DoctorsIds,
-		LabsIds:    response.LabsIds,
-		AparatsIds: response.AparatsIds,
-		CreatedAt:  response.CreatedAt,
-		UpdatedAt:  response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, cashboxModel(response))
 }
 
 // @Router 		/v1/cashbo-find [get]
 // @Summary 	Find cashbox
 // @Description This api can find cashbox
 // @Tags 		Cashbox
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.FindCashboxReq false "Filter"
@@ -794,20 +954,12 @@ func (h *handlerV1) CashboxFind(c *gin.Context) {
 	}
 
 	for _, queue := range response.Cashboxes {
-		CashboxesResp.Cashboxes = append(CashboxesResp.Cashboxes, &models.CashboxResp{
-			Id:         queue.Id,
-			ClientId:   int(queue.ClientId),
-			Summa:      int(queue.Summa),
-			IsPayed:    queue.IsPayed,
-			CashCount:  int(queue.CashCount),
-			DoctorsIds: queue.DoctorsIds,
-			LabsIds:    queue.LabsIds,
-			AparatsIds: queue.AparatsIds,
-			CreatedAt:  queue.CreatedAt,
-			UpdatedAt:  queue.UpdatedAt,
-		})
+		CashboxesResp.Cashboxes = append(CashboxesResp.Cashboxes, cashboxModel(queue))
 	}
 	CashboxesResp.Count = int(response.Count)
+	CashboxesResp.Gross = response.Gross
+	CashboxesResp.Discount = response.Discount
+	CashboxesResp.Net = response.Net
 
 	c.JSON(http.StatusCreated, CashboxesResp)
 }
@@ -854,6 +1006,7 @@ func cashboxParams(c *gin.Context) (*models.FindCashboxReq, error) {
 // @Summary 	get patient cashbox
 // @Description This api can get patient queue
 // @Tags 		Cashbox
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter 		query models.PatientQueueId false "Filter"
@@ -876,47 +1029,53 @@ func (h *handlerV1) CashboxGet(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.CashboxResp{
-		Id:         response.Id,
-		ClientId:   int(response.ClientId),
-		Summa:      int(response.Summa),
-		IsPayed:    response.IsPayed,
-		CashCount:  int(response.CashCount),
-		DoctorsIds: response.DoctorsIds,
-		LabsIds:    response.LabsIds,
-		AparatsIds: response.AparatsIds,
-		CreatedAt:  response.CreatedAt,
-		UpdatedAt:  response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, cashboxModel(response))
 }
 
 // @Router 		/v1/cashbox-print [get]
 // @Summary 	get patient cashbox print
 // @Description This api can get patient queue
 // @Tags 		Cashbox
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
+// @Produce 	application/pdf
 // @Param 		filter 		query models.PatientQueueId false "Filter"
+// @Param 		format 		query string false "PDF receipt of the format: 58mm, 80mm or a4, JSON when empty"
 // @Success 	200 		{object} models.CashboxesPrinterResp
 // @Failure     400         {object}  models.ResponseError
+// @Failure     404         {object}  models.ResponseError
 // @Failure     500         {object}  models.ResponseError
 func (h *handlerV1) CashboxPrint(c *gin.Context) {
 	var (
 		result models.CashboxesPrinterResp
+		format = c.Query("format")
 	)
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(time.Duration(h.cfg.CtxTimeout)))
+	if format != "" {
+		if err := receipt.Valid(format); err != nil {
+			c.JSON(http.StatusBadRequest, models.ResponseError{
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*time.Duration(h.cfg.CtxTimeout))
 	defer cancel()
 
 	response, err := h.serviceManager.PatientService().GetCashbox(ctx, &p.GetCashboxReq{
 		CashboxId: c.Query("id"),
 	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "CashboxPrint") {
+		h.log.Error("Error getting cashbox", logger.Error(err))
+		return
+	}
 
 	userResp, err := h.serviceManager.PatientService().PatientGet(ctx, &p.GetPatientReq{
 		Field: "client_id",
 		Value: strconv.Itoa(int(response.ClientId)),
 	})
-
 	if err != nil {
 		h.log.Error("Error getting patient", logger.Error(err))
 		c.JSON(http.StatusInternalServerError, models.ResponseError{
@@ -925,31 +1084,27 @@ func (h *handlerV1) CashboxPrint(c *gin.Context) {
 		return
 	}
 
-	for _, aparatId := range response.AparatsIds {
-		aparat, err := h.serviceManager.LabService().AparatGet(ctx, &lab.AparatGetReq{Field: "id", Value: aparatId})
-		if err != nil {
-			h.log.Error("Error getting patient", logger.Error(err))
-			c.JSON(http.StatusInternalServerError, models.ResponseError{
-				Message: err.Error(),
-			})
-			return
-		}
-		doctorInfo, err := h.serviceManager.DoctorService().DoctorGet(ctx, &doctor.GetDoctorReq{Field: "id", Value: aparat.DoctorId})
-		if err != nil {
-			h.log.Error("Error getting patient", logger.Error(err))
-			c.JSON(http.StatusInternalServerError, models.ResponseError{
-				Message: err.Error(),
-			})
-			return
+	doctors, labsById, aparatsById, ok := h.cashboxServices(ctx, c, response, "CashboxPrint")
+	if !ok {
+		return
+	}
+	// a lab or aparat service is printed without a doctor if it has none
+	doctorName := func(doctorId string) string {
+		if doctor, ok := doctors[doctorId]; ok {
+			return doctor.FirstName + " " + doctor.LastName
 		}
+		return ""
+	}
 
+	for _, aparatId := range response.AparatsIds {
+		aparat := aparatsById[aparatId]
 		result.Cashboxes = append(result.Cashboxes, &models.CashboxPrinterResp{
-			ImageUrl:    "https://www.impulse-clinic.com/wp-content/uploads/2019/08/logo_new-2.png",
+			ImageUrl:    h.cfg.ClinicLogoUrl,
 			CashCount:   int(response.CashCount),
 			FirstName:   userResp.FirstName,
 			LastName:    userResp.LastName,
 			ServiceType: aparat.Type,
-			DoctorName:  doctorInfo.FirstName + " " + doctorInfo.LastName,
+			DoctorName:  doctorName(aparat.DoctorId),
 			RoomNumber:  aparat.RoomNumber,
 			Summa:       int(response.Summa),
 			CreatedAt:   response.CreatedAt,
@@ -958,17 +1113,16 @@ func (h *handlerV1) CashboxPrint(c *gin.Context) {
 	}
 
 	for _, doctorId := range response.DoctorsIds {
-		doctor, err := h.serviceManager.DoctorService().DoctorGet(ctx, &doctor.GetDoctorReq{Field: "id", Value: doctorId})
-		if err != nil {
-			h.log.Error("Error getting patient", logger.Error(err))
-			c.JSON(http.StatusInternalServerError, models.ResponseError{
-				Message: err.Error(),
+		doctor, ok := doctors[doctorId]
+		if !ok {
+			c.JSON(http.StatusNotFound, models.ResponseError{
+				Message: "doctor not found: " + doctorId,
 			})
 			return
 		}
 
 		result.Cashboxes = append(result.Cashboxes, &models.CashboxPrinterResp{
-			ImageUrl:    "https://www.impulse-clinic.com/wp-content/uploads/2019/08/logo_new-2.png",
+			ImageUrl:    h.cfg.ClinicLogoUrl,
 			CashCount:   int(response.CashCount),
 			FirstName:   userResp.FirstName,
 			LastName:    userResp.LastName,
@@ -982,30 +1136,14 @@ func (h *handlerV1) CashboxPrint(c *gin.Context) {
 	}
 
 	for _, labId := range response.LabsIds {
-		lab, err := h.serviceManager.LabService().LabGet(ctx, &lab.LabGetReq{Field: "id", Value: labId})
-		if err != nil {
-			h.log.Error("Error getting patient", logger.Error(err))
-			c.JSON(http.StatusInternalServerError, models.ResponseError{
-				Message: err.Error(),
-			})
-			return
-		}
-		doctorInfo, err := h.serviceManager.DoctorService().DoctorGet(ctx, &doctor.GetDoctorReq{Field: "id", Value: lab.DoctorId})
-		if err != nil {
-			h.log.Error("Error getting patient", logger.Error(err))
-			c.JSON(http.StatusInternalServerError, models.ResponseError{
-				Message: err.Error(),
-			})
-			return
-		}
-
+		lab := labsById[labId]
 		result.Cashboxes = append(result.Cashboxes, &models.CashboxPrinterResp{
-			ImageUrl:    "https://www.impulse-clinic.com/wp-content/uploads/2019/08/logo_new-2.png",
+			ImageUrl:    h.cfg.ClinicLogoUrl,
 			CashCount:   int(response.CashCount),
 			FirstName:   userResp.FirstName,
 			LastName:    userResp.LastName,
 			ServiceType: lab.Type,
-			DoctorName:  doctorInfo.FirstName + " " + doctorInfo.LastName,
+			DoctorName:  doctorName(lab.DoctorId),
 			RoomNumber:  lab.RoomNumber,
 			Summa:       int(response.Summa),
 			CreatedAt:   response.CreatedAt,
@@ -1013,6 +1151,27 @@ func (h *handlerV1) CashboxPrint(c *gin.Context) {
 		result.Count += 1
 	}
 
+	if format != "" {
+		receiptResp, err := h.cashboxReceipt(ctx, response, userResp, format, doctors, labsById, aparatsById)
+		if HandleDatabaseLevelWithMessage(c, &h.log, err, "CashboxPrint") {
+			h.log.Error("Error getting cashbox queues", logger.Error(err))
+			return
+		}
+
+		c.Header("Content-Type", "application/pdf")
+		c.Header("Content-Disposition", `inline; filename="receipt-`+response.Id+`.pdf"`)
+		if err := receipt.Render(c.Writer, format, h.cfg.ReceiptFont, receiptResp); err != nil {
+			h.log.Error("Error rendering receipt", logger.Error(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	result.Gross = int(response.Gross)
+	result.Discount = int(response.Discount)
+	result.Net = int(response.Summa)
+	result.Discounts = cashboxDiscountsModel(response.Discounts)
+
 	c.JSON(http.StatusCreated, result)
 }
 
@@ -1020,6 +1179,7 @@ func (h *handlerV1) CashboxPrint(c *gin.Context) {
 // @Summary 	update cashbox
 // @Description This api can update cashbox
 // @Tags 		Cashbox
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.UpdateCashboxReq true "UpdatePatientModel"
@@ -1054,30 +1214,21 @@ func (h *handlerV1) CashboxUpdate(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.CashboxResp{
-		Id:         response.Id,
-		ClientId:   int(response.ClientId),
-		Summa:      int(response.Summa),
-		IsPayed:    response.IsPayed,
-		CashCount:  int(response.CashCount),
-		DoctorsIds: response.DoctorsIds,
-		LabsIds:    response.LabsIds,
-		AparatsIds: response.AparatsIds,
-		CreatedAt:  response.CreatedAt,
-		UpdatedAt:  response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, cashboxModel(response))
 }
 
 // @Router 		/v1/cashbox-delete/{id}  [delete]
 // @Summary 	Delete cashbox
 // @Description This api can delete cashbox
 // @Tags 		Cashbox
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		id path string true "ID"
 // @Success 	200 {object} models.ResponseOK
-// @Failure 	400 {object} models.ResponseError
-// @Failure 	500 {object} models.ResponseError
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
 func (h *handlerV1) CashboxDelete(c *gin.Context) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(7))
@@ -1086,11 +1237,8 @@ func (h *handlerV1) CashboxDelete(c *gin.Context) {
 	_, err := h.serviceManager.PatientService().DeleteCashbox(ctx, &patient.GetCashboxReq{
 		CashboxId: c.Param("id"),
 	})
-	if err != nil {
-		h.log.Error("Error deleting aparat sub category", logger.Error(err))
-		c.JSON(http.StatusInternalServerError, models.ResponseError{
-			Message: err.Error(),
-		})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DeleteCashbox") {
+		h.log.Error("Error deleting cashbox", logger.Error(err))
 		return
 	}
 
@@ -1099,16 +1247,188 @@ func (h *handlerV1) CashboxDelete(c *gin.Context) {
 	})
 }
 
+// @Router 		/v1/cashbox-pay/{id} [post]
+// @Summary 	pay cashbox
+// @Description This api can pay the cashbox at once split across cash, card and transfer payment types on the open shift of the cashier, paying more than the remaining summa is rejected
+// @Tags 		Cashbox
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "Cashbox ID"
+// @Param body 	body models.CashboxPayReq true "Body"
+// @Success 	200 {object} models.CashboxResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) CashboxPay(c *gin.Context) {
+	var body models.CashboxPayReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	req := &p.CashboxPayReq{
+		CashboxId: c.Param("id"),
+		StaffId:   c.GetString(ctxStaffId),
+		Payments:  make([]*p.CashboxPayment, 0, len(body.Payments)),
+	}
+	for _, payment := range body.Payments {
+		req.Payments = append(req.Payments, &p.CashboxPayment{
+			Id:          uuid.New().String(),
+			Summa:       payment.Summa,
+			PaymentType: payment.PaymentType,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().CashboxPay(ctx, req)
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "CashboxPay") {
+		h.log.Error("Error paying cashbox", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, cashboxModel(response))
+}
+
+// @Router 		/v1/cashbox-refund/{id} [post]
+// @Summary 	refund cashbox
+// @Description This api can refund the whole cashbox or, with service_type (doctor, lab, aparat) and service_id, one line of it.
+// @Description The money paid over the rest is returned as a negative payment and not served queues of the services are cancelled.
+// @Tags 		Cashbox
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "Cashbox ID"
+// @Param body 	body models.CashboxRefundReq true "Body"
+// @Success 	200 {object} models.CashboxResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+func (h *handlerV1) CashboxRefund(c *gin.Context) {
+	var body models.CashboxRefundReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().CashboxRefund(ctx, &p.CashboxRefundReq{
+		Id:          uuid.New().String(),
+		CashboxId:   c.Param("id"),
+		ServiceType: body.ServiceType,
+		ServiceId:   body.ServiceId,
+		Reason:      body.Reason,
+		StaffId:     c.GetString(ctxStaffId),
+		PaymentType: body.PaymentType,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "CashboxRefund") {
+		h.log.Error("Error refunding cashbox", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, cashboxModel(response))
+}
+
+func paymentHistoryModel(payment *p.PaymentHistoryResp) *models.PaymentHistoryResp {
+	return &models.PaymentHistoryResp{
+		Id:                  payment.Id,
+		ClientId:            payment.ClientId,
+		Summa:               payment.Summa,
+		PaymentType:         payment.PaymentType,
+		CashboxId:           payment.CashboxId,
+		RefundReason:        payment.RefundReason,
+		StaffId:             payment.StaffId,
+		ServiceType:         payment.ServiceType,
+		ServiceId:           payment.ServiceId,
+		FiscalStatus:        payment.FiscalStatus,
+		FiscalReceiptNumber: payment.FiscalReceiptNumber,
+		FiscalSign:          payment.FiscalSign,
+		FiscalizedAt:        payment.FiscalizedAt,
+		CreatedAt:           payment.CreatedAt,
+		UpdatedAt:           payment.UpdatedAt,
+	}
+}
+
+func cashboxDiscountsModel(discounts []*p.CashboxDiscount) []*models.CashboxDiscount {
+	result := make([]*models.CashboxDiscount, 0, len(discounts))
+	for _, discount := range discounts {
+		result = append(result, &models.CashboxDiscount{
+			DiscountId:  discount.DiscountId,
+			Name:        discount.Name,
+			ServiceType: discount.ServiceType,
+			ServiceId:   discount.ServiceId,
+			Gross:       discount.Gross,
+			Amount:      discount.Amount,
+		})
+	}
+	return result
+}
+
+func cashboxModel(cashbox *p.CashboxResp) *models.CashboxResp {
+	result := &models.CashboxResp{
+		Id:          cashbox.Id,
+		ClientId:    int(cashbox.ClientId),
+		Gross:       int(cashbox.Gross),
+		Discount:    int(cashbox.Discount),
+		Summa:       int(cashbox.Summa),
+		Paid:        int(cashbox.Paid),
+		Remaining:   int(cashbox.Remaining),
+		Refunded:    int(cashbox.Refunded),
+		IsPayed:     cashbox.IsPayed,
+		CashCount:   int(cashbox.CashCount),
+		PaymentType: cashbox.PaymentType,
+		DoctorsIds:  cashbox.DoctorsIds,
+		LabsIds:     cashbox.LabsIds,
+		AparatsIds:  cashbox.AparatsIds,
+		Items:       make([]*models.CashboxItem, 0, len(cashbox.Items)),
+		Discounts:   cashboxDiscountsModel(cashbox.Discounts),
+		Payments:    make([]*models.PaymentHistoryResp, 0, len(cashbox.Payments)),
+		StaffId:     cashbox.StaffId,
+		CreatedAt:   cashbox.CreatedAt,
+		UpdatedAt:   cashbox.UpdatedAt,
+	}
+	for _, item := range cashbox.Items {
+		result.Items = append(result.Items, &models.CashboxItem{
+			Id:               item.Id,
+			ServiceType:      item.ServiceType,
+			ServiceId:        item.ServiceId,
+			Name:             item.Name,
+			Price:            item.Price,
+			Quantity:         item.Quantity,
+			Discount:         item.Discount,
+			DiscountId:       item.DiscountId,
+			DiscountName:     item.DiscountName,
+			DoctorId:         item.DoctorId,
+			RefundedQuantity: item.RefundedQuantity,
+		})
+	}
+	for _, payment := range cashbox.Payments {
+		result.Payments = append(result.Payments, paymentHistoryModel(payment))
+	}
+	return result
+}
+
 // @Router 		/v1/payment-create [post]
 // @Summary 	create payment history
-// @Description create payment history
+// @Description create payment history on the open shift of the cashier
 // @Tags 		Payment history
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param body 	body models.CreatePaymentHistoryReq true "Body"
 // @Success 	201 {object} models.PaymentHistoryResp
-// @Failure 	400 {object} models.ResponseError
-// @Failure 	500 {object} models.ResponseError
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
 func (h *handlerV1) CreatePaymentHistory(c *gin.Context) {
 	var body models.CreatePaymentHistoryReq
 
@@ -1130,31 +1450,22 @@ func (h *handlerV1) CreatePaymentHistory(c *gin.Context) {
 		Summa:       body.Summa,
 		CashboxId:   body.CashboxId,
 		PaymentType: body.PaymentType,
+		StaffId:     c.GetString(ctxStaffId),
 	})
 
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("fail to create patient", l.Error(err))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "CreatePaymentHistory") {
+		h.log.Error("Error creating payment history", logger.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.PaymentHistoryResp{
-		Id:          response.Id,
-		ClientId:    response.ClientId,
-		Summa:       response.Summa,
-		PaymentType: response.PaymentType,
-		CashboxId:   response.CashboxId,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, paymentHistoryModel(response))
 }
 
 // @Router 		/v1/payment-get [get]
 // @Summary 	get payment history
 // @Description This api can get payment history
 // @Tags 		Payment history
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter 		query models.PaymentHistoryId false "Filter"
@@ -1177,21 +1488,14 @@ func (h *handlerV1) GetPaymentHistory(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, models.PaymentHistoryResp{
-		Id:          response.Id,
-		ClientId:    response.ClientId,
-		Summa:       response.Summa,
-		PaymentType: response.PaymentType,
-		CashboxId:   response.CashboxId,
-		CreatedAt:   response.CreatedAt,
-		UpdatedAt:   response.UpdatedAt,
-	})
+	c.JSON(http.StatusCreated, paymentHistoryModel(response))
 }
 
 // @Router 		/v1/payment-find [get]
 // @Summary 	Find cashbox
 // @Description This api can find cashbox
 // @Tags 		Payment history
+// @Security    BearerAuth
 // @Accept 		json
 // @Produce 	json
 // @Param 		filter query models.PaymentHistoryFilter false "Filter"
@@ -1220,6 +1524,7 @@ func (h *handlerV1) FindPaymentHistory(c *gin.Context) {
 		ClientId: int64(req.ClientId),
 		FromDate: req.FromDate,
 		ToDate:   req.ToDate,
+		Refunds:  req.Refunds,
 	})
 	if err != nil {
 		h.log.Error("Error finding doctor reports", logger.Error(err))
@@ -1230,17 +1535,11 @@ func (h *handlerV1) FindPaymentHistory(c *gin.Context) {
 	}
 
 	for _, history := range response.PaymentHistory {
-		paymentHistoryResp.PaymentHistories = append(paymentHistoryResp.PaymentHistories, &models.PaymentHistoryResp{
-			Id:          history.Id,
-			ClientId:    history.ClientId,
-			Summa:       history.Summa,
-			PaymentType: history.PaymentType,
-			CashboxId:   history.CashboxId,
-			CreatedAt:   history.CreatedAt,
-			UpdatedAt:   history.UpdatedAt,
-		})
+		paymentHistoryResp.PaymentHistories = append(paymentHistoryResp.PaymentHistories, paymentHistoryModel(history))
 	}
 	paymentHistoryResp.Count = int(response.Count)
+	paymentHistoryResp.Income = response.Income
+	paymentHistoryResp.Refunds = response.Refunds
 
 	c.JSON(http.StatusCreated, paymentHistoryResp)
 }
@@ -1250,6 +1549,7 @@ func paymentHistoryParams(c *gin.Context) (*models.PaymentHistoryFilter, error)
 		limit     int = 10
 		page      int = 1
 		client_id int = 0
+		refunds   bool
 		err       error
 	)
 
@@ -1273,42 +1573,19 @@ func paymentHistoryParams(c *gin.Context) (*models.PaymentHistoryFilter, error)
 			return nil, err
 		}
 	}
+
+	if c.Query("refunds") != "" {
+		refunds, err = strconv.ParseBool(c.Query("refunds"))
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &models.PaymentHistoryFilter{
 		FromDate: c.Query("from_date"),
 		ToDate:   c.Query("to_date"),
 		Limit:    limit,
 		Page:     page,
 		ClientId: client_id,
+		Refunds:  refunds,
 	}, nil
 }
-
-// @Router 		/v1/payment-delete/{id}  [delete]
-// @Summary 	Delete payment history
-// @Description This api can delete payment history
-// @Tags 		Payment history
-// @Accept 		json
-// @Produce 	json
-// @Param 		id path string true "ID"
-// @Success 	200 {object} models.ResponseOK
-// @Failure 	400 {object} models.ResponseError
-// @Failure 	500 {object} models.ResponseError
-func (h *handlerV1) DeletePaymentHistory(c *gin.Context) {
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(7))
-	defer cancel()
-
-	_, err := h.serviceManager.PatientService().DeletePaymentHistory(ctx, &patient.PaymentHistoryId{
-		Id: c.Param("id"),
-	})
-	if err != nil {
-		h.log.Error("Error deleting aparat sub category", logger.Error(err))
-		c.JSON(http.StatusInternalServerError, models.ResponseError{
-			Message: err.Error(),
-		})
-		return
-	}
-
-	c.JSON(http.StatusOK, models.ResponseOK{
-		Message: "Successfully",
-	})
-}
@@ -0,0 +1,421 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	create purchase order
+// @Description This api can draft an order of sqlad from the supplier, items without a unit_cost cost the price of the sqlad
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.PurchaseOrderReq true "Body"
+// @Success 	201 {object} models.PurchaseOrder
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/purchase-order-create [post]
+func (h *handlerV1) PurchaseOrderCreate(c *gin.Context) {
+	var body models.PurchaseOrderReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	req := orderProto(uuid.New().String(), &body)
+	req.ActorId = c.GetString(ctxStaffId)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PurchaseOrderCreate(ctx, req)
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PurchaseOrderCreate") {
+		h.log.Error("Error creating purchase order", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, orderModel(response))
+}
+
+// @Summary 	order low stock
+// @Description This api drafts an order for each supplier of the sqlad low on stock, bringing the stock up to twice the low stock level less what the open orders are still to bring. The sqlad without a supplier is listed to order by hand
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.LowStockOrderReq true "Body"
+// @Success 	201 {object} models.LowStockOrders
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/purchase-order-low-stock [post]
+func (h *handlerV1) LowStockOrder(c *gin.Context) {
+	var body models.LowStockOrderReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().LowStockOrder(ctx, &doctor.LowStockOrderReq{
+		SupplierId: body.SupplierId,
+		ActorId:    c.GetString(ctxStaffId),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "LowStockOrder") {
+		h.log.Error("Error ordering low stock", logger.Error(err))
+		return
+	}
+
+	result := models.LowStockOrders{
+		Orders:          make([]*models.PurchaseOrder, 0, len(response.Orders)),
+		WithoutSupplier: make([]*models.SqladRespModel, 0, len(response.WithoutSupplier)),
+	}
+	for _, order := range response.Orders {
+		result.Orders = append(result.Orders, orderModel(order))
+	}
+	for _, product := range response.WithoutSupplier {
+		result.WithoutSupplier = append(result.WithoutSupplier, &models.SqladRespModel{
+			Id:             product.Id,
+			Name:           product.Name,
+			Count:          product.Count,
+			Price:          product.Price,
+			LowStock:       product.LowStock,
+			ExpirationDate: product.ExpirationDate,
+			Provider:       product.Provider,
+			CreatedAt:      product.CreatedAt,
+			UpdatedAt:      product.UpdatedAt,
+		})
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// @Summary 	get purchase order
+// @Description This api can get purchase order by id with its items and what was received of them
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.PurchaseOrder
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/purchase-order-get/{id} [get]
+func (h *handlerV1) PurchaseOrderGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PurchaseOrderGet(ctx, &doctor.PurchaseOrderId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PurchaseOrderGet") {
+		h.log.Error("Error getting purchase order", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, orderModel(response))
+}
+
+// @Summary 	find purchase orders
+// @Description This api can find the purchase orders newest first. Dates are like 2006-01-02, both included
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.PurchaseOrdersFindReq false "Filter"
+// @Success 	200 {object} models.PurchaseOrders
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/purchase-order-find [get]
+func (h *handlerV1) PurchaseOrdersFind(c *gin.Context) {
+	req, err := ordersParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "ordersParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PurchaseOrdersFind(ctx, &doctor.PurchaseOrdersFindReq{
+		SupplierId: req.SupplierId,
+		Status:     req.Status,
+		FromDate:   req.FromDate,
+		ToDate:     req.ToDate,
+		Limit:      req.Limit,
+		Page:       req.Page,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PurchaseOrdersFind") {
+		h.log.Error("Error finding purchase orders", logger.Error(err))
+		return
+	}
+
+	result := models.PurchaseOrders{
+		Orders: make([]*models.PurchaseOrder, 0, len(response.Orders)),
+		Count:  response.Count,
+	}
+	for _, order := range response.Orders {
+		result.Orders = append(result.Orders, orderModel(order))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	update purchase order
+// @Description This api can replace the supplier, note and items of a draft order
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.PurchaseOrderReq true "Body"
+// @Success 	200 {object} models.PurchaseOrder
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/purchase-order-update/{id} [post]
+func (h *handlerV1) PurchaseOrderUpdate(c *gin.Context) {
+	var body models.PurchaseOrderReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PurchaseOrderUpdate(ctx, orderProto(c.Param("id"), &body))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PurchaseOrderUpdate") {
+		h.log.Error("Error updating purchase order", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, orderModel(response))
+}
+
+// @Summary 	set purchase order status
+// @Description This api can send a draft order to the supplier (ordered), cancel an order nothing was received of yet (cancelled) or close an order received short (received)
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.PurchaseOrderStatusReq true "Body"
+// @Success 	200 {object} models.PurchaseOrder
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/purchase-order-status/{id} [post]
+func (h *handlerV1) PurchaseOrderStatusSet(c *gin.Context) {
+	var body models.PurchaseOrderStatusReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PurchaseOrderStatusSet(ctx, &doctor.PurchaseOrderStatusReq{
+		Id:     c.Param("id"),
+		Status: body.Status,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PurchaseOrderStatusSet") {
+		h.log.Error("Error setting purchase order status", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, orderModel(response))
+}
+
+// @Summary 	receive purchase order
+// @Description This api adds what arrived of an ordered order to the stock, each item as a new lot. Items can arrive in parts up to the quantity ordered, the order becomes received when everything arrived
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.PurchaseOrderReceipt true "Body"
+// @Success 	200 {object} models.PurchaseOrder
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/purchase-order-receive/{id} [post]
+func (h *handlerV1) PurchaseOrderReceive(c *gin.Context) {
+	var body models.PurchaseOrderReceipt
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	req := doctor.PurchaseOrderReceipt{
+		OrderId: c.Param("id"),
+		ActorId: c.GetString(ctxStaffId),
+	}
+	for _, item := range body.Items {
+		req.Items = append(req.Items, &doctor.PurchaseOrderReceiptItem{
+			ItemId:         item.ItemId,
+			Quantity:       item.Quantity,
+			LotNumber:      item.LotNumber,
+			ExpirationDate: item.ExpirationDate,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().PurchaseOrderReceive(ctx, &req)
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "PurchaseOrderReceive") {
+		h.log.Error("Error receiving purchase order", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, orderModel(response))
+}
+
+// @Summary 	get supplier spend report
+// @Description This api sums what was received from the suppliers in the dates at the cost of the orders, per sqlad. Dates are like 2006-01-02, both included
+// @Tags 		Purchase
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		from_date 	query string true "From date"
+// @Param 		to_date 	query string true "To date"
+// @Param 		supplier_id query string false "Supplier ID"
+// @Success 	200 {object} models.SupplierSpendReport
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/supplier-spend-report [get]
+func (h *handlerV1) SupplierSpendReportGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SupplierSpendReportGet(ctx, &doctor.SupplierSpendReq{
+		FromDate:   c.Query("from_date"),
+		ToDate:     c.Query("to_date"),
+		SupplierId: c.Query("supplier_id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SupplierSpendReportGet") {
+		h.log.Error("Error getting supplier spend report", logger.Error(err))
+		return
+	}
+
+	result := models.SupplierSpendReport{
+		FromDate:  response.FromDate,
+		ToDate:    response.ToDate,
+		Suppliers: make([]*models.SupplierSpend, 0, len(response.Suppliers)),
+		Total:     response.Total,
+	}
+	for _, supplier := range response.Suppliers {
+		spend := models.SupplierSpend{
+			SupplierId:   supplier.SupplierId,
+			SupplierName: supplier.SupplierName,
+			Orders:       supplier.Orders,
+			Spent:        supplier.Spent,
+			Items:        make([]*models.SupplierSpendItem, 0, len(supplier.Items)),
+		}
+		for _, item := range supplier.Items {
+			spend.Items = append(spend.Items, &models.SupplierSpendItem{
+				SqladId:   item.SqladId,
+				SqladName: item.SqladName,
+				Quantity:  item.Quantity,
+				Spent:     item.Spent,
+			})
+		}
+		result.Suppliers = append(result.Suppliers, &spend)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func ordersParams(c *gin.Context) (*models.PurchaseOrdersFindReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.PurchaseOrdersFindReq{
+		SupplierId: c.Query("supplier_id"),
+		Status:     c.Query("status"),
+		FromDate:   c.Query("from_date"),
+		ToDate:     c.Query("to_date"),
+		Limit:      int64(limit),
+		Page:       int64(page),
+	}, nil
+}
+
+func orderProto(id string, body *models.PurchaseOrderReq) *doctor.PurchaseOrder {
+	result := doctor.PurchaseOrder{
+		Id:         id,
+		SupplierId: body.SupplierId,
+		Note:       body.Note,
+	}
+	for _, item := range body.Items {
+		result.Items = append(result.Items, &doctor.PurchaseOrderItem{
+			SqladId:  item.SqladId,
+			Quantity: item.Quantity,
+			UnitCost: item.UnitCost,
+		})
+	}
+	return &result
+}
+
+func orderModel(order *doctor.PurchaseOrder) *models.PurchaseOrder {
+	result := models.PurchaseOrder{
+		Id:            order.Id,
+		Number:        order.Number,
+		SupplierId:    order.SupplierId,
+		SupplierName:  order.SupplierName,
+		Status:        order.Status,
+		Note:          order.Note,
+		ActorId:       order.ActorId,
+		Items:         make([]*models.PurchaseOrderItem, 0, len(order.Items)),
+		Total:         order.Total,
+		ReceivedTotal: order.ReceivedTotal,
+		OrderedAt:     order.OrderedAt,
+		CreatedAt:     order.CreatedAt,
+		UpdatedAt:     order.UpdatedAt,
+	}
+	for _, item := range order.Items {
+		result.Items = append(result.Items, &models.PurchaseOrderItem{
+			Id:        item.Id,
+			SqladId:   item.SqladId,
+			SqladName: item.SqladName,
+			Quantity:  item.Quantity,
+			UnitCost:  item.UnitCost,
+			Received:  item.Received,
+		})
+	}
+	return &result
+}
@@ -0,0 +1,340 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/genproto/lab"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+	"gitlab.com/clinic-crm/api-gateway/pkg/receipt"
+)
+
+const (
+	// the logo waits this long for CLINIC_LOGO_URL, the receipt is printed without it after
+	logoTimeout = 3 * time.Second
+	// logos bigger than this are not drawn
+	logoMaxSize = 1 << 20
+)
+
+// logoCache keeps the logo of CLINIC_LOGO_URL once it is fetched.
+type logoCache struct {
+	mu    sync.Mutex
+	image []byte
+}
+
+// @Router 		/v1/receipt [get]
+// @Summary 	get cashbox receipt by its public link
+// @Description The QR code of a printed receipt links here, the link is signed instead of the staff signing in
+// @Tags 		Cashbox
+// @Produce 	application/pdf
+// @Param 		id 			query string true "Cashbox id"
+// @Param 		sig 		query string true "Signature of the link"
+// @Param 		format 		query string false "58mm, 80mm or a4, a4 when empty"
+// @Success 	200 		{file} 	  file
+// @Failure     400         {object}  models.ResponseError
+// @Failure     404         {object}  models.ResponseError
+// @Failure     500         {object}  models.ResponseError
+func (h *handlerV1) ReceiptGet(c *gin.Context) {
+	var (
+		id     = c.Query("id")
+		format = c.DefaultQuery("format", receipt.FormatA4)
+	)
+	if err := receipt.Valid(format); err != nil {
+		c.JSON(http.StatusBadRequest, models.ResponseError{
+			Message: err.Error(),
+		})
+		return
+	}
+	// a wrong signature looks like a receipt that does not exist
+	if !receipt.Verify(h.cfg.SigningKey, id, c.Query("sig")) {
+		c.JSON(http.StatusNotFound, models.ResponseError{
+			Message: "receipt is not found",
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().GetCashbox(ctx, &p.GetCashboxReq{
+		CashboxId: id,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ReceiptGet") {
+		h.log.Error("Error getting cashbox", logger.Error(err))
+		return
+	}
+
+	client, err := h.serviceManager.PatientService().PatientGet(ctx, &p.GetPatientReq{
+		Field: "client_id",
+		Value: strconv.Itoa(int(response.ClientId)),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ReceiptGet") {
+		h.log.Error("Error getting patient", logger.Error(err))
+		return
+	}
+
+	doctors, labs, aparats, ok := h.cashboxServices(ctx, c, response, "ReceiptGet")
+	if !ok {
+		return
+	}
+
+	receiptResp, err := h.cashboxReceipt(ctx, response, client, format, doctors, labs, aparats)
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ReceiptGet") {
+		h.log.Error("Error getting cashbox queues", logger.Error(err))
+		return
+	}
+
+	c.Header("Content-Type", "application/pdf")
+	c.Header("Content-Disposition", `inline; filename="receipt-`+response.Id+`.pdf"`)
+	if err := receipt.Render(c.Writer, format, h.cfg.ReceiptFont, receiptResp); err != nil {
+		h.log.Error("Error rendering receipt", logger.Error(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+// cashboxServices gets the doctors, labs and aparats of the cashbox by their ids, the doctors
+// of the labs and aparats too. It writes the error response and returns false on a failure.
+func (h *handlerV1) cashboxServices(ctx context.Context, c *gin.Context, cashbox *p.CashboxResp, method string) (
+	map[string]*doctor.Doctor, map[string]*lab.LabCreateRes, map[string]*lab.AparatCreateRes, bool) {
+	var err error
+
+	// the services and then the doctors of all of them are got in one call each
+	aparats := &lab.AparatsByIdsResp{}
+	if len(cashbox.AparatsIds) != 0 {
+		aparats, err = h.serviceManager.LabService().AparatsGetByIds(ctx, &lab.ServiceIds{Ids: cashbox.AparatsIds})
+		if HandleDatabaseLevelWithMessage(c, &h.log, err, method) {
+			h.log.Error("Error getting aparats", logger.Error(err))
+			return nil, nil, nil, false
+		}
+	}
+	labs := &lab.LabsByIdsResp{}
+	if len(cashbox.LabsIds) != 0 {
+		labs, err = h.serviceManager.LabService().LabsGetByIds(ctx, &lab.ServiceIds{Ids: cashbox.LabsIds})
+		if HandleDatabaseLevelWithMessage(c, &h.log, err, method) {
+			h.log.Error("Error getting labs", logger.Error(err))
+			return nil, nil, nil, false
+		}
+	}
+	if missing := append(aparats.MissingIds, labs.MissingIds...); len(missing) != 0 {
+		c.JSON(http.StatusNotFound, models.ResponseError{
+			Message: "services not found: " + strings.Join(missing, ", "),
+		})
+		return nil, nil, nil, false
+	}
+
+	doctorsIds := append([]string{}, cashbox.DoctorsIds...)
+	for _, aparat := range aparats.Aparats {
+		if aparat.DoctorId != "" {
+			doctorsIds = append(doctorsIds, aparat.DoctorId)
+		}
+	}
+	for _, lab := range labs.Labs {
+		if lab.DoctorId != "" {
+			doctorsIds = append(doctorsIds, lab.DoctorId)
+		}
+	}
+	doctors := make(map[string]*doctor.Doctor, len(doctorsIds))
+	if len(doctorsIds) != 0 {
+		doctorsResp, err := h.serviceManager.DoctorService().DoctorsGetByIds(ctx, &doctor.DoctorIds{Ids: doctorsIds})
+		if HandleDatabaseLevelWithMessage(c, &h.log, err, method) {
+			h.log.Error("Error getting doctors", logger.Error(err))
+			return nil, nil, nil, false
+		}
+		for _, doctor := range doctorsResp.Doctors {
+			doctors[doctor.Id] = doctor
+		}
+	}
+
+	aparatsById := make(map[string]*lab.AparatCreateRes, len(aparats.Aparats))
+	for _, aparat := range aparats.Aparats {
+		aparatsById[aparat.Id] = aparat
+	}
+	labsById := make(map[string]*lab.LabCreateRes, len(labs.Labs))
+	for _, lab := range labs.Labs {
+		labsById[lab.Id] = lab
+	}
+
+	return doctors, labsById, aparatsById, true
+}
+
+// cashboxReceipt builds the printed receipt of the cashbox from its items, the doctors,
+// labs and aparats of the items and the queues the patient got for them.
+func (h *handlerV1) cashboxReceipt(ctx context.Context, cashbox *p.CashboxResp, client *p.Patient, format string,
+	doctors map[string]*doctor.Doctor, labs map[string]*lab.LabCreateRes, aparats map[string]*lab.AparatCreateRes) (*receipt.Receipt, error) {
+	var day string
+	if len(cashbox.CreatedAt) >= 10 {
+		day = cashbox.CreatedAt[:10]
+	}
+	queues, err := h.serviceManager.PatientService().FindQueue(ctx, &p.QueueFilter{
+		ClientId: cashbox.ClientId,
+		FromDate: day,
+		Limit:    100,
+		Page:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the first queue of the service since the cashbox was made is its queue
+	queueNumbers := make(map[string]int64, len(queues.Queues))
+	for _, queue := range queues.Queues {
+		key := queue.ServiceType + "/" + queue.ServiceId
+		if _, ok := queueNumbers[key]; !ok {
+			queueNumbers[key] = queue.QueueNumber
+		}
+	}
+
+	result := &receipt.Receipt{
+		Header: receipt.Header{
+			Name:    h.cfg.ClinicName,
+			Address: h.cfg.ClinicAddress,
+			Phone:   h.cfg.ClinicPhone,
+			Logo:    h.receiptLogo(ctx),
+		},
+		Number:      cashbox.Id,
+		Patient:     client.FirstName + " " + client.LastName,
+		CreatedAt:   receiptTime(cashbox.CreatedAt),
+		Lines:       receiptLines(cashbox.Items, queueNumbers, doctors, labs, aparats),
+		Gross:       cashbox.Gross,
+		Discount:    cashbox.Discount,
+		Net:         cashbox.Summa - cashbox.Refunded,
+		Paid:        cashbox.Paid,
+		Remaining:   cashbox.Remaining,
+		PaymentType: cashbox.PaymentType,
+		Url:         h.receiptUrl(cashbox.Id, format),
+	}
+
+	return result, nil
+}
+
+// receiptLines prints the items of the cashbox with their queue numbers. The room of a line
+// is the room of its doctor, lab or aparat, the doctor of a lab or aparat item is the doctor
+// stored on the item or else the doctor of the lab or aparat.
+func receiptLines(items []*p.CashboxItem, queueNumbers map[string]int64, doctors map[string]*doctor.Doctor,
+	labs map[string]*lab.LabCreateRes, aparats map[string]*lab.AparatCreateRes) []receipt.Line {
+	lines := make([]receipt.Line, 0, len(items))
+	for _, item := range items {
+		line := receipt.Line{
+			Name:        item.Name,
+			QueueNumber: queueNumbers[item.ServiceType+"/"+item.ServiceId],
+			Quantity:    item.Quantity,
+			Price:       item.Price,
+			Discount:    item.Discount,
+		}
+
+		doctorId := item.DoctorId
+		switch item.ServiceType {
+		case "doctor":
+			if doctorId == "" {
+				doctorId = item.ServiceId
+			}
+			if doctor, ok := doctors[doctorId]; ok {
+				line.RoomNumber = doctor.RoomNumber
+				if line.Name == "" {
+					line.Name = doctor.Cpecialety
+				}
+			}
+		case "lab":
+			if lab, ok := labs[item.ServiceId]; ok {
+				line.RoomNumber = lab.RoomNumber
+				if line.Name == "" {
+					line.Name = lab.Name
+				}
+				if doctorId == "" {
+					doctorId = lab.DoctorId
+				}
+			}
+		case "aparat":
+			if aparat, ok := aparats[item.ServiceId]; ok {
+				line.RoomNumber = aparat.RoomNumber
+				if line.Name == "" {
+					line.Name = aparat.Name
+				}
+				if doctorId == "" {
+					doctorId = aparat.DoctorId
+				}
+			}
+		}
+		if doctor, ok := doctors[doctorId]; ok {
+			line.DoctorName = doctor.FirstName + " " + doctor.LastName
+		}
+
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// receiptUrl is the public link of the receipt of the cashbox, signed so it opens without signing in.
+func (h *handlerV1) receiptUrl(cashboxId, format string) string {
+	return h.cfg.BaseUrl + "receipt?" + url.Values{
+		"id":     {cashboxId},
+		"sig":    {receipt.Sign(h.cfg.SigningKey, cashboxId)},
+		"format": {format},
+	}.Encode()
+}
+
+// receiptLogo returns the image of CLINIC_LOGO_URL, fetched on the first receipt after it is set.
+// A receipt is printed without a logo while it can not be fetched.
+func (h *handlerV1) receiptLogo(ctx context.Context) []byte {
+	if h.cfg.ClinicLogoUrl == "" {
+		return nil
+	}
+	h.logo.mu.Lock()
+	defer h.logo.mu.Unlock()
+	if h.logo.image != nil {
+		return h.logo.image
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, logoTimeout)
+	defer cancel()
+
+	image, err := fetchLogo(ctx, h.cfg.ClinicLogoUrl)
+	if err != nil {
+		h.log.Error("Error getting clinic logo", logger.Error(err))
+		return nil
+	}
+	h.logo.image = image
+	return image
+}
+
+func fetchLogo(ctx context.Context, logoUrl string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logoUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("clinic logo: %s", resp.Status)
+	}
+
+	image, err := io.ReadAll(io.LimitReader(resp.Body, logoMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(image) > logoMaxSize {
+		return nil, fmt.Errorf("clinic logo is bigger than %d bytes", logoMaxSize)
+	}
+	return image, nil
+}
+
+// receiptTime cuts the timestamp to the minute for printing.
+func receiptTime(timestamp string) string {
+	if len(timestamp) < 16 {
+		return timestamp
+	}
+	return timestamp[:10] + " " + timestamp[11:16]
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"testing"
+
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/genproto/lab"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/receipt"
+)
+
+func TestReceiptLines(t *testing.T) {
+	doctors := map[string]*doctor.Doctor{
+		"d1": {Id: "d1", FirstName: "Ali", LastName: "Valiyev", Cpecialety: "Cardiologist", RoomNumber: "3"},
+		"d2": {Id: "d2", FirstName: "Olga", LastName: "Kim", RoomNumber: "5"},
+	}
+	labs := map[string]*lab.LabCreateRes{
+		"l1": {Id: "l1", Name: "Blood test", DoctorId: "d2", RoomNumber: "12"},
+	}
+	aparats := map[string]*lab.AparatCreateRes{
+		"a1": {Id: "a1", Name: "X-ray", DoctorId: "d2", RoomNumber: "7"},
+	}
+	queueNumbers := map[string]int64{"lab/l1": 4}
+
+	tests := []struct {
+		name string
+		item *p.CashboxItem
+		want receipt.Line
+	}{
+		{"doctor", &p.CashboxItem{ServiceType: "doctor", ServiceId: "d1", Quantity: 1, Price: 100000},
+			receipt.Line{Name: "Cardiologist", DoctorName: "Ali Valiyev", RoomNumber: "3", Quantity: 1, Price: 100000}},
+		{"lab with the doctor of the item", &p.CashboxItem{ServiceType: "lab", ServiceId: "l1", Name: "Blood test", DoctorId: "d1", Quantity: 2, Price: 50000},
+			receipt.Line{Name: "Blood test", DoctorName: "Ali Valiyev", RoomNumber: "12", QueueNumber: 4, Quantity: 2, Price: 50000}},
+		{"lab of an item without a doctor", &p.CashboxItem{ServiceType: "lab", ServiceId: "l1", Quantity: 1, Price: 50000},
+			receipt.Line{Name: "Blood test", DoctorName: "Olga Kim", RoomNumber: "12", QueueNumber: 4, Quantity: 1, Price: 50000}},
+		{"aparat", &p.CashboxItem{ServiceType: "aparat", ServiceId: "a1", Name: "X-ray", DoctorId: "d2", Quantity: 1, Price: 80000, Discount: 8000},
+			receipt.Line{Name: "X-ray", DoctorName: "Olga Kim", RoomNumber: "7", Quantity: 1, Price: 80000, Discount: 8000}},
+		{"service deleted since", &p.CashboxItem{ServiceType: "aparat", ServiceId: "a2", Name: "MRI", Quantity: 1, Price: 300000},
+			receipt.Line{Name: "MRI", Quantity: 1, Price: 300000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := receiptLines([]*p.CashboxItem{tt.item}, queueNumbers, doctors, labs, aparats)
+			if len(lines) != 1 {
+				t.Fatalf("%d lines, want 1", len(lines))
+			}
+			if lines[0] != tt.want {
+				t.Errorf("line = %+v, want %+v", lines[0], tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 	set doctor working hours
+// @Description This api can replace the weekly working hours and breaks of the doctor, times are clinic local like 15:04 and weekdays go from 1 (monday) to 7 (sunday)
+// @Tags 		Schedule
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id 		path string true "Doctor ID"
+// @Param body 	body models.DoctorWorkHoursReq true "Body"
+// @Success 	200 {object} models.DoctorWorkHours
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-work-hours-set/{id} [post]
+func (h *handlerV1) DoctorWorkHoursSet(c *gin.Context) {
+	var body models.DoctorWorkHoursReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorWorkHoursSet(ctx, &doctor.DoctorWorkHours{
+		DoctorId: c.Param("id"),
+		Hours:    workIntervalsProto(body.Hours),
+		Breaks:   workIntervalsProto(body.Breaks),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorWorkHoursSet") {
+		h.log.Error("Error setting doctor working hours", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, workHoursModel(response))
+}
+
+// @Summary 	get doctor working hours
+// @Description This api can get the weekly working hours and breaks of the doctor
+// @Tags 		Schedule
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id 		path string true "Doctor ID"
+// @Success 	200 {object} models.DoctorWorkHours
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-work-hours-get/{id} [get]
+func (h *handlerV1) DoctorWorkHoursGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorWorkHoursGet(ctx, &doctor.DoctorId{
+		DoctorId: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorWorkHoursGet") {
+		h.log.Error("Error getting doctor working hours", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, workHoursModel(response))
+}
+
+func workIntervalsProto(intervals []*models.WorkInterval) []*doctor.WorkInterval {
+	result := make([]*doctor.WorkInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		result = append(result, &doctor.WorkInterval{
+			Weekday:   interval.Weekday,
+			StartTime: interval.StartTime,
+			EndTime:   interval.EndTime,
+		})
+	}
+	return result
+}
+
+func workIntervalsModel(intervals []*doctor.WorkInterval) []*models.WorkInterval {
+	result := make([]*models.WorkInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		result = append(result, &models.WorkInterval{
+			Weekday:   interval.Weekday,
+			StartTime: interval.StartTime,
+			EndTime:   interval.EndTime,
+		})
+	}
+	return result
+}
+
+func workHoursModel(hours *doctor.DoctorWorkHours) *models.DoctorWorkHours {
+	return &models.DoctorWorkHours{
+		DoctorId: hours.DoctorId,
+		Hours:    workIntervalsModel(hours.Hours),
+		Breaks:   workIntervalsModel(hours.Breaks),
+	}
+}
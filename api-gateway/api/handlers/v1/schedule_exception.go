@@ -0,0 +1,217 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 	create doctor schedule exception
+// @Description This api can record a vacation, sick leave or absence of the doctor with an optional substitute, or custom hours replacing the weekly ones on the days. Dates are like 2006-01-02, times like 15:04; vacation and sick take whole days
+// @Tags 		Schedule
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.DoctorExceptionReq true "Body"
+// @Success 	201 {object} models.DoctorException
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-exception-create [post]
+func (h *handlerV1) DoctorExceptionCreate(c *gin.Context) {
+	var body models.DoctorExceptionReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorExceptionCreate(ctx, &doctor.DoctorException{
+		DoctorId:     body.DoctorId,
+		Kind:         body.Kind,
+		DateFrom:     body.DateFrom,
+		DateTo:       body.DateTo,
+		StartTime:    body.StartTime,
+		EndTime:      body.EndTime,
+		SubstituteId: body.SubstituteId,
+		Note:         body.Note,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorExceptionCreate") {
+		h.log.Error("Error creating doctor exception", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, exceptionModel(response))
+}
+
+// @Summary 	find doctor schedule exceptions
+// @Description This api can find the exceptions overlapping the dates, of all doctors when doctor_id is empty
+// @Tags 		Schedule
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		doctor_id 	query string false "Doctor ID"
+// @Param 		from_date 	query string true "From date"
+// @Param 		to_date 	query string true "To date"
+// @Success 	200 {object} models.DoctorExceptions
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-exception-find [get]
+func (h *handlerV1) DoctorExceptionsFind(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorExceptionsFind(ctx, &doctor.DoctorExceptionsFindReq{
+		DoctorId: c.Query("doctor_id"),
+		FromDate: c.Query("from_date"),
+		ToDate:   c.Query("to_date"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorExceptionsFind") {
+		h.log.Error("Error finding doctor exceptions", logger.Error(err))
+		return
+	}
+
+	result := models.DoctorExceptions{
+		Exceptions: make([]*models.DoctorException, 0, len(response.Exceptions)),
+	}
+	for _, exception := range response.Exceptions {
+		result.Exceptions = append(result.Exceptions, exceptionModel(exception))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	delete doctor schedule exception
+// @Description This api can delete the exception, the weekly hours apply again
+// @Tags 		Schedule
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-exception-delete/{id} [delete]
+func (h *handlerV1) DoctorExceptionDelete(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err := h.serviceManager.DoctorService().DoctorExceptionDelete(ctx, &doctor.DoctorExceptionId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorExceptionDelete") {
+		h.log.Error("Error deleting doctor exception", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+// @Summary 	get doctor availability
+// @Description This api tells whether the doctor works at the clinic local time like 2006-01-02 15:04, why not and who substitutes the doctor
+// @Tags 		Schedule
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id 	path string true "Doctor ID"
+// @Param 		at 	query string true "Time"
+// @Success 	200 {object} models.DoctorAvailability
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctor-availability/{id} [get]
+func (h *handlerV1) DoctorAvailabilityGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorAvailabilityGet(ctx, &doctor.DoctorAvailabilityReq{
+		DoctorId: c.Param("id"),
+		At:       c.Query("at"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorAvailabilityGet") {
+		h.log.Error("Error getting doctor availability", logger.Error(err))
+		return
+	}
+
+	result := models.DoctorAvailability{
+		DoctorId:     response.DoctorId,
+		At:           response.At,
+		Available:    response.Available,
+		Reason:       response.Reason,
+		NextStart:    response.NextStart,
+		SubstituteId: response.SubstituteId,
+	}
+	if response.Substitute != nil {
+		result.Substitute = doctorModel(response.Substitute)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	find working doctors
+// @Description This api can find the doctors of the specialty working on the date with their hours, substitutes included
+// @Tags 		Schedule
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		specialty_id 	query string true "Specialty ID"
+// @Param 		date 		query string true "Date"
+// @Success 	200 {object} models.DoctorsWorking
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/doctors-working [get]
+func (h *handlerV1) DoctorsWorking(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().DoctorsWorking(ctx, &doctor.DoctorsWorkingReq{
+		SpecialtyId: c.Query("specialty_id"),
+		Date:        c.Query("date"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "DoctorsWorking") {
+		h.log.Error("Error finding working doctors", logger.Error(err))
+		return
+	}
+
+	result := models.DoctorsWorking{
+		Doctors: make([]*models.WorkingDoctor, 0, len(response.Doctors)),
+	}
+	for _, working := range response.Doctors {
+		temp := &models.WorkingDoctor{
+			Doctor:        doctorModel(working.Doctor),
+			Hours:         make([]*models.TimeSlot, 0, len(working.Hours)),
+			SubstituteFor: working.SubstituteFor,
+		}
+		for _, slot := range working.Hours {
+			temp.Hours = append(temp.Hours, &models.TimeSlot{
+				StartTime: slot.StartTime,
+				EndTime:   slot.EndTime,
+			})
+		}
+		result.Doctors = append(result.Doctors, temp)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func exceptionModel(exception *doctor.DoctorException) *models.DoctorException {
+	return &models.DoctorException{
+		Id:           exception.Id,
+		DoctorId:     exception.DoctorId,
+		Kind:         exception.Kind,
+		DateFrom:     exception.DateFrom,
+		DateTo:       exception.DateTo,
+		StartTime:    exception.StartTime,
+		EndTime:      exception.EndTime,
+		SubstituteId: exception.SubstituteId,
+		Note:         exception.Note,
+		CreatedAt:    exception.CreatedAt,
+	}
+}
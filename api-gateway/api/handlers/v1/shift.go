@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	open shift
+// @Description This api can open the shift of the logged in cashier, payments are taken on it until it is closed
+// @Tags 		Shift
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.ShiftOpenReq true "Body"
+// @Success 	201 {object} models.Shift
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/shift-open [post]
+func (h *handlerV1) ShiftOpen(c *gin.Context) {
+	var body models.ShiftOpenReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().ShiftOpen(ctx, &p.ShiftOpenReq{
+		Id:          uuid.New().String(),
+		StaffId:     c.GetString(ctxStaffId),
+		OpeningCash: body.OpeningCash,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ShiftOpen") {
+		h.log.Error("Error opening shift", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, shiftModel(response))
+}
+
+// @Summary 	close shift
+// @Description This api can close the open shift of the logged in cashier with the counted cash, the response is the Z-report
+// @Tags 		Shift
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.ShiftCloseReq true "Body"
+// @Success 	200 {object} models.Shift
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/shift-close [post]
+func (h *handlerV1) ShiftClose(c *gin.Context) {
+	var body models.ShiftCloseReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().ShiftClose(ctx, &p.ShiftCloseReq{
+		StaffId:     c.GetString(ctxStaffId),
+		CountedCash: body.CountedCash,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ShiftClose") {
+		h.log.Error("Error closing shift", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, shiftModel(response))
+}
+
+// @Summary 	get shift
+// @Description This api can get the shift to reprint its Z-report, an open shift has the report up to now
+// @Tags 		Shift
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.Shift
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/shift-get/{id} [get]
+func (h *handlerV1) ShiftGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().ShiftGet(ctx, &p.ShiftId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ShiftGet") {
+		h.log.Error("Error getting shift", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, shiftModel(response))
+}
+
+// @Summary 	find shifts
+// @Description This api can find shifts newest first, a cashier finds only own shifts
+// @Tags 		Shift
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.ShiftsFindReq false "Filter"
+// @Success 	200 {object} models.ShiftsResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/shift-find [get]
+func (h *handlerV1) ShiftsFind(c *gin.Context) {
+	req, err := shiftsParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "shiftsParams(c)") {
+		return
+	}
+	if c.GetString(ctxRole) != models.RoleAdmin {
+		req.StaffId = c.GetString(ctxStaffId)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().ShiftsFind(ctx, &p.ShiftsFindReq{
+		Limit:    req.Limit,
+		Page:     req.Page,
+		StaffId:  req.StaffId,
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ShiftsFind") {
+		h.log.Error("Error finding shifts", logger.Error(err))
+		return
+	}
+
+	result := models.ShiftsResp{
+		Shifts: make([]*models.Shift, 0, len(response.Shifts)),
+		Count:  response.Count,
+	}
+	for _, shift := range response.Shifts {
+		result.Shifts = append(result.Shifts, shiftModel(shift))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func shiftModel(shift *p.Shift) *models.Shift {
+	result := &models.Shift{
+		Id:              shift.Id,
+		StaffId:         shift.StaffId,
+		OpeningCash:     shift.OpeningCash,
+		CountedCash:     shift.CountedCash,
+		ExpectedCash:    shift.ExpectedCash,
+		Receipts:        shift.Receipts,
+		Refunds:         shift.Refunds,
+		RefundsCount:    shift.RefundsCount,
+		Totals:          make([]*models.ShiftPaymentTotal, 0, len(shift.Totals)),
+		UnpaidCashboxes: make([]*models.ShiftUnpaidCashbox, 0, len(shift.UnpaidCashboxes)),
+		OpenedAt:        shift.OpenedAt,
+		ClosedAt:        shift.ClosedAt,
+	}
+	for _, total := range shift.Totals {
+		result.Totals = append(result.Totals, &models.ShiftPaymentTotal{
+			PaymentType: total.PaymentType,
+			Summa:       total.Summa,
+			Count:       total.Count,
+		})
+	}
+	for _, cashbox := range shift.UnpaidCashboxes {
+		result.UnpaidCashboxes = append(result.UnpaidCashboxes, &models.ShiftUnpaidCashbox{
+			CashboxId: cashbox.CashboxId,
+			ClientId:  cashbox.ClientId,
+			Summa:     cashbox.Summa,
+			Paid:      cashbox.Paid,
+			Remaining: cashbox.Remaining,
+		})
+	}
+	return result
+}
+
+func shiftsParams(c *gin.Context) (*models.ShiftsFindReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.ShiftsFindReq{
+		Limit:    int64(limit),
+		Page:     int64(page),
+		StaffId:  c.Query("staff_id"),
+		FromDate: c.Query("from_date"),
+		ToDate:   c.Query("to_date"),
+	}, nil
+}
@@ -0,0 +1,223 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	create specialty
+// @Description This api can add a specialty to the catalog, its price is the default consultation price of its doctors and slot_minutes the length of their appointments
+// @Tags 		Specialty
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.SpecialtyReq true "Body"
+// @Success 	201 {object} models.Specialty
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/specialty-create [post]
+func (h *handlerV1) SpecialtyCreate(c *gin.Context) {
+	var body models.SpecialtyReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SpecialtyCreate(ctx, specialtyProto(uuid.New().String(), &body))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SpecialtyCreate") {
+		h.log.Error("Error creating specialty", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, specialtyModel(response))
+}
+
+// @Summary 	get specialty
+// @Description This api can get specialty by id
+// @Tags 		Specialty
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.Specialty
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/specialty-get/{id} [get]
+func (h *handlerV1) SpecialtyGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SpecialtyGet(ctx, &doctor.SpecialtyId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SpecialtyGet") {
+		h.log.Error("Error getting specialty", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, specialtyModel(response))
+}
+
+// @Summary 	find specialties
+// @Description This api can find the specialties of the catalog by name
+// @Tags 		Specialty
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.SpecialtiesFindReq false "Filter"
+// @Success 	200 {object} models.Specialties
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/specialty-find [get]
+func (h *handlerV1) SpecialtiesFind(c *gin.Context) {
+	req, err := specialtiesParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "specialtiesParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SpecialtiesFind(ctx, &doctor.SpecialtiesFindReq{
+		Limit:  req.Limit,
+		Page:   req.Page,
+		Search: req.Search,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SpecialtiesFind") {
+		h.log.Error("Error finding specialties", logger.Error(err))
+		return
+	}
+
+	result := models.Specialties{
+		Specialties: make([]*models.Specialty, 0, len(response.Specialties)),
+		Count:       response.Count,
+	}
+	for _, specialty := range response.Specialties {
+		result.Specialties = append(result.Specialties, specialtyModel(specialty))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	update specialty
+// @Description This api can update specialty, a new name shows on all its doctors, prices already set on the doctors stay
+// @Tags 		Specialty
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.SpecialtyReq true "Body"
+// @Success 	200 {object} models.Specialty
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/specialty-update/{id} [post]
+func (h *handlerV1) SpecialtyUpdate(c *gin.Context) {
+	var body models.SpecialtyReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SpecialtyUpdate(ctx, specialtyProto(c.Param("id"), &body))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SpecialtyUpdate") {
+		h.log.Error("Error updating specialty", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, specialtyModel(response))
+}
+
+// @Summary 	delete specialty
+// @Description This api can delete specialty no doctor has
+// @Tags 		Specialty
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/specialty-delete/{id} [delete]
+func (h *handlerV1) SpecialtyDelete(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err := h.serviceManager.DoctorService().SpecialtyDelete(ctx, &doctor.SpecialtyId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SpecialtyDelete") {
+		h.log.Error("Error deleting specialty", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+func specialtiesParams(c *gin.Context) (*models.SpecialtiesFindReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.SpecialtiesFindReq{
+		Limit:  int64(limit),
+		Page:   int64(page),
+		Search: c.Query("search"),
+	}, nil
+}
+
+func specialtyProto(id string, body *models.SpecialtyReq) *doctor.Specialty {
+	return &doctor.Specialty{
+		Id:          id,
+		Name:        body.Name,
+		Price:       body.Price,
+		SlotMinutes: body.SlotMinutes,
+	}
+}
+
+func specialtyModel(specialty *doctor.Specialty) *models.Specialty {
+	return &models.Specialty{
+		Id:           specialty.Id,
+		Name:         specialty.Name,
+		Price:        specialty.Price,
+		SlotMinutes:  specialty.SlotMinutes,
+		DoctorsCount: specialty.DoctorsCount,
+		CreatedAt:    specialty.CreatedAt,
+		UpdatedAt:    specialty.UpdatedAt,
+	}
+}
@@ -0,0 +1,287 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 	create sqlad movement
+// @Description This api can move the stock of the sqlad: receipt from a supplier, issue to a doctor, lab or aparat, write-off or adjustment. The stock can not go below zero. Stock added makes a new lot unless lot_id is given, stock taken comes from the lot_id or from the lots expiring first
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.SqladMovementReq true "Body"
+// @Success 	201 {object} models.SqladMovement
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/sqlad-movement-create [post]
+func (h *handlerV1) SqladMovementCreate(c *gin.Context) {
+	var body models.SqladMovementReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SqladMovementCreate(ctx, &doctor.SqladMovement{
+		SqladId:        body.SqladId,
+		Kind:           body.Kind,
+		Quantity:       body.Quantity,
+		UnitCost:       body.UnitCost,
+		TargetType:     body.TargetType,
+		TargetId:       body.TargetId,
+		ActorId:        c.GetString(ctxStaffId),
+		Note:           body.Note,
+		LotId:          body.LotId,
+		LotNumber:      body.LotNumber,
+		ExpirationDate: body.ExpirationDate,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SqladMovementCreate") {
+		h.log.Error("Error creating sqlad movement", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, movementModel(response))
+}
+
+// @Summary 	find sqlad movements
+// @Description This api can find the movements of the stock newest first, with the stock after each of them. Dates are like 2006-01-02, both included
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.SqladMovementsFindReq false "Filter"
+// @Success 	200 {object} models.SqladMovements
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/sqlad-movement-find [get]
+func (h *handlerV1) SqladMovementsFind(c *gin.Context) {
+	req, err := movementsParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "movementsParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SqladMovementsFind(ctx, &doctor.SqladMovementsFindReq{
+		SqladId:    req.SqladId,
+		Kind:       req.Kind,
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		FromDate:   req.FromDate,
+		ToDate:     req.ToDate,
+		Limit:      req.Limit,
+		Page:       req.Page,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SqladMovementsFind") {
+		h.log.Error("Error finding sqlad movements", logger.Error(err))
+		return
+	}
+
+	result := models.SqladMovements{
+		Movements: make([]*models.SqladMovement, 0, len(response.Movements)),
+		Count:     response.Count,
+	}
+	for _, movement := range response.Movements {
+		result.Movements = append(result.Movements, movementModel(movement))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	get sqlad balance
+// @Description This api tells the stock at the end of the date like 2006-01-02, today when empty, of every sqlad when sqlad_id is empty
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		sqlad_id 	query string false "Sqlad ID"
+// @Param 		date 		query string false "Date"
+// @Success 	200 {object} models.SqladBalances
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/sqlad-balance [get]
+func (h *handlerV1) SqladBalanceGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SqladBalanceGet(ctx, &doctor.SqladBalanceReq{
+		SqladId: c.Query("sqlad_id"),
+		Date:    c.Query("date"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SqladBalanceGet") {
+		h.log.Error("Error getting sqlad balance", logger.Error(err))
+		return
+	}
+
+	result := models.SqladBalances{
+		Date:     response.Date,
+		Balances: make([]*models.SqladBalance, 0, len(response.Balances)),
+	}
+	for _, balance := range response.Balances {
+		result.Balances = append(result.Balances, &models.SqladBalance{
+			SqladId: balance.SqladId,
+			Name:    balance.Name,
+			Count:   balance.Count,
+			Value:   balance.Value,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	find sqlad lots
+// @Description This api can find the lots of the sqlad first expiring first, the used up ones too when with_empty
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id 			path string true "Sqlad ID"
+// @Param 		with_empty 	query bool false "With empty"
+// @Success 	200 {object} models.SqladLots
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/sqlad-lots/{id} [get]
+func (h *handlerV1) SqladLotsFind(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SqladLotsFind(ctx, &doctor.SqladLotsFindReq{
+		SqladId:   c.Param("id"),
+		WithEmpty: c.Query("with_empty") == "true",
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SqladLotsFind") {
+		h.log.Error("Error finding sqlad lots", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, lotsModel(response))
+}
+
+// @Summary 	expiring lots
+// @Description This api can find the lots in stock expiring within the days, 30 when empty, the expired ones to write off included
+// @Tags 		Sqlad
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		days query int false "Days"
+// @Success 	200 {object} models.SqladLots
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/expiring-lots [get]
+func (h *handlerV1) ExpiringLots(c *gin.Context) {
+	days := 30
+	if c.Query("days") != "" {
+		var err error
+		days, err = strconv.Atoi(c.Query("days"))
+		if HandleBadRequestErrWithMessage(c, &h.log, err, "strconv.Atoi(days)") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().ExpiringLots(ctx, &doctor.ExpiringLotsReq{
+		Days: int64(days),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "ExpiringLots") {
+		h.log.Error("Error finding expiring lots", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, lotsModel(response))
+}
+
+func movementsParams(c *gin.Context) (*models.SqladMovementsFindReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.SqladMovementsFindReq{
+		SqladId:    c.Query("sqlad_id"),
+		Kind:       c.Query("kind"),
+		TargetType: c.Query("target_type"),
+		TargetId:   c.Query("target_id"),
+		FromDate:   c.Query("from_date"),
+		ToDate:     c.Query("to_date"),
+		Limit:      int64(limit),
+		Page:       int64(page),
+	}, nil
+}
+
+func movementModel(movement *doctor.SqladMovement) *models.SqladMovement {
+	result := &models.SqladMovement{
+		Id:         movement.Id,
+		SqladId:    movement.SqladId,
+		Kind:       movement.Kind,
+		Quantity:   movement.Quantity,
+		UnitCost:   movement.UnitCost,
+		TargetType: movement.TargetType,
+		TargetId:   movement.TargetId,
+		ActorId:    movement.ActorId,
+		Note:       movement.Note,
+		CreatedAt:  movement.CreatedAt,
+		Balance:    movement.Balance,
+	}
+	result.Lots = make([]*models.SqladLotTake, 0, len(movement.Lots))
+	for _, lot := range movement.Lots {
+		result.Lots = append(result.Lots, &models.SqladLotTake{
+			LotId:          lot.LotId,
+			LotNumber:      lot.LotNumber,
+			ExpirationDate: lot.ExpirationDate,
+			Quantity:       lot.Quantity,
+		})
+	}
+	return result
+}
+
+func lotsModel(lots *doctor.SqladLots) *models.SqladLots {
+	result := models.SqladLots{
+		Lots:  make([]*models.SqladLot, 0, len(lots.Lots)),
+		Count: lots.Count,
+	}
+	for _, lot := range lots.Lots {
+		result.Lots = append(result.Lots, &models.SqladLot{
+			Id:             lot.Id,
+			SqladId:        lot.SqladId,
+			SqladName:      lot.SqladName,
+			Number:         lot.Number,
+			ExpirationDate: lot.ExpirationDate,
+			DaysLeft:       lot.DaysLeft,
+			UnitCost:       lot.UnitCost,
+			Received:       lot.Received,
+			Remaining:      lot.Remaining,
+			CreatedAt:      lot.CreatedAt,
+		})
+	}
+	return &result
+}
@@ -0,0 +1,231 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	p "gitlab.com/clinic-crm/api-gateway/genproto/patient"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	create staff
+// @Description This api can create staff (receptionist, cashier, doctor, lab_technician, admin)
+// @Tags 		Staff
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.CreateStaffModel true "Body"
+// @Success 	201 {object} models.StaffModel
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/staff-create [post]
+func (h *handlerV1) StaffCreate(c *gin.Context) {
+	var body models.CreateStaffModel
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().StaffCreate(ctx, &p.Staff{
+		Id:          uuid.New().String(),
+		FirstName:   body.FirstName,
+		LastName:    body.LastName,
+		Login:       body.Login,
+		Password:    body.Password,
+		PhoneNumber: body.PhoneNumber,
+		Role:        body.Role,
+		DoctorId:    body.DoctorId,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffCreate") {
+		h.log.Error("Error creating staff", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, staffModel(response))
+}
+
+// @Summary 	get staff
+// @Description This api can get staff by id
+// @Tags 		Staff
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.StaffModel
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/staff-get/{id} [get]
+func (h *handlerV1) StaffGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().StaffGet(ctx, &p.StaffId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffGet") {
+		h.log.Error("Error getting staff", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, staffModel(response))
+}
+
+// @Summary 	find staffs
+// @Description This api can find staffs
+// @Tags 		Staff
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.StaffsFindReq false "Filter"
+// @Success 	200 {object} models.StaffsResp
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/staff-find [get]
+func (h *handlerV1) StaffsFind(c *gin.Context) {
+	req, err := staffParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "staffParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().StaffsFind(ctx, &p.StaffsFindReq{
+		Limit:  req.Limit,
+		Page:   req.Page,
+		Search: req.Search,
+		Role:   req.Role,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffsFind") {
+		h.log.Error("Error finding staffs", logger.Error(err))
+		return
+	}
+
+	result := models.StaffsResp{
+		Staffs: make([]*models.StaffModel, 0, len(response.Staffs)),
+		Count:  response.Count,
+	}
+	for _, staff := range response.Staffs {
+		result.Staffs = append(result.Staffs, staffModel(staff))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	update staff
+// @Description This api can update staff
+// @Tags 		Staff
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.UpdateStaffModel true "Body"
+// @Success 	200 {object} models.StaffModel
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/staff-update/{id} [post]
+func (h *handlerV1) StaffUpdate(c *gin.Context) {
+	var body models.UpdateStaffModel
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.PatientService().StaffUpdate(ctx, &p.Staff{
+		Id:          c.Param("id"),
+		FirstName:   body.FirstName,
+		LastName:    body.LastName,
+		Login:       body.Login,
+		PhoneNumber: body.PhoneNumber,
+		Role:        body.Role,
+		DoctorId:    body.DoctorId,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffUpdate") {
+		h.log.Error("Error updating staff", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, staffModel(response))
+}
+
+// @Summary 	delete staff
+// @Description This api can delete staff
+// @Tags 		Staff
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/staff-delete/{id} [delete]
+func (h *handlerV1) StaffDelete(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err := h.serviceManager.PatientService().StaffDelete(ctx, &p.StaffId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StaffDelete") {
+		h.log.Error("Error deleting staff", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+func staffModel(staff *p.Staff) *models.StaffModel {
+	return &models.StaffModel{
+		Id:          staff.Id,
+		FirstName:   staff.FirstName,
+		LastName:    staff.LastName,
+		Login:       staff.Login,
+		PhoneNumber: staff.PhoneNumber,
+		Role:        staff.Role,
+		DoctorId:    staff.DoctorId,
+		CreatedAt:   staff.CreatedAt,
+		UpdatedAt:   staff.UpdatedAt,
+	}
+}
+
+func staffParams(c *gin.Context) (*models.StaffsFindReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.StaffsFindReq{
+		Limit:  int64(limit),
+		Page:   int64(page),
+		Search: c.Query("search"),
+		Role:   c.Query("role"),
+	}, nil
+}
@@ -0,0 +1,359 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+	"gitlab.com/clinic-crm/api-gateway/pkg/receipt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	start stocktake
+// @Description This api can start counting the storeroom, only one stocktake is open at a time
+// @Tags 		Stocktake
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.StocktakeStartReq true "Body"
+// @Success 	201 {object} models.Stocktake
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/stocktake-start [post]
+func (h *handlerV1) StocktakeStart(c *gin.Context) {
+	var body models.StocktakeStartReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().StocktakeStart(ctx, &doctor.Stocktake{
+		Id:      uuid.New().String(),
+		Note:    body.Note,
+		ActorId: c.GetString(ctxStaffId),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StocktakeStart") {
+		h.log.Error("Error starting stocktake", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, stocktakeModel(response))
+}
+
+// @Summary 	enter stocktake counts
+// @Description This api can enter what the staff member counted, counting a sqlad or lot again replaces their count and the counts of several staff members add up. A sqlad is counted either by lots or as a whole
+// @Tags 		Stocktake
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.StocktakeCountReq true "Body"
+// @Success 	200 {object} models.Stocktake
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/stocktake-count/{id} [post]
+func (h *handlerV1) StocktakeCountEnter(c *gin.Context) {
+	var body models.StocktakeCountReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	req := doctor.StocktakeCountReq{
+		StocktakeId: c.Param("id"),
+		CounterId:   c.GetString(ctxStaffId),
+		Items:       make([]*doctor.StocktakeCountItem, 0, len(body.Items)),
+	}
+	for _, item := range body.Items {
+		req.Items = append(req.Items, &doctor.StocktakeCountItem{
+			SqladId:  item.SqladId,
+			LotId:    item.LotId,
+			Quantity: item.Quantity,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().StocktakeCountEnter(ctx, &req)
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StocktakeCountEnter") {
+		h.log.Error("Error entering stocktake counts", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktakeModel(response))
+}
+
+// @Summary 	get stocktake
+// @Description This api can get stocktake to review the counts against the balance, with only_discrepancies only the counted lines differing from it
+// @Tags 		Stocktake
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param 		only_discrepancies query bool false "Only discrepancies"
+// @Success 	200 {object} models.Stocktake
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/stocktake-get/{id} [get]
+func (h *handlerV1) StocktakeGet(c *gin.Context) {
+	var (
+		onlyDiscrepancies bool
+		err               error
+	)
+	if c.Query("only_discrepancies") != "" {
+		onlyDiscrepancies, err = strconv.ParseBool(c.Query("only_discrepancies"))
+		if HandleBadRequestErrWithMessage(c, &h.log, err, "strconv.ParseBool(only_discrepancies)") {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().StocktakeGet(ctx, &doctor.StocktakeGetReq{
+		Id:                c.Param("id"),
+		OnlyDiscrepancies: onlyDiscrepancies,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StocktakeGet") {
+		h.log.Error("Error getting stocktake", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktakeModel(response))
+}
+
+// @Summary 	find stocktakes
+// @Description This api can find the stocktakes newest first, without their lines and counts
+// @Tags 		Stocktake
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.StocktakesFindReq false "Filter"
+// @Success 	200 {object} models.Stocktakes
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/stocktake-find [get]
+func (h *handlerV1) StocktakesFind(c *gin.Context) {
+	req, err := stocktakesParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "stocktakesParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().StocktakesFind(ctx, &doctor.StocktakesFindReq{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Page:   req.Page,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StocktakesFind") {
+		h.log.Error("Error finding stocktakes", logger.Error(err))
+		return
+	}
+
+	result := models.Stocktakes{
+		Stocktakes: make([]*models.Stocktake, 0, len(response.Stocktakes)),
+		Count:      response.Count,
+	}
+	for _, stocktake := range response.Stocktakes {
+		result.Stocktakes = append(result.Stocktakes, stocktakeModel(stocktake))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	post stocktake
+// @Description This api adjusts the stock of everything counted to the count by adjustment movements and closes the stocktake, the sqlad nobody counted is left as is
+// @Tags 		Stocktake
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.Stocktake
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/stocktake-post/{id} [post]
+func (h *handlerV1) StocktakePost(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().StocktakePost(ctx, &doctor.StocktakePostReq{
+		Id:      c.Param("id"),
+		ActorId: c.GetString(ctxStaffId),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StocktakePost") {
+		h.log.Error("Error posting stocktake", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktakeModel(response))
+}
+
+// @Summary 	cancel stocktake
+// @Description This api can cancel an open stocktake, the stock is left as is
+// @Tags 		Stocktake
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.Stocktake
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/stocktake-cancel/{id} [post]
+func (h *handlerV1) StocktakeCancel(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().StocktakeCancel(ctx, &doctor.StocktakeId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StocktakeCancel") {
+		h.log.Error("Error cancelling stocktake", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktakeModel(response))
+}
+
+// @Summary 	print stocktake discrepancies
+// @Description This api prints the counted lines of the stocktake differing from the balance as an A4 PDF
+// @Tags 		Stocktake
+// @Security    BearerAuth
+// @Produce 	application/pdf
+// @Param 		id path string true "ID"
+// @Success 	200 {file} file
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/stocktake-report/{id} [get]
+func (h *handlerV1) StocktakeReport(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().StocktakeGet(ctx, &doctor.StocktakeGetReq{
+		Id:                c.Param("id"),
+		OnlyDiscrepancies: true,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "StocktakeGet") {
+		h.log.Error("Error getting stocktake", logger.Error(err))
+		return
+	}
+
+	report := receipt.Stocktake{
+		Header: receipt.Header{
+			Name:    h.cfg.ClinicName,
+			Address: h.cfg.ClinicAddress,
+			Phone:   h.cfg.ClinicPhone,
+		},
+		Number:          response.Number,
+		Status:          response.Status,
+		Note:            response.Note,
+		CreatedAt:       response.CreatedAt,
+		PostedAt:        response.PostedAt,
+		Lines:           make([]receipt.StocktakeLine, 0, len(response.Lines)),
+		ValueDifference: response.ValueDifference,
+	}
+	for _, line := range response.Lines {
+		report.Lines = append(report.Lines, receipt.StocktakeLine{
+			Name:            line.SqladName,
+			LotNumber:       line.LotNumber,
+			ExpirationDate:  line.ExpirationDate,
+			Expected:        line.Expected,
+			Counted:         line.Counted,
+			UnitCost:        line.UnitCost,
+			ValueDifference: line.ValueDifference,
+		})
+	}
+
+	c.Header("Content-Type", "application/pdf")
+	c.Header("Content-Disposition", `inline; filename="stocktake-`+strconv.FormatInt(response.Number, 10)+`.pdf"`)
+	if err := receipt.RenderStocktake(c.Writer, h.cfg.ReceiptFont, &report); err != nil {
+		h.log.Error("Error rendering stocktake report", logger.Error(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+func stocktakesParams(c *gin.Context) (*models.StocktakesFindReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.StocktakesFindReq{
+		Status: c.Query("status"),
+		Limit:  int64(limit),
+		Page:   int64(page),
+	}, nil
+}
+
+func stocktakeModel(stocktake *doctor.Stocktake) *models.Stocktake {
+	result := models.Stocktake{
+		Id:              stocktake.Id,
+		Number:          stocktake.Number,
+		Status:          stocktake.Status,
+		Note:            stocktake.Note,
+		ActorId:         stocktake.ActorId,
+		PostedBy:        stocktake.PostedBy,
+		Lines:           make([]*models.StocktakeLine, 0, len(stocktake.Lines)),
+		Counts:          make([]*models.StocktakeCount, 0, len(stocktake.Counts)),
+		ValueDifference: stocktake.ValueDifference,
+		CreatedAt:       stocktake.CreatedAt,
+		PostedAt:        stocktake.PostedAt,
+	}
+	for _, line := range stocktake.Lines {
+		result.Lines = append(result.Lines, &models.StocktakeLine{
+			SqladId:         line.SqladId,
+			SqladName:       line.SqladName,
+			LotId:           line.LotId,
+			LotNumber:       line.LotNumber,
+			ExpirationDate:  line.ExpirationDate,
+			Expected:        line.Expected,
+			Counted:         line.Counted,
+			Difference:      line.Difference,
+			IsCounted:       line.IsCounted,
+			UnitCost:        line.UnitCost,
+			ValueDifference: line.ValueDifference,
+			MovementId:      line.MovementId,
+		})
+	}
+	for _, count := range stocktake.Counts {
+		result.Counts = append(result.Counts, &models.StocktakeCount{
+			SqladId:   count.SqladId,
+			SqladName: count.SqladName,
+			LotId:     count.LotId,
+			Quantity:  count.Quantity,
+			CounterId: count.CounterId,
+			UpdatedAt: count.UpdatedAt,
+		})
+	}
+	return &result
+}
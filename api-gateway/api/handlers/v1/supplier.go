@@ -0,0 +1,233 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.com/clinic-crm/api-gateway/api/models"
+	"gitlab.com/clinic-crm/api-gateway/genproto/doctor"
+	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// @Summary 	create supplier
+// @Description This api can add a supplier to the directory, sqlad is ordered from its supplier
+// @Tags 		Supplier
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param body 	body models.SupplierReq true "Body"
+// @Success 	201 {object} models.Supplier
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/supplier-create [post]
+func (h *handlerV1) SupplierCreate(c *gin.Context) {
+	var body models.SupplierReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SupplierCreate(ctx, supplierProto(uuid.New().String(), &body))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SupplierCreate") {
+		h.log.Error("Error creating supplier", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, supplierModel(response))
+}
+
+// @Summary 	get supplier
+// @Description This api can get supplier by id
+// @Tags 		Supplier
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.Supplier
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/supplier-get/{id} [get]
+func (h *handlerV1) SupplierGet(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SupplierGet(ctx, &doctor.SupplierId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SupplierGet") {
+		h.log.Error("Error getting supplier", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, supplierModel(response))
+}
+
+// @Summary 	find suppliers
+// @Description This api can find the suppliers of the directory by name, contact person, phone number or email
+// @Tags 		Supplier
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		filter query models.SuppliersFindReq false "Filter"
+// @Success 	200 {object} models.Suppliers
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/supplier-find [get]
+func (h *handlerV1) SuppliersFind(c *gin.Context) {
+	req, err := suppliersParams(c)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "suppliersParams(c)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SuppliersFind(ctx, &doctor.SuppliersFindReq{
+		Limit:  req.Limit,
+		Page:   req.Page,
+		Search: req.Search,
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SuppliersFind") {
+		h.log.Error("Error finding suppliers", logger.Error(err))
+		return
+	}
+
+	result := models.Suppliers{
+		Suppliers: make([]*models.Supplier, 0, len(response.Suppliers)),
+		Count:     response.Count,
+	}
+	for _, supplier := range response.Suppliers {
+		result.Suppliers = append(result.Suppliers, supplierModel(supplier))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 	update supplier
+// @Description This api can update supplier, a new name shows on all its sqlad
+// @Tags 		Supplier
+// @Security    BearerAuth
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Param body 	body models.SupplierReq true "Body"
+// @Success 	200 {object} models.Supplier
+// @Failure 	400 {object} models.DefaultResponse
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/supplier-update/{id} [post]
+func (h *handlerV1) SupplierUpdate(c *gin.Context) {
+	var body models.SupplierReq
+
+	err := c.ShouldBindJSON(&body)
+	if HandleBadRequestErrWithMessage(c, &h.log, err, "c.ShouldBindJSON(&body)") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	response, err := h.serviceManager.DoctorService().SupplierUpdate(ctx, supplierProto(c.Param("id"), &body))
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SupplierUpdate") {
+		h.log.Error("Error updating supplier", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, supplierModel(response))
+}
+
+// @Summary 	delete supplier
+// @Description This api can delete supplier without open purchase orders, its sqlad is left without a supplier
+// @Tags 		Supplier
+// @Security    BearerAuth
+// @Produce 	json
+// @Param 		id path string true "ID"
+// @Success 	200 {object} models.ResponseOK
+// @Failure 	404 {object} models.DefaultResponse
+// @Failure 	409 {object} models.DefaultResponse
+// @Failure 	500 {object} models.DefaultResponse
+// @Router 		/v1/supplier-delete/{id} [delete]
+func (h *handlerV1) SupplierDelete(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	_, err := h.serviceManager.DoctorService().SupplierDelete(ctx, &doctor.SupplierId{
+		Id: c.Param("id"),
+	})
+	if HandleDatabaseLevelWithMessage(c, &h.log, err, "SupplierDelete") {
+		h.log.Error("Error deleting supplier", logger.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResponseOK{
+		Message: "Successfully",
+	})
+}
+
+func suppliersParams(c *gin.Context) (*models.SuppliersFindReq, error) {
+	var (
+		limit int = 10
+		page  int = 1
+		err   error
+	)
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Query("page") != "" {
+		page, err = strconv.Atoi(c.Query("page"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.SuppliersFindReq{
+		Limit:  int64(limit),
+		Page:   int64(page),
+		Search: c.Query("search"),
+	}, nil
+}
+
+func supplierProto(id string, body *models.SupplierReq) *doctor.Supplier {
+	return &doctor.Supplier{
+		Id:            id,
+		Name:          body.Name,
+		ContactPerson: body.ContactPerson,
+		PhoneNumber:   body.PhoneNumber,
+		Email:         body.Email,
+		Address:       body.Address,
+		PaymentTerms:  body.PaymentTerms,
+		PaymentDays:   body.PaymentDays,
+		Note:          body.Note,
+	}
+}
+
+func supplierModel(supplier *doctor.Supplier) *models.Supplier {
+	return &models.Supplier{
+		Id:            supplier.Id,
+		Name:          supplier.Name,
+		ContactPerson: supplier.ContactPerson,
+		PhoneNumber:   supplier.PhoneNumber,
+		Email:         supplier.Email,
+		Address:       supplier.Address,
+		PaymentTerms:  supplier.PaymentTerms,
+		PaymentDays:   supplier.PaymentDays,
+		Note:          supplier.Note,
+		SqladCount:    supplier.SqladCount,
+		CreatedAt:     supplier.CreatedAt,
+		UpdatedAt:     supplier.UpdatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package models
+
+type AppointmentCreateReq struct {
+	ClientId int64  `json:"client_id"`
+	DoctorId string `json:"doctor_id"`
+	// clinic local time of a free slot like 2006-01-02 15:04
+	StartsAt string `json:"starts_at"`
+	Note     string `json:"note"`
+}
+
+type AppointmentRescheduleReq struct {
+	StartsAt string `json:"starts_at"`
+}
+
+type AppointmentCancelReq struct {
+	Reason string `json:"reason"`
+}
+
+type Appointment struct {
+	Id           string            `json:"id"`
+	ClientId     int64             `json:"client_id"`
+	DoctorId     string            `json:"doctor_id"`
+	StartsAt     string            `json:"starts_at"`
+	EndsAt       string            `json:"ends_at"`
+	Status       string            `json:"status"`
+	Note         string            `json:"note"`
+	QueueId      string            `json:"queue_id"`
+	CancelReason string            `json:"cancel_reason"`
+	CreatedBy    string            `json:"created_by"`
+	CreatedAt    string            `json:"created_at"`
+	UpdatedAt    string            `json:"updated_at"`
+	CheckedInAt  string            `json:"checked_in_at"`
+	CancelledAt  string            `json:"cancelled_at"`
+	Queue        *PatientQueueResp `json:"queue,omitempty"`
+}
+
+type AppointmentsFindReq struct {
+	Limit    int64  `json:"limit"`
+	Page     int64  `json:"page"`
+	DoctorId string `json:"doctor_id"`
+	ClientId int64  `json:"client_id"`
+	FromDate string `json:"from_date"`
+	ToDate   string `json:"to_date"`
+	Status   string `json:"status"`
+}
+
+type AppointmentsResp struct {
+	Appointments []*Appointment `json:"appointments"`
+	Count        int64          `json:"count"`
+}
+
+type AppointmentSlot struct {
+	StartsAt      string `json:"starts_at"`
+	EndsAt        string `json:"ends_at"`
+	Free          bool   `json:"free"`
+	AppointmentId string `json:"appointment_id"`
+}
+
+type AppointmentSlotsResp struct {
+	DoctorId    string             `json:"doctor_id"`
+	Date        string             `json:"date"`
+	SlotMinutes int64              `json:"slot_minutes"`
+	Slots       []*AppointmentSlot `json:"slots"`
+}
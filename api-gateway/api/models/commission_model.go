@@ -0,0 +1,57 @@
+package models
+
+type DoctorCommissionReq struct {
+	DoctorId string `json:"doctor_id"`
+	// doctor, lab or aparat, empty for any service of the doctor
+	ServiceType string `json:"service_type"`
+	// the doctor, lab or aparat id, empty for any service of the type
+	ServiceId string `json:"service_id"`
+	// percent of what the clients paid, or fixed amount for each service
+	Kind  string  `json:"kind"`
+	Value float64 `json:"value"`
+}
+
+type DoctorCommission struct {
+	Id          string  `json:"id"`
+	DoctorId    string  `json:"doctor_id"`
+	ServiceType string  `json:"service_type"`
+	ServiceId   string  `json:"service_id"`
+	Kind        string  `json:"kind"`
+	Value       float64 `json:"value"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type DoctorCommissions struct {
+	Commissions []*DoctorCommission `json:"commissions"`
+}
+
+type PayrollLine struct {
+	ServiceType string `json:"service_type"`
+	ServiceId   string `json:"service_id"`
+	Name        string `json:"name"`
+	Quantity    int64  `json:"quantity"`
+	Revenue     int64  `json:"revenue"`
+	// the rule applied, empty when the doctor has none for the service
+	CommissionId string  `json:"commission_id"`
+	Kind         string  `json:"kind"`
+	Value        float64 `json:"value"`
+	Earnings     int64   `json:"earnings"`
+}
+
+type PayrollDoctor struct {
+	DoctorId  string         `json:"doctor_id"`
+	FirstName string         `json:"first_name"`
+	LastName  string         `json:"last_name"`
+	Revenue   int64          `json:"revenue"`
+	Earnings  int64          `json:"earnings"`
+	Lines     []*PayrollLine `json:"lines"`
+}
+
+type PayrollReport struct {
+	FromDate string           `json:"from_date"`
+	ToDate   string           `json:"to_date"`
+	Doctors  []*PayrollDoctor `json:"doctors"`
+	Revenue  int64            `json:"revenue"`
+	Earnings int64            `json:"earnings"`
+}
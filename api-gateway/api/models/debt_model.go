@@ -0,0 +1,42 @@
+package models
+
+type PatientDebt struct {
+	Id            string  `json:"id"`
+	PatientId     string  `json:"patient_id"`
+	ClientId      int64   `json:"client_id"`
+	CashboxId     string  `json:"cashbox_id"`
+	FirstName     string  `json:"first_name"`
+	LastName      string  `json:"last_name"`
+	PhoneNumber   string  `json:"phone_number"`
+	InitialAmount float32 `json:"initial_amount"`
+	Amount        float32 `json:"amount"`
+	TermDate      string  `json:"term_date"`
+	PaidAt        string  `json:"paid_at"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type PatientDebtCreateReq struct {
+	PatientId string  `json:"patient_id"`
+	CashboxId string  `json:"cashbox_id"`
+	Amount    float32 `json:"amount"`
+	TermDate  string  `json:"term_date"`
+}
+
+type PatientDebtInfo struct {
+	ClientId string         `json:"client_id"`
+	Amount   float32        `json:"amount"`
+	Debts    []*PatientDebt `json:"debts"`
+}
+
+type PatientDebtsOverdueReq struct {
+	Limit int64  `json:"limit"`
+	Page  int64  `json:"page"`
+	Date  string `json:"date"`
+}
+
+type PatientDebtsResp struct {
+	Debts  []*PatientDebt `json:"debts"`
+	Count  int64          `json:"count"`
+	Amount float32        `json:"amount"`
+}
@@ -0,0 +1,50 @@
+package models
+
+type Discount struct {
+	Id                 string `json:"id"`
+	Name               string `json:"name"`
+	Kind               string `json:"kind"`
+	Value              int64  `json:"value"`
+	Cap                int64  `json:"cap"`
+	ClientId           int64  `json:"client_id"`
+	ServiceType        string `json:"service_type"`
+	AdvertisingChannel string `json:"advertising_channel"`
+	ValidFrom          string `json:"valid_from"`
+	ValidTo            string `json:"valid_to"`
+	CreatedAt          string `json:"created_at"`
+	UpdatedAt          string `json:"updated_at"`
+}
+
+type DiscountReq struct {
+	Name               string `json:"name"`
+	Kind               string `json:"kind"`
+	Value              int64  `json:"value"`
+	Cap                int64  `json:"cap"`
+	ClientId           int64  `json:"client_id"`
+	ServiceType        string `json:"service_type"`
+	AdvertisingChannel string `json:"advertising_channel"`
+	ValidFrom          string `json:"valid_from"`
+	ValidTo            string `json:"valid_to"`
+}
+
+type DiscountsFindReq struct {
+	Limit    int64  `json:"limit"`
+	Page     int64  `json:"page"`
+	Search   string `json:"search"`
+	ClientId int64  `json:"client_id"`
+	Date     string `json:"date"`
+}
+
+type DiscountsResp struct {
+	Discounts []*Discount `json:"discounts"`
+	Count     int64       `json:"count"`
+}
+
+type CashboxDiscount struct {
+	DiscountId  string `json:"discount_id"`
+	Name        string `json:"name"`
+	ServiceType string `json:"service_type"`
+	ServiceId   string `json:"service_id"`
+	Gross       int64  `json:"gross"`
+	Amount      int64  `json:"amount"`
+}
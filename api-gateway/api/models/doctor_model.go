@@ -1,28 +1,33 @@
 package models
 
 type CreateDoctorModel struct {
-	FirstName   string  `json:"first_name"`
-	LastName    string  `json:"last_name"`
-	Gender      string  `json:"gender"`
-	WorkTime    string  `json:"work_time"`
-	Price       float64 `json:"price"`
-	Specialty   string  `json:"specialty"`
-	RoomNumber  string  `json:"room_number"`
-	PhoneNumber string  `json:"phone_number"`
+	FirstName string  `json:"first_name"`
+	LastName  string  `json:"last_name"`
+	Gender    string  `json:"gender"`
+	WorkTime  string  `json:"work_time"`
+	Price     float64 `json:"price"`
+	// name of a catalog specialty, used when specialty_ids are empty
+	Specialty   string `json:"specialty"`
+	RoomNumber  string `json:"room_number"`
+	PhoneNumber string `json:"phone_number"`
+	// the first one is the primary specialty
+	SpecialtyIds []string `json:"specialty_ids"`
 }
 
 type DoctorResp struct {
-	Id          string  `json:"id"`
-	FirstName   string  `json:"first_name"`
-	LastName    string  `json:"last_name"`
-	Gender      string  `json:"gender"`
-	WorkTime    string  `json:"work_time"`
-	Price       float64 `json:"price"`
-	Specialty   string  `json:"specialty"`
-	RoomNumber  string  `json:"room_number"`
-	PhoneNumber string  `json:"phone_number"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	Id           string       `json:"id"`
+	FirstName    string       `json:"first_name"`
+	LastName     string       `json:"last_name"`
+	Gender       string       `json:"gender"`
+	WorkTime     string       `json:"work_time"`
+	Price        float64      `json:"price"`
+	Specialty    string       `json:"specialty"`
+	RoomNumber   string       `json:"room_number"`
+	PhoneNumber  string       `json:"phone_number"`
+	CreatedAt    string       `json:"created_at"`
+	UpdatedAt    string       `json:"updated_at"`
+	SpecialtyIds []string     `json:"specialty_ids"`
+	Specialties  []*Specialty `json:"specialties"`
 }
 
 type DoctorsResp struct {
@@ -36,9 +41,10 @@ type GetDoctorReq struct {
 }
 
 type DoctorsFindReq struct {
-	Limit  int64  `json:"limit" binding:"required" default:"10"`
-	Page   int64  `json:"page" binding:"required" default:"1"`
-	Search string `json:"search"`
+	Limit       int64  `json:"limit" binding:"required" default:"10"`
+	Page        int64  `json:"page" binding:"required" default:"1"`
+	Search      string `json:"search"`
+	SpecialtyId string `json:"specialty_id"`
 }
 
 type DoctorId struct {
@@ -77,12 +83,15 @@ type DoctorReportsResp struct {
 }
 
 type SqladReqModel struct {
-	Name           string  `json:"name"`
+	Name string `json:"name"`
+	// opening stock, ignored on update
 	Count          int64   `json:"count"`
 	Price          float64 `json:"price"`
 	LowStock       int64   `json:"low_stock"`
 	ExpirationDate string  `json:"expiration_date"`
-	Provider       string  `json:"provider"`
+	// name of a supplier in the directory, used when supplier_id is empty
+	Provider   string `json:"provider"`
+	SupplierId string `json:"supplier_id"`
 }
 
 type SqladRespModel struct {
@@ -95,6 +104,7 @@ type SqladRespModel struct {
 	Provider       string  `json:"provider"`
 	CreatedAt      string  `json:"created_at"`
 	UpdatedAt      string  `json:"updated_at"`
+	SupplierId     string  `json:"supplier_id"`
 }
 
 type SqladGetReqModel struct {
@@ -112,23 +122,27 @@ type LowStocksRespModel struct {
 }
 
 type DocPageFilterResModel struct {
-	PatientInfo []*PatientInfo
+	PatientInfo []*PatientInfo `json:"patient_info"`
+	Count       int64          `json:"count"`
 }
 
 type PatientInfo struct {
-	QueueNumber    int64  `json:"queue_number"`
-	FullName       string `json:"full_name"`
-	PhoneNumber    string `json:"phone_number"`
-	DateLastVisit  string `json:"date_last_visit"`
-	PatientReports []*DoctorReportsModelRes
+	PatientId      string                   `json:"patient_id"`
+	ClientId       int64                    `json:"client_id"`
+	QueueNumber    int64                    `json:"queue_number"`
+	FullName       string                   `json:"full_name"`
+	PhoneNumber    string                   `json:"phone_number"`
+	DateLastVisit  string                   `json:"date_last_visit"`
+	PatientReports []*DoctorReportsModelRes `json:"patient_reports"`
 }
 
 type DocPageFilterReq struct {
-	ServiceId   string `json:"service_id"`
-	ServiceType string `json:"service_type"`
-	ClientId    int64  `json:"client_id"`
-	Page        int64  `json:"page"`
-	Limit       int64  `json:"limit"`
+	DoctorId string `json:"doctor_id"`
+	ClientId int64  `json:"client_id"`
+	FromDate string `json:"from_date"`
+	ToDate   string `json:"to_date"`
+	Page     int64  `json:"page"`
+	Limit    int64  `json:"limit"`
 }
 
 type DoctorTypes struct {
@@ -6,6 +6,8 @@ type LabModel struct {
 	Price         float64 `json:"price"`
 	Type          string  `json:"type"`
 	SubCategoryId string  `json:"sub_category_id"`
+	DoctorId      string  `json:"doctor_id"`
+	RoomNumber    string  `json:"room_number"`
 }
 
 type LabModelResp struct {
@@ -14,6 +16,8 @@ type LabModelResp struct {
 	Price         float64 `json:"price"`
 	Type          string  `json:"type"`
 	SubCategoryId string  `json:"sub_category_id"`
+	DoctorId      string  `json:"doctor_id"`
+	RoomNumber    string  `json:"room_number"`
 	CreatedAt     string  `json:"created_at"`
 	UpdatedAt     string  `json:"updated_at"`
 }
@@ -41,6 +45,8 @@ type AparatModel struct {
 	Price         float64 `json:"price"`
 	Type          string  `json:"type"`
 	SubCategoryId string  `json:"sub_category_id"`
+	DoctorId      string  `json:"doctor_id"`
+	RoomNumber    string  `json:"room_number"`
 }
 
 type CreateAparat struct {
@@ -48,12 +54,16 @@ type CreateAparat struct {
 	Price         float64 `json:"price"`
 	Type          string  `json:"type"`
 	SubCategoryId string  `json:"sub_category_id"`
+	DoctorId      string  `json:"doctor_id"`
+	RoomNumber    string  `json:"room_number"`
 }
 
 type UpdateAparat struct {
-	Name  string  `json:"name"`
-	Price float64 `json:"price"`
-	Type  string  `json:"type"`
+	Name       string  `json:"name"`
+	Price      float64 `json:"price"`
+	Type       string  `json:"type"`
+	DoctorId   string  `json:"doctor_id"`
+	RoomNumber string  `json:"room_number"`
 }
 
 type AparatModelResp struct {
@@ -62,6 +72,8 @@ type AparatModelResp struct {
 	Price         float64 `json:"price"`
 	Type          string  `json:"type"`
 	SubCategoryId string  `json:"sub_category_id"`
+	DoctorId      string  `json:"doctor_id"`
+	RoomNumber    string  `json:"room_number"`
 	CreatedAt     string  `json:"created_at"`
 	UpdatedAt     string  `json:"updated_at"`
 }
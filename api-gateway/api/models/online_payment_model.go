@@ -0,0 +1,33 @@
+package models
+
+type PaymentLinkReq struct {
+	Provider string `json:"provider"`
+}
+
+type PaymentLink struct {
+	CashboxId string `json:"cashbox_id"`
+	Provider  string `json:"provider"`
+	Amount    int64  `json:"amount"`
+	Url       string `json:"url"`
+}
+
+type OnlineTransaction struct {
+	Id            string `json:"id"`
+	Provider      string `json:"provider"`
+	TransactionId string `json:"transaction_id"`
+	CashboxId     string `json:"cashbox_id"`
+	Amount        int64  `json:"amount"`
+	State         string `json:"state"`
+	PaymentId     string `json:"payment_id"`
+	CancelReason  string `json:"cancel_reason"`
+	CreatedAt     string `json:"created_at"`
+	PerformedAt   string `json:"performed_at"`
+	CancelledAt   string `json:"cancelled_at"`
+}
+
+// SimulatorPayment is what the provider answered to the callbacks of a simulated payment.
+type SimulatorPayment struct {
+	TransactionId string      `json:"transaction_id"`
+	Prepare       interface{} `json:"prepare"`
+	Complete      interface{} `json:"complete"`
+}
@@ -95,20 +95,46 @@ type PatientQueueResp struct {
 	ServiceId   string `json:"service_id"`
 	ServiceType string `json:"service_type"`
 	TurnPassed  bool   `json:"turn_passed"`
+	QueueDay    string `json:"queue_day"`
+	Status      string `json:"status"`
+	CalledAt    string `json:"called_at"`
+	InServiceAt string `json:"in_service_at"`
+	DoneAt      string `json:"done_at"`
+	SkippedAt   string `json:"skipped_at"`
+	NoShowAt    string `json:"no_show_at"`
+	RecalledAt  string `json:"recalled_at"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
 
-type UpdateQueueReq struct {
-	ClientId    int64  `json:"client_id"`
+type CallNextReq struct {
 	ServiceId   string `json:"service_id"`
 	ServiceType string `json:"service_type"`
 }
 
+type QueueBoardEntry struct {
+	QueueId     string `json:"queue_id"`
+	QueueNumber int64  `json:"queue_number"`
+	Status      string `json:"status"`
+	CalledAt    string `json:"called_at"`
+}
+
+type QueueBoard struct {
+	ServiceId   string             `json:"service_id"`
+	ServiceType string             `json:"service_type"`
+	ServiceName string             `json:"service_name"`
+	RoomNumber  string             `json:"room_number"`
+	Current     []*QueueBoardEntry `json:"current"`
+	Next        []*QueueBoardEntry `json:"next"`
+	QueueDay    string             `json:"queue_day"`
+	UpdatedAt   string             `json:"updated_at"`
+}
+
 type QueueFilter struct {
 	ServiceId   string `json:"service_id"`
 	ServiceType string `json:"service_type"`
 	ClientId    int64  `json:"client_id"`
+	Status      string `json:"status"`
 	Page        int64  `json:"page"`
 	Limit       int64  `json:"limit"`
 }
@@ -120,7 +146,6 @@ type QueuesResp struct {
 
 type CreateCashboxReq struct {
 	ClientId    int64    `json:"client_id"`
-	IsPayed     bool     `json:"is_payed"`
 	PaymentType string   `json:"payment_type"`
 	DoctorsIds  []string `json:"doctors_ids"`
 	LabsIds     []string `json:"labs_ids"`
@@ -128,21 +153,49 @@ type CreateCashboxReq struct {
 }
 
 type CashboxResp struct {
-	Id         string   `json:"id"`
-	ClientId   int      `json:"client_id"`
-	Summa      int      `json:"summa"`
-	IsPayed    bool     `json:"is_payed"`
-	CashCount  int      `json:"cash_count"`
-	DoctorsIds []string `json:"doctors_ids"`
-	LabsIds    []string `json:"labs_ids"`
-	AparatsIds []string `json:"aparats_ids"`
-	CreatedAt  string   `json:"created_at"`
-	UpdatedAt  string   `json:"updated_at"`
+	Id          string                `json:"id"`
+	ClientId    int                   `json:"client_id"`
+	Gross       int                   `json:"gross"`
+	Discount    int                   `json:"discount"`
+	Summa       int                   `json:"summa"`
+	Paid        int                   `json:"paid"`
+	Remaining   int                   `json:"remaining"`
+	Refunded    int                   `json:"refunded"`
+	IsPayed     bool                  `json:"is_payed"`
+	CashCount   int                   `json:"cash_count"`
+	PaymentType string                `json:"payment_type"`
+	DoctorsIds  []string              `json:"doctors_ids"`
+	LabsIds     []string              `json:"labs_ids"`
+	AparatsIds  []string              `json:"aparats_ids"`
+	Items       []*CashboxItem        `json:"items"`
+	Discounts   []*CashboxDiscount    `json:"discounts"`
+	Payments    []*PaymentHistoryResp `json:"payments"`
+	StaffId     string                `json:"staff_id"`
+	CreatedAt   string                `json:"created_at"`
+	UpdatedAt   string                `json:"updated_at"`
+}
+
+type CashboxItem struct {
+	Id               string `json:"id"`
+	ServiceType      string `json:"service_type"`
+	ServiceId        string `json:"service_id"`
+	Name             string `json:"name"`
+	Price            int64  `json:"price"`
+	Quantity         int64  `json:"quantity"`
+	Discount         int64  `json:"discount"`
+	DiscountId       string `json:"discount_id"`
+	DiscountName     string `json:"discount_name"`
+	DoctorId         string `json:"doctor_id"`
+	RefundedQuantity int64  `json:"refunded_quantity"`
 }
 
 type CashboxesPrinterResp struct {
 	Cashboxes []*CashboxPrinterResp `json:"cashboxes"`
 	Count     int                   `json:"count"`
+	Gross     int                   `json:"gross"`
+	Discount  int                   `json:"discount"`
+	Net       int                   `json:"net"`
+	Discounts []*CashboxDiscount    `json:"discounts"`
 }
 
 type CashboxPrinterResp struct {
@@ -169,6 +222,9 @@ type FindCashboxReq struct {
 type FindCashboxResp struct {
 	Cashboxes []*CashboxResp `json:"cashboxes"`
 	Count     int            `json:"count"`
+	Gross     int64          `json:"gross"`
+	Discount  int64          `json:"discount"`
+	Net       int64          `json:"net"`
 }
 
 type UpdateCashboxReq struct {
@@ -177,21 +233,46 @@ type UpdateCashboxReq struct {
 	PaymentType string `json:"payment_type"`
 }
 
-type CreatePaymentHistoryReq struct {
-	ClientId    int64  `json:"client_id"`
+type CashboxPayment struct {
 	Summa       int64  `json:"summa"`
 	PaymentType string `json:"payment_type"`
-	CashboxId   string `json:"cashbox_id"`
 }
 
-type PaymentHistoryResp struct {
-	Id          string `json:"id"`
+type CashboxRefundReq struct {
+	ServiceType string `json:"service_type"`
+	ServiceId   string `json:"service_id"`
+	Reason      string `json:"reason"`
+	PaymentType string `json:"payment_type"`
+}
+
+type CashboxPayReq struct {
+	Payments []*CashboxPayment `json:"payments"`
+}
+
+type CreatePaymentHistoryReq struct {
 	ClientId    int64  `json:"client_id"`
 	Summa       int64  `json:"summa"`
 	PaymentType string `json:"payment_type"`
 	CashboxId   string `json:"cashbox_id"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
+}
+
+type PaymentHistoryResp struct {
+	Id           string `json:"id"`
+	ClientId     int64  `json:"client_id"`
+	Summa        int64  `json:"summa"`
+	PaymentType  string `json:"payment_type"`
+	CashboxId    string `json:"cashbox_id"`
+	RefundReason string `json:"refund_reason"`
+	StaffId      string `json:"staff_id"`
+	ServiceType  string `json:"service_type"`
+	ServiceId    string `json:"service_id"`
+	// pending until the fiscal module registers the payment, then registered
+	FiscalStatus        string `json:"fiscal_status"`
+	FiscalReceiptNumber string `json:"fiscal_receipt_number"`
+	FiscalSign          string `json:"fiscal_sign"`
+	FiscalizedAt        string `json:"fiscalized_at"`
+	CreatedAt           string `json:"created_at"`
+	UpdatedAt           string `json:"updated_at"`
 }
 
 type PaymentHistoryId struct {
@@ -204,9 +285,12 @@ type PaymentHistoryFilter struct {
 	ClientId int    `json:"client_id"`
 	FromDate string `json:"from_date"`
 	ToDate   string `json:"to_date"`
+	Refunds  bool   `json:"refunds"`
 }
 
 type PaymentHistoriesResp struct {
 	PaymentHistories []*PaymentHistoryResp `json:"payment_histories"`
 	Count            int                   `json:"count"`
+	Income           int64                 `json:"income"`
+	Refunds          int64                 `json:"refunds"`
 }
@@ -0,0 +1,150 @@
+package models
+
+type SupplierReq struct {
+	Name          string `json:"name"`
+	ContactPerson string `json:"contact_person"`
+	PhoneNumber   string `json:"phone_number"`
+	Email         string `json:"email"`
+	Address       string `json:"address"`
+	// terms agreed with the supplier, like "50% upfront"
+	PaymentTerms string `json:"payment_terms"`
+	// days the clinic has to pay a receipt in
+	PaymentDays int64  `json:"payment_days"`
+	Note        string `json:"note"`
+}
+
+type Supplier struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	ContactPerson string `json:"contact_person"`
+	PhoneNumber   string `json:"phone_number"`
+	Email         string `json:"email"`
+	Address       string `json:"address"`
+	PaymentTerms  string `json:"payment_terms"`
+	PaymentDays   int64  `json:"payment_days"`
+	Note          string `json:"note"`
+	// sqlad ordered from the supplier
+	SqladCount int64  `json:"sqlad_count"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+type SuppliersFindReq struct {
+	Limit  int64  `json:"limit" default:"10"`
+	Page   int64  `json:"page" default:"1"`
+	Search string `json:"search"`
+}
+
+type Suppliers struct {
+	Suppliers []*Supplier `json:"suppliers"`
+	Count     int64       `json:"count"`
+}
+
+type PurchaseOrderReq struct {
+	SupplierId string                  `json:"supplier_id"`
+	Note       string                  `json:"note"`
+	Items      []*PurchaseOrderItemReq `json:"items"`
+}
+
+type PurchaseOrderItemReq struct {
+	SqladId  string `json:"sqlad_id"`
+	Quantity int64  `json:"quantity"`
+	// the price of the sqlad when empty
+	UnitCost float64 `json:"unit_cost"`
+}
+
+type PurchaseOrder struct {
+	Id string `json:"id"`
+	// sequential number printed on the order
+	Number       int64  `json:"number"`
+	SupplierId   string `json:"supplier_id"`
+	SupplierName string `json:"supplier_name"`
+	// draft, ordered, partially_received, received or cancelled
+	Status  string               `json:"status"`
+	Note    string               `json:"note"`
+	ActorId string               `json:"actor_id"`
+	Items   []*PurchaseOrderItem `json:"items"`
+	// cost of the quantities ordered
+	Total float64 `json:"total"`
+	// cost of the quantities received
+	ReceivedTotal float64 `json:"received_total"`
+	OrderedAt     string  `json:"ordered_at"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type PurchaseOrderItem struct {
+	Id        string  `json:"id"`
+	SqladId   string  `json:"sqlad_id"`
+	SqladName string  `json:"sqlad_name"`
+	Quantity  int64   `json:"quantity"`
+	UnitCost  float64 `json:"unit_cost"`
+	Received  int64   `json:"received"`
+}
+
+type PurchaseOrdersFindReq struct {
+	SupplierId string `json:"supplier_id"`
+	Status     string `json:"status"`
+	// dates like 2006-01-02 the orders were created in, both included
+	FromDate string `json:"from_date"`
+	ToDate   string `json:"to_date"`
+	Limit    int64  `json:"limit" default:"10"`
+	Page     int64  `json:"page" default:"1"`
+}
+
+type PurchaseOrders struct {
+	Orders []*PurchaseOrder `json:"orders"`
+	Count  int64            `json:"count"`
+}
+
+type LowStockOrderReq struct {
+	// orders only the sqlad of the supplier when given
+	SupplierId string `json:"supplier_id"`
+}
+
+type LowStockOrders struct {
+	// one draft order per supplier
+	Orders []*PurchaseOrder `json:"orders"`
+	// sqlad low on stock without a supplier to order from
+	WithoutSupplier []*SqladRespModel `json:"without_supplier"`
+}
+
+type PurchaseOrderStatusReq struct {
+	// ordered, cancelled or received to close an order received short
+	Status string `json:"status"`
+}
+
+type PurchaseOrderReceipt struct {
+	Items []*PurchaseOrderReceiptItem `json:"items"`
+}
+
+type PurchaseOrderReceiptItem struct {
+	ItemId   string `json:"item_id"`
+	Quantity int64  `json:"quantity"`
+	// lot of the stock received
+	LotNumber      string `json:"lot_number"`
+	ExpirationDate string `json:"expiration_date"`
+}
+
+type SupplierSpend struct {
+	SupplierId   string `json:"supplier_id"`
+	SupplierName string `json:"supplier_name"`
+	// orders the stock was received by
+	Orders int64                `json:"orders"`
+	Spent  float64              `json:"spent"`
+	Items  []*SupplierSpendItem `json:"items"`
+}
+
+type SupplierSpendItem struct {
+	SqladId   string  `json:"sqlad_id"`
+	SqladName string  `json:"sqlad_name"`
+	Quantity  int64   `json:"quantity"`
+	Spent     float64 `json:"spent"`
+}
+
+type SupplierSpendReport struct {
+	FromDate  string           `json:"from_date"`
+	ToDate    string           `json:"to_date"`
+	Suppliers []*SupplierSpend `json:"suppliers"`
+	Total     float64          `json:"total"`
+}
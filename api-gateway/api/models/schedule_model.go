@@ -0,0 +1,75 @@
+package models
+
+type WorkInterval struct {
+	// 1 is monday ... 7 is sunday
+	Weekday   int32  `json:"weekday"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+type DoctorWorkHours struct {
+	DoctorId string          `json:"doctor_id"`
+	Hours    []*WorkInterval `json:"hours"`
+	Breaks   []*WorkInterval `json:"breaks"`
+}
+
+type DoctorWorkHoursReq struct {
+	Hours  []*WorkInterval `json:"hours"`
+	Breaks []*WorkInterval `json:"breaks"`
+}
+
+type TimeSlot struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+type DoctorExceptionReq struct {
+	DoctorId string `json:"doctor_id"`
+	// vacation, sick, absent or hours
+	Kind     string `json:"kind"`
+	DateFrom string `json:"date_from"`
+	DateTo   string `json:"date_to"`
+	// the absence or the custom hours within the days, whole days when empty
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+	SubstituteId string `json:"substitute_id"`
+	Note         string `json:"note"`
+}
+
+type DoctorException struct {
+	Id           string `json:"id"`
+	DoctorId     string `json:"doctor_id"`
+	Kind         string `json:"kind"`
+	DateFrom     string `json:"date_from"`
+	DateTo       string `json:"date_to"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+	SubstituteId string `json:"substitute_id"`
+	Note         string `json:"note"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type DoctorExceptions struct {
+	Exceptions []*DoctorException `json:"exceptions"`
+}
+
+type DoctorAvailability struct {
+	DoctorId  string `json:"doctor_id"`
+	At        string `json:"at"`
+	Available bool   `json:"available"`
+	// vacation, sick, absent, off_hours or no_schedule
+	Reason       string      `json:"reason"`
+	NextStart    string      `json:"next_start"`
+	SubstituteId string      `json:"substitute_id"`
+	Substitute   *DoctorResp `json:"substitute"`
+}
+
+type WorkingDoctor struct {
+	Doctor        *DoctorResp `json:"doctor"`
+	Hours         []*TimeSlot `json:"hours"`
+	SubstituteFor string      `json:"substitute_for"`
+}
+
+type DoctorsWorking struct {
+	Doctors []*WorkingDoctor `json:"doctors"`
+}
@@ -0,0 +1,51 @@
+package models
+
+type ShiftOpenReq struct {
+	OpeningCash int64 `json:"opening_cash"`
+}
+
+type ShiftCloseReq struct {
+	CountedCash int64 `json:"counted_cash"`
+}
+
+type ShiftsFindReq struct {
+	Limit    int64  `json:"limit"`
+	Page     int64  `json:"page"`
+	StaffId  string `json:"staff_id"`
+	FromDate string `json:"from_date"`
+	ToDate   string `json:"to_date"`
+}
+
+type ShiftPaymentTotal struct {
+	PaymentType string `json:"payment_type"`
+	Summa       int64  `json:"summa"`
+	Count       int64  `json:"count"`
+}
+
+type ShiftUnpaidCashbox struct {
+	CashboxId string `json:"cashbox_id"`
+	ClientId  int64  `json:"client_id"`
+	Summa     int64  `json:"summa"`
+	Paid      int64  `json:"paid"`
+	Remaining int64  `json:"remaining"`
+}
+
+type Shift struct {
+	Id              string                `json:"id"`
+	StaffId         string                `json:"staff_id"`
+	OpeningCash     int64                 `json:"opening_cash"`
+	CountedCash     int64                 `json:"counted_cash"`
+	ExpectedCash    int64                 `json:"expected_cash"`
+	Receipts        int64                 `json:"receipts"`
+	Refunds         int64                 `json:"refunds"`
+	RefundsCount    int64                 `json:"refunds_count"`
+	Totals          []*ShiftPaymentTotal  `json:"totals"`
+	UnpaidCashboxes []*ShiftUnpaidCashbox `json:"unpaid_cashboxes"`
+	OpenedAt        string                `json:"opened_at"`
+	ClosedAt        string                `json:"closed_at"`
+}
+
+type ShiftsResp struct {
+	Shifts []*Shift `json:"shifts"`
+	Count  int64    `json:"count"`
+}
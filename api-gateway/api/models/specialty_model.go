@@ -0,0 +1,30 @@
+package models
+
+type SpecialtyReq struct {
+	Name string `json:"name"`
+	// default consultation price of the doctors of the specialty
+	Price float64 `json:"price"`
+	// appointment length, 30 when empty
+	SlotMinutes int64 `json:"slot_minutes"`
+}
+
+type Specialty struct {
+	Id           string  `json:"id"`
+	Name         string  `json:"name"`
+	Price        float64 `json:"price"`
+	SlotMinutes  int64   `json:"slot_minutes"`
+	DoctorsCount int64   `json:"doctors_count"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+type SpecialtiesFindReq struct {
+	Limit  int64  `json:"limit" default:"10"`
+	Page   int64  `json:"page" default:"1"`
+	Search string `json:"search"`
+}
+
+type Specialties struct {
+	Specialties []*Specialty `json:"specialties"`
+	Count       int64        `json:"count"`
+}
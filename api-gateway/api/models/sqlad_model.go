@@ -0,0 +1,126 @@
+package models
+
+type SqladMovementReq struct {
+	SqladId string `json:"sqlad_id"`
+	// receipt, issue, write_off or adjustment
+	Kind string `json:"kind"`
+	// signed for adjustments, issues and write-offs are taken off the stock
+	Quantity int64 `json:"quantity"`
+	// the price of the sqlad when empty
+	UnitCost float64 `json:"unit_cost"`
+	// doctor, lab or aparat the stock is issued to
+	TargetType string `json:"target_type"`
+	TargetId   string `json:"target_id"`
+	Note       string `json:"note"`
+	// lot to take from or to add to, first expiring lots or a new lot when empty
+	LotId string `json:"lot_id"`
+	// batch number and expiry like 2006-01-02 of the new lot
+	LotNumber      string `json:"lot_number"`
+	ExpirationDate string `json:"expiration_date"`
+}
+
+type SqladMovement struct {
+	Id         string  `json:"id"`
+	SqladId    string  `json:"sqlad_id"`
+	Kind       string  `json:"kind"`
+	Quantity   int64   `json:"quantity"`
+	UnitCost   float64 `json:"unit_cost"`
+	TargetType string  `json:"target_type"`
+	TargetId   string  `json:"target_id"`
+	ActorId    string  `json:"actor_id"`
+	Note       string  `json:"note"`
+	CreatedAt  string  `json:"created_at"`
+	// stock after the movement
+	Balance int64           `json:"balance"`
+	Lots    []*SqladLotTake `json:"lots"`
+}
+
+type SqladLotTake struct {
+	LotId          string `json:"lot_id"`
+	LotNumber      string `json:"lot_number"`
+	ExpirationDate string `json:"expiration_date"`
+	Quantity       int64  `json:"quantity"`
+}
+
+type SqladLot struct {
+	Id             string `json:"id"`
+	SqladId        string `json:"sqlad_id"`
+	SqladName      string `json:"sqlad_name"`
+	Number         string `json:"number"`
+	ExpirationDate string `json:"expiration_date"`
+	// negative when expired
+	DaysLeft  int64   `json:"days_left"`
+	UnitCost  float64 `json:"unit_cost"`
+	Received  int64   `json:"received"`
+	Remaining int64   `json:"remaining"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type SqladLots struct {
+	Lots  []*SqladLot `json:"lots"`
+	Count int64       `json:"count"`
+}
+
+type SqladMovementsFindReq struct {
+	SqladId    string `json:"sqlad_id"`
+	Kind       string `json:"kind"`
+	TargetType string `json:"target_type"`
+	TargetId   string `json:"target_id"`
+	FromDate   string `json:"from_date"`
+	ToDate     string `json:"to_date"`
+	Limit      int64  `json:"limit" default:"10"`
+	Page       int64  `json:"page" default:"1"`
+}
+
+type SqladMovements struct {
+	Movements []*SqladMovement `json:"movements"`
+	Count     int64            `json:"count"`
+}
+
+type SqladBalance struct {
+	SqladId string  `json:"sqlad_id"`
+	Name    string  `json:"name"`
+	Count   int64   `json:"count"`
+	Value   float64 `json:"value"`
+}
+
+type SqladBalances struct {
+	Date     string          `json:"date"`
+	Balances []*SqladBalance `json:"balances"`
+}
+
+type SqladBomItem struct {
+	SqladId   string `json:"sqlad_id"`
+	SqladName string `json:"sqlad_name"`
+	// taken off the stock each time the service is performed
+	Quantity int64 `json:"quantity"`
+}
+
+type SqladBom struct {
+	// lab or aparat
+	ServiceType string          `json:"service_type"`
+	ServiceId   string          `json:"service_id"`
+	Items       []*SqladBomItem `json:"items"`
+}
+
+type ConsumptionLine struct {
+	ServiceType string `json:"service_type"`
+	ServiceId   string `json:"service_id"`
+	ServiceName string `json:"service_name"`
+	SqladId     string `json:"sqlad_id"`
+	SqladName   string `json:"sqlad_name"`
+	// analyses recorded for the service
+	Performed int64 `json:"performed"`
+	// by the bill of materials of the analyses recorded
+	Expected int64 `json:"expected"`
+	// issued to the service, by hand too
+	Actual int64 `json:"actual"`
+	// actual less expected, positive when more was used than planned
+	Difference int64 `json:"difference"`
+}
+
+type ConsumptionReport struct {
+	FromDate string             `json:"from_date"`
+	ToDate   string             `json:"to_date"`
+	Lines    []*ConsumptionLine `json:"lines"`
+}
@@ -0,0 +1,73 @@
+package models
+
+// Staff roles
+const (
+	RoleReceptionist  = "receptionist"
+	RoleCashier       = "cashier"
+	RoleDoctor        = "doctor"
+	RoleLabTechnician = "lab_technician"
+	RoleAdmin         = "admin"
+)
+
+type StaffModel struct {
+	Id          string `json:"id"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	Login       string `json:"login"`
+	PhoneNumber string `json:"phone_number"`
+	Role        string `json:"role"`
+	DoctorId    string `json:"doctor_id"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type CreateStaffModel struct {
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	Login       string `json:"login"`
+	Password    string `json:"password"`
+	PhoneNumber string `json:"phone_number"`
+	Role        string `json:"role"`
+	DoctorId    string `json:"doctor_id"`
+}
+
+type UpdateStaffModel struct {
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	Login       string `json:"login"`
+	PhoneNumber string `json:"phone_number"`
+	Role        string `json:"role"`
+	DoctorId    string `json:"doctor_id"`
+}
+
+type StaffsFindReq struct {
+	Limit  int64  `json:"limit"`
+	Page   int64  `json:"page"`
+	Search string `json:"search"`
+	Role   string `json:"role"`
+}
+
+type StaffsResp struct {
+	Staffs []*StaffModel `json:"staffs"`
+	Count  int64         `json:"count"`
+}
+
+type LoginReq struct {
+	Login    string `json:"login"`
+	Password string `json:"password"`
+}
+
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type ChangePasswordReq struct {
+	OldPassword string `json:"old_password"`
+	NewPassword string `json:"new_password"`
+}
+
+type TokenResp struct {
+	AccessToken  string      `json:"access_token"`
+	RefreshToken string      `json:"refresh_token"`
+	Staff        *StaffModel `json:"staff"`
+}
@@ -0,0 +1,76 @@
+package models
+
+type StocktakeStartReq struct {
+	Note string `json:"note"`
+}
+
+type Stocktake struct {
+	Id string `json:"id"`
+	// sequential number printed on the report
+	Number int64 `json:"number"`
+	// open, posted or cancelled
+	Status   string `json:"status"`
+	Note     string `json:"note"`
+	ActorId  string `json:"actor_id"`
+	PostedBy string `json:"posted_by"`
+	// counted and not counted stock, against the balance now while open and at posting once posted
+	Lines []*StocktakeLine `json:"lines"`
+	// what each staff member counted
+	Counts []*StocktakeCount `json:"counts"`
+	// value of the differences at the unit costs
+	ValueDifference float64 `json:"value_difference"`
+	CreatedAt       string  `json:"created_at"`
+	PostedAt        string  `json:"posted_at"`
+}
+
+type StocktakeLine struct {
+	SqladId        string `json:"sqlad_id"`
+	SqladName      string `json:"sqlad_name"`
+	LotId          string `json:"lot_id"`
+	LotNumber      string `json:"lot_number"`
+	ExpirationDate string `json:"expiration_date"`
+	// the balance of the system when the sqlad or lot was first counted, the balance now when nobody counted it
+	Expected int64 `json:"expected"`
+	// what was counted by everyone
+	Counted int64 `json:"counted"`
+	// counted less expected
+	Difference int64 `json:"difference"`
+	// false when nobody counted the sqlad, posting leaves it as is
+	IsCounted       bool    `json:"is_counted"`
+	UnitCost        float64 `json:"unit_cost"`
+	ValueDifference float64 `json:"value_difference"`
+	// adjustment movement of the difference once posted
+	MovementId string `json:"movement_id"`
+}
+
+type StocktakeCount struct {
+	SqladId   string `json:"sqlad_id"`
+	SqladName string `json:"sqlad_name"`
+	// empty when the sqlad is counted as a whole
+	LotId     string `json:"lot_id"`
+	Quantity  int64  `json:"quantity"`
+	CounterId string `json:"counter_id"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type StocktakeCountReq struct {
+	Items []*StocktakeCountItem `json:"items"`
+}
+
+type StocktakeCountItem struct {
+	SqladId string `json:"sqlad_id"`
+	// counts the sqlad by lots when given, the lots not counted are counted zero
+	LotId    string `json:"lot_id"`
+	Quantity int64  `json:"quantity"`
+}
+
+type StocktakesFindReq struct {
+	Status string `json:"status"`
+	Limit  int64  `json:"limit" default:"10"`
+	Page   int64  `json:"page" default:"1"`
+}
+
+type Stocktakes struct {
+	Stocktakes []*Stocktake `json:"stocktakes"`
+	Count      int64        `json:"count"`
+}
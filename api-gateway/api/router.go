@@ -3,6 +3,7 @@ package api
 import (
 	_ "gitlab.com/clinic-crm/api-gateway/api/docs"
 	v1 "gitlab.com/clinic-crm/api-gateway/api/handlers/v1"
+	"gitlab.com/clinic-crm/api-gateway/api/models"
 	"gitlab.com/clinic-crm/api-gateway/config"
 	"gitlab.com/clinic-crm/api-gateway/pkg/logger"
 	"gitlab.com/clinic-crm/api-gateway/services"
@@ -25,7 +26,9 @@ type Option struct {
 // @version         1.0
 // @description     This is MedicalCRM server api. Created by Otajonov Quvonchbek
 // @termsOfService  1 term MedicalCRM
+// @securityDefinitions.apikey BearerAuth
 // @in header
+// @name Authorization
 func New(option Option) *gin.Engine {
 	router := gin.New()
 
@@ -52,109 +55,238 @@ func New(option Option) *gin.Engine {
 	corConfig.AllowMethods = []string{"*"}
 	router.Use(cors.New(corConfig))
 
+	// Role guards, admin passes every one of them
+	var (
+		anyStaff       = handlerV1.Authorize()
+		admin          = handlerV1.Authorize(models.RoleAdmin)
+		receptionist   = handlerV1.Authorize(models.RoleReceptionist)
+		cashier        = handlerV1.Authorize(models.RoleCashier)
+		doctor         = handlerV1.Authorize(models.RoleDoctor)
+		labTechnician  = handlerV1.Authorize(models.RoleLabTechnician)
+		cashboxStaff   = handlerV1.Authorize(models.RoleReceptionist, models.RoleCashier)
+		queueStaff     = handlerV1.Authorize(models.RoleReceptionist, models.RoleDoctor, models.RoleLabTechnician)
+		analysisReader = handlerV1.Authorize(models.RoleLabTechnician, models.RoleDoctor)
+	)
+
 	api := router.Group("/v1")
+	// Auth...
+	api.POST("/auth/login", handlerV1.Login)
+	api.POST("/auth/refresh", handlerV1.RefreshToken)
+	api.POST("/auth/logout", anyStaff, handlerV1.Logout)
+	api.POST("/auth/change-password", anyStaff, handlerV1.ChangePassword)
+	api.GET("/auth/me", anyStaff, handlerV1.Me)
+
+	// Staff...
+	api.POST("/staff-create", admin, handlerV1.StaffCreate)
+	api.GET("/staff-get/:id", admin, handlerV1.StaffGet)
+	api.GET("/staff-find", admin, handlerV1.StaffsFind)
+	api.POST("/staff-update/:id", admin, handlerV1.StaffUpdate)
+	api.DELETE("/staff-delete/:id", admin, handlerV1.StaffDelete)
+
 	// Patient...
-	api.POST("/patient-create", handlerV1.PatientCreate)
-	api.GET("/patient-get", handlerV1.PatientGet)
-	api.GET("/patient-find", handlerV1.PatientsFind)
-	api.POST("/patient-update/:id", handlerV1.PatientUpdate)
-	api.DELETE("/patient-delete/:id", handlerV1.PatientDelete)
+	api.POST("/patient-create", receptionist, handlerV1.PatientCreate)
+	api.GET("/patient-get", anyStaff, handlerV1.PatientGet)
+	api.GET("/patient-find", anyStaff, handlerV1.PatientsFind)
+	api.POST("/patient-update/:id", receptionist, handlerV1.PatientUpdate)
+	api.DELETE("/patient-delete/:id", admin, handlerV1.PatientDelete)
 
 	// Doctor...
-	api.POST("/doctor-create", handlerV1.DoctorCreate)
-	api.GET("/doctor-get", handlerV1.DoctorGet)
-	api.GET("/doctor-find", handlerV1.DoctorsFind)
-	api.POST("/doctor-update/:id", handlerV1.DoctorUpdate)
-	api.DELETE("/doctor-delete/:id", handlerV1.DoctorDelete)
-	api.GET("/doctor-type-get", handlerV1.DoctorTypeGet)
+	api.POST("/doctor-create", admin, handlerV1.DoctorCreate)
+	api.GET("/doctor-get", anyStaff, handlerV1.DoctorGet)
+	api.GET("/doctor-find", anyStaff, handlerV1.DoctorsFind)
+	api.POST("/doctor-update/:id", admin, handlerV1.DoctorUpdate)
+	api.DELETE("/doctor-delete/:id", admin, handlerV1.DoctorDelete)
+	api.GET("/doctor-type-get", anyStaff, handlerV1.DoctorTypeGet)
+
+	// Specialty...
+	api.POST("/specialty-create", admin, handlerV1.SpecialtyCreate)
+	api.GET("/specialty-get/:id", anyStaff, handlerV1.SpecialtyGet)
+	api.GET("/specialty-find", anyStaff, handlerV1.SpecialtiesFind)
+	api.POST("/specialty-update/:id", admin, handlerV1.SpecialtyUpdate)
+	api.DELETE("/specialty-delete/:id", admin, handlerV1.SpecialtyDelete)
+
+	// Doctor page
+	api.GET("/doctor-page-filter", doctor, handlerV1.DoctorPageFilter)
+
+	// Working hours
+	api.POST("/doctor-work-hours-set/:id", admin, handlerV1.DoctorWorkHoursSet)
+	api.GET("/doctor-work-hours-get/:id", anyStaff, handlerV1.DoctorWorkHoursGet)
+	api.POST("/doctor-exception-create", admin, handlerV1.DoctorExceptionCreate)
+	api.GET("/doctor-exception-find", anyStaff, handlerV1.DoctorExceptionsFind)
+	api.DELETE("/doctor-exception-delete/:id", admin, handlerV1.DoctorExceptionDelete)
+	api.GET("/doctor-availability/:id", anyStaff, handlerV1.DoctorAvailabilityGet)
+	api.GET("/doctors-working", anyStaff, handlerV1.DoctorsWorking)
+
+	// Payroll
+	api.POST("/doctor-commission-set", admin, handlerV1.DoctorCommissionSet)
+	api.GET("/doctor-commission-find", admin, handlerV1.DoctorCommissionsFind)
+	api.DELETE("/doctor-commission-delete/:id", admin, handlerV1.DoctorCommissionDelete)
+	api.GET("/payroll-report", admin, handlerV1.PayrollReportGet)
+	api.GET("/payroll-report-export", admin, handlerV1.PayrollReportExport)
 
 	// Labs...
-	api.POST("/lab-create", handlerV1.LabCreate)
-	api.GET("/lab-get", handlerV1.LabGet)
-	api.GET("/lab-find", handlerV1.LabsFind)
-	api.POST("/lab-update/:id", handlerV1.LabUpdate)
-	api.DELETE("lab-delete/:id", handlerV1.LabDelete)
+	api.POST("/lab-create", admin, handlerV1.LabCreate)
+	api.GET("/lab-get", anyStaff, handlerV1.LabGet)
+	api.GET("/lab-find", anyStaff, handlerV1.LabsFind)
+	api.POST("/lab-update/:id", admin, handlerV1.LabUpdate)
+	api.DELETE("lab-delete/:id", admin, handlerV1.LabDelete)
 
 	// Aparat...
-	api.POST("/aparat-create", handlerV1.AparatCreate)
-	api.GET("/aparat-get", handlerV1.AparatGet)
-	api.GET("/aparat-find", handlerV1.AparatsFind)
-	api.POST("/aparat-update/:id", handlerV1.AparatsUpdate)
-	api.DELETE("aparat-delete/:id", handlerV1.AparatsDelete)
+	api.POST("/aparat-create", admin, handlerV1.AparatCreate)
+	api.GET("/aparat-get", anyStaff, handlerV1.AparatGet)
+	api.GET("/aparat-find", anyStaff, handlerV1.AparatsFind)
+	api.POST("/aparat-update/:id", admin, handlerV1.AparatsUpdate)
+	api.DELETE("aparat-delete/:id", admin, handlerV1.AparatsDelete)
 
 	// Lab Category
-	api.POST("/lab-category-create", handlerV1.LabCategoryCreate)
-	api.GET("/lab-category-get", handlerV1.LabCategoryGet)
-	api.GET("/lab-category-find", handlerV1.LabCategoryFind)
-	api.POST("/lab-category-update/:id", handlerV1.LabCategoryUpdate)
-	api.DELETE("lab-category-delete/:id", handlerV1.LabCategoryDelete)
+	api.POST("/lab-category-create", admin, handlerV1.LabCategoryCreate)
+	api.GET("/lab-category-get", anyStaff, handlerV1.LabCategoryGet)
+	api.GET("/lab-category-find", anyStaff, handlerV1.LabCategoryFind)
+	api.POST("/lab-category-update/:id", admin, handlerV1.LabCategoryUpdate)
+	api.DELETE("lab-category-delete/:id", admin, handlerV1.LabCategoryDelete)
 
 	// Aparat Category
-	api.POST("/aparat-category-create", handlerV1.AparatCategoryCreate)
-	api.GET("/aparat-category-get", handlerV1.AparatCategoryGet)
-	api.GET("/aparat-category-find", handlerV1.AparatCategoryFind)
-	api.POST("/aparat-category-update/:id", handlerV1.AparatCategoryUpdate)
-	api.DELETE("aparat-category-delete/:id", handlerV1.AparatCategoryDelete)
+	api.POST("/aparat-category-create", admin, handlerV1.AparatCategoryCreate)
+	api.GET("/aparat-category-get", anyStaff, handlerV1.AparatCategoryGet)
+	api.GET("/aparat-category-find", anyStaff, handlerV1.AparatCategoryFind)
+	api.POST("/aparat-category-update/:id", admin, handlerV1.AparatCategoryUpdate)
+	api.DELETE("aparat-category-delete/:id", admin, handlerV1.AparatCategoryDelete)
 
 	// Lab sub Category
-	api.POST("/lab-sub-category-create", handlerV1.LabSubCategoryCreate)
-	api.GET("/lab-sub-category-get", handlerV1.LabSubCategoryGet)
-	api.GET("/lab-sub-category-find", handlerV1.LabSubCategoryFind)
-	api.POST("/lab-sub-category-update/:id", handlerV1.LabSubCategoryUpdate)
-	api.DELETE("lab-sub-category-delete/:id", handlerV1.LabSubCategoryDelete)
+	api.POST("/lab-sub-category-create", admin, handlerV1.LabSubCategoryCreate)
+	api.GET("/lab-sub-category-get", anyStaff, handlerV1.LabSubCategoryGet)
+	api.GET("/lab-sub-category-find", anyStaff, handlerV1.LabSubCategoryFind)
+	api.POST("/lab-sub-category-update/:id", admin, handlerV1.LabSubCategoryUpdate)
+	api.DELETE("lab-sub-category-delete/:id", admin, handlerV1.LabSubCategoryDelete)
 
 	// Aparat sub Category
-	api.POST("/aparat-sub-category-create", handlerV1.AparatSubCategoryCreate)
-	api.GET("/aparat-sub-category-get", handlerV1.AparatSubCategoryGet)
-	api.GET("/aparat-sub-category-find", handlerV1.AparatSubCategoryFind)
-	api.POST("/aparat-sub-category-update/:id", handlerV1.AparatSubCategoryUpdate)
-	api.DELETE("aparat-sub-category-delete/:id", handlerV1.AparatSubCategoryDelete)
+	api.POST("/aparat-sub-category-create", admin, handlerV1.AparatSubCategoryCreate)
+	api.GET("/aparat-sub-category-get", anyStaff, handlerV1.AparatSubCategoryGet)
+	api.GET("/aparat-sub-category-find", anyStaff, handlerV1.AparatSubCategoryFind)
+	api.POST("/aparat-sub-category-update/:id", admin, handlerV1.AparatSubCategoryUpdate)
+	api.DELETE("aparat-sub-category-delete/:id", admin, handlerV1.AparatSubCategoryDelete)
 	// Doctor report
-	api.POST("/doctor-report-create", handlerV1.DoctorReportCreate)
-	api.GET("/doctor-report-get", handlerV1.DoctorReportGet)
-	api.GET("/doctor-report-find", handlerV1.DoctorReportsFind)
-	api.DELETE("doctor-report-delete/:id", handlerV1.DoctorReportDelete)
+	api.POST("/doctor-report-create", doctor, handlerV1.DoctorReportCreate)
+	api.GET("/doctor-report-get", doctor, handlerV1.DoctorReportGet)
+	api.GET("/doctor-report-find", doctor, handlerV1.DoctorReportsFind)
+	api.DELETE("doctor-report-delete/:id", doctor, handlerV1.DoctorReportDelete)
 
 	// Aparat analysis
-	api.POST("/aparat-analysis-create", handlerV1.AparatAnalysisCreate)
-	api.GET("/aparat-analysis-get", handlerV1.AparatAnalysisGet)
-	api.DELETE("aparat-analysis-delete/:id", handlerV1.AparatAnalysisDelete)
+	api.POST("/aparat-analysis-create", labTechnician, handlerV1.AparatAnalysisCreate)
+	api.GET("/aparat-analysis-get", analysisReader, handlerV1.AparatAnalysisGet)
+	api.DELETE("aparat-analysis-delete/:id", labTechnician, handlerV1.AparatAnalysisDelete)
 
 	// Lab analysis
-	api.POST("/lab-analysis-create", handlerV1.LabAnalysisCreate)
-	api.GET("/lab-analysis-get", handlerV1.LabAnalysisGet)
-	api.DELETE("/lab-analysis-delete/:id", handlerV1.LabAnalysisDelete)
+	api.POST("/lab-analysis-create", labTechnician, handlerV1.LabAnalysisCreate)
+	api.GET("/lab-analysis-get", analysisReader, handlerV1.LabAnalysisGet)
+	api.DELETE("/lab-analysis-delete/:id", labTechnician, handlerV1.LabAnalysisDelete)
 
 	// Sqlad
-	api.POST("/sqlad-create", handlerV1.SqladCreate)
-	api.GET("/sqlad-get", handlerV1.SqladGet)
-	api.GET("/low-stock", handlerV1.LowStock)
-	api.POST("/sqlad-update/:id", handlerV1.SqladUpdate)
-	api.DELETE("/sqlad-delete/:id", handlerV1.SqladDelete)
+	api.POST("/sqlad-create", admin, handlerV1.SqladCreate)
+	api.GET("/sqlad-get", admin, handlerV1.SqladGet)
+	api.GET("/low-stock", admin, handlerV1.LowStock)
+	api.GET("/expiring-lots", admin, handlerV1.ExpiringLots)
+	api.POST("/sqlad-update/:id", admin, handlerV1.SqladUpdate)
+	api.DELETE("/sqlad-delete/:id", admin, handlerV1.SqladDelete)
+	api.POST("/sqlad-movement-create", admin, handlerV1.SqladMovementCreate)
+	api.GET("/sqlad-movement-find", admin, handlerV1.SqladMovementsFind)
+	api.GET("/sqlad-balance", admin, handlerV1.SqladBalanceGet)
+	api.GET("/sqlad-lots/:id", admin, handlerV1.SqladLotsFind)
+	api.POST("/sqlad-bom-set", admin, handlerV1.SqladBomSet)
+	api.GET("/sqlad-bom-get", admin, handlerV1.SqladBomGet)
+	api.GET("/consumption-report", admin, handlerV1.ConsumptionReportGet)
+
+	// Suppliers
+	api.POST("/supplier-create", admin, handlerV1.SupplierCreate)
+	api.GET("/supplier-get/:id", admin, handlerV1.SupplierGet)
+	api.GET("/supplier-find", admin, handlerV1.SuppliersFind)
+	api.POST("/supplier-update/:id", admin, handlerV1.SupplierUpdate)
+	api.DELETE("/supplier-delete/:id", admin, handlerV1.SupplierDelete)
+	api.POST("/purchase-order-create", admin, handlerV1.PurchaseOrderCreate)
+	api.POST("/purchase-order-low-stock", admin, handlerV1.LowStockOrder)
+	api.GET("/purchase-order-get/:id", admin, handlerV1.PurchaseOrderGet)
+	api.GET("/purchase-order-find", admin, handlerV1.PurchaseOrdersFind)
+	api.POST("/purchase-order-update/:id", admin, handlerV1.PurchaseOrderUpdate)
+	api.POST("/purchase-order-status/:id", admin, handlerV1.PurchaseOrderStatusSet)
+	api.POST("/purchase-order-receive/:id", admin, handlerV1.PurchaseOrderReceive)
+	api.GET("/supplier-spend-report", admin, handlerV1.SupplierSpendReportGet)
+
+	// Stocktakes
+	api.POST("/stocktake-start", admin, handlerV1.StocktakeStart)
+	api.POST("/stocktake-count/:id", admin, handlerV1.StocktakeCountEnter)
+	api.GET("/stocktake-get/:id", admin, handlerV1.StocktakeGet)
+	api.GET("/stocktake-find", admin, handlerV1.StocktakesFind)
+	api.POST("/stocktake-post/:id", admin, handlerV1.StocktakePost)
+	api.POST("/stocktake-cancel/:id", admin, handlerV1.StocktakeCancel)
+	api.GET("/stocktake-report/:id", admin, handlerV1.StocktakeReport)
 
 	// Queue
-	api.POST("/queue-create", handlerV1.PatientQueueCreate)
-	api.GET("/queue-get", handlerV1.PatientQueueGet)
-	api.GET("/queue-check-get", handlerV1.CheckPatientQueue)
-	api.GET("/queue-find", handlerV1.PatientQueuesFind)
-	api.POST("/queue-update/:id", handlerV1.PatientQueueUpdate)
+	api.POST("/queue-create", receptionist, handlerV1.PatientQueueCreate)
+	api.GET("/queue-get", queueStaff, handlerV1.PatientQueueGet)
+	api.GET("/queue-check-get", queueStaff, handlerV1.CheckPatientQueue)
+	api.GET("/queue-find", queueStaff, handlerV1.PatientQueuesFind)
+	api.POST("/queue-call-next", queueStaff, handlerV1.QueueCallNext)
+	api.POST("/queue-start/:id", queueStaff, handlerV1.QueueStart)
+	api.POST("/queue-complete/:id", queueStaff, handlerV1.QueueComplete)
+	api.POST("/queue-skip/:id", queueStaff, handlerV1.QueueSkip)
+	api.POST("/queue-recall/:id", queueStaff, handlerV1.QueueRecall)
+	api.POST("/queue-no-show/:id", queueStaff, handlerV1.QueueNoShow)
+	api.GET("/queue-board", handlerV1.QueueBoard)
+
+	// Appointments
+	api.POST("/appointment-create", receptionist, handlerV1.AppointmentCreate)
+	api.GET("/appointment-get/:id", queueStaff, handlerV1.AppointmentGet)
+	api.GET("/appointment-find", queueStaff, handlerV1.AppointmentsFind)
+	api.GET("/appointment-slots", queueStaff, handlerV1.AppointmentSlots)
+	api.POST("/appointment-reschedule/:id", receptionist, handlerV1.AppointmentReschedule)
+	api.POST("/appointment-cancel/:id", receptionist, handlerV1.AppointmentCancel)
+	api.POST("/appointment-check-in/:id", receptionist, handlerV1.AppointmentCheckIn)
 
 	// Cashbox
-	api.POST("/cashbox-create", handlerV1.CashboxCreate)
-	api.GET("/cashbox-print", handlerV1.CashboxPrint)
-	api.GET("/cashbox-find", handlerV1.CashboxFind)
-	api.GET("/cashbox-get", handlerV1.CashboxGet)
-	api.POST("/cashbox-update/:id", handlerV1.CashboxUpdate)
-	api.DELETE("cashbox-delete/:id", handlerV1.CashboxDelete)
+	api.POST("/cashbox-create", cashboxStaff, handlerV1.CashboxCreate)
+	api.GET("/cashbox-print", cashboxStaff, handlerV1.CashboxPrint)
+	// the QR code of a printed receipt links here, the link is signed instead
+	api.GET("/receipt", handlerV1.ReceiptGet)
+	api.GET("/cashbox-find", cashboxStaff, handlerV1.CashboxFind)
+	api.GET("/cashbox-get", cashboxStaff, handlerV1.CashboxGet)
+	api.POST("/cashbox-update/:id", cashier, handlerV1.CashboxUpdate)
+	api.POST("/cashbox-pay/:id", cashier, handlerV1.CashboxPay)
+	api.POST("/cashbox-refund/:id", cashier, handlerV1.CashboxRefund)
+	api.DELETE("cashbox-delete/:id", admin, handlerV1.CashboxDelete)
+	api.POST("/cashbox-payment-link/:id", cashboxStaff, handlerV1.CashboxPaymentLink)
+
+	// Online payments, the callbacks are signed by the provider
+	api.POST("/payments/:provider/callback", handlerV1.PaymentCallback)
+	if option.Conf.PaymentSimulator {
+		api.GET("/payments/simulator/pay", admin, handlerV1.PaymentSimulatorPay)
+	}
+	api.GET("/online-transaction-get", cashboxStaff, handlerV1.OnlineTransactionGet)
 
 	// Payment history
-	api.POST("/payment-create", handlerV1.CreatePaymentHistory)
-	api.GET("/payment-get", handlerV1.GetPaymentHistory)
-	api.GET("/payment-find", handlerV1.FindPaymentHistory)
-	api.DELETE("payment-delete/:id", handlerV1.DeletePaymentHistory)
+	api.POST("/payment-create", cashier, handlerV1.CreatePaymentHistory)
+	api.GET("/payment-get", cashier, handlerV1.GetPaymentHistory)
+	api.GET("/payment-find", cashier, handlerV1.FindPaymentHistory)
+
+	// Discounts
+	api.POST("/discount-create", admin, handlerV1.DiscountCreate)
+	api.GET("/discount-get/:id", cashboxStaff, handlerV1.DiscountGet)
+	api.GET("/discount-find", cashboxStaff, handlerV1.DiscountsFind)
+	api.POST("/discount-update/:id", admin, handlerV1.DiscountUpdate)
+	api.DELETE("/discount-delete/:id", admin, handlerV1.DiscountDelete)
+
+	// Cashier shifts
+	api.POST("/shift-open", cashier, handlerV1.ShiftOpen)
+	api.POST("/shift-close", cashier, handlerV1.ShiftClose)
+	api.GET("/shift-get/:id", cashier, handlerV1.ShiftGet)
+	api.GET("/shift-find", cashier, handlerV1.ShiftsFind)
+
+	// Patient debts
+	api.POST("/patient-debt-create", cashier, handlerV1.PatientDebtCreate)
+	api.GET("/patient-debt-info", cashboxStaff, handlerV1.PatientDebtInfo)
+	api.GET("/patient-debts-overdue", cashboxStaff, handlerV1.PatientDebtsOverdue)
 
 	api.Static("/media", "./media")
-	api.POST("/media/photo", handlerV1.UploadMedia)
+	api.POST("/media/photo", anyStaff, handlerV1.UploadMedia)
 
 	url := ginSwagger.URL("swagger/doc.json")
 	api.GET("swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
@@ -0,0 +1,108 @@
+package tokens
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+// Token types
+const (
+	AccessToken  = "access"
+	RefreshToken = "refresh"
+)
+
+var (
+	ErrTokenExpired = errors.New("token is expired")
+	ErrTokenInvalid = errors.New("token is invalid")
+)
+
+type Claims struct {
+	Role      string `json:"role"`
+	DoctorId  string `json:"doctor_id,omitempty"`
+	TokenType string `json:"token_type"`
+	jwt.RegisteredClaims
+}
+
+type JWTHandler struct {
+	SigningKey      string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+type Tokens struct {
+	AccessToken    string
+	RefreshToken   string
+	RefreshTokenId string
+}
+
+// GenerateTokens issues a new access/refresh pair for the staff member. RefreshTokenId
+// must be stored by the caller, only the last issued refresh token can be used.
+func (j *JWTHandler) GenerateTokens(staffId, role, doctorId string) (*Tokens, error) {
+	now := time.Now()
+
+	access, err := j.sign(Claims{
+		Role:      role,
+		DoctorId:  doctorId,
+		TokenType: AccessToken,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   staffId,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(j.AccessTokenTTL)),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refreshId := uuid.New().String()
+	refresh, err := j.sign(Claims{
+		Role:      role,
+		DoctorId:  doctorId,
+		TokenType: RefreshToken,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        refreshId,
+			Subject:   staffId,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(j.RefreshTokenTTL)),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tokens{
+		AccessToken:    access,
+		RefreshToken:   refresh,
+		RefreshTokenId: refreshId,
+	}, nil
+}
+
+// ParseToken validates the signature, expiry and type of the token.
+func (j *JWTHandler) ParseToken(tokenStr, tokenType string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrTokenInvalid
+		}
+		return []byte(j.SigningKey), nil
+	})
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, ErrTokenExpired
+	} else if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	if claims.TokenType != tokenType || claims.Subject == "" {
+		return nil, ErrTokenInvalid
+	}
+
+	return claims, nil
+}
+
+func (j *JWTHandler) sign(claims Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(j.SigningKey))
+}
@@ -11,6 +11,10 @@ func main() {
 	cfg := config.Load()
 	log := logger.New(cfg.LogLevel, "api_gateway")
 
+	if err := cfg.Validate(); err != nil {
+		log.Fatal("invalid config", logger.Error(err))
+	}
+
 	serviceManager, err := services.NewServiceManager(cfg)
 	if err != nil {
 		log.Error("gRPC dial error", logger.Error(err))
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"os"
 
 	"github.com/spf13/cast"
@@ -21,6 +22,25 @@ type Config struct {
 
 	CtxTimeout int // context timeout in second
 
+	// receipt header
+	ClinicName    string
+	ClinicAddress string
+	ClinicPhone   string
+	ClinicLogoUrl string
+	ReceiptFont   string // TTF path for receipts with cyrillic, the built in font is used when empty
+
+	// online payments, click is enabled when its secret key is set
+	ClickServiceId   string
+	ClickMerchantId  string
+	ClickSecretKey   string
+	ClickPayUrl      string
+	PaymentSimulator bool   // the simulator provider pays links locally, for development
+	SimulatorSecret  string // required by the simulator, it signs the callbacks
+
+	SigningKey      string // required, signs the tokens of the staff
+	AccessTokenTTL  int    // access token lifetime in minutes
+	RefreshTokenTTL int    // refresh token lifetime in hours
+
 	LogLevel string
 	HTTPPort string
 }
@@ -42,9 +62,40 @@ func Load() Config {
 	c.CtxTimeout = cast.ToInt(getOrReturnDefault("CTX_TIMEOUT", 7))
 	c.BaseUrl = cast.ToString(getOrReturnDefault("BASE_URL", "https://medical.samandardev.uz/v1/"))
 
+	c.ClinicName = cast.ToString(getOrReturnDefault("CLINIC_NAME", "Clinic"))
+	c.ClinicAddress = cast.ToString(getOrReturnDefault("CLINIC_ADDRESS", ""))
+	c.ClinicPhone = cast.ToString(getOrReturnDefault("CLINIC_PHONE", ""))
+	c.ClinicLogoUrl = cast.ToString(getOrReturnDefault("CLINIC_LOGO_URL", ""))
+	c.ReceiptFont = cast.ToString(getOrReturnDefault("RECEIPT_FONT", ""))
+
+	c.ClickServiceId = cast.ToString(getOrReturnDefault("CLICK_SERVICE_ID", ""))
+	c.ClickMerchantId = cast.ToString(getOrReturnDefault("CLICK_MERCHANT_ID", ""))
+	c.ClickSecretKey = cast.ToString(getOrReturnDefault("CLICK_SECRET_KEY", ""))
+	c.ClickPayUrl = cast.ToString(getOrReturnDefault("CLICK_PAY_URL", "https://my.click.uz/services/pay"))
+	c.PaymentSimulator = cast.ToBool(getOrReturnDefault("PAYMENT_SIMULATOR", false))
+	c.SimulatorSecret = cast.ToString(getOrReturnDefault("SIMULATOR_SECRET", ""))
+
+	c.SigningKey = cast.ToString(getOrReturnDefault("SIGNING_KEY", ""))
+	c.AccessTokenTTL = cast.ToInt(getOrReturnDefault("ACCESS_TOKEN_TTL", 60))
+	c.RefreshTokenTTL = cast.ToInt(getOrReturnDefault("REFRESH_TOKEN_TTL", 72))
+
 	return c
 }
 
+// Validate checks the secrets the gateway can not run without.
+func (c Config) Validate() error {
+	if c.SigningKey == "" {
+		return errors.New("SIGNING_KEY is required")
+	}
+	if c.PaymentSimulator && c.Environment == "production" {
+		return errors.New("PAYMENT_SIMULATOR can not run in production")
+	}
+	if c.PaymentSimulator && c.SimulatorSecret == "" {
+		return errors.New("SIMULATOR_SECRET is required by PAYMENT_SIMULATOR")
+	}
+	return nil
+}
+
 func getOrReturnDefault(key string, defaultValue interface{}) interface{} {
 	_, exists := os.LookupEnv(key)
 	if exists {
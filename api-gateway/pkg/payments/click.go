@@ -0,0 +1,126 @@
+package payments
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Click is a provider speaking the Click SHOP API: a prepare request checks the
+// cashbox and a complete request performs the payment, or cancels it when it comes
+// with a negative error. Both are signed with md5 over the fields and the secret key.
+type Click struct {
+	ServiceId  string
+	MerchantId string
+	SecretKey  string
+	// PayUrl is the payment page of the provider
+	PayUrl string
+}
+
+const (
+	clickPrepare  = "0"
+	clickComplete = "1"
+)
+
+var clickErrors = map[error]int{
+	ErrSignature: -1,
+	ErrAmount:    -2,
+	ErrAction:    -3,
+	ErrNotFound:  -5,
+	ErrRequest:   -8,
+	ErrCancelled: -9,
+	ErrInternal:  -7,
+}
+
+func (p *Click) Link(cashboxId string, amount int64) string {
+	return p.PayUrl + "?" + url.Values{
+		"service_id":        {p.ServiceId},
+		"merchant_id":       {p.MerchantId},
+		"amount":            {strconv.FormatInt(amount, 10)},
+		"transaction_param": {cashboxId},
+	}.Encode()
+}
+
+func (p *Click) Parse(r *http.Request) (*Callback, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, ErrRequest
+	}
+	form := r.PostForm
+	callback := &Callback{
+		TransactionId: form.Get("click_trans_id"),
+		CashboxId:     form.Get("merchant_trans_id"),
+	}
+	if callback.TransactionId == "" || callback.CashboxId == "" || form.Get("sign_time") == "" {
+		return callback, ErrRequest
+	}
+
+	if !hmacEqual(form.Get("sign_string"), p.Sign(form)) {
+		return callback, ErrSignature
+	}
+
+	amount, err := strconv.ParseFloat(form.Get("amount"), 64)
+	if err != nil || amount <= 0 || amount != math.Trunc(amount) {
+		return callback, ErrAmount
+	}
+	callback.Amount = int64(amount)
+
+	switch form.Get("action") {
+	case clickPrepare:
+		callback.Action = ActionCheck
+	case clickComplete:
+		callback.Action = ActionPerform
+		// a complete with an error is Click telling the payment failed
+		if code, _ := strconv.Atoi(form.Get("error")); code < 0 {
+			callback.Action = ActionCancel
+			callback.Reason = form.Get("error_note")
+		}
+	default:
+		return callback, ErrAction
+	}
+
+	return callback, nil
+}
+
+// Sign returns the sign_string of the callback fields.
+func (p *Click) Sign(form url.Values) string {
+	data := form.Get("click_trans_id") + form.Get("service_id") + p.SecretKey + form.Get("merchant_trans_id")
+	if form.Get("action") == clickComplete {
+		data += form.Get("merchant_prepare_id")
+	}
+	data += form.Get("amount") + form.Get("action") + form.Get("sign_time")
+
+	sum := md5.Sum([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func (p *Click) Reply(callback *Callback, err error) interface{} {
+	code, note := 0, "Success"
+	if err != nil {
+		code, note = clickErrors[err], err.Error()
+		if code == 0 {
+			code = clickErrors[ErrInternal]
+		}
+	}
+
+	reply := map[string]interface{}{
+		"click_trans_id":    callback.TransactionId,
+		"merchant_trans_id": callback.CashboxId,
+		"error":             code,
+		"error_note":        note,
+	}
+	// the click transaction id is unique at Click, it is the id of our side too
+	if callback.Action == ActionCheck {
+		reply["merchant_prepare_id"] = callback.TransactionId
+	} else {
+		reply["merchant_confirm_id"] = callback.TransactionId
+	}
+	return reply
+}
+
+func hmacEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
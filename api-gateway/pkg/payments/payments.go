@@ -0,0 +1,44 @@
+package payments
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Callback actions of a provider transaction
+const (
+	ActionCheck   = "check"
+	ActionPerform = "perform"
+	ActionCancel  = "cancel"
+)
+
+// Errors a callback is answered with, every provider has its own codes for them.
+var (
+	ErrSignature = errors.New("invalid callback signature")
+	ErrRequest   = errors.New("invalid callback request")
+	ErrAction    = errors.New("unknown callback action")
+	ErrAmount    = errors.New("incorrect amount for the cashbox")
+	ErrNotFound  = errors.New("cashbox or transaction not found")
+	ErrCancelled = errors.New("transaction is cancelled")
+	ErrInternal  = errors.New("payment could not be recorded")
+)
+
+// Callback is a verified request of a provider about one of its transactions.
+type Callback struct {
+	Action        string
+	TransactionId string
+	CashboxId     string
+	Amount        int64
+	Reason        string
+}
+
+// Provider is a payment provider patients pay cashboxes through online.
+type Provider interface {
+	// Link returns the page the patient pays the amount of the cashbox on.
+	Link(cashboxId string, amount int64) string
+	// Parse verifies the signature of the callback request and parses it.
+	Parse(r *http.Request) (*Callback, error)
+	// Reply returns the body the provider expects for the callback, err is one of the
+	// callback errors or nil when the callback was handled.
+	Reply(callback *Callback, err error) interface{}
+}
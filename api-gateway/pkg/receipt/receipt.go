@@ -0,0 +1,375 @@
+package receipt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// Receipt formats, the thermal ones are as long as their content.
+const (
+	Format58 = "58mm"
+	Format80 = "80mm"
+	FormatA4 = "a4"
+)
+
+var ErrFormat = errors.New("receipt format must be one of 58mm, 80mm, a4")
+
+type Header struct {
+	Name    string
+	Address string
+	Phone   string
+	// Logo is a PNG, JPEG or GIF drawn in the header, the header has no logo when it is of another type
+	Logo []byte
+}
+
+type Line struct {
+	Name        string
+	DoctorName  string
+	RoomNumber  string
+	QueueNumber int64
+	Quantity    int64
+	Price       int64
+	Discount    int64
+}
+
+// Total is what is paid for the line.
+func (l Line) Total() int64 {
+	return l.Price*l.Quantity - l.Discount
+}
+
+type Receipt struct {
+	Header      Header
+	Number      string
+	Patient     string
+	CreatedAt   string
+	Lines       []Line
+	Gross       int64
+	Discount    int64
+	Net         int64
+	Paid        int64
+	Remaining   int64
+	PaymentType string
+	// Url is encoded in the QR code of the receipt
+	Url string
+}
+
+type layout struct {
+	width, margin float64
+	font, small   float64
+	qr            float64
+}
+
+var layouts = map[string]layout{
+	Format58: {width: 58, margin: 3, font: 7, small: 6, qr: 24},
+	Format80: {width: 80, margin: 4, font: 8, small: 7, qr: 30},
+	FormatA4: {width: 210, margin: 15, font: 10, small: 8, qr: 35},
+}
+
+// Valid checks the format is one of the receipt formats.
+func Valid(format string) error {
+	if _, ok := layouts[format]; !ok {
+		return ErrFormat
+	}
+	return nil
+}
+
+// Render writes the receipt as a PDF of the format. The fonts built into PDF have no
+// cyrillic, fontPath is a TTF used instead of them when it is set.
+func Render(w io.Writer, format, fontPath string, r *Receipt) error {
+	l, ok := layouts[format]
+	if !ok {
+		return ErrFormat
+	}
+
+	qr, err := qrcode.Encode(r.Url, qrcode.Medium, 256)
+	if err != nil {
+		return err
+	}
+
+	if format == FormatA4 {
+		pdf := newPdf(gofpdf.SizeType{Wd: 210, Ht: 297}, fontPath, qr, r.Header.Logo)
+		renderA4(pdf, l, r)
+		return pdf.Output(w)
+	}
+
+	// a thermal receipt is drawn once on a long page to know its height
+	pdf := newPdf(gofpdf.SizeType{Wd: l.width, Ht: 1000}, fontPath, qr, r.Header.Logo)
+	height := renderThermal(pdf, l, r)
+	if err := pdf.Error(); err != nil {
+		return err
+	}
+
+	pdf = newPdf(gofpdf.SizeType{Wd: l.width, Ht: height}, fontPath, qr, r.Header.Logo)
+	renderThermal(pdf, l, r)
+	return pdf.Output(w)
+}
+
+type document struct {
+	*gofpdf.Fpdf
+	family string
+	tr     func(string) string
+	logo   *gofpdf.ImageInfoType
+}
+
+func newPdf(size gofpdf.SizeType, fontPath string, qr, logo []byte) *document {
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{UnitStr: "mm", Size: size})
+	pdf.SetAutoPageBreak(false, 0)
+
+	doc := &document{Fpdf: pdf, family: "Helvetica", tr: pdf.UnicodeTranslatorFromDescriptor("")}
+	if fontPath != "" {
+		pdf.AddUTF8Font("receipt", "", fontPath)
+		pdf.AddUTF8Font("receipt", "B", fontPath)
+		doc.family, doc.tr = "receipt", func(s string) string { return s }
+	}
+
+	if qr != nil {
+		pdf.RegisterImageOptionsReader("qr", gofpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qr))
+	}
+	if imageType := logoType(logo); imageType != "" && pdf.Ok() {
+		// a broken logo is left out instead of failing the receipt
+		info := pdf.RegisterImageOptionsReader("logo", gofpdf.ImageOptions{ImageType: imageType}, bytes.NewReader(logo))
+		if pdf.Ok() && info != nil && info.Height() > 0 {
+			doc.logo = info
+		}
+		pdf.ClearError()
+	}
+	pdf.AddPage()
+	return doc
+}
+
+// logoType is the gofpdf image type of the logo, empty when it can not be drawn.
+func logoType(logo []byte) string {
+	if len(logo) == 0 {
+		return ""
+	}
+	switch http.DetectContentType(logo) {
+	case "image/png":
+		return "PNG"
+	case "image/jpeg":
+		return "JPG"
+	case "image/gif":
+		return "GIF"
+	}
+	return ""
+}
+
+// logoSize is the size of the logo as high as height and as wide as maxWidth at most,
+// zero when there is no logo.
+func (d *document) logoSize(height, maxWidth float64) (float64, float64) {
+	if d.logo == nil {
+		return 0, 0
+	}
+	width := height * d.logo.Width() / d.logo.Height()
+	if width > maxWidth {
+		width, height = maxWidth, maxWidth*d.logo.Height()/d.logo.Width()
+	}
+	return width, height
+}
+
+func (d *document) drawLogo(x, y, width, height float64) {
+	if d.logo != nil {
+		d.ImageOptions("logo", x, y, width, height, false, gofpdf.ImageOptions{}, 0, "")
+	}
+}
+
+func (d *document) text(style string, size, width, height float64, align, s string) {
+	d.SetFont(d.family, style, size)
+	d.MultiCell(width, height, d.tr(s), "", align, false)
+}
+
+func (d *document) row(size, width, height float64, left, right string) {
+	d.SetFont(d.family, "", size)
+	x := d.GetX()
+	d.CellFormat(width/2, height, d.tr(left), "", 0, "L", false, 0, "")
+	d.SetX(x + width/2)
+	d.CellFormat(width/2, height, d.tr(right), "", 1, "R", false, 0, "")
+	d.SetX(x)
+}
+
+func (d *document) rule(width float64) {
+	y := d.GetY() + 1
+	d.SetDashPattern([]float64{0.8, 0.8}, 0)
+	d.Line(d.GetX(), y, d.GetX()+width, y)
+	d.SetDashPattern([]float64{}, 0)
+	d.SetY(y + 1)
+}
+
+// renderThermal draws the receipt in one narrow column and returns the height it took.
+func renderThermal(d *document, l layout, r *Receipt) float64 {
+	var (
+		width = l.width - 2*l.margin
+		lh    = l.font * 0.5
+	)
+	d.SetMargins(l.margin, l.margin, l.margin)
+	d.SetXY(l.margin, l.margin)
+
+	if logoWidth, logoHeight := d.logoSize(l.qr/2, width); logoHeight > 0 {
+		d.drawLogo(l.margin+(width-logoWidth)/2, l.margin, logoWidth, logoHeight)
+		d.SetY(l.margin + logoHeight + 1)
+	}
+	d.text("B", l.font+2, width, lh+1, "C", r.Header.Name)
+	if r.Header.Address != "" {
+		d.text("", l.small, width, lh, "C", r.Header.Address)
+	}
+	if r.Header.Phone != "" {
+		d.text("", l.small, width, lh, "C", r.Header.Phone)
+	}
+	d.rule(width)
+
+	d.row(l.small, width, lh, "Receipt", shortNumber(r.Number))
+	d.row(l.small, width, lh, "Date", r.CreatedAt)
+	d.row(l.small, width, lh, "Patient", r.Patient)
+	d.rule(width)
+
+	for _, line := range r.Lines {
+		d.text("B", l.font, width, lh, "L", line.Name)
+		if info := lineInfo(line); info != "" {
+			d.text("", l.small, width, lh, "L", info)
+		}
+		d.row(l.font, width, lh, fmt.Sprintf("%d x %s", line.Quantity, Money(line.Price)), Money(line.Price*line.Quantity))
+		if line.Discount > 0 {
+			d.row(l.small, width, lh, "Discount", "-"+Money(line.Discount))
+		}
+	}
+	d.rule(width)
+
+	totals(d, l, width, lh, r)
+	d.rule(width)
+
+	d.ImageOptions("qr", l.margin+(width-l.qr)/2, d.GetY()+1, l.qr, l.qr, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+	d.SetY(d.GetY() + l.qr + 2)
+	d.text("", l.small, width, lh, "C", "Thank you!")
+
+	return d.GetY() + l.margin
+}
+
+func renderA4(d *document, l layout, r *Receipt) {
+	var (
+		width = l.width - 2*l.margin
+		lh    = 6.0
+	)
+	d.SetMargins(l.margin, l.margin, l.margin)
+	d.SetXY(l.margin, l.margin)
+
+	// the logo is on the right of the name and the address
+	logoWidth, logoHeight := d.logoSize(16, width/3)
+	d.drawLogo(l.margin+width-logoWidth, l.margin, logoWidth, logoHeight)
+	d.text("B", 16, width-logoWidth-2, 8, "L", r.Header.Name)
+	d.text("", l.small+1, width-logoWidth-2, 5, "L", strings.TrimSpace(r.Header.Address+"  "+r.Header.Phone))
+	if bottom := l.margin + logoHeight; d.GetY() < bottom {
+		d.SetY(bottom)
+	}
+	d.Ln(4)
+
+	d.text("B", 13, width, 7, "L", "Receipt "+shortNumber(r.Number))
+	d.row(l.font, width, lh, "Patient: "+r.Patient, "Date: "+r.CreatedAt)
+	d.Ln(3)
+
+	columns := []struct {
+		title string
+		width float64
+		align string
+	}{
+		{"#", 8, "C"}, {"Service", 50, "L"}, {"Doctor", 36, "L"}, {"Room", 14, "C"}, {"Queue", 14, "C"},
+		{"Qty", 10, "C"}, {"Price", 20, "R"}, {"Discount", 14, "R"}, {"Total", 14, "R"},
+	}
+	d.SetFont(d.family, "B", l.small)
+	d.SetFillColor(235, 235, 235)
+	for _, column := range columns {
+		d.CellFormat(column.width, lh, d.tr(column.title), "1", 0, column.align, true, 0, "")
+	}
+	d.Ln(-1)
+
+	d.SetFont(d.family, "", l.small)
+	for i, line := range r.Lines {
+		var queue string
+		if line.QueueNumber > 0 {
+			queue = strconv.FormatInt(line.QueueNumber, 10)
+		}
+		values := []string{
+			strconv.Itoa(i + 1), line.Name, line.DoctorName, line.RoomNumber, queue,
+			strconv.FormatInt(line.Quantity, 10), Money(line.Price), Money(line.Discount), Money(line.Total()),
+		}
+		for j, column := range columns {
+			d.CellFormat(column.width, lh, d.tr(fit(d, values[j], column.width)), "1", 0, column.align, false, 0, "")
+		}
+		d.Ln(-1)
+	}
+	d.Ln(4)
+
+	top := d.GetY()
+	d.SetX(l.margin + width/2)
+	totals(d, l, width/2, lh, r)
+
+	d.ImageOptions("qr", l.margin, top, l.qr, l.qr, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+}
+
+func totals(d *document, l layout, width, lh float64, r *Receipt) {
+	if r.Discount > 0 {
+		d.row(l.font, width, lh, "Subtotal", Money(r.Gross))
+		d.row(l.font, width, lh, "Discount", "-"+Money(r.Discount))
+	}
+	d.SetFont(d.family, "B", l.font+1)
+	x := d.GetX()
+	d.CellFormat(width/2, lh+1, d.tr("TOTAL"), "", 0, "L", false, 0, "")
+	d.CellFormat(width/2, lh+1, d.tr(Money(r.Net)), "", 1, "R", false, 0, "")
+	d.SetX(x)
+	d.row(l.font, width, lh, "Paid", Money(r.Paid))
+	if r.Remaining > 0 {
+		d.row(l.font, width, lh, "To pay", Money(r.Remaining))
+	}
+	if r.PaymentType != "" {
+		d.row(l.font, width, lh, "Payment", r.PaymentType)
+	}
+}
+
+func lineInfo(line Line) string {
+	info := make([]string, 0, 3)
+	if line.DoctorName != "" {
+		info = append(info, line.DoctorName)
+	}
+	if line.RoomNumber != "" {
+		info = append(info, "room "+line.RoomNumber)
+	}
+	if line.QueueNumber > 0 {
+		info = append(info, "queue #"+strconv.FormatInt(line.QueueNumber, 10))
+	}
+	return strings.Join(info, ", ")
+}
+
+// fit cuts the text to the width of a table cell.
+func fit(d *document, s string, width float64) string {
+	for s != "" && d.GetStringWidth(d.tr(s)) > width-2 {
+		runes := []rune(s)
+		s = string(runes[:len(runes)-1])
+	}
+	return s
+}
+
+func shortNumber(id string) string {
+	if len(id) > 8 {
+		return strings.ToUpper(id[:8])
+	}
+	return strings.ToUpper(id)
+}
+
+// Money formats the amount with its thousands separated by spaces.
+func Money(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	s := strconv.FormatInt(amount, 10)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + " " + s[i:]
+	}
+	return sign + s
+}
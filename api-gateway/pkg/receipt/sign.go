@@ -0,0 +1,20 @@
+package receipt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Sign returns the signature of the public link of the receipt, so the link can be opened
+// without signing in and the numbers of the other receipts can not be guessed from it.
+func Sign(key, id string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte("receipt:" + id))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the public link of the receipt.
+func Verify(key, id, signature string) bool {
+	return id != "" && hmac.Equal([]byte(Sign(key, id)), []byte(signature))
+}
@@ -0,0 +1,114 @@
+package receipt
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+type Stocktake struct {
+	Header    Header
+	Number    int64
+	Status    string
+	Note      string
+	CreatedAt string
+	PostedAt  string
+	// the counted lines with a difference
+	Lines           []StocktakeLine
+	ValueDifference float64
+}
+
+type StocktakeLine struct {
+	Name            string
+	LotNumber       string
+	ExpirationDate  string
+	Expected        int64
+	Counted         int64
+	UnitCost        float64
+	ValueDifference float64
+}
+
+// RenderStocktake writes the discrepancies of the stocktake as an A4 PDF, the table goes
+// on over as many pages as it takes.
+func RenderStocktake(w io.Writer, fontPath string, s *Stocktake) error {
+	var (
+		l     = layouts[FormatA4]
+		width = l.width - 2*l.margin
+		lh    = 6.0
+		d     = newPdf(gofpdf.SizeType{Wd: 210, Ht: 297}, fontPath, nil, nil)
+	)
+	d.SetMargins(l.margin, l.margin, l.margin)
+	d.SetXY(l.margin, l.margin)
+
+	d.text("B", 16, width, 8, "L", s.Header.Name)
+	d.text("", l.small+1, width, 5, "L", strings.TrimSpace(s.Header.Address+"  "+s.Header.Phone))
+	d.Ln(4)
+
+	d.text("B", 13, width, 7, "L", "Stocktake "+strconv.FormatInt(s.Number, 10)+" discrepancies")
+	d.row(l.font, width, lh, "Started: "+s.CreatedAt, "Status: "+s.Status)
+	if s.PostedAt != "" {
+		d.row(l.font, width, lh, "Posted: "+s.PostedAt, "")
+	}
+	if s.Note != "" {
+		d.text("", l.font, width, lh, "L", s.Note)
+	}
+	d.Ln(3)
+
+	columns := []struct {
+		title string
+		width float64
+		align string
+	}{
+		{"#", 8, "C"}, {"Sqlad", 52, "L"}, {"Lot", 22, "L"}, {"Expires", 20, "C"},
+		{"Expected", 16, "R"}, {"Counted", 16, "R"}, {"Difference", 16, "R"}, {"Unit cost", 14, "R"}, {"Value", 16, "R"},
+	}
+	head := func() {
+		d.SetFont(d.family, "B", l.small)
+		d.SetFillColor(235, 235, 235)
+		for _, column := range columns {
+			d.CellFormat(column.width, lh, d.tr(column.title), "1", 0, column.align, true, 0, "")
+		}
+		d.Ln(-1)
+		d.SetFont(d.family, "", l.small)
+	}
+	head()
+
+	_, pageHeight := d.GetPageSize()
+	for i, line := range s.Lines {
+		if d.GetY()+lh > pageHeight-l.margin {
+			d.AddPage()
+			head()
+		}
+		values := []string{
+			strconv.Itoa(i + 1), line.Name, line.LotNumber, line.ExpirationDate,
+			strconv.FormatInt(line.Expected, 10), strconv.FormatInt(line.Counted, 10),
+			strconv.FormatInt(line.Counted-line.Expected, 10), amount(line.UnitCost), amount(line.ValueDifference),
+		}
+		for j, column := range columns {
+			d.CellFormat(column.width, lh, d.tr(fit(d, values[j], column.width)), "1", 0, column.align, false, 0, "")
+		}
+		d.Ln(-1)
+	}
+	if len(s.Lines) == 0 {
+		d.text("", l.font, width, lh, "L", "Nothing differs from the balance.")
+	}
+	d.Ln(4)
+
+	if d.GetY()+lh+1 > pageHeight-l.margin {
+		d.AddPage()
+	}
+	d.SetX(l.margin + width/2)
+	d.SetFont(d.family, "B", l.font+1)
+	d.CellFormat(width/4, lh+1, d.tr("TOTAL"), "", 0, "L", false, 0, "")
+	d.CellFormat(width/4, lh+1, d.tr(amount(s.ValueDifference)), "", 1, "R", false, 0, "")
+
+	return d.Output(w)
+}
+
+// amount rounds the cost to the whole sum like Money.
+func amount(cost float64) string {
+	return Money(int64(math.Round(cost)))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/grpc_client"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
@@ -36,7 +37,12 @@ func main() {
 
 	strg := storage.NewStoragePg(psqlConn)
 
-	patientService := service.NewDoctorService(strg)
+	grpcClient, err := grpc_client.New(cfg)
+	if err != nil {
+		log.Fatalf("failed to connect services: %v", err)
+	}
+
+	patientService := service.NewDoctorService(strg, grpcClient)
 	lis, err := net.Listen("tcp", ":"+cfg.DoctorServicePort)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -7,16 +7,18 @@ import (
 )
 
 type Config struct {
-	DoctorServicePort string
-	DoctorServiceHost string
-	Environment       string
-	LogLevel          string
-	PostgresHost      string
-	PostgresPort      string
-	PostgresUser      string
-	PostgresPassword  string
-	PostgresDatabase  string
-	DatabaseUrl       string
+	DoctorServicePort  string
+	DoctorServiceHost  string
+	PatientServicePort string
+	PatientServiceHost string
+	Environment        string
+	LogLevel           string
+	PostgresHost       string
+	PostgresPort       string
+	PostgresUser       string
+	PostgresPassword   string
+	PostgresDatabase   string
+	DatabaseUrl        string
 }
 
 func Load() Config {
@@ -29,6 +31,8 @@ func Load() Config {
 	c := Config{}
 	c.DoctorServiceHost = cast.ToString(GetOrReturnDefault("DOCTOR_SERVICE_HOST", "localhost"))
 	c.DoctorServicePort = cast.ToString(GetOrReturnDefault("DOCTOR_SERVICE_PORT", "5001"))
+	c.PatientServiceHost = cast.ToString(GetOrReturnDefault("PATIENT_SERVICE_HOST", "localhost"))
+	c.PatientServicePort = cast.ToString(GetOrReturnDefault("PATIENT_SERVICE_PORT", "5000"))
 	c.Environment = cast.ToString(GetOrReturnDefault("ENVIRONMENT", "develop"))
 	c.LogLevel = cast.ToString(GetOrReturnDefault("LOG_LEVEL", "debug"))
 	c.PostgresHost = cast.ToString(GetOrReturnDefault("POSTGRES_HOST", "localhost"))
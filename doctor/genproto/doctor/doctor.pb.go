@@ -26,7 +26,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type DoctorType struct {
 	DoctorType           string   `protobuf:"bytes,1,opt,name=doctor_type,json=doctorType,proto3" json:"doctor_type"`
@@ -49,7 +49,7 @@ func (m *DoctorType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_DoctorType.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -97,7 +97,7 @@ func (m *DoctorTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_DoctorTypes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -136,6 +136,7 @@ type DocPageFilter struct {
 	Page                 int64    `protobuf:"varint,3,opt,name=page,proto3" json:"page"`
 	FromDate             string   `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
 	ToDate               string   `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	DoctorId             string   `protobuf:"bytes,6,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -155,7 +156,7 @@ func (m *DocPageFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_DocPageFilter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -209,9 +210,17 @@ func (m *DocPageFilter) GetToDate() string {
 	return ""
 }
 
+func (m *DocPageFilter) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
+	}
+	return ""
+}
+
 type DocPageFilterRes struct {
 	PatientInfo          []*DocPage         `protobuf:"bytes,1,rep,name=patient_info,json=patientInfo,proto3" json:"patient_info"`
 	DoctorReport         []*DoctorReportRes `protobuf:"bytes,2,rep,name=doctor_report,json=doctorReport,proto3" json:"doctor_report"`
+	Count                int64              `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -231,7 +240,7 @@ func (m *DocPageFilterRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_DocPageFilterRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -264,11 +273,20 @@ func (m *DocPageFilterRes) GetDoctorReport() []*DoctorReportRes {
 	return nil
 }
 
+func (m *DocPageFilterRes) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type DocPage struct {
 	QueueNumber          int64    `protobuf:"varint,1,opt,name=queue_number,json=queueNumber,proto3" json:"queue_number"`
 	FullName             string   `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name"`
 	PhoneNumber          string   `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
 	DateLastVisit        string   `protobuf:"bytes,4,opt,name=date_last_visit,json=dateLastVisit,proto3" json:"date_last_visit"`
+	ClientId             int64    `protobuf:"varint,5,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	PatientId            string   `protobuf:"bytes,6,opt,name=patient_id,json=patientId,proto3" json:"patient_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -288,7 +306,7 @@ func (m *DocPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_DocPage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -335,6 +353,20 @@ func (m *DocPage) GetDateLastVisit() string {
 	return ""
 }
 
+func (m *DocPage) GetClientId() int64 {
+	if m != nil {
+		return m.ClientId
+	}
+	return 0
+}
+
+func (m *DocPage) GetPatientId() string {
+	if m != nil {
+		return m.PatientId
+	}
+	return ""
+}
+
 type LowStockRes struct {
 	LowStock             []*SqladRes `protobuf:"bytes,1,rep,name=low_stock,json=lowStock,proto3" json:"low_stock"`
 	Count                int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
@@ -357,7 +389,7 @@ func (m *LowStockRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_LowStockRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -411,7 +443,7 @@ func (m *SqladId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_SqladId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -459,7 +491,7 @@ func (m *SqladGetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_SqladGetReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -493,13 +525,20 @@ func (m *SqladGetReq) GetValue() string {
 }
 
 type SqladReq struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
-	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
-	LowStock             int64    `protobuf:"varint,5,opt,name=low_stock,json=lowStock,proto3" json:"low_stock"`
-	ExpirationDate       string   `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
-	Provider             string   `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// opening stock received on create, ignored on update: the stock changes by movements only
+	Count    int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	Price    float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
+	LowStock int64   `protobuf:"varint,5,opt,name=low_stock,json=lowStock,proto3" json:"low_stock"`
+	// expiry of the opening stock, ignored on update: every receipt has its own lot
+	ExpirationDate string `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	// name of the supplier, used to look the supplier up when supplier_id is empty
+	Provider string `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider"`
+	// staff creating the sqlad, the actor of the opening receipt
+	ActorId string `protobuf:"bytes,8,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	// supplier the sqlad is ordered from
+	SupplierId           string   `protobuf:"bytes,9,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -519,7 +558,7 @@ func (m *SqladReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_SqladReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -587,16 +626,34 @@ func (m *SqladReq) GetProvider() string {
 	return ""
 }
 
+func (m *SqladReq) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *SqladReq) GetSupplierId() string {
+	if m != nil {
+		return m.SupplierId
+	}
+	return ""
+}
+
 type SqladRes struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
-	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
-	LowStock             int64    `protobuf:"varint,5,opt,name=low_stock,json=lowStock,proto3" json:"low_stock"`
-	ExpirationDate       string   `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// balance of the movements
+	Count    int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	Price    float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
+	LowStock int64   `protobuf:"varint,5,opt,name=low_stock,json=lowStock,proto3" json:"low_stock"`
+	// the nearest expiry of the lots in stock
+	ExpirationDate string `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	// name of the supplier
 	Provider             string   `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider"`
 	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	SupplierId           string   `protobuf:"bytes,10,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -616,7 +673,7 @@ func (m *SqladRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_SqladRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -698,6 +755,13 @@ func (m *SqladRes) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *SqladRes) GetSupplierId() string {
+	if m != nil {
+		return m.SupplierId
+	}
+	return ""
+}
+
 type ReportId struct {
 	ReportId             string   `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -719,7 +783,7 @@ func (m *ReportId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_ReportId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -767,7 +831,7 @@ func (m *DoctorReportsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return xxx_messageInfo_DoctorReportsResp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -823,7 +887,7 @@ func (m *DoctorReportsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return xxx_messageInfo_DoctorReportsFindReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -885,7 +949,7 @@ func (m *GetDoctorReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_GetDoctorReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -942,7 +1006,7 @@ func (m *DoctorReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_DoctorReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1015,7 +1079,7 @@ func (m *DoctorReportRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_DoctorReportRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1097,7 +1161,7 @@ func (m *DoctorId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_DoctorId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1127,6 +1191,7 @@ type DoctorsFindReq struct {
 	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
 	Page                 int64    `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
 	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search"`
+	SpecialtyId          string   `protobuf:"bytes,4,opt,name=specialty_id,json=specialtyId,proto3" json:"specialty_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1146,7 +1211,7 @@ func (m *DoctorsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_DoctorsFindReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1186,6 +1251,13 @@ func (m *DoctorsFindReq) GetSearch() string {
 	return ""
 }
 
+func (m *DoctorsFindReq) GetSpecialtyId() string {
+	if m != nil {
+		return m.SpecialtyId
+	}
+	return ""
+}
+
 type DoctorsResp struct {
 	Doctors              []*Doctor `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors"`
 	Count                int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
@@ -1208,7 +1280,7 @@ func (m *DoctorsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_DoctorsResp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1241,6 +1313,109 @@ func (m *DoctorsResp) GetCount() int64 {
 	return 0
 }
 
+type DoctorIds struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorIds) Reset()         { *m = DoctorIds{} }
+func (m *DoctorIds) String() string { return proto.CompactTextString(m) }
+func (*DoctorIds) ProtoMessage()    {}
+func (*DoctorIds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{19}
+}
+func (m *DoctorIds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorIds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorIds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorIds.Merge(m, src)
+}
+func (m *DoctorIds) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorIds) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorIds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorIds proto.InternalMessageInfo
+
+func (m *DoctorIds) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type DoctorsByIdsResp struct {
+	Doctors []*Doctor `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors"`
+	// ids no doctor was found for
+	MissingIds           []string `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorsByIdsResp) Reset()         { *m = DoctorsByIdsResp{} }
+func (m *DoctorsByIdsResp) String() string { return proto.CompactTextString(m) }
+func (*DoctorsByIdsResp) ProtoMessage()    {}
+func (*DoctorsByIdsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{20}
+}
+func (m *DoctorsByIdsResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorsByIdsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorsByIdsResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoctorsByIdsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorsByIdsResp.Merge(m, src)
+}
+func (m *DoctorsByIdsResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorsByIdsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorsByIdsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorsByIdsResp proto.InternalMessageInfo
+
+func (m *DoctorsByIdsResp) GetDoctors() []*Doctor {
+	if m != nil {
+		return m.Doctors
+	}
+	return nil
+}
+
+func (m *DoctorsByIdsResp) GetMissingIds() []string {
+	if m != nil {
+		return m.MissingIds
+	}
+	return nil
+}
+
 type GetDoctorReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -1253,7 +1428,7 @@ func (m *GetDoctorReq) Reset()         { *m = GetDoctorReq{} }
 func (m *GetDoctorReq) String() string { return proto.CompactTextString(m) }
 func (*GetDoctorReq) ProtoMessage()    {}
 func (*GetDoctorReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5107a06a9a1f6bc, []int{19}
+	return fileDescriptor_c5107a06a9a1f6bc, []int{21}
 }
 func (m *GetDoctorReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1263,7 +1438,7 @@ func (m *GetDoctorReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_GetDoctorReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1297,28 +1472,32 @@ func (m *GetDoctorReq) GetValue() string {
 }
 
 type Doctor struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	FirstName            string   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	Gender               string   `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender"`
-	WorkTime             string   `protobuf:"bytes,5,opt,name=work_time,json=workTime,proto3" json:"work_time"`
-	Price                float64  `protobuf:"fixed64,6,opt,name=price,proto3" json:"price"`
-	Cpecialety           string   `protobuf:"bytes,7,opt,name=cpecialety,proto3" json:"cpecialety"`
-	RoomNumber           string   `protobuf:"bytes,8,opt,name=room_number,json=roomNumber,proto3" json:"room_number"`
-	PhoneNumber          string   `protobuf:"bytes,9,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
-	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	FirstName string  `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName  string  `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	Gender    string  `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender"`
+	WorkTime  string  `protobuf:"bytes,5,opt,name=work_time,json=workTime,proto3" json:"work_time"`
+	Price     float64 `protobuf:"fixed64,6,opt,name=price,proto3" json:"price"`
+	// name of the primary specialty, set from specialty_ids
+	Cpecialety  string `protobuf:"bytes,7,opt,name=cpecialety,proto3" json:"cpecialety"`
+	RoomNumber  string `protobuf:"bytes,8,opt,name=room_number,json=roomNumber,proto3" json:"room_number"`
+	PhoneNumber string `protobuf:"bytes,9,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	CreatedAt   string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt   string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt   string `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	// the first one is the primary specialty
+	SpecialtyIds         []string     `protobuf:"bytes,13,rep,name=specialty_ids,json=specialtyIds,proto3" json:"specialty_ids"`
+	Specialties          []*Specialty `protobuf:"bytes,14,rep,name=specialties,proto3" json:"specialties"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Doctor) Reset()         { *m = Doctor{} }
 func (m *Doctor) String() string { return proto.CompactTextString(m) }
 func (*Doctor) ProtoMessage()    {}
 func (*Doctor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c5107a06a9a1f6bc, []int{20}
+	return fileDescriptor_c5107a06a9a1f6bc, []int{22}
 }
 func (m *Doctor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1507,7 @@ func (m *Doctor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Doctor.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1431,2218 +1610,28784 @@ func (m *Doctor) GetDeletedAt() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*DoctorType)(nil), "doctor.DoctorType")
-	proto.RegisterType((*DoctorTypes)(nil), "doctor.DoctorTypes")
-	proto.RegisterType((*DocPageFilter)(nil), "doctor.DocPageFilter")
-	proto.RegisterType((*DocPageFilterRes)(nil), "doctor.DocPageFilterRes")
-	proto.RegisterType((*DocPage)(nil), "doctor.DocPage")
-	proto.RegisterType((*LowStockRes)(nil), "doctor.LowStockRes")
-	proto.RegisterType((*SqladId)(nil), "doctor.SqladId")
-	proto.RegisterType((*SqladGetReq)(nil), "doctor.SqladGetReq")
-	proto.RegisterType((*SqladReq)(nil), "doctor.SqladReq")
-	proto.RegisterType((*SqladRes)(nil), "doctor.SqladRes")
-	proto.RegisterType((*ReportId)(nil), "doctor.ReportId")
-	proto.RegisterType((*DoctorReportsResp)(nil), "doctor.DoctorReportsResp")
-	proto.RegisterType((*DoctorReportsFindReq)(nil), "doctor.DoctorReportsFindReq")
-	proto.RegisterType((*GetDoctorReport)(nil), "doctor.GetDoctorReport")
-	proto.RegisterType((*DoctorReport)(nil), "doctor.DoctorReport")
-	proto.RegisterType((*DoctorReportRes)(nil), "doctor.DoctorReportRes")
-	proto.RegisterType((*DoctorId)(nil), "doctor.DoctorId")
-	proto.RegisterType((*DoctorsFindReq)(nil), "doctor.DoctorsFindReq")
-	proto.RegisterType((*DoctorsResp)(nil), "doctor.DoctorsResp")
-	proto.RegisterType((*GetDoctorReq)(nil), "doctor.GetDoctorReq")
-	proto.RegisterType((*Doctor)(nil), "doctor.Doctor")
+func (m *Doctor) GetSpecialtyIds() []string {
+	if m != nil {
+		return m.SpecialtyIds
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("doctor/doctor.proto", fileDescriptor_c5107a06a9a1f6bc) }
-
-var fileDescriptor_c5107a06a9a1f6bc = []byte{
-	// 1156 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xa5, 0x58, 0x22, 0x87, 0xb2, 0xa4, 0x6e, 0x5c, 0x5b, 0x91, 0x1b, 0xd5, 0xe5, 0xa1,
-	0xf1, 0x25, 0x72, 0x61, 0xa3, 0x40, 0x13, 0xa4, 0x41, 0xdd, 0x2a, 0x31, 0x04, 0xa4, 0x46, 0x41,
-	0xa7, 0x45, 0xd1, 0x0b, 0x41, 0x93, 0x2b, 0x65, 0x11, 0x8a, 0x4b, 0x91, 0x2b, 0x3b, 0xbe, 0xf7,
-	0xd8, 0x63, 0x0f, 0x7d, 0x89, 0xbe, 0x41, 0x1f, 0xa0, 0xc7, 0xbe, 0x40, 0x81, 0xc0, 0x7d, 0x8b,
-	0x9e, 0x8a, 0xfd, 0x93, 0x48, 0x5a, 0x72, 0x12, 0xa0, 0x3d, 0xf4, 0x24, 0xee, 0x37, 0x33, 0xbb,
-	0xf3, 0xf3, 0xed, 0xec, 0x08, 0x6e, 0x87, 0x34, 0x60, 0x34, 0xdd, 0x97, 0x3f, 0xfd, 0x24, 0xa5,
-	0x8c, 0xa2, 0x9a, 0x5c, 0x75, 0x77, 0xc6, 0x94, 0x8e, 0x23, 0xbc, 0x2f, 0xd0, 0xb3, 0xd9, 0x68,
-	0x1f, 0x4f, 0x12, 0x76, 0x29, 0x95, 0x9c, 0xfb, 0x00, 0x03, 0xa1, 0xf6, 0xfc, 0x32, 0xc1, 0xe8,
-	0x43, 0xb0, 0xa5, 0x91, 0xc7, 0x2e, 0x13, 0xdc, 0x31, 0x76, 0x8d, 0x3d, 0xcb, 0x85, 0x70, 0xae,
-	0xe0, 0xfc, 0x00, 0xf6, 0x42, 0x3d, 0x43, 0x9f, 0x42, 0x23, 0xa7, 0x9f, 0x75, 0x8c, 0xdd, 0xea,
-	0x9e, 0x7d, 0x80, 0xfa, 0xca, 0x8f, 0x85, 0xaa, 0x6b, 0x87, 0x39, 0xb3, 0x4d, 0x58, 0x0f, 0xe8,
-	0x2c, 0x66, 0x9d, 0xca, 0xae, 0xb1, 0x57, 0x75, 0xe5, 0xc2, 0xf9, 0xc9, 0x80, 0x8d, 0x01, 0x0d,
-	0xbe, 0xf1, 0xc7, 0xf8, 0x29, 0x89, 0x18, 0x4e, 0xd1, 0x0e, 0x58, 0x41, 0x44, 0x70, 0xcc, 0x3c,
-	0x12, 0x0a, 0x67, 0xaa, 0xae, 0x29, 0x81, 0x61, 0xc8, 0x37, 0x89, 0xc8, 0x84, 0xcc, 0x37, 0x11,
-	0x0b, 0x84, 0xe0, 0x56, 0xe2, 0x8f, 0x71, 0xa7, 0x2a, 0x40, 0xf1, 0xcd, 0xb7, 0x19, 0xa5, 0x74,
-	0xe2, 0x85, 0x3e, 0xc3, 0x9d, 0x5b, 0x22, 0x26, 0x93, 0x03, 0x03, 0x9f, 0x61, 0xb4, 0x0d, 0x75,
-	0x46, 0xa5, 0x68, 0x5d, 0x88, 0x6a, 0x8c, 0x72, 0x81, 0xf3, 0xa3, 0x01, 0xed, 0x82, 0x3b, 0x2e,
-	0xce, 0xd0, 0x01, 0x34, 0x12, 0x9f, 0x49, 0x97, 0xe2, 0x11, 0x55, 0x01, 0xb7, 0x72, 0x01, 0x73,
-	0x7d, 0xd7, 0x56, 0x4a, 0xc3, 0x78, 0x44, 0xd1, 0x23, 0xd8, 0x50, 0x49, 0x4a, 0x71, 0x42, 0x53,
-	0xee, 0x30, 0x37, 0xda, 0x2e, 0x66, 0xc9, 0x15, 0x32, 0x17, 0x67, 0xae, 0x4a, 0xa9, 0x04, 0x9c,
-	0x9f, 0x0d, 0xa8, 0xab, 0x6d, 0xd1, 0x47, 0xd0, 0x98, 0xce, 0xf0, 0x0c, 0x7b, 0xf1, 0x6c, 0x72,
-	0x86, 0x53, 0x95, 0x12, 0x5b, 0x60, 0x27, 0x02, 0x12, 0xb1, 0xce, 0xa2, 0xc8, 0x8b, 0xfd, 0x09,
-	0x16, 0x99, 0xe1, 0xb1, 0xce, 0xa2, 0xe8, 0xc4, 0x9f, 0x08, 0xfb, 0xe4, 0x05, 0x8d, 0xe7, 0xf6,
-	0x55, 0x21, 0xb7, 0x05, 0xa6, 0xec, 0x3f, 0x86, 0x16, 0xcf, 0x85, 0x17, 0xf9, 0x19, 0xf3, 0xce,
-	0x49, 0x46, 0x98, 0xca, 0xd8, 0x06, 0x87, 0x9f, 0xf9, 0x19, 0xfb, 0x8e, 0x83, 0x8e, 0x0b, 0xf6,
-	0x33, 0x7a, 0x71, 0xca, 0x68, 0xf0, 0x92, 0xe7, 0xe5, 0x3e, 0x58, 0x11, 0xbd, 0xf0, 0x32, 0xbe,
-	0x56, 0x49, 0x69, 0xeb, 0xf8, 0x4e, 0xa7, 0x91, 0x1f, 0xf2, 0xc0, 0xcc, 0x48, 0x59, 0xac, 0x20,
-	0xc0, 0x1d, 0xa8, 0x0b, 0xdd, 0x61, 0x88, 0x9a, 0x50, 0x51, 0x25, 0xb7, 0xdc, 0x0a, 0x09, 0x9d,
-	0x07, 0x60, 0x0b, 0xd1, 0x31, 0x66, 0x2e, 0x9e, 0x72, 0xfb, 0x11, 0xc1, 0x91, 0xd6, 0x90, 0x0b,
-	0x8e, 0x9e, 0xfb, 0xd1, 0x4c, 0xc7, 0x2d, 0x17, 0xce, 0x6f, 0x06, 0x98, 0xca, 0x85, 0x69, 0x79,
-	0x5f, 0x4e, 0x97, 0x5c, 0xa6, 0xc4, 0xf7, 0xc2, 0xb9, 0x6a, 0xce, 0x39, 0x8e, 0x26, 0x29, 0x09,
-	0x24, 0x81, 0x0c, 0x57, 0x2e, 0x78, 0xba, 0x17, 0x71, 0xaf, 0x4b, 0x86, 0xce, 0xa3, 0xbc, 0x07,
-	0x2d, 0xfc, 0x2a, 0x21, 0xa9, 0xcf, 0x08, 0x8d, 0x25, 0xc5, 0x6a, 0xe2, 0x9c, 0xe6, 0x02, 0x16,
-	0x1c, 0xec, 0x82, 0x99, 0xa4, 0xf4, 0x9c, 0x84, 0x38, 0xed, 0xd4, 0x65, 0xcd, 0xf4, 0xda, 0xf9,
-	0x7b, 0xe1, 0x7e, 0xf6, 0xff, 0x73, 0x1f, 0xdd, 0x05, 0x08, 0x52, 0xec, 0x33, 0x1c, 0x7a, 0x3e,
-	0xeb, 0x98, 0x42, 0x6a, 0x29, 0xe4, 0x88, 0x71, 0xf1, 0x2c, 0x09, 0xb5, 0xd8, 0x92, 0x62, 0x85,
-	0x1c, 0x31, 0xe7, 0x1e, 0x98, 0xf2, 0x1a, 0x0c, 0x43, 0xee, 0xab, 0xbc, 0x3f, 0xde, 0x3c, 0x05,
-	0x66, 0xaa, 0x84, 0x0e, 0x81, 0xf7, 0xf2, 0xd7, 0x28, 0x73, 0x71, 0x96, 0xa0, 0xc7, 0xd0, 0x2c,
-	0x5c, 0x3c, 0xdd, 0x9f, 0x56, 0xde, 0xbc, 0x8d, 0xfc, 0xcd, 0x5b, 0xd5, 0xa6, 0xbe, 0x87, 0xcd,
-	0xc2, 0x51, 0x4f, 0x49, 0x1c, 0x2a, 0x4e, 0xca, 0x7e, 0x64, 0x2c, 0xeb, 0x47, 0x95, 0x5c, 0x3f,
-	0xda, 0x82, 0x5a, 0x86, 0xfd, 0x34, 0x78, 0xa1, 0x2e, 0xa0, 0x5a, 0x39, 0x9f, 0x43, 0xeb, 0x18,
-	0xb3, 0xfc, 0xe6, 0xef, 0x44, 0xf4, 0x08, 0x1a, 0x05, 0xdb, 0x32, 0x59, 0x0a, 0xdd, 0x54, 0xb5,
-	0x86, 0x79, 0x37, 0xdd, 0x01, 0x4b, 0xe5, 0x8a, 0x84, 0xca, 0x2d, 0x53, 0x02, 0x43, 0x41, 0x33,
-	0x86, 0x5f, 0xe9, 0x4e, 0x20, 0xbe, 0x9d, 0x5f, 0x0d, 0x68, 0x95, 0xf2, 0xf7, 0xdf, 0x9e, 0x58,
-	0xa2, 0xd2, 0xfa, 0xcd, 0x54, 0xaa, 0x2d, 0xa1, 0xd2, 0x40, 0xef, 0x5e, 0x38, 0xda, 0x28, 0x1e,
-	0xed, 0xb8, 0xd0, 0x94, 0x8a, 0xff, 0x62, 0x65, 0xbf, 0xd6, 0xcf, 0xa6, 0x24, 0xe6, 0x1e, 0xd4,
-	0xe5, 0x71, 0x9a, 0x91, 0xcd, 0x12, 0x23, 0xb5, 0x78, 0x05, 0x05, 0x1f, 0x42, 0x23, 0x47, 0x94,
-	0x77, 0x6b, 0x87, 0xaf, 0x2b, 0x50, 0x93, 0x96, 0xd7, 0xca, 0x75, 0x17, 0x60, 0x44, 0xd2, 0x8c,
-	0xe5, 0x1f, 0x0f, 0x4b, 0x20, 0xe2, 0xf5, 0xe0, 0xcd, 0xc2, 0xd7, 0x52, 0x55, 0x30, 0x0e, 0x08,
-	0xe1, 0x16, 0xd4, 0xc6, 0x38, 0xe6, 0x1d, 0x40, 0x96, 0x4c, 0xad, 0xb8, 0xd1, 0x05, 0x4d, 0x5f,
-	0x7a, 0x8c, 0x4c, 0xf4, 0x03, 0x6b, 0x72, 0xe0, 0x39, 0x91, 0xad, 0x4a, 0x36, 0xa5, 0x5a, 0xbe,
-	0x29, 0xf5, 0x00, 0x82, 0x04, 0x07, 0xc4, 0x8f, 0x30, 0xbb, 0x54, 0x0d, 0x25, 0x87, 0xf0, 0x21,
-	0x25, 0xa5, 0x74, 0xa2, 0x1f, 0x31, 0xd9, 0x53, 0x80, 0x43, 0xea, 0x0d, 0x2b, 0x3f, 0x73, 0xd6,
-	0xf5, 0x67, 0xae, 0xc8, 0x25, 0xb8, 0x99, 0x4b, 0x76, 0x89, 0x4b, 0x5c, 0x1c, 0xe2, 0x08, 0x2b,
-	0x71, 0x43, 0x8a, 0x15, 0x72, 0xc4, 0x0e, 0xfe, 0xac, 0x8b, 0x41, 0x86, 0xd1, 0xf4, 0x14, 0xa7,
-	0xe7, 0x3c, 0xa4, 0x4f, 0xf4, 0xd5, 0xfc, 0x4a, 0x1c, 0x81, 0x4a, 0xf5, 0xee, 0x96, 0xd6, 0xce,
-	0x1a, 0x3a, 0x04, 0x4b, 0x7e, 0x1f, 0x63, 0x86, 0x36, 0xb5, 0x38, 0x5f, 0xf5, 0x25, 0x46, 0x8f,
-	0xe6, 0x34, 0xe3, 0xd4, 0x45, 0x5b, 0x45, 0x05, 0xcd, 0xe7, 0xee, 0xed, 0x12, 0xce, 0x39, 0xe9,
-	0xac, 0x2d, 0x9c, 0xfc, 0x56, 0x04, 0xfa, 0x16, 0x4e, 0x3e, 0xd4, 0x16, 0x03, 0x11, 0x3b, 0x6a,
-	0x17, 0x35, 0x86, 0x61, 0x77, 0xab, 0x2f, 0x87, 0xcf, 0xbe, 0x1e, 0x3e, 0xfb, 0x4f, 0xf8, 0xf0,
-	0xe9, 0xac, 0xa1, 0xc7, 0x3a, 0x47, 0x7c, 0x24, 0xe4, 0x41, 0xae, 0x50, 0x2d, 0x7b, 0x2b, 0x26,
-	0x48, 0x67, 0x0d, 0x3d, 0x01, 0x94, 0x6f, 0x3f, 0x2a, 0xb1, 0x9b, 0xcb, 0x5a, 0x7b, 0x77, 0x55,
-	0xc3, 0x17, 0xdb, 0x14, 0xba, 0x18, 0x77, 0x64, 0x7b, 0x49, 0xb6, 0xdf, 0xb4, 0xcd, 0x49, 0xe9,
-	0xfd, 0x11, 0xf9, 0xff, 0x60, 0x99, 0xfe, 0xbc, 0x0a, 0x77, 0x96, 0x4a, 0x55, 0x2d, 0xbe, 0x28,
-	0x46, 0x57, 0xce, 0xaf, 0x7e, 0x14, 0x6f, 0xc8, 0xef, 0xa1, 0x9a, 0x98, 0x54, 0x62, 0xca, 0xd3,
-	0xd8, 0xb4, 0x7b, 0x6d, 0x3e, 0x13, 0x46, 0xa6, 0x1e, 0xb3, 0xd0, 0xed, 0x82, 0x5c, 0x0e, 0x5e,
-	0x2b, 0x8c, 0xe4, 0x49, 0x8a, 0x36, 0x6f, 0x77, 0xd2, 0x67, 0xca, 0x48, 0x45, 0xd6, 0x2a, 0xa8,
-	0xdc, 0x18, 0xd8, 0x03, 0x30, 0xf5, 0xe4, 0xf9, 0x66, 0xce, 0xe4, 0x66, 0x54, 0x51, 0xec, 0xb6,
-	0xcc, 0x6a, 0xee, 0x3f, 0xc6, 0xfb, 0xa5, 0xd9, 0x5d, 0xc2, 0xdd, 0xce, 0x52, 0x58, 0x6c, 0xf3,
-	0x65, 0xfb, 0xf7, 0xab, 0x9e, 0xf1, 0xc7, 0x55, 0xcf, 0x78, 0x7d, 0xd5, 0x33, 0x7e, 0xf9, 0xab,
-	0xb7, 0x76, 0x56, 0x13, 0xe7, 0x1f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x30, 0x58, 0x04,
-	0x88, 0x0d, 0x00, 0x00,
+func (m *Doctor) GetSpecialties() []*Specialty {
+	if m != nil {
+		return m.Specialties
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DoctorServiceClient is the client API for DoctorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DoctorServiceClient interface {
-	DoctorCreate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
-	DoctorGet(ctx context.Context, in *GetDoctorReq, opts ...grpc.CallOption) (*Doctor, error)
-	DoctorsFind(ctx context.Context, in *DoctorsFindReq, opts ...grpc.CallOption) (*DoctorsResp, error)
-	DoctorUpdate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
-	DoctorDelete(ctx context.Context, in *DoctorId, opts ...grpc.CallOption) (*empty.Empty, error)
-	DoctorTypeGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DoctorTypes, error)
-	// Doctor reports...
-	DoctorReportCreate(ctx context.Context, in *DoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error)
-	DoctorReportGet(ctx context.Context, in *GetDoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error)
-	DoctorReportsFind(ctx context.Context, in *DoctorReportsFindReq, opts ...grpc.CallOption) (*DoctorReportsResp, error)
-	DoctorReportDelete(ctx context.Context, in *ReportId, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Sqlad
-	SqladCreate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error)
-	SqladGet(ctx context.Context, in *SqladGetReq, opts ...grpc.CallOption) (*SqladRes, error)
-	SqladUpdate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error)
-	SqladDelete(ctx context.Context, in *SqladId, opts ...grpc.CallOption) (*empty.Empty, error)
-	LowStock(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LowStockRes, error)
-	// Doctor page
-	DoctorPageFilter(ctx context.Context, in *DocPageFilter, opts ...grpc.CallOption) (*DocPageFilterRes, error)
+type Specialty struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// default consultation price of the doctors of the specialty
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price"`
+	// length of an appointment with the doctors of the specialty
+	SlotMinutes          int64    `protobuf:"varint,4,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes"`
+	CreatedAt            string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DoctorsCount         int64    `protobuf:"varint,7,opt,name=doctors_count,json=doctorsCount,proto3" json:"doctors_count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type doctorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *Specialty) Reset()         { *m = Specialty{} }
+func (m *Specialty) String() string { return proto.CompactTextString(m) }
+func (*Specialty) ProtoMessage()    {}
+func (*Specialty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{23}
 }
-
-func NewDoctorServiceClient(cc *grpc.ClientConn) DoctorServiceClient {
-	return &doctorServiceClient{cc}
+func (m *Specialty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *doctorServiceClient) DoctorCreate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
-	out := new(Doctor)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Specialty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Specialty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Specialty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Specialty.Merge(m, src)
+}
+func (m *Specialty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Specialty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Specialty.DiscardUnknown(m)
 }
 
-func (c *doctorServiceClient) DoctorGet(ctx context.Context, in *GetDoctorReq, opts ...grpc.CallOption) (*Doctor, error) {
-	out := new(Doctor)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Specialty proto.InternalMessageInfo
+
+func (m *Specialty) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) DoctorsFind(ctx context.Context, in *DoctorsFindReq, opts ...grpc.CallOption) (*DoctorsResp, error) {
-	out := new(DoctorsResp)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorsFind", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Specialty) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) DoctorUpdate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
-	out := new(Doctor)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorUpdate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Specialty) GetPrice() float64 {
+	if m != nil {
+		return m.Price
 	}
-	return out, nil
+	return 0
 }
 
-func (c *doctorServiceClient) DoctorDelete(ctx context.Context, in *DoctorId, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Specialty) GetSlotMinutes() int64 {
+	if m != nil {
+		return m.SlotMinutes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *doctorServiceClient) DoctorTypeGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DoctorTypes, error) {
-	out := new(DoctorTypes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorTypeGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Specialty) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) DoctorReportCreate(ctx context.Context, in *DoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error) {
-	out := new(DoctorReportRes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Specialty) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) DoctorReportGet(ctx context.Context, in *GetDoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error) {
-	out := new(DoctorReportRes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Specialty) GetDoctorsCount() int64 {
+	if m != nil {
+		return m.DoctorsCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *doctorServiceClient) DoctorReportsFind(ctx context.Context, in *DoctorReportsFindReq, opts ...grpc.CallOption) (*DoctorReportsResp, error) {
-	out := new(DoctorReportsResp)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportsFind", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SpecialtyId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecialtyId) Reset()         { *m = SpecialtyId{} }
+func (m *SpecialtyId) String() string { return proto.CompactTextString(m) }
+func (*SpecialtyId) ProtoMessage()    {}
+func (*SpecialtyId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{24}
+}
+func (m *SpecialtyId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecialtyId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecialtyId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SpecialtyId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecialtyId.Merge(m, src)
+}
+func (m *SpecialtyId) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecialtyId) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecialtyId.DiscardUnknown(m)
 }
 
-func (c *doctorServiceClient) DoctorReportDelete(ctx context.Context, in *ReportId, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpecialtyId proto.InternalMessageInfo
+
+func (m *SpecialtyId) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) SqladCreate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error) {
-	out := new(SqladRes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SpecialtiesFindReq struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
+	Page                 int64    `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecialtiesFindReq) Reset()         { *m = SpecialtiesFindReq{} }
+func (m *SpecialtiesFindReq) String() string { return proto.CompactTextString(m) }
+func (*SpecialtiesFindReq) ProtoMessage()    {}
+func (*SpecialtiesFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{25}
+}
+func (m *SpecialtiesFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecialtiesFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecialtiesFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SpecialtiesFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecialtiesFindReq.Merge(m, src)
+}
+func (m *SpecialtiesFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecialtiesFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecialtiesFindReq.DiscardUnknown(m)
 }
 
-func (c *doctorServiceClient) SqladGet(ctx context.Context, in *SqladGetReq, opts ...grpc.CallOption) (*SqladRes, error) {
-	out := new(SqladRes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpecialtiesFindReq proto.InternalMessageInfo
+
+func (m *SpecialtiesFindReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *doctorServiceClient) SqladUpdate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error) {
-	out := new(SqladRes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladUpdate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SpecialtiesFindReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
 	}
-	return out, nil
+	return 0
 }
 
-func (c *doctorServiceClient) SqladDelete(ctx context.Context, in *SqladId, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SpecialtiesFindReq) GetSearch() string {
+	if m != nil {
+		return m.Search
 	}
-	return out, nil
+	return ""
 }
 
-func (c *doctorServiceClient) LowStock(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LowStockRes, error) {
-	out := new(LowStockRes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/LowStock", in, out, opts...)
-	if err != nil {
-		return nil, err
+type Specialties struct {
+	Specialties          []*Specialty `protobuf:"bytes,1,rep,name=specialties,proto3" json:"specialties"`
+	Count                int64        `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Specialties) Reset()         { *m = Specialties{} }
+func (m *Specialties) String() string { return proto.CompactTextString(m) }
+func (*Specialties) ProtoMessage()    {}
+func (*Specialties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{26}
+}
+func (m *Specialties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Specialties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Specialties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Specialties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Specialties.Merge(m, src)
+}
+func (m *Specialties) XXX_Size() int {
+	return m.Size()
+}
+func (m *Specialties) XXX_DiscardUnknown() {
+	xxx_messageInfo_Specialties.DiscardUnknown(m)
 }
 
-func (c *doctorServiceClient) DoctorPageFilter(ctx context.Context, in *DocPageFilter, opts ...grpc.CallOption) (*DocPageFilterRes, error) {
-	out := new(DocPageFilterRes)
-	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorPageFilter", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Specialties proto.InternalMessageInfo
+
+func (m *Specialties) GetSpecialties() []*Specialty {
+	if m != nil {
+		return m.Specialties
 	}
-	return out, nil
+	return nil
 }
 
-// DoctorServiceServer is the server API for DoctorService service.
-type DoctorServiceServer interface {
-	DoctorCreate(context.Context, *Doctor) (*Doctor, error)
-	DoctorGet(context.Context, *GetDoctorReq) (*Doctor, error)
-	DoctorsFind(context.Context, *DoctorsFindReq) (*DoctorsResp, error)
-	DoctorUpdate(context.Context, *Doctor) (*Doctor, error)
-	DoctorDelete(context.Context, *DoctorId) (*empty.Empty, error)
-	DoctorTypeGet(context.Context, *empty.Empty) (*DoctorTypes, error)
-	// Doctor reports...
-	DoctorReportCreate(context.Context, *DoctorReport) (*DoctorReportRes, error)
-	DoctorReportGet(context.Context, *GetDoctorReport) (*DoctorReportRes, error)
-	DoctorReportsFind(context.Context, *DoctorReportsFindReq) (*DoctorReportsResp, error)
-	DoctorReportDelete(context.Context, *ReportId) (*empty.Empty, error)
-	// Sqlad
-	SqladCreate(context.Context, *SqladReq) (*SqladRes, error)
-	SqladGet(context.Context, *SqladGetReq) (*SqladRes, error)
-	SqladUpdate(context.Context, *SqladReq) (*SqladRes, error)
-	SqladDelete(context.Context, *SqladId) (*empty.Empty, error)
-	LowStock(context.Context, *empty.Empty) (*LowStockRes, error)
-	// Doctor page
-	DoctorPageFilter(context.Context, *DocPageFilter) (*DocPageFilterRes, error)
+func (m *Specialties) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-// UnimplementedDoctorServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedDoctorServiceServer struct {
+type WorkInterval struct {
+	// 1 is monday ... 7 is sunday
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday"`
+	// clinic local time like 15:04
+	StartTime            string   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime              string   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedDoctorServiceServer) DoctorCreate(ctx context.Context, req *Doctor) (*Doctor, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorCreate not implemented")
+func (m *WorkInterval) Reset()         { *m = WorkInterval{} }
+func (m *WorkInterval) String() string { return proto.CompactTextString(m) }
+func (*WorkInterval) ProtoMessage()    {}
+func (*WorkInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{27}
 }
-func (*UnimplementedDoctorServiceServer) DoctorGet(ctx context.Context, req *GetDoctorReq) (*Doctor, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorGet not implemented")
+func (m *WorkInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDoctorServiceServer) DoctorsFind(ctx context.Context, req *DoctorsFindReq) (*DoctorsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorsFind not implemented")
+func (m *WorkInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkInterval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDoctorServiceServer) DoctorUpdate(ctx context.Context, req *Doctor) (*Doctor, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorUpdate not implemented")
+func (m *WorkInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkInterval.Merge(m, src)
 }
-func (*UnimplementedDoctorServiceServer) DoctorDelete(ctx context.Context, req *DoctorId) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorDelete not implemented")
+func (m *WorkInterval) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDoctorServiceServer) DoctorTypeGet(ctx context.Context, req *empty.Empty) (*DoctorTypes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorTypeGet not implemented")
+func (m *WorkInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkInterval.DiscardUnknown(m)
 }
-func (*UnimplementedDoctorServiceServer) DoctorReportCreate(ctx context.Context, req *DoctorReport) (*DoctorReportRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportCreate not implemented")
+
+var xxx_messageInfo_WorkInterval proto.InternalMessageInfo
+
+func (m *WorkInterval) GetWeekday() int32 {
+	if m != nil {
+		return m.Weekday
+	}
+	return 0
 }
-func (*UnimplementedDoctorServiceServer) DoctorReportGet(ctx context.Context, req *GetDoctorReport) (*DoctorReportRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportGet not implemented")
+
+func (m *WorkInterval) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
 }
-func (*UnimplementedDoctorServiceServer) DoctorReportsFind(ctx context.Context, req *DoctorReportsFindReq) (*DoctorReportsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportsFind not implemented")
+
+func (m *WorkInterval) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
 }
-func (*UnimplementedDoctorServiceServer) DoctorReportDelete(ctx context.Context, req *ReportId) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportDelete not implemented")
+
+type DoctorWorkHours struct {
+	DoctorId string          `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Hours    []*WorkInterval `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours"`
+	// breaks are cut out of the hours of the same weekday
+	Breaks               []*WorkInterval `protobuf:"bytes,3,rep,name=breaks,proto3" json:"breaks"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (*UnimplementedDoctorServiceServer) SqladCreate(ctx context.Context, req *SqladReq) (*SqladRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SqladCreate not implemented")
+
+func (m *DoctorWorkHours) Reset()         { *m = DoctorWorkHours{} }
+func (m *DoctorWorkHours) String() string { return proto.CompactTextString(m) }
+func (*DoctorWorkHours) ProtoMessage()    {}
+func (*DoctorWorkHours) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{28}
 }
-func (*UnimplementedDoctorServiceServer) SqladGet(ctx context.Context, req *SqladGetReq) (*SqladRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SqladGet not implemented")
+func (m *DoctorWorkHours) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDoctorServiceServer) SqladUpdate(ctx context.Context, req *SqladReq) (*SqladRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SqladUpdate not implemented")
+func (m *DoctorWorkHours) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorWorkHours.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDoctorServiceServer) SqladDelete(ctx context.Context, req *SqladId) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SqladDelete not implemented")
+func (m *DoctorWorkHours) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorWorkHours.Merge(m, src)
 }
-func (*UnimplementedDoctorServiceServer) LowStock(ctx context.Context, req *empty.Empty) (*LowStockRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LowStock not implemented")
+func (m *DoctorWorkHours) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDoctorServiceServer) DoctorPageFilter(ctx context.Context, req *DocPageFilter) (*DocPageFilterRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DoctorPageFilter not implemented")
+func (m *DoctorWorkHours) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorWorkHours.DiscardUnknown(m)
 }
 
-func RegisterDoctorServiceServer(s *grpc.Server, srv DoctorServiceServer) {
-	s.RegisterService(&_DoctorService_serviceDesc, srv)
-}
+var xxx_messageInfo_DoctorWorkHours proto.InternalMessageInfo
 
-func _DoctorService_DoctorCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Doctor)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorCreate(ctx, req.(*Doctor))
+func (m *DoctorWorkHours) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_DoctorGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDoctorReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorGet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorGet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorGet(ctx, req.(*GetDoctorReq))
+func (m *DoctorWorkHours) GetHours() []*WorkInterval {
+	if m != nil {
+		return m.Hours
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DoctorService_DoctorsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DoctorsFindReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorsFind(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorsFind",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorsFind(ctx, req.(*DoctorsFindReq))
+func (m *DoctorWorkHours) GetBreaks() []*WorkInterval {
+	if m != nil {
+		return m.Breaks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DoctorService_DoctorUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Doctor)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorUpdate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorUpdate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorUpdate(ctx, req.(*Doctor))
-	}
-	return interceptor(ctx, in, info, handler)
+type DoctorSlotsReq struct {
+	DoctorId string `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	// like 2006-01-02
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _DoctorService_DoctorDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DoctorId)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorDelete(ctx, in)
+func (m *DoctorSlotsReq) Reset()         { *m = DoctorSlotsReq{} }
+func (m *DoctorSlotsReq) String() string { return proto.CompactTextString(m) }
+func (*DoctorSlotsReq) ProtoMessage()    {}
+func (*DoctorSlotsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{29}
+}
+func (m *DoctorSlotsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorSlotsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorSlotsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorDelete",
+}
+func (m *DoctorSlotsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorSlotsReq.Merge(m, src)
+}
+func (m *DoctorSlotsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorSlotsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorSlotsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorSlotsReq proto.InternalMessageInfo
+
+func (m *DoctorSlotsReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorDelete(ctx, req.(*DoctorId))
+	return ""
+}
+
+func (m *DoctorSlotsReq) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_DoctorTypeGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+type TimeSlot struct {
+	StartTime            string   `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime              string   `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeSlot) Reset()         { *m = TimeSlot{} }
+func (m *TimeSlot) String() string { return proto.CompactTextString(m) }
+func (*TimeSlot) ProtoMessage()    {}
+func (*TimeSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{30}
+}
+func (m *TimeSlot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeSlot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorTypeGet(ctx, in)
+}
+func (m *TimeSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeSlot.Merge(m, src)
+}
+func (m *TimeSlot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeSlot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeSlot proto.InternalMessageInfo
+
+func (m *TimeSlot) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorTypeGet",
+	return ""
+}
+
+func (m *TimeSlot) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorTypeGet(ctx, req.(*empty.Empty))
+	return ""
+}
+
+type DoctorSlots struct {
+	DoctorId    string `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	Date        string `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	SlotMinutes int64  `protobuf:"varint,3,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes"`
+	// the slots of the working hours of the date, booked ones included
+	Slots                []*TimeSlot `protobuf:"bytes,4,rep,name=slots,proto3" json:"slots"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DoctorSlots) Reset()         { *m = DoctorSlots{} }
+func (m *DoctorSlots) String() string { return proto.CompactTextString(m) }
+func (*DoctorSlots) ProtoMessage()    {}
+func (*DoctorSlots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{31}
+}
+func (m *DoctorSlots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorSlots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorSlots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DoctorSlots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorSlots.Merge(m, src)
+}
+func (m *DoctorSlots) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorSlots) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorSlots.DiscardUnknown(m)
 }
 
-func _DoctorService_DoctorReportCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DoctorReport)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_DoctorSlots proto.InternalMessageInfo
+
+func (m *DoctorSlots) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorReportCreate(ctx, in)
+	return ""
+}
+
+func (m *DoctorSlots) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorReportCreate",
+	return ""
+}
+
+func (m *DoctorSlots) GetSlotMinutes() int64 {
+	if m != nil {
+		return m.SlotMinutes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorReportCreate(ctx, req.(*DoctorReport))
+	return 0
+}
+
+func (m *DoctorSlots) GetSlots() []*TimeSlot {
+	if m != nil {
+		return m.Slots
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _DoctorService_DoctorReportGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDoctorReport)
-	if err := dec(in); err != nil {
-		return nil, err
+// exception of the weekly working hours of a doctor on the dates from-to, both inclusive
+type DoctorException struct {
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DoctorId string `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	// vacation, sick, absent or hours
+	Kind     string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind"`
+	DateFrom string `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from"`
+	DateTo   string `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to"`
+	// hours work these times instead of the weekly hours, absent with times is
+	// absent only then, vacation and sick are whole days
+	StartTime string `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime   string `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	// doctor taking the patients of an absent one
+	SubstituteId         string   `protobuf:"bytes,8,opt,name=substitute_id,json=substituteId,proto3" json:"substitute_id"`
+	Note                 string   `protobuf:"bytes,9,opt,name=note,proto3" json:"note"`
+	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorException) Reset()         { *m = DoctorException{} }
+func (m *DoctorException) String() string { return proto.CompactTextString(m) }
+func (*DoctorException) ProtoMessage()    {}
+func (*DoctorException) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{32}
+}
+func (m *DoctorException) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorException) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorException.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorReportGet(ctx, in)
+}
+func (m *DoctorException) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorException.Merge(m, src)
+}
+func (m *DoctorException) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorException) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorException.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorException proto.InternalMessageInfo
+
+func (m *DoctorException) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorReportGet",
+	return ""
+}
+
+func (m *DoctorException) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorReportGet(ctx, req.(*GetDoctorReport))
+	return ""
+}
+
+func (m *DoctorException) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_DoctorReportsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DoctorReportsFindReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DoctorException) GetDateFrom() string {
+	if m != nil {
+		return m.DateFrom
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorReportsFind(ctx, in)
+	return ""
+}
+
+func (m *DoctorException) GetDateTo() string {
+	if m != nil {
+		return m.DateTo
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorReportsFind",
+	return ""
+}
+
+func (m *DoctorException) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorReportsFind(ctx, req.(*DoctorReportsFindReq))
+	return ""
+}
+
+func (m *DoctorException) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_DoctorReportDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportId)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DoctorException) GetSubstituteId() string {
+	if m != nil {
+		return m.SubstituteId
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorReportDelete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorReportDelete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorReportDelete(ctx, req.(*ReportId))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_SqladCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SqladReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).SqladCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/SqladCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).SqladCreate(ctx, req.(*SqladReq))
+func (m *DoctorException) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_SqladGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SqladGetReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).SqladGet(ctx, in)
+func (m *DoctorException) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/SqladGet",
+	return ""
+}
+
+type DoctorExceptionId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorExceptionId) Reset()         { *m = DoctorExceptionId{} }
+func (m *DoctorExceptionId) String() string { return proto.CompactTextString(m) }
+func (*DoctorExceptionId) ProtoMessage()    {}
+func (*DoctorExceptionId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{33}
+}
+func (m *DoctorExceptionId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorExceptionId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorExceptionId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).SqladGet(ctx, req.(*SqladGetReq))
+}
+func (m *DoctorExceptionId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorExceptionId.Merge(m, src)
+}
+func (m *DoctorExceptionId) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorExceptionId) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorExceptionId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorExceptionId proto.InternalMessageInfo
+
+func (m *DoctorExceptionId) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_SqladUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SqladReq)
-	if err := dec(in); err != nil {
-		return nil, err
+type DoctorExceptionsFindReq struct {
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	FromDate             string   `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate               string   `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorExceptionsFindReq) Reset()         { *m = DoctorExceptionsFindReq{} }
+func (m *DoctorExceptionsFindReq) String() string { return proto.CompactTextString(m) }
+func (*DoctorExceptionsFindReq) ProtoMessage()    {}
+func (*DoctorExceptionsFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{34}
+}
+func (m *DoctorExceptionsFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorExceptionsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorExceptionsFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).SqladUpdate(ctx, in)
+}
+func (m *DoctorExceptionsFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorExceptionsFindReq.Merge(m, src)
+}
+func (m *DoctorExceptionsFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorExceptionsFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorExceptionsFindReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorExceptionsFindReq proto.InternalMessageInfo
+
+func (m *DoctorExceptionsFindReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/SqladUpdate",
+	return ""
+}
+
+func (m *DoctorExceptionsFindReq) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).SqladUpdate(ctx, req.(*SqladReq))
+	return ""
+}
+
+func (m *DoctorExceptionsFindReq) GetToDate() string {
+	if m != nil {
+		return m.ToDate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_SqladDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SqladId)
-	if err := dec(in); err != nil {
-		return nil, err
+type DoctorExceptions struct {
+	Exceptions           []*DoctorException `protobuf:"bytes,1,rep,name=exceptions,proto3" json:"exceptions"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DoctorExceptions) Reset()         { *m = DoctorExceptions{} }
+func (m *DoctorExceptions) String() string { return proto.CompactTextString(m) }
+func (*DoctorExceptions) ProtoMessage()    {}
+func (*DoctorExceptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{35}
+}
+func (m *DoctorExceptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorExceptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorExceptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).SqladDelete(ctx, in)
+}
+func (m *DoctorExceptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorExceptions.Merge(m, src)
+}
+func (m *DoctorExceptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorExceptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorExceptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorExceptions proto.InternalMessageInfo
+
+func (m *DoctorExceptions) GetExceptions() []*DoctorException {
+	if m != nil {
+		return m.Exceptions
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/SqladDelete",
+	return nil
+}
+
+type DoctorAvailabilityReq struct {
+	DoctorId string `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	// clinic local time like 2006-01-02 15:04
+	At                   string   `protobuf:"bytes,2,opt,name=at,proto3" json:"at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorAvailabilityReq) Reset()         { *m = DoctorAvailabilityReq{} }
+func (m *DoctorAvailabilityReq) String() string { return proto.CompactTextString(m) }
+func (*DoctorAvailabilityReq) ProtoMessage()    {}
+func (*DoctorAvailabilityReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{36}
+}
+func (m *DoctorAvailabilityReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorAvailabilityReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorAvailabilityReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).SqladDelete(ctx, req.(*SqladId))
+}
+func (m *DoctorAvailabilityReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorAvailabilityReq.Merge(m, src)
+}
+func (m *DoctorAvailabilityReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorAvailabilityReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorAvailabilityReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorAvailabilityReq proto.InternalMessageInfo
+
+func (m *DoctorAvailabilityReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_LowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DoctorAvailabilityReq) GetAt() string {
+	if m != nil {
+		return m.At
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).LowStock(ctx, in)
+	return ""
+}
+
+type DoctorAvailability struct {
+	DoctorId  string `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	At        string `protobuf:"bytes,2,opt,name=at,proto3" json:"at"`
+	Available bool   `protobuf:"varint,3,opt,name=available,proto3" json:"available"`
+	// vacation, sick, absent or off_hours when not available, no_schedule when
+	// the doctor has no working hours set and only absences are known
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`
+	// start of the next working hours later that day, empty when there are none
+	NextStart            string   `protobuf:"bytes,5,opt,name=next_start,json=nextStart,proto3" json:"next_start"`
+	SubstituteId         string   `protobuf:"bytes,6,opt,name=substitute_id,json=substituteId,proto3" json:"substitute_id"`
+	Substitute           *Doctor  `protobuf:"bytes,7,opt,name=substitute,proto3" json:"substitute"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorAvailability) Reset()         { *m = DoctorAvailability{} }
+func (m *DoctorAvailability) String() string { return proto.CompactTextString(m) }
+func (*DoctorAvailability) ProtoMessage()    {}
+func (*DoctorAvailability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{37}
+}
+func (m *DoctorAvailability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorAvailability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorAvailability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/LowStock",
+}
+func (m *DoctorAvailability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorAvailability.Merge(m, src)
+}
+func (m *DoctorAvailability) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorAvailability) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorAvailability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoctorAvailability proto.InternalMessageInfo
+
+func (m *DoctorAvailability) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).LowStock(ctx, req.(*empty.Empty))
+	return ""
+}
+
+func (m *DoctorAvailability) GetAt() string {
+	if m != nil {
+		return m.At
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _DoctorService_DoctorPageFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DocPageFilter)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DoctorAvailability) GetAvailable() bool {
+	if m != nil {
+		return m.Available
 	}
-	if interceptor == nil {
-		return srv.(DoctorServiceServer).DoctorPageFilter(ctx, in)
+	return false
+}
+
+func (m *DoctorAvailability) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/doctor.DoctorService/DoctorPageFilter",
+	return ""
+}
+
+func (m *DoctorAvailability) GetNextStart() string {
+	if m != nil {
+		return m.NextStart
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DoctorPageFilter(ctx, req.(*DocPageFilter))
+	return ""
+}
+
+func (m *DoctorAvailability) GetSubstituteId() string {
+	if m != nil {
+		return m.SubstituteId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _DoctorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "doctor.DoctorService",
-	HandlerType: (*DoctorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DoctorCreate",
-			Handler:    _DoctorService_DoctorCreate_Handler,
-		},
-		{
-			MethodName: "DoctorGet",
-			Handler:    _DoctorService_DoctorGet_Handler,
-		},
-		{
-			MethodName: "DoctorsFind",
-			Handler:    _DoctorService_DoctorsFind_Handler,
-		},
-		{
-			MethodName: "DoctorUpdate",
-			Handler:    _DoctorService_DoctorUpdate_Handler,
-		},
-		{
-			MethodName: "DoctorDelete",
-			Handler:    _DoctorService_DoctorDelete_Handler,
-		},
-		{
-			MethodName: "DoctorTypeGet",
-			Handler:    _DoctorService_DoctorTypeGet_Handler,
-		},
-		{
-			MethodName: "DoctorReportCreate",
-			Handler:    _DoctorService_DoctorReportCreate_Handler,
-		},
-		{
-			MethodName: "DoctorReportGet",
-			Handler:    _DoctorService_DoctorReportGet_Handler,
-		},
-		{
-			MethodName: "DoctorReportsFind",
-			Handler:    _DoctorService_DoctorReportsFind_Handler,
-		},
-		{
-			MethodName: "DoctorReportDelete",
-			Handler:    _DoctorService_DoctorReportDelete_Handler,
-		},
-		{
-			MethodName: "SqladCreate",
-			Handler:    _DoctorService_SqladCreate_Handler,
-		},
-		{
-			MethodName: "SqladGet",
-			Handler:    _DoctorService_SqladGet_Handler,
-		},
-		{
-			MethodName: "SqladUpdate",
-			Handler:    _DoctorService_SqladUpdate_Handler,
-		},
-		{
-			MethodName: "SqladDelete",
-			Handler:    _DoctorService_SqladDelete_Handler,
-		},
-		{
-			MethodName: "LowStock",
-			Handler:    _DoctorService_LowStock_Handler,
-		},
-		{
-			MethodName: "DoctorPageFilter",
-			Handler:    _DoctorService_DoctorPageFilter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "doctor/doctor.proto",
-}
-
-func (m *DoctorType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DoctorAvailability) GetSubstitute() *Doctor {
+	if m != nil {
+		return m.Substitute
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DoctorType) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.DoctorType) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorType)))
-		i += copy(dAtA[i:], m.DoctorType)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type DoctorsWorkingReq struct {
+	SpecialtyId string `protobuf:"bytes,1,opt,name=specialty_id,json=specialtyId,proto3" json:"specialty_id"`
+	// like 2006-01-02
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DoctorTypes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DoctorsWorkingReq) Reset()         { *m = DoctorsWorkingReq{} }
+func (m *DoctorsWorkingReq) String() string { return proto.CompactTextString(m) }
+func (*DoctorsWorkingReq) ProtoMessage()    {}
+func (*DoctorsWorkingReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{38}
 }
-
-func (m *DoctorTypes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.DoctorTypes) > 0 {
-		for _, msg := range m.DoctorTypes {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintDoctor(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *DoctorsWorkingReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorsWorkingReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorsWorkingReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *DoctorsWorkingReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorsWorkingReq.Merge(m, src)
+}
+func (m *DoctorsWorkingReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorsWorkingReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorsWorkingReq.DiscardUnknown(m)
 }
 
-func (m *DocPageFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DoctorsWorkingReq proto.InternalMessageInfo
+
+func (m *DoctorsWorkingReq) GetSpecialtyId() string {
+	if m != nil {
+		return m.SpecialtyId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DocPageFilter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClientId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.ClientId))
-	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
-	}
-	if len(m.FromDate) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
-		i += copy(dAtA[i:], m.FromDate)
-	}
-	if len(m.ToDate) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
-		i += copy(dAtA[i:], m.ToDate)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DoctorsWorkingReq) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DocPageFilterRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type WorkingDoctor struct {
+	Doctor *Doctor     `protobuf:"bytes,1,opt,name=doctor,proto3" json:"doctor"`
+	Hours  []*TimeSlot `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours"`
+	// the absent doctor whose hours the substitute works
+	SubstituteFor        string   `protobuf:"bytes,3,opt,name=substitute_for,json=substituteFor,proto3" json:"substitute_for"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DocPageFilterRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PatientInfo) > 0 {
-		for _, msg := range m.PatientInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintDoctor(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.DoctorReport) > 0 {
-		for _, msg := range m.DoctorReport {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintDoctor(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *WorkingDoctor) Reset()         { *m = WorkingDoctor{} }
+func (m *WorkingDoctor) String() string { return proto.CompactTextString(m) }
+func (*WorkingDoctor) ProtoMessage()    {}
+func (*WorkingDoctor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{39}
+}
+func (m *WorkingDoctor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkingDoctor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkingDoctor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *WorkingDoctor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkingDoctor.Merge(m, src)
+}
+func (m *WorkingDoctor) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkingDoctor) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkingDoctor.DiscardUnknown(m)
 }
 
-func (m *DocPage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WorkingDoctor proto.InternalMessageInfo
+
+func (m *WorkingDoctor) GetDoctor() *Doctor {
+	if m != nil {
+		return m.Doctor
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DocPage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.QueueNumber != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.QueueNumber))
-	}
-	if len(m.FullName) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FullName)))
-		i += copy(dAtA[i:], m.FullName)
-	}
-	if len(m.PhoneNumber) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
-		i += copy(dAtA[i:], m.PhoneNumber)
-	}
-	if len(m.DateLastVisit) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DateLastVisit)))
-		i += copy(dAtA[i:], m.DateLastVisit)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *WorkingDoctor) GetHours() []*TimeSlot {
+	if m != nil {
+		return m.Hours
 	}
-	return i, nil
+	return nil
 }
 
-func (m *LowStockRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *WorkingDoctor) GetSubstituteFor() string {
+	if m != nil {
+		return m.SubstituteFor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LowStockRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.LowStock) > 0 {
-		for _, msg := range m.LowStock {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintDoctor(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type DoctorsWorkingResp struct {
+	Doctors              []*WorkingDoctor `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SqladId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DoctorsWorkingResp) Reset()         { *m = DoctorsWorkingResp{} }
+func (m *DoctorsWorkingResp) String() string { return proto.CompactTextString(m) }
+func (*DoctorsWorkingResp) ProtoMessage()    {}
+func (*DoctorsWorkingResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{40}
+}
+func (m *DoctorsWorkingResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorsWorkingResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorsWorkingResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DoctorsWorkingResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorsWorkingResp.Merge(m, src)
+}
+func (m *DoctorsWorkingResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorsWorkingResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorsWorkingResp.DiscardUnknown(m)
 }
 
-func (m *SqladId) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_DoctorsWorkingResp proto.InternalMessageInfo
+
+func (m *DoctorsWorkingResp) GetDoctors() []*WorkingDoctor {
+	if m != nil {
+		return m.Doctors
 	}
-	return i, nil
+	return nil
 }
 
-func (m *SqladGetReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// DoctorCommission is the part of a service the doctor earns. The rule of the service
+// wins over the one of its type, which wins over the one of any service.
+type DoctorCommission struct {
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DoctorId string `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	// doctor, lab or aparat, empty is any service
+	ServiceType string `protobuf:"bytes,3,opt,name=service_type,json=serviceType,proto3" json:"service_type"`
+	// empty is any service of the type
+	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id"`
+	// percent of the paid amount or fixed per service performed
+	Kind                 string   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind"`
+	Value                float64  `protobuf:"fixed64,6,opt,name=value,proto3" json:"value"`
+	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SqladGetReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Field) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DoctorCommission) Reset()         { *m = DoctorCommission{} }
+func (m *DoctorCommission) String() string { return proto.CompactTextString(m) }
+func (*DoctorCommission) ProtoMessage()    {}
+func (*DoctorCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{41}
+}
+func (m *DoctorCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *DoctorCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorCommission.Merge(m, src)
+}
+func (m *DoctorCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorCommission.DiscardUnknown(m)
 }
 
-func (m *SqladReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DoctorCommission proto.InternalMessageInfo
+
+func (m *DoctorCommission) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SqladReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-	}
-	if m.Price != 0 {
-		dAtA[i] = 0x21
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
-	}
-	if m.LowStock != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.LowStock))
-	}
-	if len(m.ExpirationDate) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
-		i += copy(dAtA[i:], m.ExpirationDate)
-	}
-	if len(m.Provider) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Provider)))
-		i += copy(dAtA[i:], m.Provider)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DoctorCommission) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *SqladRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DoctorCommission) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SqladRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-	}
-	if m.Price != 0 {
-		dAtA[i] = 0x21
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
-	}
-	if m.LowStock != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.LowStock))
-	}
-	if len(m.ExpirationDate) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
-		i += copy(dAtA[i:], m.ExpirationDate)
-	}
-	if len(m.Provider) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Provider)))
-		i += copy(dAtA[i:], m.Provider)
-	}
-	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
-	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DoctorCommission) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *ReportId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DoctorCommission) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ReportId) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReportId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ReportId)))
-		i += copy(dAtA[i:], m.ReportId)
+func (m *DoctorCommission) GetValue() float64 {
+	if m != nil {
+		return m.Value
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *DoctorCommission) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DoctorReportsResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DoctorCommission) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DoctorReportsResp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.DoctorReports) > 0 {
-		for _, msg := range m.DoctorReports {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintDoctor(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+type DoctorCommissionId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DoctorCommissionId) Reset()         { *m = DoctorCommissionId{} }
+func (m *DoctorCommissionId) String() string { return proto.CompactTextString(m) }
+func (*DoctorCommissionId) ProtoMessage()    {}
+func (*DoctorCommissionId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{42}
+}
+func (m *DoctorCommissionId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorCommissionId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorCommissionId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *DoctorCommissionId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorCommissionId.Merge(m, src)
+}
+func (m *DoctorCommissionId) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorCommissionId) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorCommissionId.DiscardUnknown(m)
 }
 
-func (m *DoctorReportsFindReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DoctorCommissionId proto.InternalMessageInfo
+
+func (m *DoctorCommissionId) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DoctorReportsFindReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
-	}
-	if len(m.Search) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Search)))
-		i += copy(dAtA[i:], m.Search)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type DoctorCommissionsFindReq struct {
+	// empty is every doctor
+	DoctorId             string   `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDoctorReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DoctorCommissionsFindReq) Reset()         { *m = DoctorCommissionsFindReq{} }
+func (m *DoctorCommissionsFindReq) String() string { return proto.CompactTextString(m) }
+func (*DoctorCommissionsFindReq) ProtoMessage()    {}
+func (*DoctorCommissionsFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{43}
+}
+func (m *DoctorCommissionsFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorCommissionsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorCommissionsFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DoctorCommissionsFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorCommissionsFindReq.Merge(m, src)
+}
+func (m *DoctorCommissionsFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorCommissionsFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorCommissionsFindReq.DiscardUnknown(m)
 }
 
-func (m *GetDoctorReport) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Field) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_DoctorCommissionsFindReq proto.InternalMessageInfo
+
+func (m *DoctorCommissionsFindReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DoctorReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type DoctorCommissions struct {
+	Commissions          []*DoctorCommission `protobuf:"bytes,1,rep,name=commissions,proto3" json:"commissions"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *DoctorReport) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.ClientId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ClientId)))
-		i += copy(dAtA[i:], m.ClientId)
-	}
-	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
-	}
-	if len(m.Text) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Text)))
-		i += copy(dAtA[i:], m.Text)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DoctorCommissions) Reset()         { *m = DoctorCommissions{} }
+func (m *DoctorCommissions) String() string { return proto.CompactTextString(m) }
+func (*DoctorCommissions) ProtoMessage()    {}
+func (*DoctorCommissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{44}
+}
+func (m *DoctorCommissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoctorCommissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoctorCommissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *DoctorCommissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoctorCommissions.Merge(m, src)
+}
+func (m *DoctorCommissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoctorCommissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoctorCommissions.DiscardUnknown(m)
 }
 
-func (m *DoctorReportRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DoctorCommissions proto.InternalMessageInfo
+
+func (m *DoctorCommissions) GetCommissions() []*DoctorCommission {
+	if m != nil {
+		return m.Commissions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DoctorReportRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.ClientId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ClientId)))
-		i += copy(dAtA[i:], m.ClientId)
-	}
-	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
+type PayrollReportReq struct {
+	// clinic days like 2006-01-02, both inclusive
+	FromDate string `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate   string `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	// empty is every doctor
+	DoctorId             string   `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayrollReportReq) Reset()         { *m = PayrollReportReq{} }
+func (m *PayrollReportReq) String() string { return proto.CompactTextString(m) }
+func (*PayrollReportReq) ProtoMessage()    {}
+func (*PayrollReportReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{45}
+}
+func (m *PayrollReportReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayrollReportReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayrollReportReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Text) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Text)))
-		i += copy(dAtA[i:], m.Text)
+}
+func (m *PayrollReportReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayrollReportReq.Merge(m, src)
+}
+func (m *PayrollReportReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayrollReportReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayrollReportReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayrollReportReq proto.InternalMessageInfo
+
+func (m *PayrollReportReq) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
 	}
-	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
+	return ""
+}
+
+func (m *PayrollReportReq) GetToDate() string {
+	if m != nil {
+		return m.ToDate
 	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
+	return ""
+}
+
+func (m *PayrollReportReq) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+// PayrollLine is what the doctor earned for one service over the period.
+type PayrollLine struct {
+	ServiceType string `protobuf:"bytes,1,opt,name=service_type,json=serviceType,proto3" json:"service_type"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Quantity    int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity"`
+	// paid by the clients
+	Revenue int64 `protobuf:"varint,5,opt,name=revenue,proto3" json:"revenue"`
+	// the rule applied, empty when the doctor has none for the service
+	CommissionId         string   `protobuf:"bytes,6,opt,name=commission_id,json=commissionId,proto3" json:"commission_id"`
+	Kind                 string   `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind"`
+	Value                float64  `protobuf:"fixed64,8,opt,name=value,proto3" json:"value"`
+	Earnings             int64    `protobuf:"varint,9,opt,name=earnings,proto3" json:"earnings"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayrollLine) Reset()         { *m = PayrollLine{} }
+func (m *PayrollLine) String() string { return proto.CompactTextString(m) }
+func (*PayrollLine) ProtoMessage()    {}
+func (*PayrollLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{46}
+}
+func (m *PayrollLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayrollLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayrollLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *PayrollLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayrollLine.Merge(m, src)
+}
+func (m *PayrollLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayrollLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayrollLine.DiscardUnknown(m)
 }
 
-func (m *DoctorId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PayrollLine proto.InternalMessageInfo
+
+func (m *PayrollLine) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DoctorId) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
+func (m *PayrollLine) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *PayrollLine) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DoctorsFindReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PayrollLine) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DoctorsFindReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+func (m *PayrollLine) GetRevenue() int64 {
+	if m != nil {
+		return m.Revenue
 	}
-	if m.Page != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+	return 0
+}
+
+func (m *PayrollLine) GetCommissionId() string {
+	if m != nil {
+		return m.CommissionId
 	}
-	if len(m.Search) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Search)))
-		i += copy(dAtA[i:], m.Search)
+	return ""
+}
+
+func (m *PayrollLine) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *PayrollLine) GetValue() float64 {
+	if m != nil {
+		return m.Value
 	}
-	return i, nil
+	return 0
 }
 
-func (m *DoctorsResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PayrollLine) GetEarnings() int64 {
+	if m != nil {
+		return m.Earnings
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DoctorsResp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Doctors) > 0 {
-		for _, msg := range m.Doctors {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintDoctor(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+type PayrollDoctor struct {
+	DoctorId             string         `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id"`
+	FirstName            string         `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName             string         `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	Revenue              int64          `protobuf:"varint,4,opt,name=revenue,proto3" json:"revenue"`
+	Earnings             int64          `protobuf:"varint,5,opt,name=earnings,proto3" json:"earnings"`
+	Lines                []*PayrollLine `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PayrollDoctor) Reset()         { *m = PayrollDoctor{} }
+func (m *PayrollDoctor) String() string { return proto.CompactTextString(m) }
+func (*PayrollDoctor) ProtoMessage()    {}
+func (*PayrollDoctor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{47}
+}
+func (m *PayrollDoctor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayrollDoctor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayrollDoctor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (m *PayrollDoctor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayrollDoctor.Merge(m, src)
+}
+func (m *PayrollDoctor) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayrollDoctor) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayrollDoctor.DiscardUnknown(m)
 }
 
-func (m *GetDoctorReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PayrollDoctor proto.InternalMessageInfo
+
+func (m *PayrollDoctor) GetDoctorId() string {
+	if m != nil {
+		return m.DoctorId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetDoctorReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Field) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
+func (m *PayrollDoctor) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
 	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+	return ""
+}
+
+func (m *PayrollDoctor) GetLastName() string {
+	if m != nil {
+		return m.LastName
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return ""
+}
+
+func (m *PayrollDoctor) GetRevenue() int64 {
+	if m != nil {
+		return m.Revenue
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Doctor) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PayrollDoctor) GetEarnings() int64 {
+	if m != nil {
+		return m.Earnings
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Doctor) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *PayrollDoctor) GetLines() []*PayrollLine {
+	if m != nil {
+		return m.Lines
 	}
-	if len(m.FirstName) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
-		i += copy(dAtA[i:], m.FirstName)
+	return nil
+}
+
+type PayrollReport struct {
+	FromDate             string           `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate               string           `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	Doctors              []*PayrollDoctor `protobuf:"bytes,3,rep,name=doctors,proto3" json:"doctors"`
+	Revenue              int64            `protobuf:"varint,4,opt,name=revenue,proto3" json:"revenue"`
+	Earnings             int64            `protobuf:"varint,5,opt,name=earnings,proto3" json:"earnings"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PayrollReport) Reset()         { *m = PayrollReport{} }
+func (m *PayrollReport) String() string { return proto.CompactTextString(m) }
+func (*PayrollReport) ProtoMessage()    {}
+func (*PayrollReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{48}
+}
+func (m *PayrollReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayrollReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayrollReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.LastName) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
-		i += copy(dAtA[i:], m.LastName)
+}
+func (m *PayrollReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayrollReport.Merge(m, src)
+}
+func (m *PayrollReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayrollReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayrollReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayrollReport proto.InternalMessageInfo
+
+func (m *PayrollReport) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
 	}
-	if len(m.Gender) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Gender)))
-		i += copy(dAtA[i:], m.Gender)
+	return ""
+}
+
+func (m *PayrollReport) GetToDate() string {
+	if m != nil {
+		return m.ToDate
 	}
-	if len(m.WorkTime) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.WorkTime)))
-		i += copy(dAtA[i:], m.WorkTime)
+	return ""
+}
+
+func (m *PayrollReport) GetDoctors() []*PayrollDoctor {
+	if m != nil {
+		return m.Doctors
 	}
-	if m.Price != 0 {
-		dAtA[i] = 0x31
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
+	return nil
+}
+
+func (m *PayrollReport) GetRevenue() int64 {
+	if m != nil {
+		return m.Revenue
 	}
-	if len(m.Cpecialety) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Cpecialety)))
-		i += copy(dAtA[i:], m.Cpecialety)
+	return 0
+}
+
+func (m *PayrollReport) GetEarnings() int64 {
+	if m != nil {
+		return m.Earnings
 	}
-	if len(m.RoomNumber) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.RoomNumber)))
-		i += copy(dAtA[i:], m.RoomNumber)
+	return 0
+}
+
+// SqladMovement is a change of the stock of a sqlad product, the stock is the sum of them.
+type SqladMovement struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	SqladId string `protobuf:"bytes,2,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	// receipt, issue, write_off or adjustment
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind"`
+	// the change of the stock, signed for adjustments. Issues and write-offs are taken
+	// off the stock whatever the sign given
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity"`
+	// purchase price of a unit, the price of the sqlad when not given
+	UnitCost float64 `protobuf:"fixed64,5,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost"`
+	// doctor, lab or aparat the stock is issued to
+	TargetType string `protobuf:"bytes,6,opt,name=target_type,json=targetType,proto3" json:"target_type"`
+	TargetId   string `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3" json:"target_id"`
+	// staff who moved the stock
+	ActorId   string `protobuf:"bytes,8,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	Note      string `protobuf:"bytes,9,opt,name=note,proto3" json:"note"`
+	CreatedAt string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	// stock of the sqlad after the movement
+	Balance int64 `protobuf:"varint,11,opt,name=balance,proto3" json:"balance"`
+	// lot to take from or to add to. When empty, stock is taken first-expiring-first-out
+	// and added as a new lot
+	LotId string `protobuf:"bytes,12,opt,name=lot_id,json=lotId,proto3" json:"lot_id"`
+	// batch number and expiry of the new lot
+	LotNumber      string `protobuf:"bytes,13,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number"`
+	ExpirationDate string `protobuf:"bytes,14,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	// what the movement took from or added to each lot
+	Lots                 []*SqladLotTake `protobuf:"bytes,15,rep,name=lots,proto3" json:"lots"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SqladMovement) Reset()         { *m = SqladMovement{} }
+func (m *SqladMovement) String() string { return proto.CompactTextString(m) }
+func (*SqladMovement) ProtoMessage()    {}
+func (*SqladMovement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{49}
+}
+func (m *SqladMovement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladMovement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladMovement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PhoneNumber) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
-		i += copy(dAtA[i:], m.PhoneNumber)
+}
+func (m *SqladMovement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladMovement.Merge(m, src)
+}
+func (m *SqladMovement) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladMovement) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladMovement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladMovement proto.InternalMessageInfo
+
+func (m *SqladMovement) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
+	return ""
+}
+
+func (m *SqladMovement) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
+	return ""
+}
+
+func (m *SqladMovement) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	if len(m.DeletedAt) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DeletedAt)))
-		i += copy(dAtA[i:], m.DeletedAt)
+	return ""
+}
+
+func (m *SqladMovement) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *SqladMovement) GetUnitCost() float64 {
+	if m != nil {
+		return m.UnitCost
 	}
-	return i, nil
+	return 0
 }
 
-func encodeVarintDoctor(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SqladMovement) GetTargetType() string {
+	if m != nil {
+		return m.TargetType
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return ""
 }
-func (m *DoctorType) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SqladMovement) GetTargetId() string {
+	if m != nil {
+		return m.TargetId
 	}
-	var l int
-	_ = l
-	l = len(m.DoctorType)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladMovement) GetActorId() string {
+	if m != nil {
+		return m.ActorId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SqladMovement) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	return n
+	return ""
 }
 
-func (m *DoctorTypes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladMovement) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	var l int
-	_ = l
-	if len(m.DoctorTypes) > 0 {
-		for _, e := range m.DoctorTypes {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
-		}
+	return ""
+}
+
+func (m *SqladMovement) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
 	}
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
+	return 0
+}
+
+func (m *SqladMovement) GetLotId() string {
+	if m != nil {
+		return m.LotId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SqladMovement) GetLotNumber() string {
+	if m != nil {
+		return m.LotNumber
 	}
-	return n
+	return ""
 }
 
-func (m *DocPageFilter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladMovement) GetExpirationDate() string {
+	if m != nil {
+		return m.ExpirationDate
 	}
-	var l int
-	_ = l
-	if m.ClientId != 0 {
-		n += 1 + sovDoctor(uint64(m.ClientId))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovDoctor(uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		n += 1 + sovDoctor(uint64(m.Page))
-	}
-	l = len(m.FromDate)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.ToDate)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return ""
 }
 
-func (m *DocPageFilterRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladMovement) GetLots() []*SqladLotTake {
+	if m != nil {
+		return m.Lots
 	}
-	var l int
-	_ = l
-	if len(m.PatientInfo) > 0 {
-		for _, e := range m.PatientInfo {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
+	return nil
+}
+
+type SqladLotTake struct {
+	LotId                string   `protobuf:"bytes,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id"`
+	LotNumber            string   `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number"`
+	ExpirationDate       string   `protobuf:"bytes,3,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	Quantity             int64    `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladLotTake) Reset()         { *m = SqladLotTake{} }
+func (m *SqladLotTake) String() string { return proto.CompactTextString(m) }
+func (*SqladLotTake) ProtoMessage()    {}
+func (*SqladLotTake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{50}
+}
+func (m *SqladLotTake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladLotTake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladLotTake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DoctorReport) > 0 {
-		for _, e := range m.DoctorReport {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
-		}
+}
+func (m *SqladLotTake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladLotTake.Merge(m, src)
+}
+func (m *SqladLotTake) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladLotTake) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladLotTake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladLotTake proto.InternalMessageInfo
+
+func (m *SqladLotTake) GetLotId() string {
+	if m != nil {
+		return m.LotId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SqladLotTake) GetLotNumber() string {
+	if m != nil {
+		return m.LotNumber
 	}
-	return n
+	return ""
 }
 
-func (m *DocPage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladLotTake) GetExpirationDate() string {
+	if m != nil {
+		return m.ExpirationDate
 	}
-	var l int
-	_ = l
-	if m.QueueNumber != 0 {
-		n += 1 + sovDoctor(uint64(m.QueueNumber))
+	return ""
+}
+
+func (m *SqladLotTake) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
 	}
-	l = len(m.FullName)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+// SqladLot is the stock of one receipt of the sqlad.
+type SqladLot struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	SqladId   string `protobuf:"bytes,2,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName string `protobuf:"bytes,3,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	Number    string `protobuf:"bytes,4,opt,name=number,proto3" json:"number"`
+	// like 2006-01-02, empty when it does not expire
+	ExpirationDate string `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	// days until the expiry, negative when expired
+	DaysLeft             int64    `protobuf:"varint,6,opt,name=days_left,json=daysLeft,proto3" json:"days_left"`
+	UnitCost             float64  `protobuf:"fixed64,7,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost"`
+	Received             int64    `protobuf:"varint,8,opt,name=received,proto3" json:"received"`
+	Remaining            int64    `protobuf:"varint,9,opt,name=remaining,proto3" json:"remaining"`
+	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladLot) Reset()         { *m = SqladLot{} }
+func (m *SqladLot) String() string { return proto.CompactTextString(m) }
+func (*SqladLot) ProtoMessage()    {}
+func (*SqladLot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{51}
+}
+func (m *SqladLot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladLot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladLot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladLot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladLot.Merge(m, src)
+}
+func (m *SqladLot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladLot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladLot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladLot proto.InternalMessageInfo
+
+func (m *SqladLot) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.DateLastVisit)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladLot) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SqladLot) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
 	}
-	return n
+	return ""
 }
 
-func (m *LowStockRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladLot) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	var l int
-	_ = l
-	if len(m.LowStock) > 0 {
-		for _, e := range m.LowStock {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
-		}
+	return ""
+}
+
+func (m *SqladLot) GetExpirationDate() string {
+	if m != nil {
+		return m.ExpirationDate
 	}
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
+	return ""
+}
+
+func (m *SqladLot) GetDaysLeft() int64 {
+	if m != nil {
+		return m.DaysLeft
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *SqladLot) GetUnitCost() float64 {
+	if m != nil {
+		return m.UnitCost
 	}
-	return n
+	return 0
 }
 
-func (m *SqladId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladLot) GetReceived() int64 {
+	if m != nil {
+		return m.Received
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+func (m *SqladLot) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *SqladLot) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return n
+	return ""
 }
 
-func (m *SqladGetReq) Size() (n int) {
-	if m == nil {
-		return 0
+type SqladLotsFindReq struct {
+	SqladId string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	// the lots used up too
+	WithEmpty            bool     `protobuf:"varint,2,opt,name=with_empty,json=withEmpty,proto3" json:"with_empty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladLotsFindReq) Reset()         { *m = SqladLotsFindReq{} }
+func (m *SqladLotsFindReq) String() string { return proto.CompactTextString(m) }
+func (*SqladLotsFindReq) ProtoMessage()    {}
+func (*SqladLotsFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{52}
+}
+func (m *SqladLotsFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladLotsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladLotsFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladLotsFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladLotsFindReq.Merge(m, src)
+}
+func (m *SqladLotsFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladLotsFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladLotsFindReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladLotsFindReq proto.InternalMessageInfo
+
+func (m *SqladLotsFindReq) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladLotsFindReq) GetWithEmpty() bool {
+	if m != nil {
+		return m.WithEmpty
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return false
+}
+
+type ExpiringLotsReq struct {
+	// lots in stock expiring within the days, the expired ones included
+	Days                 int64    `protobuf:"varint,1,opt,name=days,proto3" json:"days"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExpiringLotsReq) Reset()         { *m = ExpiringLotsReq{} }
+func (m *ExpiringLotsReq) String() string { return proto.CompactTextString(m) }
+func (*ExpiringLotsReq) ProtoMessage()    {}
+func (*ExpiringLotsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{53}
+}
+func (m *ExpiringLotsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringLotsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringLotsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ExpiringLotsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringLotsReq.Merge(m, src)
+}
+func (m *ExpiringLotsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringLotsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringLotsReq.DiscardUnknown(m)
 }
 
-func (m *SqladReq) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ExpiringLotsReq proto.InternalMessageInfo
+
+func (m *ExpiringLotsReq) GetDays() int64 {
+	if m != nil {
+		return m.Days
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
-	}
-	if m.Price != 0 {
-		n += 9
-	}
-	if m.LowStock != 0 {
-		n += 1 + sovDoctor(uint64(m.LowStock))
-	}
-	l = len(m.ExpirationDate)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+type SqladLots struct {
+	Lots                 []*SqladLot `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots"`
+	Count                int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SqladLots) Reset()         { *m = SqladLots{} }
+func (m *SqladLots) String() string { return proto.CompactTextString(m) }
+func (*SqladLots) ProtoMessage()    {}
+func (*SqladLots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{54}
+}
+func (m *SqladLots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladLots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladLots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladLots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladLots.Merge(m, src)
+}
+func (m *SqladLots) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladLots) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladLots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladLots proto.InternalMessageInfo
+
+func (m *SqladLots) GetLots() []*SqladLot {
+	if m != nil {
+		return m.Lots
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *SqladLots) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return n
+	return 0
 }
 
-func (m *SqladRes) Size() (n int) {
-	if m == nil {
-		return 0
+type SqladMovementsFindReq struct {
+	// empty is every sqlad
+	SqladId    string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	Kind       string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind"`
+	TargetType string `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type"`
+	TargetId   string `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id"`
+	// dates like 2006-01-02, both inclusive, empty is unbounded
+	FromDate             string   `protobuf:"bytes,5,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate               string   `protobuf:"bytes,6,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	Limit                int64    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit"`
+	Page                 int64    `protobuf:"varint,8,opt,name=page,proto3" json:"page"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladMovementsFindReq) Reset()         { *m = SqladMovementsFindReq{} }
+func (m *SqladMovementsFindReq) String() string { return proto.CompactTextString(m) }
+func (*SqladMovementsFindReq) ProtoMessage()    {}
+func (*SqladMovementsFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{55}
+}
+func (m *SqladMovementsFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladMovementsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladMovementsFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladMovementsFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladMovementsFindReq.Merge(m, src)
+}
+func (m *SqladMovementsFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladMovementsFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladMovementsFindReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladMovementsFindReq proto.InternalMessageInfo
+
+func (m *SqladMovementsFindReq) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladMovementsFindReq) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
+	return ""
+}
+
+func (m *SqladMovementsFindReq) GetTargetType() string {
+	if m != nil {
+		return m.TargetType
 	}
-	if m.Price != 0 {
-		n += 9
+	return ""
+}
+
+func (m *SqladMovementsFindReq) GetTargetId() string {
+	if m != nil {
+		return m.TargetId
 	}
-	if m.LowStock != 0 {
-		n += 1 + sovDoctor(uint64(m.LowStock))
+	return ""
+}
+
+func (m *SqladMovementsFindReq) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
 	}
-	l = len(m.ExpirationDate)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladMovementsFindReq) GetToDate() string {
+	if m != nil {
+		return m.ToDate
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladMovementsFindReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+func (m *SqladMovementsFindReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+type SqladMovements struct {
+	Movements            []*SqladMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements"`
+	Count                int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SqladMovements) Reset()         { *m = SqladMovements{} }
+func (m *SqladMovements) String() string { return proto.CompactTextString(m) }
+func (*SqladMovements) ProtoMessage()    {}
+func (*SqladMovements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{56}
+}
+func (m *SqladMovements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladMovements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladMovements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *SqladMovements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladMovements.Merge(m, src)
+}
+func (m *SqladMovements) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladMovements) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladMovements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladMovements proto.InternalMessageInfo
+
+func (m *SqladMovements) GetMovements() []*SqladMovement {
+	if m != nil {
+		return m.Movements
 	}
-	return n
+	return nil
 }
 
-func (m *ReportId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladMovements) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	var l int
-	_ = l
-	l = len(m.ReportId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+type SqladBalanceReq struct {
+	// empty is every sqlad
+	SqladId string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	// the stock at the end of the date like 2006-01-02
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladBalanceReq) Reset()         { *m = SqladBalanceReq{} }
+func (m *SqladBalanceReq) String() string { return proto.CompactTextString(m) }
+func (*SqladBalanceReq) ProtoMessage()    {}
+func (*SqladBalanceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{57}
+}
+func (m *SqladBalanceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladBalanceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladBalanceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *SqladBalanceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladBalanceReq.Merge(m, src)
+}
+func (m *SqladBalanceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladBalanceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladBalanceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladBalanceReq proto.InternalMessageInfo
+
+func (m *SqladBalanceReq) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	return n
+	return ""
 }
 
-func (m *DoctorReportsResp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladBalanceReq) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	var l int
-	_ = l
-	if len(m.DoctorReports) > 0 {
-		for _, e := range m.DoctorReports {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+type SqladBalance struct {
+	SqladId string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Count   int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	// the stock at the price of the sqlad
+	Value                float64  `protobuf:"fixed64,4,opt,name=value,proto3" json:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladBalance) Reset()         { *m = SqladBalance{} }
+func (m *SqladBalance) String() string { return proto.CompactTextString(m) }
+func (*SqladBalance) ProtoMessage()    {}
+func (*SqladBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{58}
+}
+func (m *SqladBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *SqladBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladBalance.Merge(m, src)
+}
+func (m *SqladBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladBalance proto.InternalMessageInfo
+
+func (m *SqladBalance) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	return n
+	return ""
 }
 
-func (m *DoctorReportsFindReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladBalance) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovDoctor(uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		n += 1 + sovDoctor(uint64(m.Page))
-	}
-	l = len(m.Search)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return ""
 }
 
-func (m *GetDoctorReport) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SqladBalance) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return n
+	return 0
 }
 
-func (m *DoctorReport) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.DoctorId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SqladBalance) GetValue() float64 {
+	if m != nil {
+		return m.Value
 	}
-	return n
+	return 0
 }
 
-func (m *DoctorReportRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.DoctorId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+type SqladBalances struct {
+	Date                 string          `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`
+	Balances             []*SqladBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SqladBalances) Reset()         { *m = SqladBalances{} }
+func (m *SqladBalances) String() string { return proto.CompactTextString(m) }
+func (*SqladBalances) ProtoMessage()    {}
+func (*SqladBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{59}
+}
+func (m *SqladBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *SqladBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladBalances.Merge(m, src)
+}
+func (m *SqladBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladBalances proto.InternalMessageInfo
+
+func (m *SqladBalances) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	return n
+	return ""
 }
 
-func (m *DoctorId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladBalances) GetBalances() []*SqladBalance {
+	if m != nil {
+		return m.Balances
 	}
-	var l int
-	_ = l
-	l = len(m.DoctorId)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return nil
+}
+
+// SqladBom is the sqlad one lab or aparat service consumes each time it is performed.
+type SqladBom struct {
+	// lab or aparat
+	ServiceType          string          `protobuf:"bytes,1,opt,name=service_type,json=serviceType,proto3" json:"service_type"`
+	ServiceId            string          `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id"`
+	Items                []*SqladBomItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SqladBom) Reset()         { *m = SqladBom{} }
+func (m *SqladBom) String() string { return proto.CompactTextString(m) }
+func (*SqladBom) ProtoMessage()    {}
+func (*SqladBom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{60}
+}
+func (m *SqladBom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladBom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladBom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *SqladBom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladBom.Merge(m, src)
+}
+func (m *SqladBom) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladBom) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladBom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladBom proto.InternalMessageInfo
+
+func (m *SqladBom) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
 	}
-	return n
+	return ""
 }
 
-func (m *DoctorsFindReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladBom) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovDoctor(uint64(m.Limit))
+	return ""
+}
+
+func (m *SqladBom) GetItems() []*SqladBomItem {
+	if m != nil {
+		return m.Items
 	}
-	if m.Page != 0 {
-		n += 1 + sovDoctor(uint64(m.Page))
+	return nil
+}
+
+type SqladBomItem struct {
+	SqladId   string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName string `protobuf:"bytes,2,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	// taken for each service performed
+	Quantity             int64    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladBomItem) Reset()         { *m = SqladBomItem{} }
+func (m *SqladBomItem) String() string { return proto.CompactTextString(m) }
+func (*SqladBomItem) ProtoMessage()    {}
+func (*SqladBomItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{61}
+}
+func (m *SqladBomItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladBomItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladBomItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Search)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladBomItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladBomItem.Merge(m, src)
+}
+func (m *SqladBomItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladBomItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladBomItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladBomItem proto.InternalMessageInfo
+
+func (m *SqladBomItem) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SqladBomItem) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
 	}
-	return n
+	return ""
 }
 
-func (m *DoctorsResp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladBomItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
 	}
-	var l int
-	_ = l
-	if len(m.Doctors) > 0 {
-		for _, e := range m.Doctors {
-			l = e.Size()
-			n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+type SqladBomReq struct {
+	ServiceType          string   `protobuf:"bytes,1,opt,name=service_type,json=serviceType,proto3" json:"service_type"`
+	ServiceId            string   `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladBomReq) Reset()         { *m = SqladBomReq{} }
+func (m *SqladBomReq) String() string { return proto.CompactTextString(m) }
+func (*SqladBomReq) ProtoMessage()    {}
+func (*SqladBomReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{62}
+}
+func (m *SqladBomReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladBomReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladBomReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		n += 1 + sovDoctor(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *SqladBomReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladBomReq.Merge(m, src)
+}
+func (m *SqladBomReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladBomReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladBomReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladBomReq proto.InternalMessageInfo
+
+func (m *SqladBomReq) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
 	}
-	return n
+	return ""
 }
 
-func (m *GetDoctorReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladBomReq) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+type SqladConsumeReq struct {
+	ServiceType string `protobuf:"bytes,1,opt,name=service_type,json=serviceType,proto3" json:"service_type"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id"`
+	// the analysis recording the service performed, consumed once
+	AnalysisId           string   `protobuf:"bytes,3,opt,name=analysis_id,json=analysisId,proto3" json:"analysis_id"`
+	ActorId              string   `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladConsumeReq) Reset()         { *m = SqladConsumeReq{} }
+func (m *SqladConsumeReq) String() string { return proto.CompactTextString(m) }
+func (*SqladConsumeReq) ProtoMessage()    {}
+func (*SqladConsumeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{63}
+}
+func (m *SqladConsumeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladConsumeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladConsumeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladConsumeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladConsumeReq.Merge(m, src)
+}
+func (m *SqladConsumeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladConsumeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladConsumeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladConsumeReq proto.InternalMessageInfo
+
+func (m *SqladConsumeReq) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SqladConsumeReq) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
 	}
-	return n
+	return ""
 }
 
-func (m *Doctor) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SqladConsumeReq) GetAnalysisId() string {
+	if m != nil {
+		return m.AnalysisId
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladConsumeReq) GetActorId() string {
+	if m != nil {
+		return m.ActorId
 	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+// SqladUsage is what one performed service consumed of a sqlad.
+type SqladUsage struct {
+	SqladId   string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName string `protobuf:"bytes,2,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	// by the bill of materials
+	Expected int64 `protobuf:"varint,3,opt,name=expected,proto3" json:"expected"`
+	// taken off the stock, less than expected when the stock ran out
+	Taken                int64    `protobuf:"varint,4,opt,name=taken,proto3" json:"taken"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SqladUsage) Reset()         { *m = SqladUsage{} }
+func (m *SqladUsage) String() string { return proto.CompactTextString(m) }
+func (*SqladUsage) ProtoMessage()    {}
+func (*SqladUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{64}
+}
+func (m *SqladUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladUsage.Merge(m, src)
+}
+func (m *SqladUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladUsage proto.InternalMessageInfo
+
+func (m *SqladUsage) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
 	}
-	l = len(m.Gender)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladUsage) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
 	}
-	l = len(m.WorkTime)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladUsage) GetExpected() int64 {
+	if m != nil {
+		return m.Expected
 	}
-	if m.Price != 0 {
-		n += 9
+	return 0
+}
+
+func (m *SqladUsage) GetTaken() int64 {
+	if m != nil {
+		return m.Taken
 	}
-	l = len(m.Cpecialety)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return 0
+}
+
+type SqladConsumeResp struct {
+	AnalysisId           string        `protobuf:"bytes,1,opt,name=analysis_id,json=analysisId,proto3" json:"analysis_id"`
+	Usages               []*SqladUsage `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SqladConsumeResp) Reset()         { *m = SqladConsumeResp{} }
+func (m *SqladConsumeResp) String() string { return proto.CompactTextString(m) }
+func (*SqladConsumeResp) ProtoMessage()    {}
+func (*SqladConsumeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{65}
+}
+func (m *SqladConsumeResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SqladConsumeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SqladConsumeResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RoomNumber)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *SqladConsumeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SqladConsumeResp.Merge(m, src)
+}
+func (m *SqladConsumeResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SqladConsumeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SqladConsumeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SqladConsumeResp proto.InternalMessageInfo
+
+func (m *SqladConsumeResp) GetAnalysisId() string {
+	if m != nil {
+		return m.AnalysisId
 	}
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *SqladConsumeResp) GetUsages() []*SqladUsage {
+	if m != nil {
+		return m.Usages
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return nil
+}
+
+type ConsumptionReportReq struct {
+	// dates like 2006-01-02, both inclusive
+	FromDate string `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate   string `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	// lab or aparat, empty is both
+	ServiceType          string   `protobuf:"bytes,3,opt,name=service_type,json=serviceType,proto3" json:"service_type"`
+	ServiceId            string   `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsumptionReportReq) Reset()         { *m = ConsumptionReportReq{} }
+func (m *ConsumptionReportReq) String() string { return proto.CompactTextString(m) }
+func (*ConsumptionReportReq) ProtoMessage()    {}
+func (*ConsumptionReportReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{66}
+}
+func (m *ConsumptionReportReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumptionReportReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumptionReportReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+}
+func (m *ConsumptionReportReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumptionReportReq.Merge(m, src)
+}
+func (m *ConsumptionReportReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumptionReportReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumptionReportReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumptionReportReq proto.InternalMessageInfo
+
+func (m *ConsumptionReportReq) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovDoctor(uint64(l))
+	return ""
+}
+
+func (m *ConsumptionReportReq) GetToDate() string {
+	if m != nil {
+		return m.ToDate
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *ConsumptionReportReq) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
 	}
-	return n
+	return ""
 }
 
-func sovDoctor(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ConsumptionReportReq) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
 }
-func sozDoctor(x uint64) (n int) {
-	return sovDoctor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// ConsumptionLine compares what the services performed should have consumed of a sqlad
+// with what was issued to them.
+type ConsumptionLine struct {
+	ServiceType string `protobuf:"bytes,1,opt,name=service_type,json=serviceType,proto3" json:"service_type"`
+	ServiceId   string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id"`
+	SqladId     string `protobuf:"bytes,3,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName   string `protobuf:"bytes,4,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	// services performed
+	Performed int64 `protobuf:"varint,5,opt,name=performed,proto3" json:"performed"`
+	Expected  int64 `protobuf:"varint,6,opt,name=expected,proto3" json:"expected"`
+	// issued to the service, by the services performed or by hand
+	Actual int64 `protobuf:"varint,7,opt,name=actual,proto3" json:"actual"`
+	// actual less expected
+	Difference           int64    `protobuf:"varint,8,opt,name=difference,proto3" json:"difference"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DoctorType) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
+
+func (m *ConsumptionLine) Reset()         { *m = ConsumptionLine{} }
+func (m *ConsumptionLine) String() string { return proto.CompactTextString(m) }
+func (*ConsumptionLine) ProtoMessage()    {}
+func (*ConsumptionLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{67}
+}
+func (m *ConsumptionLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumptionLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumptionLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumptionLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumptionLine.Merge(m, src)
+}
+func (m *ConsumptionLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumptionLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumptionLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumptionLine proto.InternalMessageInfo
+
+func (m *ConsumptionLine) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
+func (m *ConsumptionLine) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *ConsumptionLine) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
+	}
+	return ""
+}
+
+func (m *ConsumptionLine) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
+	}
+	return ""
+}
+
+func (m *ConsumptionLine) GetPerformed() int64 {
+	if m != nil {
+		return m.Performed
+	}
+	return 0
+}
+
+func (m *ConsumptionLine) GetExpected() int64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *ConsumptionLine) GetActual() int64 {
+	if m != nil {
+		return m.Actual
+	}
+	return 0
+}
+
+func (m *ConsumptionLine) GetDifference() int64 {
+	if m != nil {
+		return m.Difference
+	}
+	return 0
+}
+
+type ConsumptionReport struct {
+	FromDate             string             `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate               string             `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	Lines                []*ConsumptionLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ConsumptionReport) Reset()         { *m = ConsumptionReport{} }
+func (m *ConsumptionReport) String() string { return proto.CompactTextString(m) }
+func (*ConsumptionReport) ProtoMessage()    {}
+func (*ConsumptionReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{68}
+}
+func (m *ConsumptionReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumptionReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumptionReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumptionReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumptionReport.Merge(m, src)
+}
+func (m *ConsumptionReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumptionReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumptionReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumptionReport proto.InternalMessageInfo
+
+func (m *ConsumptionReport) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
+	}
+	return ""
+}
+
+func (m *ConsumptionReport) GetToDate() string {
+	if m != nil {
+		return m.ToDate
+	}
+	return ""
+}
+
+func (m *ConsumptionReport) GetLines() []*ConsumptionLine {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+type Supplier struct {
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	ContactPerson string `protobuf:"bytes,3,opt,name=contact_person,json=contactPerson,proto3" json:"contact_person"`
+	PhoneNumber   string `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	Email         string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+	Address       string `protobuf:"bytes,6,opt,name=address,proto3" json:"address"`
+	// terms agreed with the supplier, like "50% upfront"
+	PaymentTerms string `protobuf:"bytes,7,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms"`
+	// days the clinic has to pay a receipt in
+	PaymentDays int64  `protobuf:"varint,8,opt,name=payment_days,json=paymentDays,proto3" json:"payment_days"`
+	Note        string `protobuf:"bytes,9,opt,name=note,proto3" json:"note"`
+	CreatedAt   string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt   string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	// sqlad ordered from the supplier
+	SqladCount           int64    `protobuf:"varint,12,opt,name=sqlad_count,json=sqladCount,proto3" json:"sqlad_count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Supplier) Reset()         { *m = Supplier{} }
+func (m *Supplier) String() string { return proto.CompactTextString(m) }
+func (*Supplier) ProtoMessage()    {}
+func (*Supplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{69}
+}
+func (m *Supplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Supplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Supplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Supplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Supplier.Merge(m, src)
+}
+func (m *Supplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *Supplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Supplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Supplier proto.InternalMessageInfo
+
+func (m *Supplier) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Supplier) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Supplier) GetContactPerson() string {
+	if m != nil {
+		return m.ContactPerson
+	}
+	return ""
+}
+
+func (m *Supplier) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
+	}
+	return ""
+}
+
+func (m *Supplier) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Supplier) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Supplier) GetPaymentTerms() string {
+	if m != nil {
+		return m.PaymentTerms
+	}
+	return ""
+}
+
+func (m *Supplier) GetPaymentDays() int64 {
+	if m != nil {
+		return m.PaymentDays
+	}
+	return 0
+}
+
+func (m *Supplier) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *Supplier) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Supplier) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *Supplier) GetSqladCount() int64 {
+	if m != nil {
+		return m.SqladCount
+	}
+	return 0
+}
+
+type SupplierId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SupplierId) Reset()         { *m = SupplierId{} }
+func (m *SupplierId) String() string { return proto.CompactTextString(m) }
+func (*SupplierId) ProtoMessage()    {}
+func (*SupplierId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{70}
+}
+func (m *SupplierId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplierId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplierId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierId.Merge(m, src)
+}
+func (m *SupplierId) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierId) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierId proto.InternalMessageInfo
+
+func (m *SupplierId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type SuppliersFindReq struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
+	Page                 int64    `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuppliersFindReq) Reset()         { *m = SuppliersFindReq{} }
+func (m *SuppliersFindReq) String() string { return proto.CompactTextString(m) }
+func (*SuppliersFindReq) ProtoMessage()    {}
+func (*SuppliersFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{71}
+}
+func (m *SuppliersFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuppliersFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuppliersFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuppliersFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuppliersFindReq.Merge(m, src)
+}
+func (m *SuppliersFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuppliersFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuppliersFindReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuppliersFindReq proto.InternalMessageInfo
+
+func (m *SuppliersFindReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SuppliersFindReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *SuppliersFindReq) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+type Suppliers struct {
+	Suppliers            []*Supplier `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers"`
+	Count                int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Suppliers) Reset()         { *m = Suppliers{} }
+func (m *Suppliers) String() string { return proto.CompactTextString(m) }
+func (*Suppliers) ProtoMessage()    {}
+func (*Suppliers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{72}
+}
+func (m *Suppliers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Suppliers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Suppliers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Suppliers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Suppliers.Merge(m, src)
+}
+func (m *Suppliers) XXX_Size() int {
+	return m.Size()
+}
+func (m *Suppliers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Suppliers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Suppliers proto.InternalMessageInfo
+
+func (m *Suppliers) GetSuppliers() []*Supplier {
+	if m != nil {
+		return m.Suppliers
+	}
+	return nil
+}
+
+func (m *Suppliers) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// PurchaseOrder is the sqlad ordered from a supplier, it goes draft, ordered,
+// partially_received and received, or cancelled before anything is received.
+type PurchaseOrder struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// sequential number to print on the order
+	Number       int64  `protobuf:"varint,2,opt,name=number,proto3" json:"number"`
+	SupplierId   string `protobuf:"bytes,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id"`
+	SupplierName string `protobuf:"bytes,4,opt,name=supplier_name,json=supplierName,proto3" json:"supplier_name"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status"`
+	Note         string `protobuf:"bytes,6,opt,name=note,proto3" json:"note"`
+	// staff creating the order
+	ActorId string               `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	Items   []*PurchaseOrderItem `protobuf:"bytes,8,rep,name=items,proto3" json:"items"`
+	// cost of the quantities ordered
+	Total float64 `protobuf:"fixed64,9,opt,name=total,proto3" json:"total"`
+	// cost of the quantities received
+	ReceivedTotal        float64  `protobuf:"fixed64,10,opt,name=received_total,json=receivedTotal,proto3" json:"received_total"`
+	OrderedAt            string   `protobuf:"bytes,11,opt,name=ordered_at,json=orderedAt,proto3" json:"ordered_at"`
+	CreatedAt            string   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseOrder) Reset()         { *m = PurchaseOrder{} }
+func (m *PurchaseOrder) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrder) ProtoMessage()    {}
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{73}
+}
+func (m *PurchaseOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrder.Merge(m, src)
+}
+func (m *PurchaseOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrder proto.InternalMessageInfo
+
+func (m *PurchaseOrder) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *PurchaseOrder) GetSupplierId() string {
+	if m != nil {
+		return m.SupplierId
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetSupplierName() string {
+	if m != nil {
+		return m.SupplierName
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetItems() []*PurchaseOrderItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *PurchaseOrder) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *PurchaseOrder) GetReceivedTotal() float64 {
+	if m != nil {
+		return m.ReceivedTotal
+	}
+	return 0
+}
+
+func (m *PurchaseOrder) GetOrderedAt() string {
+	if m != nil {
+		return m.OrderedAt
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *PurchaseOrder) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type PurchaseOrderItem struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	SqladId              string   `protobuf:"bytes,2,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName            string   `protobuf:"bytes,3,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	Quantity             int64    `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity"`
+	UnitCost             float64  `protobuf:"fixed64,5,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost"`
+	Received             int64    `protobuf:"varint,6,opt,name=received,proto3" json:"received"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseOrderItem) Reset()         { *m = PurchaseOrderItem{} }
+func (m *PurchaseOrderItem) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrderItem) ProtoMessage()    {}
+func (*PurchaseOrderItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{74}
+}
+func (m *PurchaseOrderItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrderItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrderItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrderItem.Merge(m, src)
+}
+func (m *PurchaseOrderItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrderItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrderItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrderItem proto.InternalMessageInfo
+
+func (m *PurchaseOrderItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PurchaseOrderItem) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
+	}
+	return ""
+}
+
+func (m *PurchaseOrderItem) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
+	}
+	return ""
+}
+
+func (m *PurchaseOrderItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *PurchaseOrderItem) GetUnitCost() float64 {
+	if m != nil {
+		return m.UnitCost
+	}
+	return 0
+}
+
+func (m *PurchaseOrderItem) GetReceived() int64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+type PurchaseOrderId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseOrderId) Reset()         { *m = PurchaseOrderId{} }
+func (m *PurchaseOrderId) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrderId) ProtoMessage()    {}
+func (*PurchaseOrderId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{75}
+}
+func (m *PurchaseOrderId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrderId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrderId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrderId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrderId.Merge(m, src)
+}
+func (m *PurchaseOrderId) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrderId) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrderId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrderId proto.InternalMessageInfo
+
+func (m *PurchaseOrderId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type PurchaseOrdersFindReq struct {
+	SupplierId string `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id"`
+	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// dates like 2006-01-02 the orders were created in, both inclusive
+	FromDate             string   `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate               string   `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	Page                 int64    `protobuf:"varint,6,opt,name=page,proto3" json:"page"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseOrdersFindReq) Reset()         { *m = PurchaseOrdersFindReq{} }
+func (m *PurchaseOrdersFindReq) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrdersFindReq) ProtoMessage()    {}
+func (*PurchaseOrdersFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{76}
+}
+func (m *PurchaseOrdersFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrdersFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrdersFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrdersFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrdersFindReq.Merge(m, src)
+}
+func (m *PurchaseOrdersFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrdersFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrdersFindReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrdersFindReq proto.InternalMessageInfo
+
+func (m *PurchaseOrdersFindReq) GetSupplierId() string {
+	if m != nil {
+		return m.SupplierId
+	}
+	return ""
+}
+
+func (m *PurchaseOrdersFindReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PurchaseOrdersFindReq) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
+	}
+	return ""
+}
+
+func (m *PurchaseOrdersFindReq) GetToDate() string {
+	if m != nil {
+		return m.ToDate
+	}
+	return ""
+}
+
+func (m *PurchaseOrdersFindReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *PurchaseOrdersFindReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type PurchaseOrders struct {
+	Orders               []*PurchaseOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	Count                int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PurchaseOrders) Reset()         { *m = PurchaseOrders{} }
+func (m *PurchaseOrders) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrders) ProtoMessage()    {}
+func (*PurchaseOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{77}
+}
+func (m *PurchaseOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrders.Merge(m, src)
+}
+func (m *PurchaseOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrders proto.InternalMessageInfo
+
+func (m *PurchaseOrders) GetOrders() []*PurchaseOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *PurchaseOrders) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type LowStockOrderReq struct {
+	// orders only the sqlad of the supplier when given
+	SupplierId           string   `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id"`
+	ActorId              string   `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LowStockOrderReq) Reset()         { *m = LowStockOrderReq{} }
+func (m *LowStockOrderReq) String() string { return proto.CompactTextString(m) }
+func (*LowStockOrderReq) ProtoMessage()    {}
+func (*LowStockOrderReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{78}
+}
+func (m *LowStockOrderReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LowStockOrderReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LowStockOrderReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LowStockOrderReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LowStockOrderReq.Merge(m, src)
+}
+func (m *LowStockOrderReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LowStockOrderReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LowStockOrderReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LowStockOrderReq proto.InternalMessageInfo
+
+func (m *LowStockOrderReq) GetSupplierId() string {
+	if m != nil {
+		return m.SupplierId
+	}
+	return ""
+}
+
+func (m *LowStockOrderReq) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+type LowStockOrders struct {
+	// one draft order per supplier
+	Orders []*PurchaseOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders"`
+	// sqlad low on stock without a supplier to order from
+	WithoutSupplier      []*SqladRes `protobuf:"bytes,2,rep,name=without_supplier,json=withoutSupplier,proto3" json:"without_supplier"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LowStockOrders) Reset()         { *m = LowStockOrders{} }
+func (m *LowStockOrders) String() string { return proto.CompactTextString(m) }
+func (*LowStockOrders) ProtoMessage()    {}
+func (*LowStockOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{79}
+}
+func (m *LowStockOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LowStockOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LowStockOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LowStockOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LowStockOrders.Merge(m, src)
+}
+func (m *LowStockOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *LowStockOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_LowStockOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LowStockOrders proto.InternalMessageInfo
+
+func (m *LowStockOrders) GetOrders() []*PurchaseOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *LowStockOrders) GetWithoutSupplier() []*SqladRes {
+	if m != nil {
+		return m.WithoutSupplier
+	}
+	return nil
+}
+
+type PurchaseOrderStatusReq struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// ordered, cancelled or received to close an order received short
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseOrderStatusReq) Reset()         { *m = PurchaseOrderStatusReq{} }
+func (m *PurchaseOrderStatusReq) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrderStatusReq) ProtoMessage()    {}
+func (*PurchaseOrderStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{80}
+}
+func (m *PurchaseOrderStatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrderStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrderStatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrderStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrderStatusReq.Merge(m, src)
+}
+func (m *PurchaseOrderStatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrderStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrderStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrderStatusReq proto.InternalMessageInfo
+
+func (m *PurchaseOrderStatusReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PurchaseOrderStatusReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type PurchaseOrderReceipt struct {
+	OrderId              string                      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	ActorId              string                      `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	Items                []*PurchaseOrderReceiptItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *PurchaseOrderReceipt) Reset()         { *m = PurchaseOrderReceipt{} }
+func (m *PurchaseOrderReceipt) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrderReceipt) ProtoMessage()    {}
+func (*PurchaseOrderReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{81}
+}
+func (m *PurchaseOrderReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrderReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrderReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrderReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrderReceipt.Merge(m, src)
+}
+func (m *PurchaseOrderReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrderReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrderReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrderReceipt proto.InternalMessageInfo
+
+func (m *PurchaseOrderReceipt) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *PurchaseOrderReceipt) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *PurchaseOrderReceipt) GetItems() []*PurchaseOrderReceiptItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type PurchaseOrderReceiptItem struct {
+	ItemId   string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id"`
+	Quantity int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity"`
+	// lot of the stock received
+	LotNumber            string   `protobuf:"bytes,3,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number"`
+	ExpirationDate       string   `protobuf:"bytes,4,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchaseOrderReceiptItem) Reset()         { *m = PurchaseOrderReceiptItem{} }
+func (m *PurchaseOrderReceiptItem) String() string { return proto.CompactTextString(m) }
+func (*PurchaseOrderReceiptItem) ProtoMessage()    {}
+func (*PurchaseOrderReceiptItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{82}
+}
+func (m *PurchaseOrderReceiptItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurchaseOrderReceiptItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurchaseOrderReceiptItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurchaseOrderReceiptItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchaseOrderReceiptItem.Merge(m, src)
+}
+func (m *PurchaseOrderReceiptItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurchaseOrderReceiptItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchaseOrderReceiptItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchaseOrderReceiptItem proto.InternalMessageInfo
+
+func (m *PurchaseOrderReceiptItem) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *PurchaseOrderReceiptItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *PurchaseOrderReceiptItem) GetLotNumber() string {
+	if m != nil {
+		return m.LotNumber
+	}
+	return ""
+}
+
+func (m *PurchaseOrderReceiptItem) GetExpirationDate() string {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return ""
+}
+
+type SupplierSpendReq struct {
+	// dates like 2006-01-02 the stock was received in, both inclusive
+	FromDate             string   `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate               string   `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	SupplierId           string   `protobuf:"bytes,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SupplierSpendReq) Reset()         { *m = SupplierSpendReq{} }
+func (m *SupplierSpendReq) String() string { return proto.CompactTextString(m) }
+func (*SupplierSpendReq) ProtoMessage()    {}
+func (*SupplierSpendReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{83}
+}
+func (m *SupplierSpendReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierSpendReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplierSpendReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplierSpendReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierSpendReq.Merge(m, src)
+}
+func (m *SupplierSpendReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierSpendReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierSpendReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierSpendReq proto.InternalMessageInfo
+
+func (m *SupplierSpendReq) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
+	}
+	return ""
+}
+
+func (m *SupplierSpendReq) GetToDate() string {
+	if m != nil {
+		return m.ToDate
+	}
+	return ""
+}
+
+func (m *SupplierSpendReq) GetSupplierId() string {
+	if m != nil {
+		return m.SupplierId
+	}
+	return ""
+}
+
+type SupplierSpend struct {
+	SupplierId   string `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id"`
+	SupplierName string `protobuf:"bytes,2,opt,name=supplier_name,json=supplierName,proto3" json:"supplier_name"`
+	// orders the stock was received by
+	Orders               int64                `protobuf:"varint,3,opt,name=orders,proto3" json:"orders"`
+	Spent                float64              `protobuf:"fixed64,4,opt,name=spent,proto3" json:"spent"`
+	Items                []*SupplierSpendItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SupplierSpend) Reset()         { *m = SupplierSpend{} }
+func (m *SupplierSpend) String() string { return proto.CompactTextString(m) }
+func (*SupplierSpend) ProtoMessage()    {}
+func (*SupplierSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{84}
+}
+func (m *SupplierSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplierSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplierSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierSpend.Merge(m, src)
+}
+func (m *SupplierSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierSpend proto.InternalMessageInfo
+
+func (m *SupplierSpend) GetSupplierId() string {
+	if m != nil {
+		return m.SupplierId
+	}
+	return ""
+}
+
+func (m *SupplierSpend) GetSupplierName() string {
+	if m != nil {
+		return m.SupplierName
+	}
+	return ""
+}
+
+func (m *SupplierSpend) GetOrders() int64 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+func (m *SupplierSpend) GetSpent() float64 {
+	if m != nil {
+		return m.Spent
+	}
+	return 0
+}
+
+func (m *SupplierSpend) GetItems() []*SupplierSpendItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type SupplierSpendItem struct {
+	SqladId              string   `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName            string   `protobuf:"bytes,2,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	Quantity             int64    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	Spent                float64  `protobuf:"fixed64,4,opt,name=spent,proto3" json:"spent"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SupplierSpendItem) Reset()         { *m = SupplierSpendItem{} }
+func (m *SupplierSpendItem) String() string { return proto.CompactTextString(m) }
+func (*SupplierSpendItem) ProtoMessage()    {}
+func (*SupplierSpendItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{85}
+}
+func (m *SupplierSpendItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierSpendItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplierSpendItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplierSpendItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierSpendItem.Merge(m, src)
+}
+func (m *SupplierSpendItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierSpendItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierSpendItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierSpendItem proto.InternalMessageInfo
+
+func (m *SupplierSpendItem) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
+	}
+	return ""
+}
+
+func (m *SupplierSpendItem) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
+	}
+	return ""
+}
+
+func (m *SupplierSpendItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *SupplierSpendItem) GetSpent() float64 {
+	if m != nil {
+		return m.Spent
+	}
+	return 0
+}
+
+type SupplierSpendReport struct {
+	FromDate             string           `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date"`
+	ToDate               string           `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date"`
+	Suppliers            []*SupplierSpend `protobuf:"bytes,3,rep,name=suppliers,proto3" json:"suppliers"`
+	Total                float64          `protobuf:"fixed64,4,opt,name=total,proto3" json:"total"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SupplierSpendReport) Reset()         { *m = SupplierSpendReport{} }
+func (m *SupplierSpendReport) String() string { return proto.CompactTextString(m) }
+func (*SupplierSpendReport) ProtoMessage()    {}
+func (*SupplierSpendReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{86}
+}
+func (m *SupplierSpendReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierSpendReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplierSpendReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplierSpendReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierSpendReport.Merge(m, src)
+}
+func (m *SupplierSpendReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierSpendReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierSpendReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierSpendReport proto.InternalMessageInfo
+
+func (m *SupplierSpendReport) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
+	}
+	return ""
+}
+
+func (m *SupplierSpendReport) GetToDate() string {
+	if m != nil {
+		return m.ToDate
+	}
+	return ""
+}
+
+func (m *SupplierSpendReport) GetSuppliers() []*SupplierSpend {
+	if m != nil {
+		return m.Suppliers
+	}
+	return nil
+}
+
+func (m *SupplierSpendReport) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// Stocktake is a physical count of the storeroom, it goes open, then posted or cancelled.
+type Stocktake struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// sequential number to print on the report
+	Number int64  `protobuf:"varint,2,opt,name=number,proto3" json:"number"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	Note   string `protobuf:"bytes,4,opt,name=note,proto3" json:"note"`
+	// staff starting the stocktake
+	ActorId string `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	// staff posting the stocktake
+	PostedBy  string `protobuf:"bytes,6,opt,name=posted_by,json=postedBy,proto3" json:"posted_by"`
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	PostedAt  string `protobuf:"bytes,8,opt,name=posted_at,json=postedAt,proto3" json:"posted_at"`
+	// counted and not counted stock, against the balance now while open and at posting once posted
+	Lines []*StocktakeLine `protobuf:"bytes,9,rep,name=lines,proto3" json:"lines"`
+	// what each staff member counted
+	Counts []*StocktakeCount `protobuf:"bytes,10,rep,name=counts,proto3" json:"counts"`
+	// value of the differences at the unit costs
+	ValueDifference      float64  `protobuf:"fixed64,11,opt,name=value_difference,json=valueDifference,proto3" json:"value_difference"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Stocktake) Reset()         { *m = Stocktake{} }
+func (m *Stocktake) String() string { return proto.CompactTextString(m) }
+func (*Stocktake) ProtoMessage()    {}
+func (*Stocktake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{87}
+}
+func (m *Stocktake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stocktake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stocktake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stocktake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stocktake.Merge(m, src)
+}
+func (m *Stocktake) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stocktake) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stocktake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stocktake proto.InternalMessageInfo
+
+func (m *Stocktake) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Stocktake) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Stocktake) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Stocktake) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *Stocktake) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *Stocktake) GetPostedBy() string {
+	if m != nil {
+		return m.PostedBy
+	}
+	return ""
+}
+
+func (m *Stocktake) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Stocktake) GetPostedAt() string {
+	if m != nil {
+		return m.PostedAt
+	}
+	return ""
+}
+
+func (m *Stocktake) GetLines() []*StocktakeLine {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *Stocktake) GetCounts() []*StocktakeCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Stocktake) GetValueDifference() float64 {
+	if m != nil {
+		return m.ValueDifference
+	}
+	return 0
+}
+
+// StocktakeLine is the stock of a sqlad, or of one of its lots when it is counted by lots.
+type StocktakeLine struct {
+	SqladId        string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName      string `protobuf:"bytes,2,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	LotId          string `protobuf:"bytes,3,opt,name=lot_id,json=lotId,proto3" json:"lot_id"`
+	LotNumber      string `protobuf:"bytes,4,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number"`
+	ExpirationDate string `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date"`
+	// the balance of the system when the sqlad or lot was first counted, posting adds the difference
+	// to the balance then, the balance now when nobody counted it
+	Expected int64 `protobuf:"varint,6,opt,name=expected,proto3" json:"expected"`
+	// what was counted by everyone, the lots of a sqlad counted by lots but not counted are counted zero
+	Counted int64 `protobuf:"varint,7,opt,name=counted,proto3" json:"counted"`
+	// counted less expected
+	Difference int64 `protobuf:"varint,8,opt,name=difference,proto3" json:"difference"`
+	// false when nobody counted the sqlad, posting leaves it as is
+	IsCounted       bool    `protobuf:"varint,9,opt,name=is_counted,json=isCounted,proto3" json:"is_counted"`
+	UnitCost        float64 `protobuf:"fixed64,10,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost"`
+	ValueDifference float64 `protobuf:"fixed64,11,opt,name=value_difference,json=valueDifference,proto3" json:"value_difference"`
+	// adjustment movement of the difference once posted
+	MovementId           string   `protobuf:"bytes,12,opt,name=movement_id,json=movementId,proto3" json:"movement_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StocktakeLine) Reset()         { *m = StocktakeLine{} }
+func (m *StocktakeLine) String() string { return proto.CompactTextString(m) }
+func (*StocktakeLine) ProtoMessage()    {}
+func (*StocktakeLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{88}
+}
+func (m *StocktakeLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakeLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakeLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakeLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakeLine.Merge(m, src)
+}
+func (m *StocktakeLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakeLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakeLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakeLine proto.InternalMessageInfo
+
+func (m *StocktakeLine) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
+	}
+	return ""
+}
+
+func (m *StocktakeLine) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
+	}
+	return ""
+}
+
+func (m *StocktakeLine) GetLotId() string {
+	if m != nil {
+		return m.LotId
+	}
+	return ""
+}
+
+func (m *StocktakeLine) GetLotNumber() string {
+	if m != nil {
+		return m.LotNumber
+	}
+	return ""
+}
+
+func (m *StocktakeLine) GetExpirationDate() string {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return ""
+}
+
+func (m *StocktakeLine) GetExpected() int64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *StocktakeLine) GetCounted() int64 {
+	if m != nil {
+		return m.Counted
+	}
+	return 0
+}
+
+func (m *StocktakeLine) GetDifference() int64 {
+	if m != nil {
+		return m.Difference
+	}
+	return 0
+}
+
+func (m *StocktakeLine) GetIsCounted() bool {
+	if m != nil {
+		return m.IsCounted
+	}
+	return false
+}
+
+func (m *StocktakeLine) GetUnitCost() float64 {
+	if m != nil {
+		return m.UnitCost
+	}
+	return 0
+}
+
+func (m *StocktakeLine) GetValueDifference() float64 {
+	if m != nil {
+		return m.ValueDifference
+	}
+	return 0
+}
+
+func (m *StocktakeLine) GetMovementId() string {
+	if m != nil {
+		return m.MovementId
+	}
+	return ""
+}
+
+type StocktakeCount struct {
+	SqladId   string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	SqladName string `protobuf:"bytes,2,opt,name=sqlad_name,json=sqladName,proto3" json:"sqlad_name"`
+	// empty when the sqlad is counted as a whole
+	LotId    string `protobuf:"bytes,3,opt,name=lot_id,json=lotId,proto3" json:"lot_id"`
+	Quantity int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity"`
+	// staff who counted
+	CounterId            string   `protobuf:"bytes,5,opt,name=counter_id,json=counterId,proto3" json:"counter_id"`
+	UpdatedAt            string   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StocktakeCount) Reset()         { *m = StocktakeCount{} }
+func (m *StocktakeCount) String() string { return proto.CompactTextString(m) }
+func (*StocktakeCount) ProtoMessage()    {}
+func (*StocktakeCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{89}
+}
+func (m *StocktakeCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakeCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakeCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakeCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakeCount.Merge(m, src)
+}
+func (m *StocktakeCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakeCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakeCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakeCount proto.InternalMessageInfo
+
+func (m *StocktakeCount) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
+	}
+	return ""
+}
+
+func (m *StocktakeCount) GetSqladName() string {
+	if m != nil {
+		return m.SqladName
+	}
+	return ""
+}
+
+func (m *StocktakeCount) GetLotId() string {
+	if m != nil {
+		return m.LotId
+	}
+	return ""
+}
+
+func (m *StocktakeCount) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *StocktakeCount) GetCounterId() string {
+	if m != nil {
+		return m.CounterId
+	}
+	return ""
+}
+
+func (m *StocktakeCount) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type StocktakeId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StocktakeId) Reset()         { *m = StocktakeId{} }
+func (m *StocktakeId) String() string { return proto.CompactTextString(m) }
+func (*StocktakeId) ProtoMessage()    {}
+func (*StocktakeId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{90}
+}
+func (m *StocktakeId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakeId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakeId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakeId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakeId.Merge(m, src)
+}
+func (m *StocktakeId) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakeId) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakeId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakeId proto.InternalMessageInfo
+
+func (m *StocktakeId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type StocktakeGetReq struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// only the counted lines with a difference
+	OnlyDiscrepancies    bool     `protobuf:"varint,2,opt,name=only_discrepancies,json=onlyDiscrepancies,proto3" json:"only_discrepancies"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StocktakeGetReq) Reset()         { *m = StocktakeGetReq{} }
+func (m *StocktakeGetReq) String() string { return proto.CompactTextString(m) }
+func (*StocktakeGetReq) ProtoMessage()    {}
+func (*StocktakeGetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{91}
+}
+func (m *StocktakeGetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakeGetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakeGetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakeGetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakeGetReq.Merge(m, src)
+}
+func (m *StocktakeGetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakeGetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakeGetReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakeGetReq proto.InternalMessageInfo
+
+func (m *StocktakeGetReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StocktakeGetReq) GetOnlyDiscrepancies() bool {
+	if m != nil {
+		return m.OnlyDiscrepancies
+	}
+	return false
+}
+
+type StocktakesFindReq struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	Page                 int64    `protobuf:"varint,3,opt,name=page,proto3" json:"page"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StocktakesFindReq) Reset()         { *m = StocktakesFindReq{} }
+func (m *StocktakesFindReq) String() string { return proto.CompactTextString(m) }
+func (*StocktakesFindReq) ProtoMessage()    {}
+func (*StocktakesFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{92}
+}
+func (m *StocktakesFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakesFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakesFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakesFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakesFindReq.Merge(m, src)
+}
+func (m *StocktakesFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakesFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakesFindReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakesFindReq proto.InternalMessageInfo
+
+func (m *StocktakesFindReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StocktakesFindReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *StocktakesFindReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type Stocktakes struct {
+	Stocktakes           []*Stocktake `protobuf:"bytes,1,rep,name=stocktakes,proto3" json:"stocktakes"`
+	Count                int64        `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Stocktakes) Reset()         { *m = Stocktakes{} }
+func (m *Stocktakes) String() string { return proto.CompactTextString(m) }
+func (*Stocktakes) ProtoMessage()    {}
+func (*Stocktakes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{93}
+}
+func (m *Stocktakes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stocktakes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stocktakes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stocktakes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stocktakes.Merge(m, src)
+}
+func (m *Stocktakes) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stocktakes) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stocktakes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stocktakes proto.InternalMessageInfo
+
+func (m *Stocktakes) GetStocktakes() []*Stocktake {
+	if m != nil {
+		return m.Stocktakes
+	}
+	return nil
+}
+
+func (m *Stocktakes) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// StocktakeCountReq enters what the staff member counted, a count of the same sqlad or lot
+// by the same staff member replaces the one before.
+type StocktakeCountReq struct {
+	StocktakeId          string                `protobuf:"bytes,1,opt,name=stocktake_id,json=stocktakeId,proto3" json:"stocktake_id"`
+	CounterId            string                `protobuf:"bytes,2,opt,name=counter_id,json=counterId,proto3" json:"counter_id"`
+	Items                []*StocktakeCountItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StocktakeCountReq) Reset()         { *m = StocktakeCountReq{} }
+func (m *StocktakeCountReq) String() string { return proto.CompactTextString(m) }
+func (*StocktakeCountReq) ProtoMessage()    {}
+func (*StocktakeCountReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{94}
+}
+func (m *StocktakeCountReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakeCountReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakeCountReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakeCountReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakeCountReq.Merge(m, src)
+}
+func (m *StocktakeCountReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakeCountReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakeCountReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakeCountReq proto.InternalMessageInfo
+
+func (m *StocktakeCountReq) GetStocktakeId() string {
+	if m != nil {
+		return m.StocktakeId
+	}
+	return ""
+}
+
+func (m *StocktakeCountReq) GetCounterId() string {
+	if m != nil {
+		return m.CounterId
+	}
+	return ""
+}
+
+func (m *StocktakeCountReq) GetItems() []*StocktakeCountItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type StocktakeCountItem struct {
+	SqladId string `protobuf:"bytes,1,opt,name=sqlad_id,json=sqladId,proto3" json:"sqlad_id"`
+	// counted by lots when given
+	LotId                string   `protobuf:"bytes,2,opt,name=lot_id,json=lotId,proto3" json:"lot_id"`
+	Quantity             int64    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StocktakeCountItem) Reset()         { *m = StocktakeCountItem{} }
+func (m *StocktakeCountItem) String() string { return proto.CompactTextString(m) }
+func (*StocktakeCountItem) ProtoMessage()    {}
+func (*StocktakeCountItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{95}
+}
+func (m *StocktakeCountItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakeCountItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakeCountItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakeCountItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakeCountItem.Merge(m, src)
+}
+func (m *StocktakeCountItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakeCountItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakeCountItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakeCountItem proto.InternalMessageInfo
+
+func (m *StocktakeCountItem) GetSqladId() string {
+	if m != nil {
+		return m.SqladId
+	}
+	return ""
+}
+
+func (m *StocktakeCountItem) GetLotId() string {
+	if m != nil {
+		return m.LotId
+	}
+	return ""
+}
+
+func (m *StocktakeCountItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+type StocktakePostReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ActorId              string   `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StocktakePostReq) Reset()         { *m = StocktakePostReq{} }
+func (m *StocktakePostReq) String() string { return proto.CompactTextString(m) }
+func (*StocktakePostReq) ProtoMessage()    {}
+func (*StocktakePostReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5107a06a9a1f6bc, []int{96}
+}
+func (m *StocktakePostReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StocktakePostReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StocktakePostReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StocktakePostReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StocktakePostReq.Merge(m, src)
+}
+func (m *StocktakePostReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StocktakePostReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StocktakePostReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StocktakePostReq proto.InternalMessageInfo
+
+func (m *StocktakePostReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StocktakePostReq) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DoctorType)(nil), "doctor.DoctorType")
+	proto.RegisterType((*DoctorTypes)(nil), "doctor.DoctorTypes")
+	proto.RegisterType((*DocPageFilter)(nil), "doctor.DocPageFilter")
+	proto.RegisterType((*DocPageFilterRes)(nil), "doctor.DocPageFilterRes")
+	proto.RegisterType((*DocPage)(nil), "doctor.DocPage")
+	proto.RegisterType((*LowStockRes)(nil), "doctor.LowStockRes")
+	proto.RegisterType((*SqladId)(nil), "doctor.SqladId")
+	proto.RegisterType((*SqladGetReq)(nil), "doctor.SqladGetReq")
+	proto.RegisterType((*SqladReq)(nil), "doctor.SqladReq")
+	proto.RegisterType((*SqladRes)(nil), "doctor.SqladRes")
+	proto.RegisterType((*ReportId)(nil), "doctor.ReportId")
+	proto.RegisterType((*DoctorReportsResp)(nil), "doctor.DoctorReportsResp")
+	proto.RegisterType((*DoctorReportsFindReq)(nil), "doctor.DoctorReportsFindReq")
+	proto.RegisterType((*GetDoctorReport)(nil), "doctor.GetDoctorReport")
+	proto.RegisterType((*DoctorReport)(nil), "doctor.DoctorReport")
+	proto.RegisterType((*DoctorReportRes)(nil), "doctor.DoctorReportRes")
+	proto.RegisterType((*DoctorId)(nil), "doctor.DoctorId")
+	proto.RegisterType((*DoctorsFindReq)(nil), "doctor.DoctorsFindReq")
+	proto.RegisterType((*DoctorsResp)(nil), "doctor.DoctorsResp")
+	proto.RegisterType((*DoctorIds)(nil), "doctor.DoctorIds")
+	proto.RegisterType((*DoctorsByIdsResp)(nil), "doctor.DoctorsByIdsResp")
+	proto.RegisterType((*GetDoctorReq)(nil), "doctor.GetDoctorReq")
+	proto.RegisterType((*Doctor)(nil), "doctor.Doctor")
+	proto.RegisterType((*Specialty)(nil), "doctor.Specialty")
+	proto.RegisterType((*SpecialtyId)(nil), "doctor.SpecialtyId")
+	proto.RegisterType((*SpecialtiesFindReq)(nil), "doctor.SpecialtiesFindReq")
+	proto.RegisterType((*Specialties)(nil), "doctor.Specialties")
+	proto.RegisterType((*WorkInterval)(nil), "doctor.WorkInterval")
+	proto.RegisterType((*DoctorWorkHours)(nil), "doctor.DoctorWorkHours")
+	proto.RegisterType((*DoctorSlotsReq)(nil), "doctor.DoctorSlotsReq")
+	proto.RegisterType((*TimeSlot)(nil), "doctor.TimeSlot")
+	proto.RegisterType((*DoctorSlots)(nil), "doctor.DoctorSlots")
+	proto.RegisterType((*DoctorException)(nil), "doctor.DoctorException")
+	proto.RegisterType((*DoctorExceptionId)(nil), "doctor.DoctorExceptionId")
+	proto.RegisterType((*DoctorExceptionsFindReq)(nil), "doctor.DoctorExceptionsFindReq")
+	proto.RegisterType((*DoctorExceptions)(nil), "doctor.DoctorExceptions")
+	proto.RegisterType((*DoctorAvailabilityReq)(nil), "doctor.DoctorAvailabilityReq")
+	proto.RegisterType((*DoctorAvailability)(nil), "doctor.DoctorAvailability")
+	proto.RegisterType((*DoctorsWorkingReq)(nil), "doctor.DoctorsWorkingReq")
+	proto.RegisterType((*WorkingDoctor)(nil), "doctor.WorkingDoctor")
+	proto.RegisterType((*DoctorsWorkingResp)(nil), "doctor.DoctorsWorkingResp")
+	proto.RegisterType((*DoctorCommission)(nil), "doctor.DoctorCommission")
+	proto.RegisterType((*DoctorCommissionId)(nil), "doctor.DoctorCommissionId")
+	proto.RegisterType((*DoctorCommissionsFindReq)(nil), "doctor.DoctorCommissionsFindReq")
+	proto.RegisterType((*DoctorCommissions)(nil), "doctor.DoctorCommissions")
+	proto.RegisterType((*PayrollReportReq)(nil), "doctor.PayrollReportReq")
+	proto.RegisterType((*PayrollLine)(nil), "doctor.PayrollLine")
+	proto.RegisterType((*PayrollDoctor)(nil), "doctor.PayrollDoctor")
+	proto.RegisterType((*PayrollReport)(nil), "doctor.PayrollReport")
+	proto.RegisterType((*SqladMovement)(nil), "doctor.SqladMovement")
+	proto.RegisterType((*SqladLotTake)(nil), "doctor.SqladLotTake")
+	proto.RegisterType((*SqladLot)(nil), "doctor.SqladLot")
+	proto.RegisterType((*SqladLotsFindReq)(nil), "doctor.SqladLotsFindReq")
+	proto.RegisterType((*ExpiringLotsReq)(nil), "doctor.ExpiringLotsReq")
+	proto.RegisterType((*SqladLots)(nil), "doctor.SqladLots")
+	proto.RegisterType((*SqladMovementsFindReq)(nil), "doctor.SqladMovementsFindReq")
+	proto.RegisterType((*SqladMovements)(nil), "doctor.SqladMovements")
+	proto.RegisterType((*SqladBalanceReq)(nil), "doctor.SqladBalanceReq")
+	proto.RegisterType((*SqladBalance)(nil), "doctor.SqladBalance")
+	proto.RegisterType((*SqladBalances)(nil), "doctor.SqladBalances")
+	proto.RegisterType((*SqladBom)(nil), "doctor.SqladBom")
+	proto.RegisterType((*SqladBomItem)(nil), "doctor.SqladBomItem")
+	proto.RegisterType((*SqladBomReq)(nil), "doctor.SqladBomReq")
+	proto.RegisterType((*SqladConsumeReq)(nil), "doctor.SqladConsumeReq")
+	proto.RegisterType((*SqladUsage)(nil), "doctor.SqladUsage")
+	proto.RegisterType((*SqladConsumeResp)(nil), "doctor.SqladConsumeResp")
+	proto.RegisterType((*ConsumptionReportReq)(nil), "doctor.ConsumptionReportReq")
+	proto.RegisterType((*ConsumptionLine)(nil), "doctor.ConsumptionLine")
+	proto.RegisterType((*ConsumptionReport)(nil), "doctor.ConsumptionReport")
+	proto.RegisterType((*Supplier)(nil), "doctor.Supplier")
+	proto.RegisterType((*SupplierId)(nil), "doctor.SupplierId")
+	proto.RegisterType((*SuppliersFindReq)(nil), "doctor.SuppliersFindReq")
+	proto.RegisterType((*Suppliers)(nil), "doctor.Suppliers")
+	proto.RegisterType((*PurchaseOrder)(nil), "doctor.PurchaseOrder")
+	proto.RegisterType((*PurchaseOrderItem)(nil), "doctor.PurchaseOrderItem")
+	proto.RegisterType((*PurchaseOrderId)(nil), "doctor.PurchaseOrderId")
+	proto.RegisterType((*PurchaseOrdersFindReq)(nil), "doctor.PurchaseOrdersFindReq")
+	proto.RegisterType((*PurchaseOrders)(nil), "doctor.PurchaseOrders")
+	proto.RegisterType((*LowStockOrderReq)(nil), "doctor.LowStockOrderReq")
+	proto.RegisterType((*LowStockOrders)(nil), "doctor.LowStockOrders")
+	proto.RegisterType((*PurchaseOrderStatusReq)(nil), "doctor.PurchaseOrderStatusReq")
+	proto.RegisterType((*PurchaseOrderReceipt)(nil), "doctor.PurchaseOrderReceipt")
+	proto.RegisterType((*PurchaseOrderReceiptItem)(nil), "doctor.PurchaseOrderReceiptItem")
+	proto.RegisterType((*SupplierSpendReq)(nil), "doctor.SupplierSpendReq")
+	proto.RegisterType((*SupplierSpend)(nil), "doctor.SupplierSpend")
+	proto.RegisterType((*SupplierSpendItem)(nil), "doctor.SupplierSpendItem")
+	proto.RegisterType((*SupplierSpendReport)(nil), "doctor.SupplierSpendReport")
+	proto.RegisterType((*Stocktake)(nil), "doctor.Stocktake")
+	proto.RegisterType((*StocktakeLine)(nil), "doctor.StocktakeLine")
+	proto.RegisterType((*StocktakeCount)(nil), "doctor.StocktakeCount")
+	proto.RegisterType((*StocktakeId)(nil), "doctor.StocktakeId")
+	proto.RegisterType((*StocktakeGetReq)(nil), "doctor.StocktakeGetReq")
+	proto.RegisterType((*StocktakesFindReq)(nil), "doctor.StocktakesFindReq")
+	proto.RegisterType((*Stocktakes)(nil), "doctor.Stocktakes")
+	proto.RegisterType((*StocktakeCountReq)(nil), "doctor.StocktakeCountReq")
+	proto.RegisterType((*StocktakeCountItem)(nil), "doctor.StocktakeCountItem")
+	proto.RegisterType((*StocktakePostReq)(nil), "doctor.StocktakePostReq")
+}
+
+func init() { proto.RegisterFile("doctor/doctor.proto", fileDescriptor_c5107a06a9a1f6bc) }
+
+var fileDescriptor_c5107a06a9a1f6bc = []byte{
+	// 4349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x8f, 0x1c, 0x49,
+	0x56, 0x9d, 0x55, 0x5d, 0xd5, 0x55, 0xaf, 0xaa, 0xbf, 0xa2, 0xdd, 0x3d, 0xe5, 0xf4, 0xc7, 0x78,
+	0x63, 0x77, 0x66, 0xbd, 0x03, 0xb6, 0x87, 0xb1, 0x76, 0x77, 0x66, 0x76, 0x76, 0x18, 0xdb, 0xed,
+	0xf1, 0x16, 0xd3, 0x9e, 0xf1, 0x64, 0xdb, 0xc3, 0x0a, 0x84, 0x4a, 0xd9, 0x95, 0xd1, 0xed, 0x94,
+	0xb3, 0x32, 0xab, 0x33, 0xa3, 0xda, 0x2e, 0x09, 0x89, 0xcb, 0x6a, 0x81, 0x0b, 0x7b, 0x03, 0xb4,
+	0xfc, 0x01, 0x90, 0x40, 0x42, 0x08, 0x71, 0x80, 0x3f, 0x00, 0xe2, 0xb2, 0x12, 0x47, 0x2e, 0x68,
+	0x96, 0x0b, 0xe2, 0x0f, 0x70, 0x03, 0xc5, 0x57, 0x66, 0x44, 0x64, 0x66, 0x75, 0xb7, 0xed, 0x3d,
+	0x70, 0xea, 0x8e, 0xf7, 0x5e, 0x44, 0xbe, 0x78, 0x5f, 0xf1, 0xe2, 0xc5, 0x2b, 0xd8, 0x0a, 0x92,
+	0x31, 0x4d, 0xd2, 0x5b, 0xe2, 0xcf, 0xcd, 0x69, 0x9a, 0xd0, 0x04, 0xb5, 0xc5, 0xc8, 0xbd, 0x74,
+	0x94, 0x24, 0x47, 0x11, 0xb9, 0xc5, 0xa1, 0x07, 0xb3, 0xc3, 0x5b, 0x64, 0x32, 0xa5, 0x73, 0x41,
+	0x84, 0x6f, 0x00, 0xec, 0x72, 0xb2, 0xc7, 0xf3, 0x29, 0x41, 0x6f, 0x42, 0x4f, 0x4c, 0x1a, 0xd1,
+	0xf9, 0x94, 0x0c, 0x9c, 0x6b, 0xce, 0xf5, 0xae, 0x07, 0x41, 0x4e, 0x80, 0x7f, 0x07, 0x7a, 0x05,
+	0x79, 0x86, 0xbe, 0x0b, 0x7d, 0x8d, 0x3e, 0x1b, 0x38, 0xd7, 0x9a, 0xd7, 0x7b, 0xef, 0xa1, 0x9b,
+	0x92, 0x8f, 0x82, 0xd4, 0xeb, 0x05, 0xda, 0xb4, 0x0b, 0xd0, 0x1a, 0x27, 0xb3, 0x98, 0x0e, 0x1a,
+	0xd7, 0x9c, 0xeb, 0x4d, 0x4f, 0x0c, 0xf0, 0x5f, 0x39, 0xb0, 0xba, 0x9b, 0x8c, 0x1f, 0xf9, 0x47,
+	0xe4, 0xd3, 0x30, 0xa2, 0x24, 0x45, 0x97, 0xa0, 0x3b, 0x8e, 0x42, 0x12, 0xd3, 0x51, 0x18, 0x70,
+	0x66, 0x9a, 0x5e, 0x47, 0x00, 0x86, 0x01, 0x5b, 0x24, 0x0a, 0x27, 0x61, 0xbe, 0x08, 0x1f, 0x20,
+	0x04, 0xcb, 0x53, 0xff, 0x88, 0x0c, 0x9a, 0x1c, 0xc8, 0xff, 0x67, 0xcb, 0x1c, 0xa6, 0xc9, 0x64,
+	0x14, 0xf8, 0x94, 0x0c, 0x96, 0xf9, 0x9e, 0x3a, 0x0c, 0xb0, 0xeb, 0x53, 0x82, 0xde, 0x80, 0x15,
+	0x9a, 0x08, 0x54, 0x8b, 0xa3, 0xda, 0x34, 0xe1, 0x88, 0x4b, 0xd0, 0x95, 0x7b, 0x0b, 0x83, 0x41,
+	0x5b, 0xcc, 0x12, 0x80, 0x61, 0x80, 0x7f, 0xee, 0xc0, 0x86, 0xc1, 0xab, 0x47, 0x32, 0xf4, 0x1e,
+	0xf4, 0xa7, 0x3e, 0x15, 0xfc, 0xc6, 0x87, 0x89, 0x94, 0xc6, 0xba, 0x26, 0x0d, 0x46, 0xef, 0xf5,
+	0x24, 0xd1, 0x30, 0x3e, 0x4c, 0xd0, 0x47, 0xb0, 0x2a, 0xbf, 0x92, 0x92, 0x69, 0x92, 0xb2, 0xdd,
+	0xb0, 0x49, 0x6f, 0x98, 0x22, 0xf4, 0x38, 0xce, 0x23, 0x99, 0xd7, 0x0f, 0x34, 0x40, 0x21, 0xc8,
+	0xa6, 0x2e, 0xc8, 0x5f, 0x38, 0xb0, 0x22, 0x3f, 0x86, 0xbe, 0x01, 0xfd, 0xe3, 0x19, 0x99, 0x91,
+	0x51, 0x3c, 0x9b, 0x1c, 0x90, 0x54, 0x4a, 0xb1, 0xc7, 0x61, 0x9f, 0x73, 0x10, 0x17, 0xcf, 0x2c,
+	0x8a, 0x46, 0xb1, 0x3f, 0x21, 0x83, 0x86, 0x14, 0xcf, 0x2c, 0x8a, 0x3e, 0xf7, 0x27, 0x7c, 0xfe,
+	0xf4, 0x69, 0x12, 0xe7, 0xf3, 0x9b, 0x1c, 0xdf, 0xe3, 0x30, 0x39, 0xff, 0x6d, 0x58, 0x67, 0xe2,
+	0x1b, 0x45, 0x7e, 0x46, 0x47, 0x27, 0x61, 0x16, 0x52, 0x29, 0xe4, 0x55, 0x06, 0xde, 0xf3, 0x33,
+	0xfa, 0x15, 0x03, 0x9a, 0xda, 0x6c, 0x59, 0xda, 0xbc, 0x02, 0x90, 0xcb, 0x4e, 0x89, 0xbb, 0xab,
+	0x04, 0x15, 0x60, 0x0f, 0x7a, 0x7b, 0xc9, 0xf3, 0x7d, 0x9a, 0x8c, 0x9f, 0x31, 0x49, 0xdf, 0x80,
+	0x6e, 0x94, 0x3c, 0x1f, 0x65, 0x6c, 0x2c, 0xc5, 0xbc, 0xa1, 0x24, 0xb6, 0x7f, 0x1c, 0xf9, 0x01,
+	0x13, 0x55, 0x27, 0x92, 0x33, 0x6a, 0xec, 0xed, 0x22, 0xac, 0x70, 0xda, 0x61, 0x80, 0xd6, 0xa0,
+	0x21, 0x2d, 0xac, 0xeb, 0x35, 0xc2, 0x00, 0x7f, 0x00, 0x3d, 0x8e, 0x7a, 0x40, 0xa8, 0x47, 0x8e,
+	0xd9, 0xfc, 0xc3, 0x90, 0x44, 0x8a, 0x42, 0x0c, 0x18, 0xf4, 0xc4, 0x8f, 0x66, 0x4a, 0x66, 0x62,
+	0x80, 0xff, 0xc7, 0x81, 0x8e, 0x64, 0xe1, 0xd8, 0x5e, 0x97, 0x59, 0xa7, 0x26, 0x65, 0xfe, 0x7f,
+	0xb5, 0x0e, 0x19, 0x74, 0x9a, 0x86, 0x63, 0x61, 0xaf, 0x8e, 0x27, 0x06, 0xe8, 0x92, 0xbe, 0x6f,
+	0x29, 0xc2, 0x7c, 0x97, 0xdf, 0x86, 0x75, 0xf2, 0x62, 0x1a, 0xa6, 0x3e, 0x0d, 0x93, 0x58, 0x58,
+	0xb4, 0x90, 0xe3, 0x5a, 0x01, 0xe6, 0x96, 0xed, 0x42, 0x67, 0x9a, 0x26, 0x27, 0x61, 0x40, 0xd2,
+	0xc1, 0x8a, 0xd0, 0xb7, 0x1a, 0xa3, 0x8b, 0xd0, 0xf1, 0x95, 0xd1, 0x77, 0x38, 0x6e, 0xc5, 0x17,
+	0x36, 0xcf, 0x82, 0x43, 0x36, 0x9b, 0x4e, 0xa3, 0x90, 0x70, 0x6c, 0x97, 0x63, 0x41, 0x81, 0x86,
+	0x01, 0xfe, 0x8b, 0x46, 0xbe, 0xf5, 0xec, 0xff, 0xe1, 0xd6, 0xaf, 0x00, 0x8c, 0x53, 0xe2, 0x53,
+	0x12, 0x8c, 0x7c, 0x2a, 0x37, 0xdf, 0x95, 0x90, 0x3b, 0x94, 0xa1, 0x67, 0xd3, 0x40, 0xa1, 0xc5,
+	0xee, 0xbb, 0x12, 0x72, 0x87, 0xda, 0xd2, 0x81, 0x92, 0x74, 0xbe, 0x0d, 0x1d, 0xe1, 0xb5, 0xc3,
+	0x80, 0x6d, 0x46, 0xb8, 0xfb, 0x28, 0x97, 0x51, 0x27, 0x95, 0x48, 0x1c, 0xc2, 0xa6, 0xee, 0xf5,
+	0x99, 0x47, 0xb2, 0x29, 0xfa, 0x18, 0xd6, 0x8c, 0x38, 0xa1, 0x62, 0x6d, 0x6d, 0xa0, 0x58, 0xd5,
+	0x03, 0x45, 0x5d, 0xc8, 0xfd, 0x31, 0x5c, 0x30, 0x3e, 0xf5, 0x69, 0x18, 0x07, 0xd2, 0xe0, 0x45,
+	0x6c, 0x75, 0xaa, 0x62, 0x6b, 0x43, 0x8b, 0xad, 0x3b, 0xd0, 0xce, 0x88, 0x9f, 0x8e, 0x9f, 0xca,
+	0xc8, 0x20, 0x47, 0xf8, 0x87, 0xb0, 0xfe, 0x80, 0xd0, 0x5d, 0x2b, 0x58, 0x9d, 0xd9, 0x8b, 0x22,
+	0xe8, 0x1b, 0x73, 0x6d, 0x6b, 0x32, 0x62, 0x89, 0x8c, 0x59, 0x79, 0x2c, 0x31, 0x22, 0x77, 0xd3,
+	0x8c, 0xdc, 0x6c, 0x13, 0x94, 0xbc, 0x50, 0x21, 0x8a, 0xff, 0x8f, 0xff, 0xc6, 0x81, 0x75, 0x4b,
+	0x7e, 0xbf, 0xda, 0x2f, 0x5a, 0xb6, 0xd6, 0x5a, 0x6c, 0x6b, 0x6d, 0xcb, 0xd6, 0x98, 0x29, 0xed,
+	0xaa, 0xd5, 0x8d, 0x4f, 0x3b, 0xd6, 0x31, 0x35, 0x83, 0x35, 0x41, 0xf8, 0xfa, 0x34, 0xcb, 0x4e,
+	0x84, 0x6c, 0x4a, 0xc6, 0xa1, 0x1f, 0xd1, 0x39, 0xfb, 0xa6, 0xd8, 0x56, 0x2f, 0x87, 0x0d, 0x03,
+	0xfc, 0x50, 0x65, 0x09, 0xc2, 0x76, 0xaf, 0xc3, 0x8a, 0xe0, 0x48, 0x19, 0xed, 0x9a, 0x65, 0xb4,
+	0x0a, 0x5d, 0x63, 0xa5, 0x57, 0xa0, 0xab, 0xb6, 0x9b, 0xa1, 0x0d, 0x68, 0x86, 0x81, 0x58, 0xa8,
+	0xeb, 0xb1, 0x7f, 0xf1, 0xef, 0xf1, 0xa3, 0x98, 0xcd, 0xbf, 0x3b, 0x1f, 0x06, 0xe7, 0xfd, 0xe4,
+	0x9b, 0xd0, 0x9b, 0x84, 0x59, 0x16, 0xc6, 0x47, 0x23, 0xb6, 0x6e, 0x83, 0xaf, 0x0b, 0x12, 0x34,
+	0x0c, 0x32, 0xfc, 0x21, 0xf4, 0x35, 0x4b, 0x3e, 0xdf, 0x61, 0xf0, 0x8f, 0x4d, 0x68, 0x8b, 0x99,
+	0x25, 0x7b, 0xba, 0x02, 0x70, 0x18, 0xa6, 0x19, 0xd5, 0x8f, 0xdd, 0x2e, 0x87, 0xf0, 0x73, 0x97,
+	0x85, 0x3b, 0x5f, 0x61, 0xa5, 0x45, 0x45, 0xbe, 0x44, 0xee, 0x40, 0xfb, 0x88, 0xc4, 0x2c, 0x86,
+	0x09, 0xe1, 0xcb, 0x11, 0x9b, 0xf4, 0x3c, 0x49, 0x9f, 0x8d, 0x68, 0x38, 0x51, 0xd9, 0x4c, 0x87,
+	0x01, 0x1e, 0x87, 0x22, 0xd8, 0x8a, 0xb0, 0xda, 0xd6, 0xc3, 0xea, 0x55, 0x80, 0xb1, 0xd0, 0x1c,
+	0xa1, 0x73, 0x19, 0x12, 0x35, 0x08, 0x13, 0x4f, 0x9a, 0x24, 0x13, 0x75, 0xfc, 0x8b, 0xa8, 0x08,
+	0x0c, 0x24, 0x4f, 0x7f, 0x3b, 0x41, 0xe8, 0x96, 0x13, 0x04, 0xd3, 0xd8, 0x61, 0xb1, 0xb1, 0xf7,
+	0xec, 0xc0, 0x7a, 0x05, 0x20, 0x20, 0x11, 0x91, 0xe8, 0xbe, 0x40, 0x4b, 0xc8, 0x1d, 0x8a, 0xbe,
+	0x09, 0xab, 0xba, 0x39, 0x66, 0x83, 0x55, 0xae, 0xc1, 0xbe, 0x66, 0x8f, 0x19, 0xba, 0x0d, 0xb9,
+	0x7d, 0x86, 0x24, 0x1b, 0xac, 0x71, 0x93, 0xd8, 0xcc, 0x33, 0x06, 0x45, 0xea, 0xe9, 0x54, 0xf8,
+	0x5f, 0x1d, 0xe8, 0xe6, 0xa8, 0xb3, 0x9e, 0x67, 0x42, 0xc4, 0x4d, 0x5d, 0xc4, 0xcc, 0x61, 0xa2,
+	0x84, 0x8e, 0x26, 0x61, 0x3c, 0xa3, 0x24, 0xe3, 0x3a, 0x6b, 0x7a, 0x3d, 0x06, 0x7b, 0x28, 0x40,
+	0xaf, 0x16, 0x0e, 0x98, 0x08, 0xa4, 0x35, 0x8f, 0x84, 0xf7, 0xac, 0xf0, 0x2f, 0xc8, 0x54, 0x31,
+	0xbb, 0x27, 0x9d, 0xa8, 0xb7, 0x5f, 0x88, 0xa4, 0x94, 0xf1, 0x7c, 0x05, 0x68, 0xbf, 0xd8, 0xfb,
+	0xeb, 0x3b, 0x07, 0x7e, 0x5c, 0x7c, 0x36, 0x24, 0x25, 0x45, 0x38, 0x67, 0x51, 0x44, 0x4d, 0x54,
+	0x38, 0x80, 0xfe, 0x6f, 0x27, 0xe9, 0xb3, 0x61, 0x4c, 0x49, 0x7a, 0xe2, 0x47, 0x68, 0x00, 0x2b,
+	0xcf, 0x09, 0x79, 0x16, 0xf8, 0x73, 0xce, 0x6d, 0xcb, 0x53, 0x43, 0x26, 0xbe, 0x8c, 0xfa, 0x29,
+	0x15, 0x7e, 0x21, 0x5d, 0x8d, 0x43, 0xb8, 0x63, 0x5c, 0x84, 0x0e, 0x89, 0x03, 0x81, 0x14, 0xcc,
+	0xaf, 0x90, 0x38, 0x60, 0x28, 0xfc, 0x47, 0xf9, 0xc1, 0xc0, 0x3e, 0xf5, 0xa3, 0x64, 0x96, 0x66,
+	0x0b, 0x03, 0x2e, 0x7a, 0x07, 0x5a, 0x4f, 0x19, 0x95, 0x4c, 0xe3, 0x2f, 0xa8, 0x9d, 0xe9, 0x9c,
+	0x7a, 0x82, 0x04, 0xfd, 0x3a, 0xb4, 0x0f, 0x52, 0xe2, 0x3f, 0xcb, 0x06, 0xcd, 0x05, 0xc4, 0x92,
+	0x06, 0xdf, 0x51, 0xa1, 0x7c, 0x3f, 0x4a, 0x58, 0x4e, 0x70, 0xbc, 0x98, 0x11, 0x04, 0xcb, 0x3c,
+	0x0d, 0x92, 0xe6, 0xc9, 0xfe, 0xc7, 0xbb, 0xd0, 0x61, 0xbb, 0x62, 0x0b, 0x58, 0x32, 0x71, 0x16,
+	0xc9, 0xa4, 0x61, 0xca, 0xe4, 0x8f, 0x1d, 0x15, 0xdd, 0x39, 0x27, 0xe7, 0x66, 0xa3, 0xe4, 0x0f,
+	0xcd, 0xb2, 0x3f, 0xbc, 0x0d, 0x2d, 0x36, 0x64, 0xbe, 0x62, 0xe4, 0xf6, 0x8a, 0x7d, 0x4f, 0xa0,
+	0xf1, 0xcf, 0x1b, 0x4a, 0x3f, 0xf7, 0x5f, 0x8c, 0xc9, 0x94, 0xa5, 0x79, 0x55, 0x07, 0x77, 0xc1,
+	0x5f, 0xa3, 0xcc, 0xdf, 0xb3, 0x30, 0x56, 0x67, 0x36, 0xff, 0x9f, 0x4f, 0x60, 0xf7, 0x19, 0x76,
+	0x45, 0x54, 0xd7, 0x45, 0x06, 0xf8, 0x34, 0x4d, 0x26, 0xec, 0xba, 0xc8, 0x91, 0x34, 0x51, 0xd7,
+	0x45, 0x36, 0x7c, 0x9c, 0x58, 0x02, 0x6d, 0x2f, 0x12, 0xe8, 0x8a, 0x21, 0x50, 0x1e, 0xc1, 0x66,
+	0x07, 0x19, 0x0d, 0xe9, 0x8c, 0x92, 0x22, 0xef, 0xee, 0x17, 0x40, 0xc1, 0x68, 0x9c, 0x50, 0x22,
+	0xc3, 0x2b, 0xff, 0xff, 0x94, 0xb8, 0x8a, 0xbf, 0x09, 0x9b, 0x96, 0x6c, 0x2a, 0xfc, 0x3e, 0x82,
+	0x37, 0x2c, 0xa2, 0xdc, 0xf9, 0x17, 0x2a, 0xd6, 0xb8, 0x53, 0x37, 0xea, 0xef, 0xd4, 0x4d, 0xfd,
+	0x4e, 0x8d, 0x3f, 0x83, 0x0d, 0xfb, 0x6b, 0xe8, 0xfb, 0x00, 0x24, 0x1f, 0x55, 0x67, 0xb5, 0x39,
+	0xb5, 0xa7, 0x91, 0xe2, 0x5d, 0xd8, 0x16, 0xe8, 0x3b, 0x27, 0x7e, 0x18, 0xf9, 0x07, 0x61, 0x14,
+	0xd2, 0xf9, 0xa9, 0x8c, 0xaf, 0x41, 0xc3, 0xa7, 0x92, 0xe3, 0x86, 0x4f, 0xf1, 0x7f, 0x3b, 0x80,
+	0xca, 0xcb, 0x9c, 0x6b, 0x0d, 0x74, 0x19, 0xba, 0xbe, 0x98, 0x1c, 0x89, 0x1d, 0x77, 0xbc, 0x02,
+	0xc0, 0x42, 0x63, 0x4a, 0xfc, 0x2c, 0x89, 0xd5, 0x69, 0x2d, 0x46, 0x4c, 0x7d, 0x31, 0x79, 0x41,
+	0x47, 0xdc, 0x48, 0x54, 0xd0, 0x67, 0x90, 0x7d, 0x06, 0x28, 0x9b, 0x45, 0xbb, 0xc2, 0x2c, 0x6e,
+	0x02, 0x14, 0x63, 0x6e, 0x58, 0xe5, 0x54, 0x47, 0xa3, 0xc0, 0xbf, 0xa5, 0x6c, 0x22, 0x63, 0x41,
+	0x26, 0x8c, 0x8f, 0x98, 0xbc, 0xec, 0x8c, 0xce, 0x29, 0x65, 0x74, 0x95, 0xe1, 0xe4, 0xa7, 0x0e,
+	0xac, 0xca, 0x55, 0xc4, 0x9a, 0xe8, 0x6d, 0x90, 0x35, 0xa7, 0x81, 0x53, 0xc9, 0x89, 0xc4, 0x32,
+	0xf7, 0xd6, 0xa3, 0x64, 0x85, 0x7b, 0x73, 0x34, 0x7a, 0x0b, 0xd6, 0x34, 0x11, 0x1c, 0x26, 0xaa,
+	0xfc, 0xa0, 0x09, 0xe6, 0xd3, 0x24, 0xc5, 0xf7, 0x01, 0xd9, 0x9b, 0xca, 0xa6, 0xe8, 0x96, 0x9d,
+	0x02, 0x6e, 0xeb, 0xf1, 0x35, 0x67, 0x3a, 0xcf, 0x04, 0xf1, 0x7f, 0x39, 0xca, 0x3a, 0xef, 0x25,
+	0x13, 0x9e, 0x00, 0x9e, 0x37, 0x9a, 0x30, 0x41, 0x92, 0xf4, 0x24, 0x1c, 0x13, 0x51, 0x3f, 0x93,
+	0xc5, 0x12, 0x09, 0xe3, 0x15, 0x36, 0x16, 0x26, 0x24, 0x49, 0x9e, 0x3b, 0x77, 0x25, 0x44, 0x8b,
+	0x47, 0x2d, 0x2d, 0x1e, 0xe5, 0xa9, 0xa5, 0x4c, 0xdc, 0xf8, 0xc0, 0x72, 0xfe, 0x95, 0xc5, 0x29,
+	0x43, 0xc7, 0xbe, 0x41, 0x7c, 0x0b, 0x90, 0xbd, 0xd5, 0x8a, 0xe0, 0xf0, 0x7d, 0x18, 0xd8, 0x54,
+	0x67, 0x8a, 0x0e, 0xf8, 0x0b, 0xd8, 0x2c, 0x4d, 0x44, 0x1f, 0x42, 0x6f, 0x5c, 0x0c, 0xa5, 0x52,
+	0x06, 0xa6, 0x89, 0x14, 0xf4, 0x9e, 0x4e, 0x8c, 0x09, 0x6c, 0x3c, 0xf2, 0xe7, 0x69, 0x12, 0x45,
+	0xea, 0x86, 0x76, 0x6c, 0x86, 0x20, 0xa7, 0x3e, 0x04, 0x35, 0xea, 0xcb, 0x7a, 0xd6, 0x55, 0x0d,
+	0xff, 0xa4, 0x01, 0x3d, 0xf9, 0x9d, 0xbd, 0x30, 0x26, 0x25, 0x85, 0x3a, 0xa7, 0x29, 0xb4, 0x51,
+	0xa1, 0x50, 0x2d, 0x85, 0xe7, 0xff, 0xb3, 0x22, 0xc4, 0xf1, 0xcc, 0x8f, 0x69, 0x48, 0xe7, 0x32,
+	0x19, 0xcc, 0xc7, 0x2c, 0x8b, 0x49, 0xc9, 0x09, 0x89, 0x67, 0x44, 0x16, 0x39, 0xd4, 0x90, 0xc5,
+	0x83, 0x42, 0x22, 0x5a, 0x3c, 0x18, 0xeb, 0x1a, 0x54, 0xf6, 0xb3, 0x52, 0x65, 0x3f, 0x1d, 0xdd,
+	0x7e, 0x5c, 0xe8, 0x10, 0x3f, 0x8d, 0xc3, 0xf8, 0x28, 0xe3, 0x87, 0x4a, 0xd3, 0xcb, 0xc7, 0xf8,
+	0x5f, 0x1c, 0x58, 0x95, 0x62, 0x90, 0x9e, 0xbd, 0x30, 0x1c, 0xbe, 0xca, 0x55, 0x46, 0xdb, 0xef,
+	0xb2, 0xb9, 0x5f, 0x9d, 0xc1, 0x96, 0xc9, 0x20, 0xfa, 0x0e, 0xcb, 0x4b, 0x63, 0x92, 0x0d, 0xda,
+	0xdc, 0x88, 0xb6, 0x94, 0x11, 0x69, 0xba, 0xf3, 0x04, 0x05, 0xfe, 0xeb, 0x62, 0x2f, 0xb2, 0x96,
+	0xf0, 0x72, 0x76, 0xa3, 0x85, 0x93, 0xa6, 0x19, 0x4e, 0x0c, 0x49, 0x15, 0x17, 0xcb, 0x97, 0xda,
+	0x19, 0xfe, 0x87, 0x26, 0xac, 0xf2, 0x1a, 0xda, 0xc3, 0xe4, 0x84, 0x4c, 0x48, 0x5c, 0x2e, 0x7d,
+	0x5c, 0x84, 0x4e, 0xc6, 0x08, 0x0a, 0x73, 0x5b, 0xc9, 0x64, 0x19, 0xb3, 0x2a, 0x9b, 0x59, 0x64,
+	0x6c, 0x97, 0xa0, 0x3b, 0x8b, 0x43, 0x3a, 0x1a, 0x27, 0x99, 0x38, 0x80, 0x1c, 0xaf, 0xc3, 0x00,
+	0xf7, 0x92, 0x8c, 0x17, 0xb4, 0xa8, 0x9f, 0x1e, 0x11, 0x2a, 0x4c, 0x5f, 0x58, 0x1b, 0x08, 0x10,
+	0xb7, 0xfc, 0x4b, 0xd0, 0x95, 0x04, 0xa1, 0x32, 0xb8, 0x8e, 0x00, 0x0c, 0x83, 0x45, 0x75, 0xc4,
+	0xf3, 0xa7, 0x32, 0x4c, 0x96, 0x07, 0x7e, 0xe4, 0xc7, 0x63, 0xc2, 0xef, 0x87, 0x4d, 0x4f, 0x0d,
+	0xd1, 0x36, 0xb4, 0x59, 0x2e, 0x19, 0x06, 0xf2, 0x66, 0xd8, 0x8a, 0x12, 0x59, 0x4e, 0x66, 0x60,
+	0x79, 0x27, 0x5d, 0x15, 0xeb, 0x45, 0x09, 0x95, 0x37, 0xd2, 0x8a, 0x7a, 0xe1, 0x5a, 0x65, 0xbd,
+	0xf0, 0x3a, 0x2c, 0xf3, 0x3c, 0x74, 0xdd, 0xcc, 0xd0, 0xb9, 0x86, 0xf6, 0x12, 0xfa, 0xd8, 0x7f,
+	0x46, 0x3c, 0x4e, 0xc1, 0xd2, 0xe2, 0xbe, 0x0e, 0xd6, 0x38, 0x73, 0xea, 0x39, 0x6b, 0x9c, 0x81,
+	0xb3, 0x66, 0x5d, 0x25, 0xb3, 0x4e, 0xaf, 0xf8, 0x2f, 0x55, 0x21, 0x76, 0x2f, 0x39, 0x97, 0xfd,
+	0xb0, 0x58, 0xc6, 0x51, 0x9a, 0xab, 0x76, 0x39, 0x44, 0x95, 0x1d, 0x24, 0xdb, 0x32, 0x91, 0x89,
+	0x6b, 0x79, 0x6e, 0x55, 0xf2, 0xcc, 0x33, 0xeb, 0x79, 0x36, 0x8a, 0xc8, 0xa1, 0xb8, 0xc6, 0x36,
+	0x59, 0x66, 0x3d, 0xcf, 0xf6, 0xc8, 0x21, 0x35, 0x8d, 0x71, 0xc5, 0x32, 0x46, 0x17, 0x3a, 0x29,
+	0x19, 0x93, 0xf0, 0x84, 0x08, 0x73, 0x6a, 0x7a, 0xf9, 0x98, 0x65, 0x5f, 0x29, 0x99, 0xf8, 0x21,
+	0x73, 0x20, 0x19, 0xca, 0x0a, 0xc0, 0x69, 0x49, 0xf2, 0x1e, 0x6c, 0x28, 0x49, 0xe5, 0x47, 0x9b,
+	0x2e, 0x21, 0xa7, 0x24, 0xa1, 0xe7, 0x21, 0x7d, 0x3a, 0xe2, 0x4f, 0x68, 0x5c, 0x7c, 0x1d, 0xaf,
+	0xcb, 0x20, 0xf7, 0x19, 0x00, 0xbf, 0x05, 0xeb, 0xf7, 0xd9, 0x96, 0xc3, 0xf8, 0x68, 0x4f, 0xde,
+	0xd2, 0x78, 0xe6, 0x34, 0xcf, 0xe4, 0x0d, 0x9a, 0xff, 0x8f, 0x1f, 0x40, 0x37, 0xff, 0x28, 0xfa,
+	0x96, 0x34, 0xb1, 0xaa, 0x67, 0x8c, 0xbd, 0x84, 0x0a, 0xf3, 0xaa, 0xb9, 0x03, 0xff, 0xa7, 0x03,
+	0xdb, 0x46, 0xb4, 0x38, 0xcb, 0x1e, 0x54, 0x94, 0x68, 0x68, 0x51, 0xc2, 0x72, 0xf6, 0xe6, 0x62,
+	0x67, 0x5f, 0xb6, 0x9c, 0xdd, 0x88, 0xa8, 0xad, 0xfa, 0x88, 0xda, 0x36, 0x22, 0x6a, 0x5e, 0x5c,
+	0x58, 0xa9, 0x2a, 0x2e, 0x74, 0x8a, 0xe2, 0x02, 0xfe, 0x5d, 0x58, 0x33, 0x77, 0x89, 0x6e, 0x43,
+	0x77, 0xa2, 0x06, 0x76, 0x7a, 0x67, 0x90, 0x7a, 0x05, 0x5d, 0x8d, 0x0c, 0x3f, 0x81, 0x75, 0x3e,
+	0xe3, 0xae, 0x88, 0x28, 0xa7, 0x0b, 0xaf, 0x94, 0x08, 0x87, 0xd0, 0xd7, 0x57, 0x38, 0x65, 0xfa,
+	0xd9, 0x5f, 0x41, 0xc4, 0xa9, 0xbd, 0xac, 0x9d, 0xda, 0xf8, 0x09, 0xac, 0xea, 0x9f, 0xca, 0x72,
+	0x7e, 0x9c, 0x82, 0x1f, 0xf4, 0x2e, 0x74, 0x64, 0x78, 0x2c, 0xd5, 0x21, 0x8c, 0x9d, 0xe6, 0x54,
+	0xf8, 0x85, 0x8c, 0x17, 0x77, 0x93, 0xc9, 0x6b, 0xc8, 0x79, 0xde, 0x81, 0x56, 0x48, 0xc9, 0xa4,
+	0x54, 0xd7, 0x50, 0x9f, 0x18, 0x52, 0x32, 0xf1, 0x04, 0x09, 0x0e, 0xa0, 0xaf, 0x83, 0x4f, 0xf1,
+	0x3d, 0x2d, 0x3a, 0x35, 0xec, 0xe8, 0xa4, 0x07, 0xc4, 0xa6, 0x15, 0x10, 0xbf, 0x90, 0xef, 0x79,
+	0x77, 0x93, 0x89, 0xba, 0xf0, 0xbc, 0xd2, 0x16, 0xf1, 0xcf, 0x1c, 0x69, 0x35, 0xf7, 0x92, 0x38,
+	0x9b, 0x4d, 0xc8, 0x6b, 0x59, 0x95, 0x79, 0xa1, 0x1f, 0xfb, 0xd1, 0x3c, 0x0b, 0xb3, 0x22, 0x3b,
+	0x05, 0x05, 0xb2, 0x4e, 0xd5, 0x65, 0xe3, 0x54, 0xc5, 0x2f, 0x00, 0x38, 0x43, 0x4f, 0x32, 0x56,
+	0x8e, 0x7b, 0x25, 0x31, 0x92, 0x17, 0x53, 0x32, 0xa6, 0x24, 0x50, 0x62, 0x54, 0x63, 0x66, 0x93,
+	0xd4, 0x7f, 0x46, 0x62, 0x79, 0xe0, 0x88, 0x01, 0x1e, 0xc1, 0x86, 0x29, 0x8a, 0x6c, 0x6a, 0xef,
+	0xc4, 0x29, 0xed, 0xe4, 0x1d, 0x68, 0xcf, 0x18, 0xa7, 0xca, 0x42, 0x91, 0x61, 0x24, 0x7c, 0x13,
+	0x9e, 0xa4, 0xc0, 0x7f, 0xe2, 0xc0, 0x05, 0xb1, 0xb8, 0x28, 0x02, 0xbc, 0xe2, 0x0d, 0xe0, 0x95,
+	0x6f, 0x69, 0xf8, 0x7f, 0x1d, 0x58, 0xd7, 0x18, 0x7a, 0x4d, 0x57, 0x05, 0x5d, 0x67, 0xcd, 0x45,
+	0x3a, 0x5b, 0xb6, 0x75, 0x76, 0x19, 0xba, 0x53, 0x92, 0x1e, 0x26, 0xe9, 0x84, 0xa8, 0x97, 0xf5,
+	0x02, 0x60, 0x68, 0xb4, 0x6d, 0x69, 0x74, 0x07, 0xda, 0xfe, 0x98, 0xce, 0xfc, 0x48, 0x06, 0x61,
+	0x39, 0x62, 0xcf, 0x02, 0x41, 0x78, 0x78, 0x48, 0x52, 0x12, 0x8f, 0x55, 0x2c, 0xd6, 0x20, 0xf8,
+	0x05, 0x6c, 0x96, 0x34, 0xf2, 0x92, 0xea, 0xb8, 0xa1, 0x72, 0xf9, 0xa6, 0x59, 0xfa, 0xb1, 0x04,
+	0xac, 0xf2, 0xf9, 0x5f, 0xb2, 0xdc, 0x46, 0xbe, 0xaa, 0x9e, 0xa9, 0x28, 0xff, 0x16, 0xac, 0x8d,
+	0x93, 0x98, 0xfa, 0x63, 0x3a, 0x9a, 0x92, 0x94, 0x95, 0x61, 0x64, 0x11, 0x41, 0x42, 0x1f, 0x71,
+	0x60, 0xe9, 0x1d, 0x63, 0xb9, 0xfc, 0x8e, 0x71, 0x01, 0x5a, 0x2c, 0xad, 0x88, 0xe4, 0x11, 0x27,
+	0x06, 0x2c, 0x37, 0xf5, 0x83, 0x20, 0x25, 0x59, 0x26, 0xcf, 0x37, 0x35, 0x64, 0x37, 0xb6, 0xa9,
+	0x3f, 0x67, 0x67, 0xcf, 0x88, 0x92, 0x74, 0x92, 0xc9, 0x24, 0xb9, 0x2f, 0x81, 0x8f, 0x19, 0x8c,
+	0x7f, 0x57, 0x12, 0xf1, 0x3c, 0x41, 0xc8, 0xba, 0x27, 0x61, 0xbb, 0xfe, 0x3c, 0x7b, 0x99, 0x84,
+	0xf9, 0x94, 0x37, 0x15, 0xf6, 0x58, 0xcd, 0xed, 0x49, 0x1c, 0x3c, 0x7d, 0xa1, 0xdf, 0x4c, 0x78,
+	0x31, 0x3b, 0x14, 0x2f, 0x03, 0xec, 0xe7, 0x4f, 0xd7, 0xa5, 0xba, 0xc0, 0x63, 0xd8, 0x50, 0xd8,
+	0xd7, 0xf8, 0x54, 0xf0, 0x25, 0x74, 0xf3, 0x55, 0xd1, 0x4d, 0xe8, 0xaa, 0xb7, 0xf3, 0x72, 0x6a,
+	0x24, 0x11, 0x5e, 0x41, 0x52, 0x73, 0xb6, 0xff, 0xac, 0x09, 0xab, 0x8f, 0x66, 0xe9, 0xf8, 0xa9,
+	0x9f, 0x91, 0x2f, 0xd2, 0xa0, 0xc2, 0x62, 0x8a, 0x9c, 0x56, 0x4c, 0x94, 0x23, 0xfb, 0x39, 0xbf,
+	0x69, 0x3f, 0xe7, 0x8b, 0xf2, 0x9c, 0x24, 0xd0, 0xbc, 0xb2, 0xaf, 0x80, 0x2a, 0x63, 0xce, 0xa8,
+	0x4f, 0x67, 0x99, 0x2a, 0x16, 0x8b, 0x51, 0xae, 0xd1, 0xb6, 0xa6, 0x51, 0x3d, 0xb6, 0xaf, 0x98,
+	0x37, 0xa6, 0x5b, 0xea, 0x40, 0xed, 0x70, 0x41, 0x5c, 0xcc, 0x6f, 0x9e, 0xfa, 0xd6, 0xb4, 0x53,
+	0x95, 0x07, 0xea, 0x84, 0xfa, 0x11, 0x37, 0x19, 0xc7, 0x13, 0x03, 0xe6, 0x09, 0x2a, 0x69, 0x1e,
+	0x09, 0x34, 0x70, 0xf4, 0xaa, 0x82, 0x3e, 0xe6, 0x64, 0x57, 0x00, 0x12, 0xb6, 0xa0, 0x61, 0x3b,
+	0x12, 0x72, 0xc7, 0x7e, 0xba, 0xee, 0x2f, 0xb6, 0xbc, 0x55, 0xbb, 0xf0, 0xf4, 0x77, 0x0e, 0x6c,
+	0x96, 0xd8, 0x7e, 0x8d, 0x77, 0x94, 0x97, 0xbe, 0xee, 0xea, 0x37, 0x8c, 0xb6, 0x79, 0xc3, 0xc0,
+	0xdf, 0x80, 0x75, 0x93, 0xe7, 0xb2, 0x4b, 0xfc, 0xad, 0x03, 0xdb, 0x06, 0x4d, 0xee, 0x18, 0x96,
+	0x25, 0x39, 0x25, 0x4b, 0x2a, 0x8c, 0xa4, 0x61, 0x18, 0x89, 0x11, 0x4e, 0x9b, 0xf5, 0xe1, 0x74,
+	0xb9, 0x3a, 0xab, 0x6e, 0x55, 0xf9, 0x61, 0x5b, 0xcb, 0xaa, 0x9f, 0xc0, 0x9a, 0xc9, 0x31, 0xba,
+	0x01, 0x6d, 0xae, 0xe7, 0x52, 0x4a, 0x6d, 0xd0, 0x79, 0x92, 0xa8, 0xc6, 0xe7, 0x3e, 0x87, 0x0d,
+	0xd5, 0xaa, 0x25, 0xc8, 0xcf, 0x22, 0x03, 0xdd, 0xf8, 0x1b, 0x66, 0x62, 0xf3, 0xfb, 0xb0, 0x66,
+	0xac, 0x77, 0x6e, 0x36, 0x7f, 0x00, 0x1b, 0xec, 0x86, 0x96, 0xcc, 0xe8, 0x48, 0x7d, 0xd1, 0x2e,
+	0x3c, 0xe7, 0x3d, 0x63, 0xeb, 0x92, 0x52, 0x85, 0x18, 0xfc, 0x09, 0xec, 0x18, 0xab, 0xee, 0x73,
+	0xdd, 0x54, 0xf5, 0x76, 0xd5, 0xa8, 0x11, 0xff, 0xc4, 0x81, 0x0b, 0x26, 0x63, 0xcc, 0xac, 0xa6,
+	0x94, 0xed, 0x99, 0x73, 0xa8, 0xe5, 0x68, 0x49, 0x1a, 0x9c, 0x22, 0x0e, 0xf4, 0x3d, 0x33, 0xb9,
+	0xbe, 0x56, 0xbd, 0x77, 0xf1, 0x09, 0x3d, 0xd1, 0xfe, 0x33, 0x07, 0x06, 0x75, 0x34, 0xcc, 0x9a,
+	0x18, 0x55, 0xc1, 0x49, 0x9b, 0x0d, 0x87, 0x66, 0xf5, 0xa8, 0x61, 0xb9, 0x93, 0x59, 0xc9, 0x68,
+	0x9e, 0xa1, 0x92, 0xb1, 0x5c, 0x55, 0x15, 0xc0, 0x61, 0x71, 0x9a, 0xec, 0x4f, 0x49, 0x5e, 0x5d,
+	0x7e, 0x89, 0x54, 0xe2, 0xb4, 0xa0, 0x8d, 0xff, 0xde, 0x81, 0x55, 0xe3, 0x5b, 0xa7, 0x5b, 0x66,
+	0x29, 0xce, 0x37, 0xaa, 0xe3, 0xbc, 0xb4, 0x48, 0x91, 0x32, 0x6b, 0x1e, 0x92, 0x4d, 0x49, 0x4c,
+	0xd5, 0x25, 0x8e, 0x0f, 0x8a, 0x70, 0xde, 0x32, 0xc3, 0xb9, 0xc1, 0x99, 0xae, 0xbb, 0x3f, 0x80,
+	0xcd, 0x12, 0xee, 0x57, 0x73, 0x53, 0xaa, 0xe6, 0x18, 0xff, 0xa9, 0x03, 0x5b, 0x96, 0x8e, 0x5e,
+	0x21, 0xe3, 0xbb, 0xad, 0x9f, 0xed, 0x56, 0x31, 0xd5, 0xfc, 0x8a, 0x79, 0xc0, 0x8b, 0x33, 0x6b,
+	0x59, 0x3b, 0xd2, 0xf0, 0xbf, 0x37, 0xa0, 0xcb, 0x43, 0x03, 0xbb, 0x8a, 0x9c, 0xf9, 0x70, 0x2f,
+	0x5c, 0xb5, 0x59, 0x79, 0x2c, 0x2f, 0xd7, 0x1c, 0xcb, 0x2d, 0xd3, 0x15, 0x2f, 0x41, 0x77, 0x9a,
+	0x64, 0xec, 0xa4, 0x3b, 0x98, 0xab, 0x0e, 0x61, 0x01, 0xb8, 0x3b, 0x3f, 0xed, 0x7d, 0xa6, 0x98,
+	0x9b, 0x3f, 0xcf, 0xc8, 0xb9, 0x77, 0x28, 0xfa, 0x35, 0x95, 0x12, 0x77, 0x2d, 0xe1, 0xa8, 0x9d,
+	0x6a, 0x09, 0x31, 0xba, 0x09, 0x6d, 0x1e, 0x78, 0xb3, 0x01, 0x70, 0xea, 0x9d, 0x12, 0x35, 0x4f,
+	0xe9, 0x3c, 0x49, 0x85, 0xbe, 0x03, 0x1b, 0xbc, 0x96, 0x30, 0xd2, 0x12, 0xfc, 0x1e, 0x97, 0xe9,
+	0x3a, 0x87, 0xef, 0xe6, 0x60, 0xfc, 0x53, 0x56, 0x8c, 0xd6, 0xbf, 0xf9, 0x0a, 0x46, 0x57, 0x94,
+	0x43, 0x9b, 0xf5, 0xe5, 0xd0, 0xe5, 0x33, 0x04, 0x91, 0x56, 0x5d, 0x39, 0xb4, 0xf6, 0x92, 0x33,
+	0x80, 0x15, 0xbe, 0x77, 0x12, 0xc8, 0x5b, 0x8e, 0x1a, 0x9e, 0x76, 0xcd, 0x61, 0xdc, 0x85, 0xb2,
+	0xa9, 0x86, 0x88, 0x8e, 0xd7, 0x8e, 0xd7, 0x0d, 0x45, 0x47, 0x0d, 0x09, 0xcc, 0x84, 0x02, 0xac,
+	0x84, 0xe2, 0xec, 0x72, 0xe6, 0x3d, 0x68, 0xb2, 0x4a, 0x55, 0x54, 0xb2, 0x41, 0x81, 0x86, 0x01,
+	0xfe, 0x27, 0x07, 0xd6, 0x4c, 0x75, 0xbe, 0x7e, 0x4d, 0x2c, 0xca, 0x9c, 0x98, 0x31, 0xf3, 0x3d,
+	0x6b, 0x6e, 0xd0, 0x95, 0x10, 0xf1, 0xc1, 0x45, 0xed, 0x8a, 0xac, 0xf5, 0x48, 0x31, 0x5f, 0x91,
+	0x3a, 0x3d, 0x82, 0xf5, 0x1c, 0x2d, 0x1b, 0xae, 0x6d, 0x47, 0xbe, 0x01, 0x28, 0x89, 0xa3, 0xf9,
+	0x28, 0x08, 0xb3, 0x71, 0x4a, 0xa6, 0x7e, 0x3c, 0x0e, 0x49, 0x26, 0xcb, 0xaf, 0x9b, 0x0c, 0xb3,
+	0xab, 0x23, 0xf0, 0x13, 0xd8, 0xcc, 0x57, 0xcc, 0xf3, 0xb0, 0xc2, 0xe9, 0x1d, 0xc3, 0xe9, 0xcf,
+	0xfc, 0x3b, 0x02, 0xfc, 0x04, 0xa0, 0x58, 0x16, 0xfd, 0x06, 0x40, 0x96, 0x8f, 0x4a, 0x9d, 0x4c,
+	0x0a, 0xe3, 0x69, 0x44, 0x35, 0x09, 0xd3, 0x1f, 0x3a, 0xb0, 0x69, 0x2a, 0x57, 0x55, 0x93, 0x14,
+	0x50, 0x7f, 0x94, 0xd7, 0x04, 0x69, 0x6a, 0xa5, 0x61, 0x6b, 0xe5, 0x5d, 0x33, 0x53, 0x70, 0xab,
+	0xe3, 0x82, 0x7e, 0xce, 0x1c, 0x00, 0x2a, 0x23, 0x17, 0x59, 0x5a, 0x61, 0x4a, 0x8d, 0x3a, 0x53,
+	0xb2, 0x4b, 0x71, 0x3f, 0x84, 0x8d, 0xfc, 0x1b, 0x8f, 0x92, 0xac, 0x52, 0xdd, 0xf5, 0xe9, 0xcf,
+	0x7b, 0xff, 0x76, 0x0d, 0x56, 0x65, 0xf7, 0x91, 0x28, 0x9c, 0xa0, 0x77, 0x55, 0xab, 0xf0, 0x3d,
+	0x1e, 0x5c, 0x91, 0xd5, 0x74, 0xe0, 0x5a, 0x63, 0xbc, 0xc4, 0xce, 0x1f, 0xf1, 0xff, 0x03, 0x42,
+	0x51, 0x5e, 0x9d, 0xd4, 0x9b, 0x3c, 0x2b, 0x26, 0x7d, 0x94, 0xf7, 0xb4, 0x32, 0x83, 0x42, 0x3b,
+	0x26, 0x81, 0xb2, 0x32, 0x77, 0xcb, 0x82, 0xb3, 0x6a, 0x18, 0x5e, 0x42, 0x9f, 0xa8, 0x3e, 0xa5,
+	0xec, 0x01, 0xa1, 0xbc, 0x4d, 0x15, 0x6d, 0x9a, 0x94, 0xc3, 0x20, 0x73, 0xad, 0xc7, 0xf0, 0xa2,
+	0x9f, 0x15, 0x2f, 0x15, 0xdb, 0x7c, 0x32, 0x0d, 0xce, 0xb6, 0xcd, 0x0f, 0xd5, 0x8c, 0x5d, 0xde,
+	0x2c, 0x89, 0x36, 0xec, 0x0f, 0xba, 0x3b, 0x37, 0xc5, 0x4f, 0x83, 0x6e, 0xaa, 0x9f, 0x06, 0xdd,
+	0x14, 0xcf, 0x18, 0x4b, 0xe8, 0x63, 0x25, 0x65, 0x56, 0xb8, 0x62, 0x62, 0xaa, 0x21, 0xb5, 0xf7,
+	0xcb, 0xc8, 0x33, 0xbc, 0x84, 0x3e, 0x80, 0xf5, 0xbc, 0x99, 0x4f, 0xea, 0xa5, 0xdc, 0xe5, 0xe7,
+	0x96, 0x41, 0x78, 0x09, 0xbd, 0x0f, 0xfd, 0x7c, 0xc8, 0xbe, 0xbc, 0x55, 0x22, 0x1a, 0x06, 0xd5,
+	0x33, 0x77, 0x61, 0xdd, 0xea, 0x61, 0x44, 0xae, 0x4d, 0x57, 0x34, 0x37, 0xba, 0x5b, 0x15, 0x38,
+	0x8b, 0x75, 0x29, 0xeb, 0xb3, 0xb2, 0xfe, 0x89, 0x36, 0x55, 0x0a, 0xbd, 0x92, 0xfb, 0x7a, 0xb9,
+	0xe7, 0xad, 0x2c, 0x22, 0xc1, 0x92, 0xa2, 0xbb, 0x50, 0xd5, 0xe4, 0xef, 0xd6, 0xb5, 0xfe, 0xf3,
+	0x65, 0x8c, 0x7e, 0x76, 0x26, 0xc6, 0x37, 0x2a, 0xec, 0xfc, 0xb4, 0x65, 0x3e, 0xb7, 0x7e, 0x89,
+	0xc0, 0x45, 0x7a, 0xb9, 0x8a, 0x3e, 0x17, 0xea, 0xc5, 0x4a, 0x6c, 0xee, 0x05, 0xc6, 0xee, 0x6c,
+	0xbb, 0x54, 0x3f, 0x8f, 0x58, 0x20, 0x9f, 0xdb, 0xb2, 0x90, 0x2f, 0x05, 0x63, 0x5f, 0xe0, 0x8e,
+	0x5d, 0x1b, 0x92, 0xf1, 0x49, 0x1d, 0xf5, 0x6b, 0x1e, 0x4d, 0x1f, 0xc5, 0xef, 0x7b, 0x6a, 0x26,
+	0x89, 0x2f, 0x49, 0x13, 0x38, 0xdb, 0x97, 0xde, 0x97, 0x93, 0xe4, 0xce, 0xd6, 0x0d, 0x92, 0x85,
+	0x1b, 0xfb, 0x00, 0x3a, 0xea, 0x96, 0x7b, 0xba, 0xaf, 0x69, 0x3f, 0x85, 0xe2, 0xca, 0x96, 0x6d,
+	0x4b, 0xda, 0x2f, 0xe7, 0xb6, 0xad, 0x1f, 0x9d, 0x09, 0xb0, 0x3b, 0xa8, 0x04, 0x8b, 0x65, 0x7e,
+	0xa4, 0x94, 0x93, 0xb7, 0xba, 0xee, 0xeb, 0x66, 0x63, 0xe1, 0xdc, 0x3a, 0x04, 0x5e, 0x42, 0x77,
+	0x4a, 0x2b, 0x31, 0xc9, 0x97, 0xc3, 0xcf, 0x82, 0x25, 0x7e, 0xd3, 0xe8, 0x76, 0x15, 0x01, 0xc8,
+	0x20, 0x56, 0x5d, 0xb0, 0xee, 0x56, 0x05, 0x1c, 0x2f, 0xa1, 0xcf, 0x60, 0xdb, 0xea, 0x1d, 0x94,
+	0x26, 0x53, 0xd7, 0x5a, 0xe8, 0xd6, 0x21, 0xf0, 0x12, 0xda, 0x87, 0x0b, 0x16, 0x50, 0xb8, 0xc2,
+	0x9b, 0x35, 0x53, 0x72, 0x6f, 0x18, 0xd4, 0x11, 0xe0, 0x25, 0xb4, 0x57, 0xe2, 0x50, 0x5a, 0xcd,
+	0xc5, 0x9a, 0x49, 0x0b, 0xed, 0xe7, 0x71, 0x55, 0x33, 0x24, 0x93, 0xdb, 0x15, 0x73, 0x35, 0xab,
+	0x57, 0xd2, 0x75, 0xeb, 0xd1, 0x78, 0x09, 0x0d, 0x61, 0xcd, 0xec, 0xac, 0xb3, 0x99, 0xd3, 0xda,
+	0x08, 0x5d, 0xb7, 0x0e, 0xc5, 0x7d, 0xff, 0x33, 0xd8, 0xb2, 0x5b, 0xbc, 0x98, 0x7d, 0xd5, 0xf6,
+	0x7f, 0xb9, 0xb5, 0x18, 0xbc, 0x84, 0xbe, 0x82, 0x6d, 0x1b, 0x2a, 0x34, 0x72, 0xad, 0x6e, 0x52,
+	0x5d, 0x80, 0xd2, 0x28, 0x78, 0xc0, 0xdb, 0xb1, 0xc1, 0x52, 0x29, 0x6e, 0xdd, 0xb4, 0x53, 0xc2,
+	0xb9, 0xd9, 0xb6, 0xf6, 0x40, 0xdf, 0xb1, 0xdd, 0xd0, 0xe6, 0x6e, 0x57, 0x62, 0xf8, 0x32, 0x5b,
+	0xc6, 0xa3, 0xb6, 0x34, 0xe5, 0xea, 0x17, 0x6f, 0xb7, 0x1a, 0x8c, 0x97, 0xd0, 0x43, 0x40, 0xe5,
+	0x66, 0x81, 0xc2, 0x40, 0x2a, 0x1b, 0x09, 0xdc, 0x9d, 0x6a, 0x34, 0x5e, 0x42, 0xf7, 0xcc, 0x87,
+	0x73, 0xe3, 0x90, 0xb1, 0x5e, 0xd4, 0xdd, 0xed, 0x2a, 0x44, 0x26, 0x12, 0x0d, 0xa3, 0xff, 0xa2,
+	0x10, 0x8f, 0xdd, 0x96, 0xe1, 0x6e, 0x96, 0x30, 0x3c, 0x2d, 0xeb, 0xeb, 0x1d, 0x17, 0x05, 0x07,
+	0x56, 0x1f, 0x46, 0xf5, 0xec, 0xdb, 0xc5, 0xbb, 0xf0, 0xbe, 0x1e, 0xa2, 0x14, 0xd0, 0x2d, 0x41,
+	0xf0, 0x12, 0xfa, 0x5e, 0x31, 0xa9, 0x7c, 0xa2, 0x88, 0x17, 0xe6, 0xca, 0x79, 0xf7, 0xe4, 0x53,
+	0xb7, 0x7c, 0x27, 0xb5, 0x84, 0x55, 0x3c, 0x24, 0xbb, 0x83, 0x6a, 0x04, 0x77, 0xa3, 0x2f, 0x2b,
+	0x9e, 0x42, 0x19, 0x17, 0x97, 0x2b, 0x9e, 0xcd, 0x0a, 0xcb, 0xba, 0x58, 0x8b, 0xe5, 0xfb, 0x59,
+	0x53, 0x55, 0x97, 0xd2, 0xb1, 0x2a, 0xe1, 0x6e, 0x09, 0x82, 0x97, 0xd0, 0x77, 0xa1, 0xa7, 0x46,
+	0x8c, 0x03, 0x64, 0x93, 0x0c, 0x83, 0xca, 0x69, 0x1f, 0x17, 0x25, 0x38, 0x5b, 0xe3, 0xd6, 0x9b,
+	0x92, 0xbb, 0x59, 0xc2, 0x98, 0xec, 0x96, 0xce, 0xe6, 0x45, 0xec, 0x7e, 0x5c, 0xcc, 0x93, 0x3e,
+	0x5d, 0xc5, 0xf1, 0x22, 0x5f, 0xde, 0x32, 0xea, 0xa7, 0xb6, 0x13, 0x1a, 0x48, 0xb7, 0x1a, 0xcc,
+	0xad, 0x60, 0xd5, 0x28, 0x67, 0x17, 0xdb, 0xb7, 0xab, 0xe6, 0xee, 0x4e, 0x25, 0x26, 0xe3, 0x99,
+	0xee, 0x86, 0xb1, 0xae, 0xe1, 0x7b, 0xd6, 0x53, 0x45, 0x3d, 0x2b, 0x0f, 0x01, 0x95, 0x9f, 0x2c,
+	0x8a, 0x78, 0x50, 0xf9, 0x9c, 0xe1, 0xee, 0x54, 0xa3, 0x2b, 0x04, 0x24, 0xb5, 0x73, 0x5e, 0x01,
+	0x7d, 0x59, 0x59, 0x71, 0x67, 0xee, 0x79, 0xb5, 0x72, 0x4a, 0x5e, 0x91, 0x5f, 0xb4, 0xd1, 0x8a,
+	0x0a, 0xfc, 0x09, 0x29, 0x9c, 0xa6, 0xaa, 0x30, 0xbe, 0x90, 0xc3, 0x8a, 0x62, 0xa8, 0x11, 0xdb,
+	0x2d, 0xfc, 0xb1, 0x7b, 0xa9, 0x06, 0x23, 0x7d, 0xf0, 0x7d, 0xad, 0xbe, 0x23, 0xda, 0xff, 0xcb,
+	0xa5, 0x04, 0xb7, 0x0c, 0x92, 0x67, 0x83, 0x71, 0x67, 0xbf, 0xcf, 0xae, 0xff, 0xc5, 0x39, 0x5d,
+	0xaa, 0x2c, 0x54, 0x2f, 0xf3, 0x11, 0xf4, 0xf5, 0x22, 0x8c, 0x16, 0x9c, 0xcc, 0xd2, 0x4c, 0xf5,
+	0xec, 0x3b, 0x1a, 0xfb, 0xc2, 0x8a, 0xca, 0xdf, 0xcf, 0x2d, 0x08, 0x95, 0x51, 0x32, 0x2c, 0xe8,
+	0x75, 0x01, 0x4d, 0x96, 0x56, 0xb9, 0xa0, 0x9a, 0x85, 0x1f, 0x68, 0x55, 0xa4, 0x7b, 0xec, 0x74,
+	0x89, 0xb4, 0xc8, 0x5c, 0x14, 0x4d, 0x2a, 0x27, 0xdf, 0xdd, 0xf8, 0xe7, 0xaf, 0xaf, 0x3a, 0xbf,
+	0xf8, 0xfa, 0xaa, 0xf3, 0x1f, 0x5f, 0x5f, 0x75, 0xfe, 0xfc, 0x97, 0x57, 0x97, 0x0e, 0xda, 0xdc,
+	0xff, 0x6f, 0xff, 0xdf, 0x00, 0x7d, 0x17, 0x61, 0x67, 0x5a, 0x43, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DoctorServiceClient is the client API for DoctorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DoctorServiceClient interface {
+	DoctorCreate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
+	DoctorGet(ctx context.Context, in *GetDoctorReq, opts ...grpc.CallOption) (*Doctor, error)
+	DoctorsFind(ctx context.Context, in *DoctorsFindReq, opts ...grpc.CallOption) (*DoctorsResp, error)
+	DoctorsGetByIds(ctx context.Context, in *DoctorIds, opts ...grpc.CallOption) (*DoctorsByIdsResp, error)
+	DoctorUpdate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error)
+	DoctorDelete(ctx context.Context, in *DoctorId, opts ...grpc.CallOption) (*empty.Empty, error)
+	DoctorTypeGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DoctorTypes, error)
+	// Specialties
+	SpecialtyCreate(ctx context.Context, in *Specialty, opts ...grpc.CallOption) (*Specialty, error)
+	SpecialtyGet(ctx context.Context, in *SpecialtyId, opts ...grpc.CallOption) (*Specialty, error)
+	SpecialtiesFind(ctx context.Context, in *SpecialtiesFindReq, opts ...grpc.CallOption) (*Specialties, error)
+	SpecialtyUpdate(ctx context.Context, in *Specialty, opts ...grpc.CallOption) (*Specialty, error)
+	SpecialtyDelete(ctx context.Context, in *SpecialtyId, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Doctor reports...
+	DoctorReportCreate(ctx context.Context, in *DoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error)
+	DoctorReportGet(ctx context.Context, in *GetDoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error)
+	DoctorReportsFind(ctx context.Context, in *DoctorReportsFindReq, opts ...grpc.CallOption) (*DoctorReportsResp, error)
+	DoctorReportDelete(ctx context.Context, in *ReportId, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Sqlad
+	SqladCreate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error)
+	SqladGet(ctx context.Context, in *SqladGetReq, opts ...grpc.CallOption) (*SqladRes, error)
+	SqladUpdate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error)
+	SqladDelete(ctx context.Context, in *SqladId, opts ...grpc.CallOption) (*empty.Empty, error)
+	LowStock(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LowStockRes, error)
+	// Doctor page
+	DoctorPageFilter(ctx context.Context, in *DocPageFilter, opts ...grpc.CallOption) (*DocPageFilterRes, error)
+	// Working hours
+	DoctorWorkHoursSet(ctx context.Context, in *DoctorWorkHours, opts ...grpc.CallOption) (*DoctorWorkHours, error)
+	DoctorWorkHoursGet(ctx context.Context, in *DoctorId, opts ...grpc.CallOption) (*DoctorWorkHours, error)
+	DoctorSlotsGet(ctx context.Context, in *DoctorSlotsReq, opts ...grpc.CallOption) (*DoctorSlots, error)
+	// Schedule exceptions
+	DoctorExceptionCreate(ctx context.Context, in *DoctorException, opts ...grpc.CallOption) (*DoctorException, error)
+	DoctorExceptionsFind(ctx context.Context, in *DoctorExceptionsFindReq, opts ...grpc.CallOption) (*DoctorExceptions, error)
+	DoctorExceptionDelete(ctx context.Context, in *DoctorExceptionId, opts ...grpc.CallOption) (*empty.Empty, error)
+	DoctorAvailabilityGet(ctx context.Context, in *DoctorAvailabilityReq, opts ...grpc.CallOption) (*DoctorAvailability, error)
+	DoctorsWorking(ctx context.Context, in *DoctorsWorkingReq, opts ...grpc.CallOption) (*DoctorsWorkingResp, error)
+	// Commissions
+	DoctorCommissionSet(ctx context.Context, in *DoctorCommission, opts ...grpc.CallOption) (*DoctorCommission, error)
+	DoctorCommissionsFind(ctx context.Context, in *DoctorCommissionsFindReq, opts ...grpc.CallOption) (*DoctorCommissions, error)
+	DoctorCommissionDelete(ctx context.Context, in *DoctorCommissionId, opts ...grpc.CallOption) (*empty.Empty, error)
+	PayrollReportGet(ctx context.Context, in *PayrollReportReq, opts ...grpc.CallOption) (*PayrollReport, error)
+	// Sqlad stock
+	SqladMovementCreate(ctx context.Context, in *SqladMovement, opts ...grpc.CallOption) (*SqladMovement, error)
+	SqladMovementsFind(ctx context.Context, in *SqladMovementsFindReq, opts ...grpc.CallOption) (*SqladMovements, error)
+	SqladBalanceGet(ctx context.Context, in *SqladBalanceReq, opts ...grpc.CallOption) (*SqladBalances, error)
+	SqladLotsFind(ctx context.Context, in *SqladLotsFindReq, opts ...grpc.CallOption) (*SqladLots, error)
+	ExpiringLots(ctx context.Context, in *ExpiringLotsReq, opts ...grpc.CallOption) (*SqladLots, error)
+	// Sqlad consumption
+	SqladBomSet(ctx context.Context, in *SqladBom, opts ...grpc.CallOption) (*SqladBom, error)
+	SqladBomGet(ctx context.Context, in *SqladBomReq, opts ...grpc.CallOption) (*SqladBom, error)
+	SqladConsume(ctx context.Context, in *SqladConsumeReq, opts ...grpc.CallOption) (*SqladConsumeResp, error)
+	ConsumptionReportGet(ctx context.Context, in *ConsumptionReportReq, opts ...grpc.CallOption) (*ConsumptionReport, error)
+	// Suppliers
+	SupplierCreate(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error)
+	SupplierGet(ctx context.Context, in *SupplierId, opts ...grpc.CallOption) (*Supplier, error)
+	SuppliersFind(ctx context.Context, in *SuppliersFindReq, opts ...grpc.CallOption) (*Suppliers, error)
+	SupplierUpdate(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error)
+	SupplierDelete(ctx context.Context, in *SupplierId, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Purchase orders
+	PurchaseOrderCreate(ctx context.Context, in *PurchaseOrder, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	LowStockOrder(ctx context.Context, in *LowStockOrderReq, opts ...grpc.CallOption) (*LowStockOrders, error)
+	PurchaseOrderGet(ctx context.Context, in *PurchaseOrderId, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	PurchaseOrdersFind(ctx context.Context, in *PurchaseOrdersFindReq, opts ...grpc.CallOption) (*PurchaseOrders, error)
+	PurchaseOrderUpdate(ctx context.Context, in *PurchaseOrder, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	PurchaseOrderStatusSet(ctx context.Context, in *PurchaseOrderStatusReq, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	PurchaseOrderReceive(ctx context.Context, in *PurchaseOrderReceipt, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	SupplierSpendReportGet(ctx context.Context, in *SupplierSpendReq, opts ...grpc.CallOption) (*SupplierSpendReport, error)
+	// Stocktakes
+	StocktakeStart(ctx context.Context, in *Stocktake, opts ...grpc.CallOption) (*Stocktake, error)
+	StocktakeCountEnter(ctx context.Context, in *StocktakeCountReq, opts ...grpc.CallOption) (*Stocktake, error)
+	StocktakeGet(ctx context.Context, in *StocktakeGetReq, opts ...grpc.CallOption) (*Stocktake, error)
+	StocktakesFind(ctx context.Context, in *StocktakesFindReq, opts ...grpc.CallOption) (*Stocktakes, error)
+	StocktakePost(ctx context.Context, in *StocktakePostReq, opts ...grpc.CallOption) (*Stocktake, error)
+	StocktakeCancel(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*Stocktake, error)
+}
+
+type doctorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDoctorServiceClient(cc *grpc.ClientConn) DoctorServiceClient {
+	return &doctorServiceClient{cc}
+}
+
+func (c *doctorServiceClient) DoctorCreate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorGet(ctx context.Context, in *GetDoctorReq, opts ...grpc.CallOption) (*Doctor, error) {
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorsFind(ctx context.Context, in *DoctorsFindReq, opts ...grpc.CallOption) (*DoctorsResp, error) {
+	out := new(DoctorsResp)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorsFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorsGetByIds(ctx context.Context, in *DoctorIds, opts ...grpc.CallOption) (*DoctorsByIdsResp, error) {
+	out := new(DoctorsByIdsResp)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorsGetByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorUpdate(ctx context.Context, in *Doctor, opts ...grpc.CallOption) (*Doctor, error) {
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorDelete(ctx context.Context, in *DoctorId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorTypeGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DoctorTypes, error) {
+	out := new(DoctorTypes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorTypeGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SpecialtyCreate(ctx context.Context, in *Specialty, opts ...grpc.CallOption) (*Specialty, error) {
+	out := new(Specialty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SpecialtyCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SpecialtyGet(ctx context.Context, in *SpecialtyId, opts ...grpc.CallOption) (*Specialty, error) {
+	out := new(Specialty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SpecialtyGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SpecialtiesFind(ctx context.Context, in *SpecialtiesFindReq, opts ...grpc.CallOption) (*Specialties, error) {
+	out := new(Specialties)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SpecialtiesFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SpecialtyUpdate(ctx context.Context, in *Specialty, opts ...grpc.CallOption) (*Specialty, error) {
+	out := new(Specialty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SpecialtyUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SpecialtyDelete(ctx context.Context, in *SpecialtyId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SpecialtyDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorReportCreate(ctx context.Context, in *DoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error) {
+	out := new(DoctorReportRes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorReportGet(ctx context.Context, in *GetDoctorReport, opts ...grpc.CallOption) (*DoctorReportRes, error) {
+	out := new(DoctorReportRes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorReportsFind(ctx context.Context, in *DoctorReportsFindReq, opts ...grpc.CallOption) (*DoctorReportsResp, error) {
+	out := new(DoctorReportsResp)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportsFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorReportDelete(ctx context.Context, in *ReportId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorReportDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladCreate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error) {
+	out := new(SqladRes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladGet(ctx context.Context, in *SqladGetReq, opts ...grpc.CallOption) (*SqladRes, error) {
+	out := new(SqladRes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladUpdate(ctx context.Context, in *SqladReq, opts ...grpc.CallOption) (*SqladRes, error) {
+	out := new(SqladRes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladDelete(ctx context.Context, in *SqladId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) LowStock(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LowStockRes, error) {
+	out := new(LowStockRes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/LowStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorPageFilter(ctx context.Context, in *DocPageFilter, opts ...grpc.CallOption) (*DocPageFilterRes, error) {
+	out := new(DocPageFilterRes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorPageFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorWorkHoursSet(ctx context.Context, in *DoctorWorkHours, opts ...grpc.CallOption) (*DoctorWorkHours, error) {
+	out := new(DoctorWorkHours)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorWorkHoursSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorWorkHoursGet(ctx context.Context, in *DoctorId, opts ...grpc.CallOption) (*DoctorWorkHours, error) {
+	out := new(DoctorWorkHours)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorWorkHoursGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorSlotsGet(ctx context.Context, in *DoctorSlotsReq, opts ...grpc.CallOption) (*DoctorSlots, error) {
+	out := new(DoctorSlots)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorSlotsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorExceptionCreate(ctx context.Context, in *DoctorException, opts ...grpc.CallOption) (*DoctorException, error) {
+	out := new(DoctorException)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorExceptionCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorExceptionsFind(ctx context.Context, in *DoctorExceptionsFindReq, opts ...grpc.CallOption) (*DoctorExceptions, error) {
+	out := new(DoctorExceptions)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorExceptionsFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorExceptionDelete(ctx context.Context, in *DoctorExceptionId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorExceptionDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorAvailabilityGet(ctx context.Context, in *DoctorAvailabilityReq, opts ...grpc.CallOption) (*DoctorAvailability, error) {
+	out := new(DoctorAvailability)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorAvailabilityGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorsWorking(ctx context.Context, in *DoctorsWorkingReq, opts ...grpc.CallOption) (*DoctorsWorkingResp, error) {
+	out := new(DoctorsWorkingResp)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorsWorking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorCommissionSet(ctx context.Context, in *DoctorCommission, opts ...grpc.CallOption) (*DoctorCommission, error) {
+	out := new(DoctorCommission)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorCommissionSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorCommissionsFind(ctx context.Context, in *DoctorCommissionsFindReq, opts ...grpc.CallOption) (*DoctorCommissions, error) {
+	out := new(DoctorCommissions)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorCommissionsFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DoctorCommissionDelete(ctx context.Context, in *DoctorCommissionId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/DoctorCommissionDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) PayrollReportGet(ctx context.Context, in *PayrollReportReq, opts ...grpc.CallOption) (*PayrollReport, error) {
+	out := new(PayrollReport)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/PayrollReportGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladMovementCreate(ctx context.Context, in *SqladMovement, opts ...grpc.CallOption) (*SqladMovement, error) {
+	out := new(SqladMovement)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladMovementCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladMovementsFind(ctx context.Context, in *SqladMovementsFindReq, opts ...grpc.CallOption) (*SqladMovements, error) {
+	out := new(SqladMovements)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladMovementsFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladBalanceGet(ctx context.Context, in *SqladBalanceReq, opts ...grpc.CallOption) (*SqladBalances, error) {
+	out := new(SqladBalances)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladBalanceGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladLotsFind(ctx context.Context, in *SqladLotsFindReq, opts ...grpc.CallOption) (*SqladLots, error) {
+	out := new(SqladLots)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladLotsFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) ExpiringLots(ctx context.Context, in *ExpiringLotsReq, opts ...grpc.CallOption) (*SqladLots, error) {
+	out := new(SqladLots)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/ExpiringLots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladBomSet(ctx context.Context, in *SqladBom, opts ...grpc.CallOption) (*SqladBom, error) {
+	out := new(SqladBom)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladBomSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladBomGet(ctx context.Context, in *SqladBomReq, opts ...grpc.CallOption) (*SqladBom, error) {
+	out := new(SqladBom)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladBomGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SqladConsume(ctx context.Context, in *SqladConsumeReq, opts ...grpc.CallOption) (*SqladConsumeResp, error) {
+	out := new(SqladConsumeResp)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SqladConsume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) ConsumptionReportGet(ctx context.Context, in *ConsumptionReportReq, opts ...grpc.CallOption) (*ConsumptionReport, error) {
+	out := new(ConsumptionReport)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/ConsumptionReportGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SupplierCreate(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error) {
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SupplierCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SupplierGet(ctx context.Context, in *SupplierId, opts ...grpc.CallOption) (*Supplier, error) {
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SupplierGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SuppliersFind(ctx context.Context, in *SuppliersFindReq, opts ...grpc.CallOption) (*Suppliers, error) {
+	out := new(Suppliers)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SuppliersFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SupplierUpdate(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error) {
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SupplierUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SupplierDelete(ctx context.Context, in *SupplierId, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SupplierDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) PurchaseOrderCreate(ctx context.Context, in *PurchaseOrder, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/PurchaseOrderCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) LowStockOrder(ctx context.Context, in *LowStockOrderReq, opts ...grpc.CallOption) (*LowStockOrders, error) {
+	out := new(LowStockOrders)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/LowStockOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) PurchaseOrderGet(ctx context.Context, in *PurchaseOrderId, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/PurchaseOrderGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) PurchaseOrdersFind(ctx context.Context, in *PurchaseOrdersFindReq, opts ...grpc.CallOption) (*PurchaseOrders, error) {
+	out := new(PurchaseOrders)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/PurchaseOrdersFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) PurchaseOrderUpdate(ctx context.Context, in *PurchaseOrder, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/PurchaseOrderUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) PurchaseOrderStatusSet(ctx context.Context, in *PurchaseOrderStatusReq, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/PurchaseOrderStatusSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) PurchaseOrderReceive(ctx context.Context, in *PurchaseOrderReceipt, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/PurchaseOrderReceive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SupplierSpendReportGet(ctx context.Context, in *SupplierSpendReq, opts ...grpc.CallOption) (*SupplierSpendReport, error) {
+	out := new(SupplierSpendReport)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/SupplierSpendReportGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) StocktakeStart(ctx context.Context, in *Stocktake, opts ...grpc.CallOption) (*Stocktake, error) {
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/StocktakeStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) StocktakeCountEnter(ctx context.Context, in *StocktakeCountReq, opts ...grpc.CallOption) (*Stocktake, error) {
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/StocktakeCountEnter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) StocktakeGet(ctx context.Context, in *StocktakeGetReq, opts ...grpc.CallOption) (*Stocktake, error) {
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/StocktakeGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) StocktakesFind(ctx context.Context, in *StocktakesFindReq, opts ...grpc.CallOption) (*Stocktakes, error) {
+	out := new(Stocktakes)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/StocktakesFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) StocktakePost(ctx context.Context, in *StocktakePostReq, opts ...grpc.CallOption) (*Stocktake, error) {
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/StocktakePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) StocktakeCancel(ctx context.Context, in *StocktakeId, opts ...grpc.CallOption) (*Stocktake, error) {
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, "/doctor.DoctorService/StocktakeCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoctorServiceServer is the server API for DoctorService service.
+type DoctorServiceServer interface {
+	DoctorCreate(context.Context, *Doctor) (*Doctor, error)
+	DoctorGet(context.Context, *GetDoctorReq) (*Doctor, error)
+	DoctorsFind(context.Context, *DoctorsFindReq) (*DoctorsResp, error)
+	DoctorsGetByIds(context.Context, *DoctorIds) (*DoctorsByIdsResp, error)
+	DoctorUpdate(context.Context, *Doctor) (*Doctor, error)
+	DoctorDelete(context.Context, *DoctorId) (*empty.Empty, error)
+	DoctorTypeGet(context.Context, *empty.Empty) (*DoctorTypes, error)
+	// Specialties
+	SpecialtyCreate(context.Context, *Specialty) (*Specialty, error)
+	SpecialtyGet(context.Context, *SpecialtyId) (*Specialty, error)
+	SpecialtiesFind(context.Context, *SpecialtiesFindReq) (*Specialties, error)
+	SpecialtyUpdate(context.Context, *Specialty) (*Specialty, error)
+	SpecialtyDelete(context.Context, *SpecialtyId) (*empty.Empty, error)
+	// Doctor reports...
+	DoctorReportCreate(context.Context, *DoctorReport) (*DoctorReportRes, error)
+	DoctorReportGet(context.Context, *GetDoctorReport) (*DoctorReportRes, error)
+	DoctorReportsFind(context.Context, *DoctorReportsFindReq) (*DoctorReportsResp, error)
+	DoctorReportDelete(context.Context, *ReportId) (*empty.Empty, error)
+	// Sqlad
+	SqladCreate(context.Context, *SqladReq) (*SqladRes, error)
+	SqladGet(context.Context, *SqladGetReq) (*SqladRes, error)
+	SqladUpdate(context.Context, *SqladReq) (*SqladRes, error)
+	SqladDelete(context.Context, *SqladId) (*empty.Empty, error)
+	LowStock(context.Context, *empty.Empty) (*LowStockRes, error)
+	// Doctor page
+	DoctorPageFilter(context.Context, *DocPageFilter) (*DocPageFilterRes, error)
+	// Working hours
+	DoctorWorkHoursSet(context.Context, *DoctorWorkHours) (*DoctorWorkHours, error)
+	DoctorWorkHoursGet(context.Context, *DoctorId) (*DoctorWorkHours, error)
+	DoctorSlotsGet(context.Context, *DoctorSlotsReq) (*DoctorSlots, error)
+	// Schedule exceptions
+	DoctorExceptionCreate(context.Context, *DoctorException) (*DoctorException, error)
+	DoctorExceptionsFind(context.Context, *DoctorExceptionsFindReq) (*DoctorExceptions, error)
+	DoctorExceptionDelete(context.Context, *DoctorExceptionId) (*empty.Empty, error)
+	DoctorAvailabilityGet(context.Context, *DoctorAvailabilityReq) (*DoctorAvailability, error)
+	DoctorsWorking(context.Context, *DoctorsWorkingReq) (*DoctorsWorkingResp, error)
+	// Commissions
+	DoctorCommissionSet(context.Context, *DoctorCommission) (*DoctorCommission, error)
+	DoctorCommissionsFind(context.Context, *DoctorCommissionsFindReq) (*DoctorCommissions, error)
+	DoctorCommissionDelete(context.Context, *DoctorCommissionId) (*empty.Empty, error)
+	PayrollReportGet(context.Context, *PayrollReportReq) (*PayrollReport, error)
+	// Sqlad stock
+	SqladMovementCreate(context.Context, *SqladMovement) (*SqladMovement, error)
+	SqladMovementsFind(context.Context, *SqladMovementsFindReq) (*SqladMovements, error)
+	SqladBalanceGet(context.Context, *SqladBalanceReq) (*SqladBalances, error)
+	SqladLotsFind(context.Context, *SqladLotsFindReq) (*SqladLots, error)
+	ExpiringLots(context.Context, *ExpiringLotsReq) (*SqladLots, error)
+	// Sqlad consumption
+	SqladBomSet(context.Context, *SqladBom) (*SqladBom, error)
+	SqladBomGet(context.Context, *SqladBomReq) (*SqladBom, error)
+	SqladConsume(context.Context, *SqladConsumeReq) (*SqladConsumeResp, error)
+	ConsumptionReportGet(context.Context, *ConsumptionReportReq) (*ConsumptionReport, error)
+	// Suppliers
+	SupplierCreate(context.Context, *Supplier) (*Supplier, error)
+	SupplierGet(context.Context, *SupplierId) (*Supplier, error)
+	SuppliersFind(context.Context, *SuppliersFindReq) (*Suppliers, error)
+	SupplierUpdate(context.Context, *Supplier) (*Supplier, error)
+	SupplierDelete(context.Context, *SupplierId) (*empty.Empty, error)
+	// Purchase orders
+	PurchaseOrderCreate(context.Context, *PurchaseOrder) (*PurchaseOrder, error)
+	LowStockOrder(context.Context, *LowStockOrderReq) (*LowStockOrders, error)
+	PurchaseOrderGet(context.Context, *PurchaseOrderId) (*PurchaseOrder, error)
+	PurchaseOrdersFind(context.Context, *PurchaseOrdersFindReq) (*PurchaseOrders, error)
+	PurchaseOrderUpdate(context.Context, *PurchaseOrder) (*PurchaseOrder, error)
+	PurchaseOrderStatusSet(context.Context, *PurchaseOrderStatusReq) (*PurchaseOrder, error)
+	PurchaseOrderReceive(context.Context, *PurchaseOrderReceipt) (*PurchaseOrder, error)
+	SupplierSpendReportGet(context.Context, *SupplierSpendReq) (*SupplierSpendReport, error)
+	// Stocktakes
+	StocktakeStart(context.Context, *Stocktake) (*Stocktake, error)
+	StocktakeCountEnter(context.Context, *StocktakeCountReq) (*Stocktake, error)
+	StocktakeGet(context.Context, *StocktakeGetReq) (*Stocktake, error)
+	StocktakesFind(context.Context, *StocktakesFindReq) (*Stocktakes, error)
+	StocktakePost(context.Context, *StocktakePostReq) (*Stocktake, error)
+	StocktakeCancel(context.Context, *StocktakeId) (*Stocktake, error)
+}
+
+// UnimplementedDoctorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDoctorServiceServer struct {
+}
+
+func (*UnimplementedDoctorServiceServer) DoctorCreate(ctx context.Context, req *Doctor) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorGet(ctx context.Context, req *GetDoctorReq) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorsFind(ctx context.Context, req *DoctorsFindReq) (*DoctorsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorsFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorsGetByIds(ctx context.Context, req *DoctorIds) (*DoctorsByIdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorsGetByIds not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorUpdate(ctx context.Context, req *Doctor) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorUpdate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorDelete(ctx context.Context, req *DoctorId) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorDelete not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorTypeGet(ctx context.Context, req *empty.Empty) (*DoctorTypes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorTypeGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SpecialtyCreate(ctx context.Context, req *Specialty) (*Specialty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecialtyCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SpecialtyGet(ctx context.Context, req *SpecialtyId) (*Specialty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecialtyGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SpecialtiesFind(ctx context.Context, req *SpecialtiesFindReq) (*Specialties, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecialtiesFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SpecialtyUpdate(ctx context.Context, req *Specialty) (*Specialty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecialtyUpdate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SpecialtyDelete(ctx context.Context, req *SpecialtyId) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecialtyDelete not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorReportCreate(ctx context.Context, req *DoctorReport) (*DoctorReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorReportGet(ctx context.Context, req *GetDoctorReport) (*DoctorReportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorReportsFind(ctx context.Context, req *DoctorReportsFindReq) (*DoctorReportsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportsFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorReportDelete(ctx context.Context, req *ReportId) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorReportDelete not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladCreate(ctx context.Context, req *SqladReq) (*SqladRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladGet(ctx context.Context, req *SqladGetReq) (*SqladRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladUpdate(ctx context.Context, req *SqladReq) (*SqladRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladUpdate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladDelete(ctx context.Context, req *SqladId) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladDelete not implemented")
+}
+func (*UnimplementedDoctorServiceServer) LowStock(ctx context.Context, req *empty.Empty) (*LowStockRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LowStock not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorPageFilter(ctx context.Context, req *DocPageFilter) (*DocPageFilterRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorPageFilter not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorWorkHoursSet(ctx context.Context, req *DoctorWorkHours) (*DoctorWorkHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorWorkHoursSet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorWorkHoursGet(ctx context.Context, req *DoctorId) (*DoctorWorkHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorWorkHoursGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorSlotsGet(ctx context.Context, req *DoctorSlotsReq) (*DoctorSlots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorSlotsGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorExceptionCreate(ctx context.Context, req *DoctorException) (*DoctorException, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorExceptionCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorExceptionsFind(ctx context.Context, req *DoctorExceptionsFindReq) (*DoctorExceptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorExceptionsFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorExceptionDelete(ctx context.Context, req *DoctorExceptionId) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorExceptionDelete not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorAvailabilityGet(ctx context.Context, req *DoctorAvailabilityReq) (*DoctorAvailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorAvailabilityGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorsWorking(ctx context.Context, req *DoctorsWorkingReq) (*DoctorsWorkingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorsWorking not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorCommissionSet(ctx context.Context, req *DoctorCommission) (*DoctorCommission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorCommissionSet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorCommissionsFind(ctx context.Context, req *DoctorCommissionsFindReq) (*DoctorCommissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorCommissionsFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) DoctorCommissionDelete(ctx context.Context, req *DoctorCommissionId) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoctorCommissionDelete not implemented")
+}
+func (*UnimplementedDoctorServiceServer) PayrollReportGet(ctx context.Context, req *PayrollReportReq) (*PayrollReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayrollReportGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladMovementCreate(ctx context.Context, req *SqladMovement) (*SqladMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladMovementCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladMovementsFind(ctx context.Context, req *SqladMovementsFindReq) (*SqladMovements, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladMovementsFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladBalanceGet(ctx context.Context, req *SqladBalanceReq) (*SqladBalances, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladBalanceGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladLotsFind(ctx context.Context, req *SqladLotsFindReq) (*SqladLots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladLotsFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) ExpiringLots(ctx context.Context, req *ExpiringLotsReq) (*SqladLots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringLots not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladBomSet(ctx context.Context, req *SqladBom) (*SqladBom, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladBomSet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladBomGet(ctx context.Context, req *SqladBomReq) (*SqladBom, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladBomGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SqladConsume(ctx context.Context, req *SqladConsumeReq) (*SqladConsumeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqladConsume not implemented")
+}
+func (*UnimplementedDoctorServiceServer) ConsumptionReportGet(ctx context.Context, req *ConsumptionReportReq) (*ConsumptionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumptionReportGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SupplierCreate(ctx context.Context, req *Supplier) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SupplierGet(ctx context.Context, req *SupplierId) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SuppliersFind(ctx context.Context, req *SuppliersFindReq) (*Suppliers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuppliersFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SupplierUpdate(ctx context.Context, req *Supplier) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierUpdate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SupplierDelete(ctx context.Context, req *SupplierId) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierDelete not implemented")
+}
+func (*UnimplementedDoctorServiceServer) PurchaseOrderCreate(ctx context.Context, req *PurchaseOrder) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseOrderCreate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) LowStockOrder(ctx context.Context, req *LowStockOrderReq) (*LowStockOrders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LowStockOrder not implemented")
+}
+func (*UnimplementedDoctorServiceServer) PurchaseOrderGet(ctx context.Context, req *PurchaseOrderId) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseOrderGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) PurchaseOrdersFind(ctx context.Context, req *PurchaseOrdersFindReq) (*PurchaseOrders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseOrdersFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) PurchaseOrderUpdate(ctx context.Context, req *PurchaseOrder) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseOrderUpdate not implemented")
+}
+func (*UnimplementedDoctorServiceServer) PurchaseOrderStatusSet(ctx context.Context, req *PurchaseOrderStatusReq) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseOrderStatusSet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) PurchaseOrderReceive(ctx context.Context, req *PurchaseOrderReceipt) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseOrderReceive not implemented")
+}
+func (*UnimplementedDoctorServiceServer) SupplierSpendReportGet(ctx context.Context, req *SupplierSpendReq) (*SupplierSpendReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierSpendReportGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) StocktakeStart(ctx context.Context, req *Stocktake) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StocktakeStart not implemented")
+}
+func (*UnimplementedDoctorServiceServer) StocktakeCountEnter(ctx context.Context, req *StocktakeCountReq) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StocktakeCountEnter not implemented")
+}
+func (*UnimplementedDoctorServiceServer) StocktakeGet(ctx context.Context, req *StocktakeGetReq) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StocktakeGet not implemented")
+}
+func (*UnimplementedDoctorServiceServer) StocktakesFind(ctx context.Context, req *StocktakesFindReq) (*Stocktakes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StocktakesFind not implemented")
+}
+func (*UnimplementedDoctorServiceServer) StocktakePost(ctx context.Context, req *StocktakePostReq) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StocktakePost not implemented")
+}
+func (*UnimplementedDoctorServiceServer) StocktakeCancel(ctx context.Context, req *StocktakeId) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StocktakeCancel not implemented")
+}
+
+func RegisterDoctorServiceServer(s *grpc.Server, srv DoctorServiceServer) {
+	s.RegisterService(&_DoctorService_serviceDesc, srv)
+}
+
+func _DoctorService_DoctorCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Doctor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorCreate(ctx, req.(*Doctor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoctorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorGet(ctx, req.(*GetDoctorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorsFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorsFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorsFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorsFind(ctx, req.(*DoctorsFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorsGetByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorsGetByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorsGetByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorsGetByIds(ctx, req.(*DoctorIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Doctor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorUpdate(ctx, req.(*Doctor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorDelete(ctx, req.(*DoctorId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorTypeGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorTypeGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorTypeGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorTypeGet(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SpecialtyCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Specialty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SpecialtyCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SpecialtyCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SpecialtyCreate(ctx, req.(*Specialty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SpecialtyGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecialtyId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SpecialtyGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SpecialtyGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SpecialtyGet(ctx, req.(*SpecialtyId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SpecialtiesFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecialtiesFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SpecialtiesFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SpecialtiesFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SpecialtiesFind(ctx, req.(*SpecialtiesFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SpecialtyUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Specialty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SpecialtyUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SpecialtyUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SpecialtyUpdate(ctx, req.(*Specialty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SpecialtyDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecialtyId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SpecialtyDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SpecialtyDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SpecialtyDelete(ctx, req.(*SpecialtyId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorReportCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorReportCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorReportCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorReportCreate(ctx, req.(*DoctorReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorReportGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoctorReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorReportGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorReportGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorReportGet(ctx, req.(*GetDoctorReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorReportsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorReportsFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorReportsFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorReportsFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorReportsFind(ctx, req.(*DoctorReportsFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorReportDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorReportDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorReportDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorReportDelete(ctx, req.(*ReportId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladCreate(ctx, req.(*SqladReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladGet(ctx, req.(*SqladGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladUpdate(ctx, req.(*SqladReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladDelete(ctx, req.(*SqladId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_LowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).LowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/LowStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).LowStock(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorPageFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocPageFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorPageFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorPageFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorPageFilter(ctx, req.(*DocPageFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorWorkHoursSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorWorkHours)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorWorkHoursSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorWorkHoursSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorWorkHoursSet(ctx, req.(*DoctorWorkHours))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorWorkHoursGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorWorkHoursGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorWorkHoursGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorWorkHoursGet(ctx, req.(*DoctorId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorSlotsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorSlotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorSlotsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorSlotsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorSlotsGet(ctx, req.(*DoctorSlotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorExceptionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorException)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorExceptionCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorExceptionCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorExceptionCreate(ctx, req.(*DoctorException))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorExceptionsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorExceptionsFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorExceptionsFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorExceptionsFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorExceptionsFind(ctx, req.(*DoctorExceptionsFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorExceptionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorExceptionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorExceptionDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorExceptionDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorExceptionDelete(ctx, req.(*DoctorExceptionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorAvailabilityGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorAvailabilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorAvailabilityGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorAvailabilityGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorAvailabilityGet(ctx, req.(*DoctorAvailabilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorsWorking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorsWorkingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorsWorking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorsWorking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorsWorking(ctx, req.(*DoctorsWorkingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorCommissionSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorCommissionSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorCommissionSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorCommissionSet(ctx, req.(*DoctorCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorCommissionsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorCommissionsFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorCommissionsFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorCommissionsFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorCommissionsFind(ctx, req.(*DoctorCommissionsFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DoctorCommissionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorCommissionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DoctorCommissionDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/DoctorCommissionDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DoctorCommissionDelete(ctx, req.(*DoctorCommissionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_PayrollReportGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayrollReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).PayrollReportGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/PayrollReportGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).PayrollReportGet(ctx, req.(*PayrollReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladMovementCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladMovement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladMovementCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladMovementCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladMovementCreate(ctx, req.(*SqladMovement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladMovementsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladMovementsFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladMovementsFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladMovementsFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladMovementsFind(ctx, req.(*SqladMovementsFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladBalanceGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladBalanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladBalanceGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladBalanceGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladBalanceGet(ctx, req.(*SqladBalanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladLotsFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladLotsFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladLotsFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladLotsFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladLotsFind(ctx, req.(*SqladLotsFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ExpiringLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpiringLotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ExpiringLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/ExpiringLots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ExpiringLots(ctx, req.(*ExpiringLotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladBomSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladBom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladBomSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladBomSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladBomSet(ctx, req.(*SqladBom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladBomGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladBomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladBomGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladBomGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladBomGet(ctx, req.(*SqladBomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SqladConsume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SqladConsumeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SqladConsume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SqladConsume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SqladConsume(ctx, req.(*SqladConsumeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ConsumptionReportGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumptionReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ConsumptionReportGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/ConsumptionReportGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ConsumptionReportGet(ctx, req.(*ConsumptionReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SupplierCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Supplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SupplierCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SupplierCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SupplierCreate(ctx, req.(*Supplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SupplierGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SupplierGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SupplierGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SupplierGet(ctx, req.(*SupplierId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SuppliersFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuppliersFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SuppliersFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SuppliersFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SuppliersFind(ctx, req.(*SuppliersFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SupplierUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Supplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SupplierUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SupplierUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SupplierUpdate(ctx, req.(*Supplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SupplierDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SupplierDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SupplierDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SupplierDelete(ctx, req.(*SupplierId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_PurchaseOrderCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).PurchaseOrderCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/PurchaseOrderCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).PurchaseOrderCreate(ctx, req.(*PurchaseOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_LowStockOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowStockOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).LowStockOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/LowStockOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).LowStockOrder(ctx, req.(*LowStockOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_PurchaseOrderGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).PurchaseOrderGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/PurchaseOrderGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).PurchaseOrderGet(ctx, req.(*PurchaseOrderId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_PurchaseOrdersFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrdersFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).PurchaseOrdersFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/PurchaseOrdersFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).PurchaseOrdersFind(ctx, req.(*PurchaseOrdersFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_PurchaseOrderUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).PurchaseOrderUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/PurchaseOrderUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).PurchaseOrderUpdate(ctx, req.(*PurchaseOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_PurchaseOrderStatusSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).PurchaseOrderStatusSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/PurchaseOrderStatusSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).PurchaseOrderStatusSet(ctx, req.(*PurchaseOrderStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_PurchaseOrderReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).PurchaseOrderReceive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/PurchaseOrderReceive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).PurchaseOrderReceive(ctx, req.(*PurchaseOrderReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SupplierSpendReportGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierSpendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SupplierSpendReportGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/SupplierSpendReportGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SupplierSpendReportGet(ctx, req.(*SupplierSpendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_StocktakeStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Stocktake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).StocktakeStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/StocktakeStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).StocktakeStart(ctx, req.(*Stocktake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_StocktakeCountEnter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).StocktakeCountEnter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/StocktakeCountEnter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).StocktakeCountEnter(ctx, req.(*StocktakeCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_StocktakeGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).StocktakeGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/StocktakeGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).StocktakeGet(ctx, req.(*StocktakeGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_StocktakesFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakesFindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).StocktakesFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/StocktakesFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).StocktakesFind(ctx, req.(*StocktakesFindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_StocktakePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).StocktakePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/StocktakePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).StocktakePost(ctx, req.(*StocktakePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_StocktakeCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).StocktakeCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/doctor.DoctorService/StocktakeCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).StocktakeCancel(ctx, req.(*StocktakeId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DoctorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "doctor.DoctorService",
+	HandlerType: (*DoctorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DoctorCreate",
+			Handler:    _DoctorService_DoctorCreate_Handler,
+		},
+		{
+			MethodName: "DoctorGet",
+			Handler:    _DoctorService_DoctorGet_Handler,
+		},
+		{
+			MethodName: "DoctorsFind",
+			Handler:    _DoctorService_DoctorsFind_Handler,
+		},
+		{
+			MethodName: "DoctorsGetByIds",
+			Handler:    _DoctorService_DoctorsGetByIds_Handler,
+		},
+		{
+			MethodName: "DoctorUpdate",
+			Handler:    _DoctorService_DoctorUpdate_Handler,
+		},
+		{
+			MethodName: "DoctorDelete",
+			Handler:    _DoctorService_DoctorDelete_Handler,
+		},
+		{
+			MethodName: "DoctorTypeGet",
+			Handler:    _DoctorService_DoctorTypeGet_Handler,
+		},
+		{
+			MethodName: "SpecialtyCreate",
+			Handler:    _DoctorService_SpecialtyCreate_Handler,
+		},
+		{
+			MethodName: "SpecialtyGet",
+			Handler:    _DoctorService_SpecialtyGet_Handler,
+		},
+		{
+			MethodName: "SpecialtiesFind",
+			Handler:    _DoctorService_SpecialtiesFind_Handler,
+		},
+		{
+			MethodName: "SpecialtyUpdate",
+			Handler:    _DoctorService_SpecialtyUpdate_Handler,
+		},
+		{
+			MethodName: "SpecialtyDelete",
+			Handler:    _DoctorService_SpecialtyDelete_Handler,
+		},
+		{
+			MethodName: "DoctorReportCreate",
+			Handler:    _DoctorService_DoctorReportCreate_Handler,
+		},
+		{
+			MethodName: "DoctorReportGet",
+			Handler:    _DoctorService_DoctorReportGet_Handler,
+		},
+		{
+			MethodName: "DoctorReportsFind",
+			Handler:    _DoctorService_DoctorReportsFind_Handler,
+		},
+		{
+			MethodName: "DoctorReportDelete",
+			Handler:    _DoctorService_DoctorReportDelete_Handler,
+		},
+		{
+			MethodName: "SqladCreate",
+			Handler:    _DoctorService_SqladCreate_Handler,
+		},
+		{
+			MethodName: "SqladGet",
+			Handler:    _DoctorService_SqladGet_Handler,
+		},
+		{
+			MethodName: "SqladUpdate",
+			Handler:    _DoctorService_SqladUpdate_Handler,
+		},
+		{
+			MethodName: "SqladDelete",
+			Handler:    _DoctorService_SqladDelete_Handler,
+		},
+		{
+			MethodName: "LowStock",
+			Handler:    _DoctorService_LowStock_Handler,
+		},
+		{
+			MethodName: "DoctorPageFilter",
+			Handler:    _DoctorService_DoctorPageFilter_Handler,
+		},
+		{
+			MethodName: "DoctorWorkHoursSet",
+			Handler:    _DoctorService_DoctorWorkHoursSet_Handler,
+		},
+		{
+			MethodName: "DoctorWorkHoursGet",
+			Handler:    _DoctorService_DoctorWorkHoursGet_Handler,
+		},
+		{
+			MethodName: "DoctorSlotsGet",
+			Handler:    _DoctorService_DoctorSlotsGet_Handler,
+		},
+		{
+			MethodName: "DoctorExceptionCreate",
+			Handler:    _DoctorService_DoctorExceptionCreate_Handler,
+		},
+		{
+			MethodName: "DoctorExceptionsFind",
+			Handler:    _DoctorService_DoctorExceptionsFind_Handler,
+		},
+		{
+			MethodName: "DoctorExceptionDelete",
+			Handler:    _DoctorService_DoctorExceptionDelete_Handler,
+		},
+		{
+			MethodName: "DoctorAvailabilityGet",
+			Handler:    _DoctorService_DoctorAvailabilityGet_Handler,
+		},
+		{
+			MethodName: "DoctorsWorking",
+			Handler:    _DoctorService_DoctorsWorking_Handler,
+		},
+		{
+			MethodName: "DoctorCommissionSet",
+			Handler:    _DoctorService_DoctorCommissionSet_Handler,
+		},
+		{
+			MethodName: "DoctorCommissionsFind",
+			Handler:    _DoctorService_DoctorCommissionsFind_Handler,
+		},
+		{
+			MethodName: "DoctorCommissionDelete",
+			Handler:    _DoctorService_DoctorCommissionDelete_Handler,
+		},
+		{
+			MethodName: "PayrollReportGet",
+			Handler:    _DoctorService_PayrollReportGet_Handler,
+		},
+		{
+			MethodName: "SqladMovementCreate",
+			Handler:    _DoctorService_SqladMovementCreate_Handler,
+		},
+		{
+			MethodName: "SqladMovementsFind",
+			Handler:    _DoctorService_SqladMovementsFind_Handler,
+		},
+		{
+			MethodName: "SqladBalanceGet",
+			Handler:    _DoctorService_SqladBalanceGet_Handler,
+		},
+		{
+			MethodName: "SqladLotsFind",
+			Handler:    _DoctorService_SqladLotsFind_Handler,
+		},
+		{
+			MethodName: "ExpiringLots",
+			Handler:    _DoctorService_ExpiringLots_Handler,
+		},
+		{
+			MethodName: "SqladBomSet",
+			Handler:    _DoctorService_SqladBomSet_Handler,
+		},
+		{
+			MethodName: "SqladBomGet",
+			Handler:    _DoctorService_SqladBomGet_Handler,
+		},
+		{
+			MethodName: "SqladConsume",
+			Handler:    _DoctorService_SqladConsume_Handler,
+		},
+		{
+			MethodName: "ConsumptionReportGet",
+			Handler:    _DoctorService_ConsumptionReportGet_Handler,
+		},
+		{
+			MethodName: "SupplierCreate",
+			Handler:    _DoctorService_SupplierCreate_Handler,
+		},
+		{
+			MethodName: "SupplierGet",
+			Handler:    _DoctorService_SupplierGet_Handler,
+		},
+		{
+			MethodName: "SuppliersFind",
+			Handler:    _DoctorService_SuppliersFind_Handler,
+		},
+		{
+			MethodName: "SupplierUpdate",
+			Handler:    _DoctorService_SupplierUpdate_Handler,
+		},
+		{
+			MethodName: "SupplierDelete",
+			Handler:    _DoctorService_SupplierDelete_Handler,
+		},
+		{
+			MethodName: "PurchaseOrderCreate",
+			Handler:    _DoctorService_PurchaseOrderCreate_Handler,
+		},
+		{
+			MethodName: "LowStockOrder",
+			Handler:    _DoctorService_LowStockOrder_Handler,
+		},
+		{
+			MethodName: "PurchaseOrderGet",
+			Handler:    _DoctorService_PurchaseOrderGet_Handler,
+		},
+		{
+			MethodName: "PurchaseOrdersFind",
+			Handler:    _DoctorService_PurchaseOrdersFind_Handler,
+		},
+		{
+			MethodName: "PurchaseOrderUpdate",
+			Handler:    _DoctorService_PurchaseOrderUpdate_Handler,
+		},
+		{
+			MethodName: "PurchaseOrderStatusSet",
+			Handler:    _DoctorService_PurchaseOrderStatusSet_Handler,
+		},
+		{
+			MethodName: "PurchaseOrderReceive",
+			Handler:    _DoctorService_PurchaseOrderReceive_Handler,
+		},
+		{
+			MethodName: "SupplierSpendReportGet",
+			Handler:    _DoctorService_SupplierSpendReportGet_Handler,
+		},
+		{
+			MethodName: "StocktakeStart",
+			Handler:    _DoctorService_StocktakeStart_Handler,
+		},
+		{
+			MethodName: "StocktakeCountEnter",
+			Handler:    _DoctorService_StocktakeCountEnter_Handler,
+		},
+		{
+			MethodName: "StocktakeGet",
+			Handler:    _DoctorService_StocktakeGet_Handler,
+		},
+		{
+			MethodName: "StocktakesFind",
+			Handler:    _DoctorService_StocktakesFind_Handler,
+		},
+		{
+			MethodName: "StocktakePost",
+			Handler:    _DoctorService_StocktakePost_Handler,
+		},
+		{
+			MethodName: "StocktakeCancel",
+			Handler:    _DoctorService_StocktakeCancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "doctor/doctor.proto",
+}
+
+func (m *DoctorType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorType) > 0 {
+		i -= len(m.DoctorType)
+		copy(dAtA[i:], m.DoctorType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorTypes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorTypes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DoctorTypes) > 0 {
+		for iNdEx := len(m.DoctorTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DoctorTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocPageFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocPageFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocPageFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClientId != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.ClientId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocPageFilterRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocPageFilterRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocPageFilterRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DoctorReport) > 0 {
+		for iNdEx := len(m.DoctorReport) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DoctorReport[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PatientInfo) > 0 {
+		for iNdEx := len(m.PatientInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PatientInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocPage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocPage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PatientId) > 0 {
+		i -= len(m.PatientId)
+		copy(dAtA[i:], m.PatientId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PatientId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ClientId != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.ClientId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DateLastVisit) > 0 {
+		i -= len(m.DateLastVisit)
+		copy(dAtA[i:], m.DateLastVisit)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DateLastVisit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FullName) > 0 {
+		i -= len(m.FullName)
+		copy(dAtA[i:], m.FullName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FullName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueueNumber != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.QueueNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LowStockRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LowStockRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LowStockRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LowStock) > 0 {
+		for iNdEx := len(m.LowStock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LowStock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladGetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladGetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladGetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SupplierId) > 0 {
+		i -= len(m.SupplierId)
+		copy(dAtA[i:], m.SupplierId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LowStock != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.LowStock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SupplierId) > 0 {
+		i -= len(m.SupplierId)
+		copy(dAtA[i:], m.SupplierId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LowStock != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.LowStock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ReportId) > 0 {
+		i -= len(m.ReportId)
+		copy(dAtA[i:], m.ReportId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ReportId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorReportsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorReportsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorReportsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DoctorReports) > 0 {
+		for iNdEx := len(m.DoctorReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DoctorReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorReportsFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorReportsFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorReportsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDoctorReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDoctorReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDoctorReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorReportRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorReportRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorReportRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorsFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorsFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SpecialtyId) > 0 {
+		i -= len(m.SpecialtyId)
+		copy(dAtA[i:], m.SpecialtyId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SpecialtyId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Doctors) > 0 {
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintDoctor(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorsByIdsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorsByIdsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorsByIdsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingIds) > 0 {
+		for iNdEx := len(m.MissingIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingIds[iNdEx])
+			copy(dAtA[i:], m.MissingIds[iNdEx])
+			i = encodeVarintDoctor(dAtA, i, uint64(len(m.MissingIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Doctors) > 0 {
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDoctorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDoctorReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDoctorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Doctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Doctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Doctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Specialties) > 0 {
+		for iNdEx := len(m.Specialties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Specialties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SpecialtyIds) > 0 {
+		for iNdEx := len(m.SpecialtyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpecialtyIds[iNdEx])
+			copy(dAtA[i:], m.SpecialtyIds[iNdEx])
+			i = encodeVarintDoctor(dAtA, i, uint64(len(m.SpecialtyIds[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RoomNumber) > 0 {
+		i -= len(m.RoomNumber)
+		copy(dAtA[i:], m.RoomNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.RoomNumber)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Cpecialety) > 0 {
+		i -= len(m.Cpecialety)
+		copy(dAtA[i:], m.Cpecialety)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Cpecialety)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.WorkTime) > 0 {
+		i -= len(m.WorkTime)
+		copy(dAtA[i:], m.WorkTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.WorkTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Gender) > 0 {
+		i -= len(m.Gender)
+		copy(dAtA[i:], m.Gender)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Gender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Specialty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Specialty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Specialty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DoctorsCount != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.DoctorsCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SlotMinutes != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.SlotMinutes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecialtyId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecialtyId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecialtyId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecialtiesFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecialtiesFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecialtiesFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Specialties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Specialties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Specialties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Specialties) > 0 {
+		for iNdEx := len(m.Specialties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Specialties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Weekday != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Weekday))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorWorkHours) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorWorkHours) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorWorkHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Breaks) > 0 {
+		for iNdEx := len(m.Breaks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breaks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hours) > 0 {
+		for iNdEx := len(m.Hours) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorSlotsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorSlotsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorSlotsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeSlot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeSlot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeSlot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorSlots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorSlots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorSlots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Slots) > 0 {
+		for iNdEx := len(m.Slots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SlotMinutes != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.SlotMinutes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorException) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorException) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorException) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SubstituteId) > 0 {
+		i -= len(m.SubstituteId)
+		copy(dAtA[i:], m.SubstituteId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SubstituteId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DateTo) > 0 {
+		i -= len(m.DateTo)
+		copy(dAtA[i:], m.DateTo)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DateTo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DateFrom) > 0 {
+		i -= len(m.DateFrom)
+		copy(dAtA[i:], m.DateFrom)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DateFrom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorExceptionId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorExceptionId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorExceptionId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorExceptionsFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorExceptionsFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorExceptionsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorExceptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorExceptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorExceptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Exceptions) > 0 {
+		for iNdEx := len(m.Exceptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exceptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorAvailabilityReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorAvailabilityReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorAvailabilityReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.At) > 0 {
+		i -= len(m.At)
+		copy(dAtA[i:], m.At)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.At)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorAvailability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorAvailability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Substitute != nil {
+		{
+			size, err := m.Substitute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDoctor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SubstituteId) > 0 {
+		i -= len(m.SubstituteId)
+		copy(dAtA[i:], m.SubstituteId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SubstituteId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NextStart) > 0 {
+		i -= len(m.NextStart)
+		copy(dAtA[i:], m.NextStart)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.NextStart)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.At) > 0 {
+		i -= len(m.At)
+		copy(dAtA[i:], m.At)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.At)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorsWorkingReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorsWorkingReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorsWorkingReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpecialtyId) > 0 {
+		i -= len(m.SpecialtyId)
+		copy(dAtA[i:], m.SpecialtyId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SpecialtyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkingDoctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkingDoctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkingDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SubstituteFor) > 0 {
+		i -= len(m.SubstituteFor)
+		copy(dAtA[i:], m.SubstituteFor)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SubstituteFor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hours) > 0 {
+		for iNdEx := len(m.Hours) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Doctor != nil {
+		{
+			size, err := m.Doctor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDoctor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorsWorkingResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorsWorkingResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorsWorkingResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Doctors) > 0 {
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorCommissionId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorCommissionId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorCommissionId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorCommissionsFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorCommissionsFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorCommissionsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoctorCommissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoctorCommissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoctorCommissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Commissions) > 0 {
+		for iNdEx := len(m.Commissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayrollReportReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayrollReportReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayrollReportReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayrollLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayrollLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayrollLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Earnings != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Earnings))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CommissionId) > 0 {
+		i -= len(m.CommissionId)
+		copy(dAtA[i:], m.CommissionId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CommissionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Revenue != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Revenue))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayrollDoctor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayrollDoctor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayrollDoctor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Earnings != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Earnings))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Revenue != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Revenue))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayrollReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayrollReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayrollReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Earnings != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Earnings))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Revenue != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Revenue))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Doctors) > 0 {
+		for iNdEx := len(m.Doctors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Doctors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladMovement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladMovement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladMovement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Lots) > 0 {
+		for iNdEx := len(m.Lots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.LotNumber) > 0 {
+		i -= len(m.LotNumber)
+		copy(dAtA[i:], m.LotNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotNumber)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.LotId) > 0 {
+		i -= len(m.LotId)
+		copy(dAtA[i:], m.LotId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Balance != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TargetType) > 0 {
+		i -= len(m.TargetType)
+		copy(dAtA[i:], m.TargetType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.TargetType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UnitCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UnitCost))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladLotTake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladLotTake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladLotTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LotNumber) > 0 {
+		i -= len(m.LotNumber)
+		copy(dAtA[i:], m.LotNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotNumber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LotId) > 0 {
+		i -= len(m.LotId)
+		copy(dAtA[i:], m.LotId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladLot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladLot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladLot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Received != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UnitCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UnitCost))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.DaysLeft != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.DaysLeft))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladLotsFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladLotsFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladLotsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WithEmpty {
+		i--
+		if m.WithEmpty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpiringLotsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringLotsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringLotsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Days != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladLots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladLots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladLots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lots) > 0 {
+		for iNdEx := len(m.Lots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladMovementsFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladMovementsFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladMovementsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetType) > 0 {
+		i -= len(m.TargetType)
+		copy(dAtA[i:], m.TargetType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.TargetType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladMovements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladMovements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladMovements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Movements) > 0 {
+		for iNdEx := len(m.Movements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Movements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladBalanceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladBalanceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladBalanceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladBom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladBom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladBom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladBomItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladBomItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladBomItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladBomReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladBomReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladBomReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladConsumeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladConsumeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladConsumeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AnalysisId) > 0 {
+		i -= len(m.AnalysisId)
+		copy(dAtA[i:], m.AnalysisId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.AnalysisId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Taken != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Taken))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Expected != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SqladConsumeResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SqladConsumeResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SqladConsumeResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AnalysisId) > 0 {
+		i -= len(m.AnalysisId)
+		copy(dAtA[i:], m.AnalysisId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.AnalysisId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumptionReportReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumptionReportReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumptionReportReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumptionLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumptionLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumptionLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Difference != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Difference))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Actual != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Actual))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Expected != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Performed != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Performed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceType) > 0 {
+		i -= len(m.ServiceType)
+		copy(dAtA[i:], m.ServiceType)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ServiceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumptionReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumptionReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumptionReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Supplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Supplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Supplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SqladCount != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.SqladCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PaymentDays != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.PaymentDays))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PaymentTerms) > 0 {
+		i -= len(m.PaymentTerms)
+		copy(dAtA[i:], m.PaymentTerms)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PaymentTerms)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContactPerson) > 0 {
+		i -= len(m.ContactPerson)
+		copy(dAtA[i:], m.ContactPerson)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ContactPerson)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplierId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuppliersFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuppliersFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuppliersFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Suppliers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Suppliers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Suppliers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Suppliers) > 0 {
+		for iNdEx := len(m.Suppliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Suppliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.OrderedAt) > 0 {
+		i -= len(m.OrderedAt)
+		copy(dAtA[i:], m.OrderedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ReceivedTotal != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReceivedTotal))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.Total != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Total))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SupplierName) > 0 {
+		i -= len(m.SupplierName)
+		copy(dAtA[i:], m.SupplierName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SupplierId) > 0 {
+		i -= len(m.SupplierId)
+		copy(dAtA[i:], m.SupplierId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrderItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrderItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrderItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Received != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnitCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UnitCost))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrderId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrderId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrderId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrdersFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrdersFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrdersFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SupplierId) > 0 {
+		i -= len(m.SupplierId)
+		copy(dAtA[i:], m.SupplierId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LowStockOrderReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LowStockOrderReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LowStockOrderReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SupplierId) > 0 {
+		i -= len(m.SupplierId)
+		copy(dAtA[i:], m.SupplierId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LowStockOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LowStockOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LowStockOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WithoutSupplier) > 0 {
+		for iNdEx := len(m.WithoutSupplier) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithoutSupplier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrderStatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrderStatusReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrderStatusReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrderReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrderReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrderReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurchaseOrderReceiptItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrderReceiptItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurchaseOrderReceiptItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LotNumber) > 0 {
+		i -= len(m.LotNumber)
+		copy(dAtA[i:], m.LotNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ItemId) > 0 {
+		i -= len(m.ItemId)
+		copy(dAtA[i:], m.ItemId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ItemId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplierSpendReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierSpendReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierSpendReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SupplierId) > 0 {
+		i -= len(m.SupplierId)
+		copy(dAtA[i:], m.SupplierId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplierSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Spent != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Spent))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Orders != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Orders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SupplierName) > 0 {
+		i -= len(m.SupplierName)
+		copy(dAtA[i:], m.SupplierName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SupplierId) > 0 {
+		i -= len(m.SupplierId)
+		copy(dAtA[i:], m.SupplierId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SupplierId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplierSpendItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierSpendItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierSpendItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Spent != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Spent))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplierSpendReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierSpendReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierSpendReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Total != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Total))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Suppliers) > 0 {
+		for iNdEx := len(m.Suppliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Suppliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToDate) > 0 {
+		i -= len(m.ToDate)
+		copy(dAtA[i:], m.ToDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ToDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDate) > 0 {
+		i -= len(m.FromDate)
+		copy(dAtA[i:], m.FromDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.FromDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stocktake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stocktake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stocktake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ValueDifference != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ValueDifference))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PostedAt) > 0 {
+		i -= len(m.PostedAt)
+		copy(dAtA[i:], m.PostedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PostedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PostedBy) > 0 {
+		i -= len(m.PostedBy)
+		copy(dAtA[i:], m.PostedBy)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.PostedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakeLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakeLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakeLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MovementId) > 0 {
+		i -= len(m.MovementId)
+		copy(dAtA[i:], m.MovementId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.MovementId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ValueDifference != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ValueDifference))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.UnitCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UnitCost))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.IsCounted {
+		i--
+		if m.IsCounted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Difference != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Difference))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Counted != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Counted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Expected != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LotNumber) > 0 {
+		i -= len(m.LotNumber)
+		copy(dAtA[i:], m.LotNumber)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotNumber)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LotId) > 0 {
+		i -= len(m.LotId)
+		copy(dAtA[i:], m.LotId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakeCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakeCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakeCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CounterId) > 0 {
+		i -= len(m.CounterId)
+		copy(dAtA[i:], m.CounterId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CounterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LotId) > 0 {
+		i -= len(m.LotId)
+		copy(dAtA[i:], m.LotId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SqladName) > 0 {
+		i -= len(m.SqladName)
+		copy(dAtA[i:], m.SqladName)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakeId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakeId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakeId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakeGetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakeGetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakeGetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OnlyDiscrepancies {
+		i--
+		if m.OnlyDiscrepancies {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakesFindReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakesFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakesFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Page != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stocktakes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stocktakes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stocktakes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stocktakes) > 0 {
+		for iNdEx := len(m.Stocktakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stocktakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakeCountReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakeCountReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakeCountReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoctor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CounterId) > 0 {
+		i -= len(m.CounterId)
+		copy(dAtA[i:], m.CounterId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.CounterId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StocktakeId) > 0 {
+		i -= len(m.StocktakeId)
+		copy(dAtA[i:], m.StocktakeId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.StocktakeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakeCountItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakeCountItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakeCountItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintDoctor(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LotId) > 0 {
+		i -= len(m.LotId)
+		copy(dAtA[i:], m.LotId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.LotId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SqladId) > 0 {
+		i -= len(m.SqladId)
+		copy(dAtA[i:], m.SqladId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.SqladId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StocktakePostReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StocktakePostReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StocktakePostReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDoctor(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDoctor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDoctor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DoctorType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorTypes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DoctorTypes) > 0 {
+		for _, e := range m.DoctorTypes {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocPageFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientId != 0 {
+		n += 1 + sovDoctor(uint64(m.ClientId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocPageFilterRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PatientInfo) > 0 {
+		for _, e := range m.PatientInfo {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if len(m.DoctorReport) > 0 {
+		for _, e := range m.DoctorReport {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocPage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueueNumber != 0 {
+		n += 1 + sovDoctor(uint64(m.QueueNumber))
+	}
+	l = len(m.FullName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DateLastVisit)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.ClientId != 0 {
+		n += 1 + sovDoctor(uint64(m.ClientId))
+	}
+	l = len(m.PatientId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LowStockRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LowStock) > 0 {
+		for _, e := range m.LowStock {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladGetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	if m.LowStock != 0 {
+		n += 1 + sovDoctor(uint64(m.LowStock))
+	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SupplierId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	if m.LowStock != 0 {
+		n += 1 + sovDoctor(uint64(m.LowStock))
+	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SupplierId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReportId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorReportsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DoctorReports) > 0 {
+		for _, e := range m.DoctorReports {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorReportsFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDoctorReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorReportRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorsFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SpecialtyId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorsByIdsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if len(m.MissingIds) > 0 {
+		for _, s := range m.MissingIds {
+			l = len(s)
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDoctorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Doctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Gender)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.WorkTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	l = len(m.Cpecialety)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.RoomNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.SpecialtyIds) > 0 {
+		for _, s := range m.SpecialtyIds {
+			l = len(s)
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if len(m.Specialties) > 0 {
+		for _, e := range m.Specialties {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Specialty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	if m.SlotMinutes != 0 {
+		n += 1 + sovDoctor(uint64(m.SlotMinutes))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.DoctorsCount != 0 {
+		n += 1 + sovDoctor(uint64(m.DoctorsCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecialtyId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecialtiesFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Specialties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Specialties) > 0 {
+		for _, e := range m.Specialties {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Weekday != 0 {
+		n += 1 + sovDoctor(uint64(m.Weekday))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorWorkHours) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Hours) > 0 {
+		for _, e := range m.Hours {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if len(m.Breaks) > 0 {
+		for _, e := range m.Breaks {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorSlotsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeSlot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorSlots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.SlotMinutes != 0 {
+		n += 1 + sovDoctor(uint64(m.SlotMinutes))
+	}
+	if len(m.Slots) > 0 {
+		for _, e := range m.Slots {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorException) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DateFrom)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DateTo)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SubstituteId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorExceptionId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorExceptionsFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorExceptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exceptions) > 0 {
+		for _, e := range m.Exceptions {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorAvailabilityReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.At)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorAvailability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.At)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Available {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.NextStart)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SubstituteId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Substitute != nil {
+		l = m.Substitute.Size()
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorsWorkingReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecialtyId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkingDoctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Doctor != nil {
+		l = m.Doctor.Size()
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Hours) > 0 {
+		for _, e := range m.Hours {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	l = len(m.SubstituteFor)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorsWorkingResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorCommissionId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorCommissionsFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoctorCommissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commissions) > 0 {
+		for _, e := range m.Commissions {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PayrollReportReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PayrollLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	if m.Revenue != 0 {
+		n += 1 + sovDoctor(uint64(m.Revenue))
+	}
+	l = len(m.CommissionId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Earnings != 0 {
+		n += 1 + sovDoctor(uint64(m.Earnings))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PayrollDoctor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DoctorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Revenue != 0 {
+		n += 1 + sovDoctor(uint64(m.Revenue))
+	}
+	if m.Earnings != 0 {
+		n += 1 + sovDoctor(uint64(m.Earnings))
+	}
+	if len(m.Lines) > 0 {
+		for _, e := range m.Lines {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PayrollReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Doctors) > 0 {
+		for _, e := range m.Doctors {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Revenue != 0 {
+		n += 1 + sovDoctor(uint64(m.Revenue))
+	}
+	if m.Earnings != 0 {
+		n += 1 + sovDoctor(uint64(m.Earnings))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladMovement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	if m.UnitCost != 0 {
+		n += 9
+	}
+	l = len(m.TargetType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovDoctor(uint64(m.Balance))
+	}
+	l = len(m.LotId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LotNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Lots) > 0 {
+		for _, e := range m.Lots {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladLotTake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LotId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LotNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladLot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.DaysLeft != 0 {
+		n += 1 + sovDoctor(uint64(m.DaysLeft))
+	}
+	if m.UnitCost != 0 {
+		n += 9
+	}
+	if m.Received != 0 {
+		n += 1 + sovDoctor(uint64(m.Received))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovDoctor(uint64(m.Remaining))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladLotsFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.WithEmpty {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpiringLotsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Days != 0 {
+		n += 1 + sovDoctor(uint64(m.Days))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladLots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lots) > 0 {
+		for _, e := range m.Lots {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladMovementsFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.TargetType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladMovements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Movements) > 0 {
+		for _, e := range m.Movements {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladBalanceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladBom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladBomItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladBomReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladConsumeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.AnalysisId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Expected != 0 {
+		n += 1 + sovDoctor(uint64(m.Expected))
+	}
+	if m.Taken != 0 {
+		n += 1 + sovDoctor(uint64(m.Taken))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SqladConsumeResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AnalysisId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConsumptionReportReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConsumptionLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceType)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Performed != 0 {
+		n += 1 + sovDoctor(uint64(m.Performed))
+	}
+	if m.Expected != 0 {
+		n += 1 + sovDoctor(uint64(m.Expected))
+	}
+	if m.Actual != 0 {
+		n += 1 + sovDoctor(uint64(m.Actual))
+	}
+	if m.Difference != 0 {
+		n += 1 + sovDoctor(uint64(m.Difference))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConsumptionReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Lines) > 0 {
+		for _, e := range m.Lines {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Supplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ContactPerson)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PaymentTerms)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.PaymentDays != 0 {
+		n += 1 + sovDoctor(uint64(m.PaymentDays))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.SqladCount != 0 {
+		n += 1 + sovDoctor(uint64(m.SqladCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SupplierId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SuppliersFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Suppliers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Suppliers) > 0 {
+		for _, e := range m.Suppliers {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovDoctor(uint64(m.Number))
+	}
+	l = len(m.SupplierId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SupplierName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 9
+	}
+	if m.ReceivedTotal != 0 {
+		n += 9
+	}
+	l = len(m.OrderedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrderItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	if m.UnitCost != 0 {
+		n += 9
+	}
+	if m.Received != 0 {
+		n += 1 + sovDoctor(uint64(m.Received))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrderId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrdersFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SupplierId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LowStockOrderReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SupplierId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LowStockOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if len(m.WithoutSupplier) > 0 {
+		for _, e := range m.WithoutSupplier {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrderStatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrderReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurchaseOrderReceiptItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ItemId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	l = len(m.LotNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SupplierSpendReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SupplierId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SupplierSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SupplierId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SupplierName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Orders != 0 {
+		n += 1 + sovDoctor(uint64(m.Orders))
+	}
+	if m.Spent != 0 {
+		n += 9
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SupplierSpendItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	if m.Spent != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SupplierSpendReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ToDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Suppliers) > 0 {
+		for _, e := range m.Suppliers {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Stocktake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovDoctor(uint64(m.Number))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PostedBy)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.PostedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Lines) > 0 {
+		for _, e := range m.Lines {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.ValueDifference != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakeLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LotId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LotNumber)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Expected != 0 {
+		n += 1 + sovDoctor(uint64(m.Expected))
+	}
+	if m.Counted != 0 {
+		n += 1 + sovDoctor(uint64(m.Counted))
+	}
+	if m.Difference != 0 {
+		n += 1 + sovDoctor(uint64(m.Difference))
+	}
+	if m.IsCounted {
+		n += 2
+	}
+	if m.UnitCost != 0 {
+		n += 9
+	}
+	if m.ValueDifference != 0 {
+		n += 9
+	}
+	l = len(m.MovementId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakeCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.SqladName)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LotId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	l = len(m.CounterId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakeId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakeGetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.OnlyDiscrepancies {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakesFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDoctor(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovDoctor(uint64(m.Page))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Stocktakes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stocktakes) > 0 {
+		for _, e := range m.Stocktakes {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDoctor(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakeCountReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StocktakeId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.CounterId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovDoctor(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakeCountItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SqladId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.LotId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovDoctor(uint64(m.Quantity))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StocktakePostReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovDoctor(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDoctor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDoctor(x uint64) (n int) {
+	return sovDoctor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoctorType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorTypes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorTypes = append(m.DoctorTypes, &DoctorType{})
+			if err := m.DoctorTypes[len(m.DoctorTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocPageFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocPageFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocPageFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			m.ClientId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocPageFilterRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocPageFilterRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocPageFilterRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientInfo = append(m.PatientInfo, &DocPage{})
+			if err := m.PatientInfo[len(m.PatientInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorReport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorReport = append(m.DoctorReport, &DoctorReportRes{})
+			if err := m.DoctorReport[len(m.DoctorReport)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueNumber", wireType)
+			}
+			m.QueueNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FullName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateLastVisit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateLastVisit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			m.ClientId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LowStockRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LowStockRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LowStockRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowStock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowStock = append(m.LowStock, &SqladRes{})
+			if err := m.LowStock[len(m.LowStock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladGetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladGetReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladGetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowStock", wireType)
+			}
+			m.LowStock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowStock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowStock", wireType)
+			}
+			m.LowStock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowStock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorReportsResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorReportsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorReportsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorReports = append(m.DoctorReports, &DoctorReportRes{})
+			if err := m.DoctorReports[len(m.DoctorReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorReportsFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorReportsFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorReportsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDoctorReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDoctorReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDoctorReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorReportRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorReportRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorsFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorsFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialtyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialtyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorsResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doctors = append(m.Doctors, &Doctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorIds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorIds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorIds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorsByIdsResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorsByIdsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorsByIdsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doctors = append(m.Doctors, &Doctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingIds = append(m.MissingIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDoctorReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDoctorReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDoctorReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Doctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Doctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Doctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpecialety", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cpecialety = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialtyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialtyIds = append(m.SpecialtyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Specialties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Specialties = append(m.Specialties, &Specialty{})
+			if err := m.Specialties[len(m.Specialties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Specialty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Specialty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Specialty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotMinutes", wireType)
+			}
+			m.SlotMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotMinutes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorsCount", wireType)
+			}
+			m.DoctorsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DoctorsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecialtyId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecialtyId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecialtyId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecialtiesFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecialtiesFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecialtiesFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Specialties) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Specialties: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Specialties: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Specialties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Specialties = append(m.Specialties, &Specialty{})
+			if err := m.Specialties[len(m.Specialties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekday", wireType)
+			}
+			m.Weekday = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weekday |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorWorkHours) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorWorkHours: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorWorkHours: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hours = append(m.Hours, &WorkInterval{})
+			if err := m.Hours[len(m.Hours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breaks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Breaks = append(m.Breaks, &WorkInterval{})
+			if err := m.Breaks[len(m.Breaks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorSlotsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorSlotsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorSlotsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeSlot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeSlot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeSlot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorSlots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorSlots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorSlots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotMinutes", wireType)
+			}
+			m.SlotMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotMinutes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slots = append(m.Slots, &TimeSlot{})
+			if err := m.Slots[len(m.Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorException) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorException: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorException: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorExceptionId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorExceptionId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorExceptionId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorExceptionsFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorExceptionsFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorExceptionsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorExceptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorExceptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorExceptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exceptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exceptions = append(m.Exceptions, &DoctorException{})
+			if err := m.Exceptions[len(m.Exceptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorAvailabilityReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorAvailabilityReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorAvailabilityReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.At = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorAvailability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorAvailability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.At = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Available = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextStart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Substitute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Substitute == nil {
+				m.Substitute = &Doctor{}
+			}
+			if err := m.Substitute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorsWorkingReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorsWorkingReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorsWorkingReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialtyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialtyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkingDoctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkingDoctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkingDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Doctor == nil {
+				m.Doctor = &Doctor{}
+			}
+			if err := m.Doctor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hours = append(m.Hours, &TimeSlot{})
+			if err := m.Hours[len(m.Hours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteFor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorsWorkingResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorsWorkingResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorsWorkingResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doctors = append(m.Doctors, &WorkingDoctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorCommissionId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorCommissionId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorCommissionId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorCommissionsFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorCommissionsFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorCommissionsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoctorCommissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoctorCommissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoctorCommissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commissions = append(m.Commissions, &DoctorCommission{})
+			if err := m.Commissions[len(m.Commissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayrollReportReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayrollReportReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayrollReportReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayrollLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayrollLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayrollLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			m.Revenue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revenue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			m.Earnings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Earnings |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayrollDoctor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayrollDoctor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayrollDoctor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			m.Revenue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revenue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			m.Earnings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Earnings |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, &PayrollLine{})
+			if err := m.Lines[len(m.Lines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayrollReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayrollReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayrollReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Doctors = append(m.Doctors, &PayrollDoctor{})
+			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			m.Revenue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revenue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			m.Earnings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Earnings |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladMovement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladMovement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladMovement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UnitCost = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lots = append(m.Lots, &SqladLotTake{})
+			if err := m.Lots[len(m.Lots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladLotTake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladLotTake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladLotTake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladLot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladLot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladLot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysLeft", wireType)
+			}
+			m.DaysLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysLeft |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UnitCost = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladLotsFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladLotsFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladLotsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithEmpty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithEmpty = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringLotsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringLotsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringLotsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladLots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladLots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladLots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lots = append(m.Lots, &SqladLot{})
+			if err := m.Lots[len(m.Lots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladMovementsFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladMovementsFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladMovementsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladMovements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladMovements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladMovements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Movements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Movements = append(m.Movements, &SqladMovement{})
+			if err := m.Movements[len(m.Movements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladBalanceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladBalanceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladBalanceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, &SqladBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladBom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladBom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladBom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &SqladBomItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladBomItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladBomItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladBomItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladBomReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladBomReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladBomReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladConsumeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladConsumeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladConsumeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnalysisId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taken", wireType)
+			}
+			m.Taken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Taken |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SqladConsumeResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SqladConsumeResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SqladConsumeResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnalysisId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, &SqladUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumptionReportReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumptionReportReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumptionReportReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumptionLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumptionLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumptionLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Performed", wireType)
+			}
+			m.Performed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Performed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
+			}
+			m.Actual = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Actual |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Difference", wireType)
+			}
+			m.Difference = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Difference |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumptionReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumptionReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumptionReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, &ConsumptionLine{})
+			if err := m.Lines[len(m.Lines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactPerson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactPerson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentTerms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentTerms = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentDays", wireType)
+			}
+			m.PaymentDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentDays |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladCount", wireType)
+			}
+			m.SqladCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SqladCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplierId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplierId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplierId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuppliersFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuppliersFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuppliersFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Suppliers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Suppliers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Suppliers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suppliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suppliers = append(m.Suppliers, &Supplier{})
+			if err := m.Suppliers[len(m.Suppliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &PurchaseOrderItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Total = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedTotal", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReceivedTotal = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseOrderItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseOrderItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseOrderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UnitCost = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseOrderId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseOrderId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseOrderId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseOrdersFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseOrdersFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseOrdersFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorType: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorType: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3670,18 +30415,53 @@ func (m *DoctorType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorType = string(dAtA[iNdEx:postIndex])
+			m.ToDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -3697,7 +30477,7 @@ func (m *DoctorType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorTypes) Unmarshal(dAtA []byte) error {
+func (m *PurchaseOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3720,15 +30500,15 @@ func (m *DoctorTypes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorTypes: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurchaseOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurchaseOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3755,8 +30535,8 @@ func (m *DoctorTypes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorTypes = append(m.DoctorTypes, &DoctorType{})
-			if err := m.DoctorTypes[len(m.DoctorTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, &PurchaseOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3785,10 +30565,7 @@ func (m *DoctorTypes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -3804,7 +30581,7 @@ func (m *DoctorTypes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocPageFilter) Unmarshal(dAtA []byte) error {
+func (m *LowStockOrderReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3827,72 +30604,15 @@ func (m *DocPageFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocPageFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: LowStockOrderReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocPageFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LowStockOrderReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			m.ClientId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClientId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3920,11 +30640,11 @@ func (m *DocPageFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromDate = string(dAtA[iNdEx:postIndex])
+			m.SupplierId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3952,7 +30672,7 @@ func (m *DocPageFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToDate = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3960,10 +30680,7 @@ func (m *DocPageFilter) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -3979,7 +30696,7 @@ func (m *DocPageFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocPageFilterRes) Unmarshal(dAtA []byte) error {
+func (m *LowStockOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4002,15 +30719,15 @@ func (m *DocPageFilterRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocPageFilterRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: LowStockOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocPageFilterRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LowStockOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatientInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4037,14 +30754,14 @@ func (m *DocPageFilterRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatientInfo = append(m.PatientInfo, &DocPage{})
-			if err := m.PatientInfo[len(m.PatientInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, &PurchaseOrder{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorReport", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutSupplier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4071,8 +30788,8 @@ func (m *DocPageFilterRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorReport = append(m.DoctorReport, &DoctorReportRes{})
-			if err := m.DoctorReport[len(m.DoctorReport)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithoutSupplier = append(m.WithoutSupplier, &SqladRes{})
+			if err := m.WithoutSupplier[len(m.WithoutSupplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4082,10 +30799,7 @@ func (m *DocPageFilterRes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -4101,7 +30815,7 @@ func (m *DocPageFilterRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DocPage) Unmarshal(dAtA []byte) error {
+func (m *PurchaseOrderStatusReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4124,17 +30838,17 @@ func (m *DocPage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DocPage: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurchaseOrderStatusReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DocPage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurchaseOrderStatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.QueueNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4144,14 +30858,110 @@ func (m *DocPage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueueNumber |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseOrderReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseOrderReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseOrderReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4179,11 +30989,11 @@ func (m *DocPage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FullName = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4211,13 +31021,13 @@ func (m *DocPage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateLastVisit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4227,23 +31037,25 @@ func (m *DocPage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DateLastVisit = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &PurchaseOrderReceiptItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4251,10 +31063,7 @@ func (m *DocPage) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -4270,7 +31079,7 @@ func (m *DocPage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LowStockRes) Unmarshal(dAtA []byte) error {
+func (m *PurchaseOrderReceiptItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4293,17 +31102,17 @@ func (m *LowStockRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LowStockRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurchaseOrderReceiptItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LowStockRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurchaseOrderReceiptItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowStock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4313,31 +31122,29 @@ func (m *LowStockRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowStock = append(m.LowStock, &SqladRes{})
-			if err := m.LowStock[len(m.LowStock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ItemId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			m.Count = 0
+			m.Quantity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4347,68 +31154,46 @@ func (m *LowStockRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				m.Quantity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotNumber", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SqladId) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SqladId: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SqladId: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.LotNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4436,7 +31221,7 @@ func (m *SqladId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4444,10 +31229,7 @@ func (m *SqladId) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -4463,7 +31245,7 @@ func (m *SqladId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SqladGetReq) Unmarshal(dAtA []byte) error {
+func (m *SupplierSpendReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4486,15 +31268,15 @@ func (m *SqladGetReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SqladGetReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplierSpendReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SqladGetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplierSpendReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4522,11 +31304,11 @@ func (m *SqladGetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.FromDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4554,7 +31336,39 @@ func (m *SqladGetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ToDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4562,10 +31376,7 @@ func (m *SqladGetReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -4581,7 +31392,7 @@ func (m *SqladGetReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SqladReq) Unmarshal(dAtA []byte) error {
+func (m *SupplierSpend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4604,15 +31415,15 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SqladReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplierSpend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SqladReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplierSpend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4640,11 +31451,11 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.SupplierId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4672,13 +31483,13 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SupplierName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
-			m.Count = 0
+			m.Orders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4688,14 +31499,14 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				m.Orders |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -4703,12 +31514,12 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Price = float64(math.Float64frombits(v))
+			m.Spent = float64(math.Float64frombits(v))
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowStock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			m.LowStock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4718,14 +31529,80 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowStock |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &SupplierSpendItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplierSpendItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplierSpendItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplierSpendItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4753,11 +31630,11 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			m.SqladId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4785,18 +31662,45 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.SqladName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Spent = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -4812,7 +31716,7 @@ func (m *SqladReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SqladRes) Unmarshal(dAtA []byte) error {
+func (m *SupplierSpendReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4835,15 +31739,15 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SqladRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplierSpendReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SqladRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplierSpendReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4871,11 +31775,11 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.FromDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4903,13 +31807,13 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ToDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suppliers", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4919,14 +31823,29 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suppliers = append(m.Suppliers, &SupplierSpend{})
+			if err := m.Suppliers[len(m.Suppliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -4934,12 +31853,95 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Price = float64(math.Float64frombits(v))
-		case 5:
+			m.Total = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stocktake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stocktake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stocktake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowStock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.LowStock = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -4949,14 +31951,14 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowStock |= int64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4984,11 +31986,11 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5016,11 +32018,11 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5048,11 +32050,11 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5080,65 +32082,11 @@ func (m *SqladRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.PostedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReportId) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportId: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportId: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5166,65 +32114,43 @@ func (m *ReportId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReportId = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostedAt", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDoctor
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DoctorReportsResp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorReportsResp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorReportsResp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PostedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5251,16 +32177,16 @@ func (m *DoctorReportsResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorReports = append(m.DoctorReports, &DoctorReportRes{})
-			if err := m.DoctorReports[len(m.DoctorReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lines = append(m.Lines, &StocktakeLine{})
+			if err := m.Lines[len(m.Lines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5270,21 +32196,44 @@ func (m *DoctorReportsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counts = append(m.Counts, &StocktakeCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueDifference", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ValueDifference = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -5300,7 +32249,7 @@ func (m *DoctorReportsResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorReportsFindReq) Unmarshal(dAtA []byte) error {
+func (m *StocktakeLine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5323,17 +32272,17 @@ func (m *DoctorReportsFindReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorReportsFindReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: StocktakeLine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorReportsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StocktakeLine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5343,16 +32292,29 @@ func (m *DoctorReportsFindReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5362,14 +32324,27 @@ func (m *DoctorReportsFindReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqladName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5397,65 +32372,11 @@ func (m *DoctorReportsFindReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Search = string(dAtA[iNdEx:postIndex])
+			m.LotId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDoctorReport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDoctorReport: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDoctorReport: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5483,11 +32404,11 @@ func (m *GetDoctorReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.LotNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5515,67 +32436,32 @@ func (m *GetDoctorReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDoctor(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DoctorReport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDoctor
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorReport: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorReport: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counted", wireType)
 			}
-			var stringLen uint64
+			m.Counted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5585,29 +32471,16 @@ func (m *DoctorReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Counted |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Difference", wireType)
 			}
-			var stringLen uint64
+			m.Difference = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5617,29 +32490,16 @@ func (m *DoctorReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Difference |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCounted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5649,27 +32509,37 @@ func (m *DoctorReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
+			m.IsCounted = bool(v != 0)
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitCost", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UnitCost = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueDifference", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ValueDifference = float64(math.Float64frombits(v))
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovementId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5697,7 +32567,7 @@ func (m *DoctorReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
+			m.MovementId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5705,10 +32575,7 @@ func (m *DoctorReport) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -5724,7 +32591,7 @@ func (m *DoctorReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
+func (m *StocktakeCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5747,15 +32614,15 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorReportRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: StocktakeCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorReportRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StocktakeCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5783,11 +32650,11 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.SqladId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5815,11 +32682,11 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.SqladName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5847,13 +32714,13 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			m.LotId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			var stringLen uint64
+			m.Quantity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -5863,27 +32730,14 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Quantity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Text = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5911,7 +32765,7 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.CounterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -5951,10 +32805,7 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -5970,7 +32821,7 @@ func (m *DoctorReportRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorId) Unmarshal(dAtA []byte) error {
+func (m *StocktakeId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5993,15 +32844,15 @@ func (m *DoctorId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorId: wiretype end group for non-group")
+			return fmt.Errorf("proto: StocktakeId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StocktakeId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6029,7 +32880,7 @@ func (m *DoctorId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6037,10 +32888,7 @@ func (m *DoctorId) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -6056,7 +32904,7 @@ func (m *DoctorId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorsFindReq) Unmarshal(dAtA []byte) error {
+func (m *StocktakeGetReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6079,17 +32927,17 @@ func (m *DoctorsFindReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorsFindReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: StocktakeGetReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StocktakeGetReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -6099,35 +32947,29 @@ func (m *DoctorsFindReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDoctor
 			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoctor
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyDiscrepancies", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -6137,34 +32979,19 @@ func (m *DoctorsFindReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Search = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.OnlyDiscrepancies = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -6180,7 +33007,7 @@ func (m *DoctorsFindReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoctorsResp) Unmarshal(dAtA []byte) error {
+func (m *StocktakesFindReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6203,17 +33030,17 @@ func (m *DoctorsResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoctorsResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: StocktakesFindReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoctorsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StocktakesFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Doctors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -6223,31 +33050,29 @@ func (m *DoctorsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Doctors = append(m.Doctors, &Doctor{})
-			if err := m.Doctors[len(m.Doctors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Count = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -6257,7 +33082,26 @@ func (m *DoctorsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoctor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6268,10 +33112,7 @@ func (m *DoctorsResp) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -6287,7 +33128,7 @@ func (m *DoctorsResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDoctorReq) Unmarshal(dAtA []byte) error {
+func (m *Stocktakes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6310,17 +33151,17 @@ func (m *GetDoctorReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDoctorReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: Stocktakes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDoctorReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Stocktakes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stocktakes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -6330,66 +33171,52 @@ func (m *GetDoctorReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Stocktakes = append(m.Stocktakes, &Stocktake{})
+			if err := m.Stocktakes[len(m.Stocktakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
 				}
 				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoctor(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -6405,7 +33232,7 @@ func (m *GetDoctorReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Doctor) Unmarshal(dAtA []byte) error {
+func (m *StocktakeCountReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6428,15 +33255,15 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Doctor: wiretype end group for non-group")
+			return fmt.Errorf("proto: StocktakeCountReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Doctor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StocktakeCountReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StocktakeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6464,11 +33291,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.StocktakeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6496,13 +33323,13 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
+			m.CounterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -6512,102 +33339,80 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, &StocktakeCountItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Gender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkTime", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkTime = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StocktakeCountItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Price = float64(math.Float64frombits(v))
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StocktakeCountItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StocktakeCountItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpecialety", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SqladId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6635,11 +33440,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cpecialety = string(dAtA[iNdEx:postIndex])
+			m.SqladId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6667,13 +33472,13 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoomNumber = string(dAtA[iNdEx:postIndex])
+			m.LotId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			var stringLen uint64
+			m.Quantity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDoctor
@@ -6683,59 +33488,65 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Quantity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoctor(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDoctor
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDoctor
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StocktakePostReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoctor
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StocktakePostReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StocktakePostReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6763,11 +33574,11 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6795,7 +33606,7 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6803,10 +33614,7 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDoctor
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDoctor
 			}
 			if (iNdEx + skippy) > l {
@@ -6825,6 +33633,7 @@ func (m *Doctor) Unmarshal(dAtA []byte) error {
 func skipDoctor(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -6856,10 +33665,8 @@ func skipDoctor(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -6880,55 +33687,30 @@ func skipDoctor(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthDoctor
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthDoctor
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowDoctor
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipDoctor(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthDoctor
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDoctor
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDoctor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthDoctor = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowDoctor   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthDoctor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDoctor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDoctor = fmt.Errorf("proto: unexpected end of group")
 )
@@ -26,7 +26,70 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SubCategoryFindReq struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
+	Page                 int64    `protobuf:"varint,2,opt,name=page,proto3" json:"page"`
+	CategoryId           string   `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubCategoryFindReq) Reset()         { *m = SubCategoryFindReq{} }
+func (m *SubCategoryFindReq) String() string { return proto.CompactTextString(m) }
+func (*SubCategoryFindReq) ProtoMessage()    {}
+func (*SubCategoryFindReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_328b0473e092dc8d, []int{0}
+}
+func (m *SubCategoryFindReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubCategoryFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubCategoryFindReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubCategoryFindReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubCategoryFindReq.Merge(m, src)
+}
+func (m *SubCategoryFindReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubCategoryFindReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubCategoryFindReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubCategoryFindReq proto.InternalMessageInfo
+
+func (m *SubCategoryFindReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SubCategoryFindReq) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *SubCategoryFindReq) GetCategoryId() string {
+	if m != nil {
+		return m.CategoryId
+	}
+	return ""
+}
 
 type AnalysisGetReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
@@ -40,7 +103,7 @@ func (m *AnalysisGetReq) Reset()         { *m = AnalysisGetReq{} }
 func (m *AnalysisGetReq) String() string { return proto.CompactTextString(m) }
 func (*AnalysisGetReq) ProtoMessage()    {}
 func (*AnalysisGetReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{0}
+	return fileDescriptor_328b0473e092dc8d, []int{1}
 }
 func (m *AnalysisGetReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -50,7 +113,7 @@ func (m *AnalysisGetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_AnalysisGetReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -84,10 +147,12 @@ func (m *AnalysisGetReq) GetValue() string {
 }
 
 type AnalysisReq struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	ClientId             int64    `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id"`
-	AparatId             string   `protobuf:"bytes,3,opt,name=aparat_id,json=aparatId,proto3" json:"aparat_id"`
-	AnalysisUrl          string   `protobuf:"bytes,4,opt,name=analysis_url,json=analysisUrl,proto3" json:"analysis_url"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ClientId    int64  `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id"`
+	AparatId    string `protobuf:"bytes,3,opt,name=aparat_id,json=aparatId,proto3" json:"aparat_id"`
+	AnalysisUrl string `protobuf:"bytes,4,opt,name=analysis_url,json=analysisUrl,proto3" json:"analysis_url"`
+	// staff who performed the analysis, the actor of the sqlad it consumed
+	ActorId              string   `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -97,7 +162,7 @@ func (m *AnalysisReq) Reset()         { *m = AnalysisReq{} }
 func (m *AnalysisReq) String() string { return proto.CompactTextString(m) }
 func (*AnalysisReq) ProtoMessage()    {}
 func (*AnalysisReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{1}
+	return fileDescriptor_328b0473e092dc8d, []int{2}
 }
 func (m *AnalysisReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,7 +172,7 @@ func (m *AnalysisReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_AnalysisReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -154,6 +219,13 @@ func (m *AnalysisReq) GetAnalysisUrl() string {
 	return ""
 }
 
+func (m *AnalysisReq) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
 type AnalysisResp struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	ClientId             int64    `protobuf:"varint,2,opt,name=client_id,json=clientId,proto3" json:"client_id"`
@@ -170,7 +242,7 @@ func (m *AnalysisResp) Reset()         { *m = AnalysisResp{} }
 func (m *AnalysisResp) String() string { return proto.CompactTextString(m) }
 func (*AnalysisResp) ProtoMessage()    {}
 func (*AnalysisResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{2}
+	return fileDescriptor_328b0473e092dc8d, []int{3}
 }
 func (m *AnalysisResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +252,7 @@ func (m *AnalysisResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_AnalysisResp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -253,7 +325,7 @@ func (m *SubCategoriesRes) Reset()         { *m = SubCategoriesRes{} }
 func (m *SubCategoriesRes) String() string { return proto.CompactTextString(m) }
 func (*SubCategoriesRes) ProtoMessage()    {}
 func (*SubCategoriesRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{3}
+	return fileDescriptor_328b0473e092dc8d, []int{4}
 }
 func (m *SubCategoriesRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +335,7 @@ func (m *SubCategoriesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return xxx_messageInfo_SubCategoriesRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -309,7 +381,7 @@ func (m *SubCategory) Reset()         { *m = SubCategory{} }
 func (m *SubCategory) String() string { return proto.CompactTextString(m) }
 func (*SubCategory) ProtoMessage()    {}
 func (*SubCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{4}
+	return fileDescriptor_328b0473e092dc8d, []int{5}
 }
 func (m *SubCategory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +391,7 @@ func (m *SubCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_SubCategory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -374,7 +446,7 @@ func (m *SubCategoryRes) Reset()         { *m = SubCategoryRes{} }
 func (m *SubCategoryRes) String() string { return proto.CompactTextString(m) }
 func (*SubCategoryRes) ProtoMessage()    {}
 func (*SubCategoryRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{5}
+	return fileDescriptor_328b0473e092dc8d, []int{6}
 }
 func (m *SubCategoryRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +456,7 @@ func (m *SubCategoryRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_SubCategoryRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -450,7 +522,7 @@ func (m *Category) Reset()         { *m = Category{} }
 func (m *Category) String() string { return proto.CompactTextString(m) }
 func (*Category) ProtoMessage()    {}
 func (*Category) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{6}
+	return fileDescriptor_328b0473e092dc8d, []int{7}
 }
 func (m *Category) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +532,7 @@ func (m *Category) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Category.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -507,7 +579,7 @@ func (m *CategoryRes) Reset()         { *m = CategoryRes{} }
 func (m *CategoryRes) String() string { return proto.CompactTextString(m) }
 func (*CategoryRes) ProtoMessage()    {}
 func (*CategoryRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{7}
+	return fileDescriptor_328b0473e092dc8d, []int{8}
 }
 func (m *CategoryRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,7 +589,7 @@ func (m *CategoryRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CategoryRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -575,7 +647,7 @@ func (m *CategoryId) Reset()         { *m = CategoryId{} }
 func (m *CategoryId) String() string { return proto.CompactTextString(m) }
 func (*CategoryId) ProtoMessage()    {}
 func (*CategoryId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{8}
+	return fileDescriptor_328b0473e092dc8d, []int{9}
 }
 func (m *CategoryId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +657,7 @@ func (m *CategoryId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_CategoryId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -623,7 +695,7 @@ func (m *CategoryGetReq) Reset()         { *m = CategoryGetReq{} }
 func (m *CategoryGetReq) String() string { return proto.CompactTextString(m) }
 func (*CategoryGetReq) ProtoMessage()    {}
 func (*CategoryGetReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{9}
+	return fileDescriptor_328b0473e092dc8d, []int{10}
 }
 func (m *CategoryGetReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +705,7 @@ func (m *CategoryGetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_CategoryGetReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -679,7 +751,7 @@ func (m *CategoryFindReq) Reset()         { *m = CategoryFindReq{} }
 func (m *CategoryFindReq) String() string { return proto.CompactTextString(m) }
 func (*CategoryFindReq) ProtoMessage()    {}
 func (*CategoryFindReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{10}
+	return fileDescriptor_328b0473e092dc8d, []int{11}
 }
 func (m *CategoryFindReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +761,7 @@ func (m *CategoryFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_CategoryFindReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -741,7 +813,7 @@ func (m *CategoriesRes) Reset()         { *m = CategoriesRes{} }
 func (m *CategoriesRes) String() string { return proto.CompactTextString(m) }
 func (*CategoriesRes) ProtoMessage()    {}
 func (*CategoriesRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{11}
+	return fileDescriptor_328b0473e092dc8d, []int{12}
 }
 func (m *CategoriesRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +823,7 @@ func (m *CategoriesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_CategoriesRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -801,7 +873,7 @@ func (m *AparatCreateReq) Reset()         { *m = AparatCreateReq{} }
 func (m *AparatCreateReq) String() string { return proto.CompactTextString(m) }
 func (*AparatCreateReq) ProtoMessage()    {}
 func (*AparatCreateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{12}
+	return fileDescriptor_328b0473e092dc8d, []int{13}
 }
 func (m *AparatCreateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +883,7 @@ func (m *AparatCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_AparatCreateReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -898,7 +970,7 @@ func (m *AparatCreateRes) Reset()         { *m = AparatCreateRes{} }
 func (m *AparatCreateRes) String() string { return proto.CompactTextString(m) }
 func (*AparatCreateRes) ProtoMessage()    {}
 func (*AparatCreateRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{13}
+	return fileDescriptor_328b0473e092dc8d, []int{14}
 }
 func (m *AparatCreateRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -908,7 +980,7 @@ func (m *AparatCreateRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_AparatCreateRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1002,7 +1074,7 @@ func (m *AparatGetReq) Reset()         { *m = AparatGetReq{} }
 func (m *AparatGetReq) String() string { return proto.CompactTextString(m) }
 func (*AparatGetReq) ProtoMessage()    {}
 func (*AparatGetReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{14}
+	return fileDescriptor_328b0473e092dc8d, []int{15}
 }
 func (m *AparatGetReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1084,7 @@ func (m *AparatGetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_AparatGetReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1058,7 +1130,7 @@ func (m *AparatsFindReq) Reset()         { *m = AparatsFindReq{} }
 func (m *AparatsFindReq) String() string { return proto.CompactTextString(m) }
 func (*AparatsFindReq) ProtoMessage()    {}
 func (*AparatsFindReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{15}
+	return fileDescriptor_328b0473e092dc8d, []int{16}
 }
 func (m *AparatsFindReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1140,7 @@ func (m *AparatsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_AparatsFindReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1120,7 +1192,7 @@ func (m *AparatsRes) Reset()         { *m = AparatsRes{} }
 func (m *AparatsRes) String() string { return proto.CompactTextString(m) }
 func (*AparatsRes) ProtoMessage()    {}
 func (*AparatsRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{16}
+	return fileDescriptor_328b0473e092dc8d, []int{17}
 }
 func (m *AparatsRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,7 +1202,7 @@ func (m *AparatsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_AparatsRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1179,7 +1251,7 @@ func (m *AparatUpdateReq) Reset()         { *m = AparatUpdateReq{} }
 func (m *AparatUpdateReq) String() string { return proto.CompactTextString(m) }
 func (*AparatUpdateReq) ProtoMessage()    {}
 func (*AparatUpdateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{17}
+	return fileDescriptor_328b0473e092dc8d, []int{18}
 }
 func (m *AparatUpdateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,7 +1261,7 @@ func (m *AparatUpdateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_AparatUpdateReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1261,7 +1333,7 @@ func (m *AparatId) Reset()         { *m = AparatId{} }
 func (m *AparatId) String() string { return proto.CompactTextString(m) }
 func (*AparatId) ProtoMessage()    {}
 func (*AparatId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{18}
+	return fileDescriptor_328b0473e092dc8d, []int{19}
 }
 func (m *AparatId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1343,7 @@ func (m *AparatId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_AparatId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1308,7 +1380,7 @@ func (m *LabId) Reset()         { *m = LabId{} }
 func (m *LabId) String() string { return proto.CompactTextString(m) }
 func (*LabId) ProtoMessage()    {}
 func (*LabId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{19}
+	return fileDescriptor_328b0473e092dc8d, []int{20}
 }
 func (m *LabId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1318,7 +1390,7 @@ func (m *LabId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_LabId.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1360,7 +1432,7 @@ func (m *LabUpdateReq) Reset()         { *m = LabUpdateReq{} }
 func (m *LabUpdateReq) String() string { return proto.CompactTextString(m) }
 func (*LabUpdateReq) ProtoMessage()    {}
 func (*LabUpdateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{20}
+	return fileDescriptor_328b0473e092dc8d, []int{21}
 }
 func (m *LabUpdateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1442,7 @@ func (m *LabUpdateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_LabUpdateReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1431,6 +1503,165 @@ func (m *LabUpdateReq) GetRoomNumber() string {
 	return ""
 }
 
+type ServiceIds struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceIds) Reset()         { *m = ServiceIds{} }
+func (m *ServiceIds) String() string { return proto.CompactTextString(m) }
+func (*ServiceIds) ProtoMessage()    {}
+func (*ServiceIds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_328b0473e092dc8d, []int{22}
+}
+func (m *ServiceIds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceIds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceIds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceIds.Merge(m, src)
+}
+func (m *ServiceIds) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceIds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceIds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceIds proto.InternalMessageInfo
+
+func (m *ServiceIds) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type LabsByIdsResp struct {
+	Labs []*LabCreateRes `protobuf:"bytes,1,rep,name=labs,proto3" json:"labs"`
+	// ids no lab was found for
+	MissingIds           []string `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabsByIdsResp) Reset()         { *m = LabsByIdsResp{} }
+func (m *LabsByIdsResp) String() string { return proto.CompactTextString(m) }
+func (*LabsByIdsResp) ProtoMessage()    {}
+func (*LabsByIdsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_328b0473e092dc8d, []int{23}
+}
+func (m *LabsByIdsResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabsByIdsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabsByIdsResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LabsByIdsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabsByIdsResp.Merge(m, src)
+}
+func (m *LabsByIdsResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabsByIdsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabsByIdsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabsByIdsResp proto.InternalMessageInfo
+
+func (m *LabsByIdsResp) GetLabs() []*LabCreateRes {
+	if m != nil {
+		return m.Labs
+	}
+	return nil
+}
+
+func (m *LabsByIdsResp) GetMissingIds() []string {
+	if m != nil {
+		return m.MissingIds
+	}
+	return nil
+}
+
+type AparatsByIdsResp struct {
+	Aparats []*AparatCreateRes `protobuf:"bytes,1,rep,name=aparats,proto3" json:"aparats"`
+	// ids no aparat was found for
+	MissingIds           []string `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AparatsByIdsResp) Reset()         { *m = AparatsByIdsResp{} }
+func (m *AparatsByIdsResp) String() string { return proto.CompactTextString(m) }
+func (*AparatsByIdsResp) ProtoMessage()    {}
+func (*AparatsByIdsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_328b0473e092dc8d, []int{24}
+}
+func (m *AparatsByIdsResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AparatsByIdsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AparatsByIdsResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AparatsByIdsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AparatsByIdsResp.Merge(m, src)
+}
+func (m *AparatsByIdsResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AparatsByIdsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AparatsByIdsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AparatsByIdsResp proto.InternalMessageInfo
+
+func (m *AparatsByIdsResp) GetAparats() []*AparatCreateRes {
+	if m != nil {
+		return m.Aparats
+	}
+	return nil
+}
+
+func (m *AparatsByIdsResp) GetMissingIds() []string {
+	if m != nil {
+		return m.MissingIds
+	}
+	return nil
+}
+
 type LabsRes struct {
 	Labs                 []*LabCreateRes `protobuf:"bytes,1,rep,name=labs,proto3" json:"labs"`
 	Count                int64           `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
@@ -1443,7 +1674,7 @@ func (m *LabsRes) Reset()         { *m = LabsRes{} }
 func (m *LabsRes) String() string { return proto.CompactTextString(m) }
 func (*LabsRes) ProtoMessage()    {}
 func (*LabsRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{21}
+	return fileDescriptor_328b0473e092dc8d, []int{25}
 }
 func (m *LabsRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1684,7 @@ func (m *LabsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_LabsRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1499,7 +1730,7 @@ func (m *LabsFindReq) Reset()         { *m = LabsFindReq{} }
 func (m *LabsFindReq) String() string { return proto.CompactTextString(m) }
 func (*LabsFindReq) ProtoMessage()    {}
 func (*LabsFindReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{22}
+	return fileDescriptor_328b0473e092dc8d, []int{26}
 }
 func (m *LabsFindReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1509,7 +1740,7 @@ func (m *LabsFindReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_LabsFindReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1561,7 +1792,7 @@ func (m *LabGetReq) Reset()         { *m = LabGetReq{} }
 func (m *LabGetReq) String() string { return proto.CompactTextString(m) }
 func (*LabGetReq) ProtoMessage()    {}
 func (*LabGetReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{23}
+	return fileDescriptor_328b0473e092dc8d, []int{27}
 }
 func (m *LabGetReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1571,7 +1802,7 @@ func (m *LabGetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_LabGetReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1621,7 +1852,7 @@ func (m *LabCreateReq) Reset()         { *m = LabCreateReq{} }
 func (m *LabCreateReq) String() string { return proto.CompactTextString(m) }
 func (*LabCreateReq) ProtoMessage()    {}
 func (*LabCreateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{24}
+	return fileDescriptor_328b0473e092dc8d, []int{28}
 }
 func (m *LabCreateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1631,7 +1862,7 @@ func (m *LabCreateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_LabCreateReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1719,7 +1950,7 @@ func (m *LabCreateRes) Reset()         { *m = LabCreateRes{} }
 func (m *LabCreateRes) String() string { return proto.CompactTextString(m) }
 func (*LabCreateRes) ProtoMessage()    {}
 func (*LabCreateRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_328b0473e092dc8d, []int{25}
+	return fileDescriptor_328b0473e092dc8d, []int{29}
 }
 func (m *LabCreateRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1729,7 +1960,7 @@ func (m *LabCreateRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_LabCreateRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -1819,6 +2050,7 @@ func (m *LabCreateRes) GetDeletedAt() string {
 }
 
 func init() {
+	proto.RegisterType((*SubCategoryFindReq)(nil), "lab.SubCategoryFindReq")
 	proto.RegisterType((*AnalysisGetReq)(nil), "lab.AnalysisGetReq")
 	proto.RegisterType((*AnalysisReq)(nil), "lab.AnalysisReq")
 	proto.RegisterType((*AnalysisResp)(nil), "lab.AnalysisResp")
@@ -1840,6 +2072,9 @@ func init() {
 	proto.RegisterType((*AparatId)(nil), "lab.AparatId")
 	proto.RegisterType((*LabId)(nil), "lab.LabId")
 	proto.RegisterType((*LabUpdateReq)(nil), "lab.LabUpdateReq")
+	proto.RegisterType((*ServiceIds)(nil), "lab.ServiceIds")
+	proto.RegisterType((*LabsByIdsResp)(nil), "lab.LabsByIdsResp")
+	proto.RegisterType((*AparatsByIdsResp)(nil), "lab.AparatsByIdsResp")
 	proto.RegisterType((*LabsRes)(nil), "lab.LabsRes")
 	proto.RegisterType((*LabsFindReq)(nil), "lab.LabsFindReq")
 	proto.RegisterType((*LabGetReq)(nil), "lab.LabGetReq")
@@ -1850,76 +2085,84 @@ func init() {
 func init() { proto.RegisterFile("lab/lab.proto", fileDescriptor_328b0473e092dc8d) }
 
 var fileDescriptor_328b0473e092dc8d = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xf3, 0xd7, 0xe4, 0xe4, 0x7f, 0x36, 0x6d, 0xa3, 0x14, 0x96, 0x62, 0xf1, 0xd3, 0x0b,
-	0xc8, 0x8a, 0x56, 0x14, 0xba, 0xda, 0xa5, 0x64, 0xb7, 0x2c, 0x8a, 0x88, 0x40, 0x78, 0xd5, 0xeb,
-	0x68, 0x1c, 0xcf, 0x2e, 0x96, 0x9c, 0x38, 0x6b, 0x3b, 0x95, 0x96, 0x5b, 0x1e, 0x81, 0x1b, 0x84,
-	0x84, 0xb8, 0xe6, 0x11, 0xb8, 0x45, 0x42, 0xe2, 0x92, 0x47, 0x40, 0xcb, 0x8b, 0xa0, 0xf9, 0x8b,
-	0x3d, 0xb6, 0x13, 0xad, 0xd3, 0x15, 0xa2, 0xe2, 0x2e, 0x73, 0xce, 0xf9, 0xce, 0x9c, 0xf3, 0x9d,
-	0x6f, 0xc6, 0xb1, 0xa1, 0xe1, 0x60, 0x73, 0xcf, 0xc1, 0xe6, 0x60, 0xe1, 0xb9, 0x81, 0x8b, 0x0a,
-	0x0e, 0x36, 0xfb, 0xf7, 0xce, 0x5d, 0xf7, 0xdc, 0x21, 0x7b, 0xcc, 0x64, 0x2e, 0xcf, 0xf6, 0xc8,
-	0x6c, 0x11, 0x5c, 0xf2, 0x08, 0xfd, 0x00, 0x9a, 0xc3, 0x39, 0x76, 0x2e, 0x7d, 0xdb, 0xff, 0x9c,
-	0x04, 0x06, 0xb9, 0x40, 0x5d, 0x28, 0x9d, 0xd9, 0xc4, 0xb1, 0x7a, 0xda, 0x7d, 0xed, 0x41, 0xd5,
-	0xe0, 0x0b, 0x6a, 0x7d, 0x81, 0x9d, 0x25, 0xe9, 0xe5, 0xb9, 0x95, 0x2d, 0xf4, 0x6f, 0xa1, 0x26,
-	0xd1, 0x14, 0xda, 0x84, 0xbc, 0x2d, 0x71, 0x79, 0xdb, 0x42, 0xf7, 0xa0, 0x3a, 0x75, 0x6c, 0x32,
-	0x0f, 0x26, 0xb6, 0xc5, 0x80, 0x05, 0xa3, 0xc2, 0x0d, 0x23, 0xe6, 0xc4, 0x0b, 0xec, 0x61, 0xe6,
-	0x2c, 0x30, 0x4c, 0x85, 0x1b, 0x46, 0x16, 0x7a, 0x13, 0xea, 0x58, 0x24, 0x9e, 0x2c, 0x3d, 0xa7,
-	0x57, 0x64, 0xfe, 0x9a, 0xb4, 0x3d, 0xf7, 0x1c, 0xfd, 0x57, 0x0d, 0xea, 0xe1, 0xe6, 0xfe, 0xe2,
-	0x5f, 0xdd, 0x1d, 0xbd, 0x0e, 0x30, 0xf5, 0x08, 0x0e, 0x88, 0x35, 0xc1, 0x41, 0xaf, 0xc4, 0x02,
-	0xaa, 0xc2, 0x32, 0x0c, 0xa8, 0x7b, 0xb9, 0xb0, 0xa4, 0xbb, 0xcc, 0xdd, 0xc2, 0x32, 0x0c, 0xf4,
-	0xaf, 0xa1, 0x7d, 0xba, 0x34, 0x8f, 0x71, 0x40, 0xce, 0x5d, 0xcf, 0x26, 0xb4, 0x7e, 0xf4, 0x2e,
-	0x14, 0xed, 0xf9, 0x99, 0xdb, 0xd3, 0xee, 0x17, 0x1e, 0xd4, 0x1e, 0xee, 0x0c, 0xe8, 0x14, 0xc3,
-	0xa0, 0x4b, 0x83, 0xf8, 0x06, 0x0b, 0xa0, 0xa3, 0x98, 0xba, 0xcb, 0x79, 0x20, 0x7a, 0xe2, 0x0b,
-	0xdd, 0x80, 0x5a, 0x24, 0x3a, 0x41, 0x06, 0x82, 0xe2, 0x1c, 0xcf, 0xe4, 0xf8, 0xd8, 0x6f, 0xf4,
-	0x06, 0xd4, 0xa6, 0x22, 0x3e, 0x64, 0x01, 0xa4, 0x69, 0x64, 0xe9, 0xdf, 0x6b, 0xd0, 0x54, 0x4b,
-	0xb8, 0x91, 0xbc, 0x31, 0xf2, 0x8a, 0x9b, 0xc9, 0x2b, 0xc5, 0xc9, 0x1b, 0x40, 0x25, 0x4b, 0x9b,
-	0xba, 0x0b, 0xb5, 0xac, 0x1d, 0xa8, 0x05, 0x16, 0x36, 0x17, 0x58, 0x8c, 0x17, 0xf8, 0x1a, 0xc0,
-	0x71, 0xd8, 0x6c, 0x6c, 0x3f, 0x7a, 0xe2, 0xa4, 0x77, 0x8b, 0x13, 0x77, 0x0a, 0x2d, 0x89, 0x3e,
-	0xb1, 0xe7, 0x96, 0x80, 0x3b, 0xf6, 0xcc, 0x0e, 0x18, 0xbc, 0x60, 0xf0, 0x05, 0x6d, 0x6b, 0x81,
-	0xcf, 0x89, 0x10, 0x09, 0xfb, 0x8d, 0xee, 0x40, 0xd9, 0x27, 0xd8, 0x9b, 0x7e, 0x23, 0x5a, 0x12,
-	0x2b, 0xfd, 0x0b, 0x68, 0xa8, 0x5a, 0x7c, 0x4b, 0xd1, 0x62, 0x9b, 0x69, 0xf1, 0xba, 0x42, 0xfc,
-	0x5d, 0x83, 0xd6, 0x90, 0x9d, 0xa4, 0x63, 0x46, 0x58, 0xda, 0xc5, 0x90, 0xc6, 0x79, 0x17, 0x4a,
-	0x0b, 0xcf, 0x9e, 0x12, 0x56, 0x9b, 0x66, 0xf0, 0x05, 0x8d, 0x0c, 0x2e, 0x17, 0x44, 0x90, 0xcc,
-	0x7e, 0xa3, 0x77, 0xa0, 0xe5, 0x2f, 0xcd, 0x49, 0x54, 0x63, 0x5c, 0x24, 0x0d, 0x3f, 0x14, 0x2b,
-	0x3f, 0xe3, 0x96, 0x3b, 0x0d, 0x5c, 0x8f, 0x46, 0xf0, 0x33, 0x58, 0xe1, 0x86, 0x91, 0x45, 0x45,
-	0xea, 0xb9, 0xee, 0x6c, 0x32, 0x5f, 0xce, 0x4c, 0xe2, 0xf5, 0x6e, 0x71, 0x91, 0x52, 0xd3, 0x97,
-	0xcc, 0xa2, 0x7f, 0x97, 0x8f, 0xf7, 0xe1, 0xbf, 0x8a, 0x7d, 0xc4, 0xb4, 0x5c, 0xd9, 0xac, 0xe5,
-	0x6a, 0x5c, 0xcb, 0xfb, 0x50, 0xe7, 0x24, 0x6c, 0xa1, 0x55, 0x03, 0x9a, 0x1c, 0xeb, 0xdf, 0x9c,
-	0x54, 0x0d, 0x00, 0x91, 0x93, 0xce, 0x63, 0x00, 0xb7, 0xf8, 0xa5, 0xed, 0x0b, 0xa9, 0x76, 0x99,
-	0x54, 0x63, 0x63, 0x33, 0x64, 0xd0, 0x1a, 0xc5, 0xfe, 0xb4, 0x52, 0xec, 0x73, 0xd6, 0xf7, 0xcd,
-	0x2b, 0x56, 0x99, 0x60, 0x69, 0xf3, 0x04, 0xcb, 0x09, 0x25, 0xf6, 0xa1, 0x32, 0x94, 0x8f, 0xa6,
-	0xf8, 0x6d, 0x72, 0x17, 0x4a, 0x63, 0x6c, 0xa6, 0x38, 0x7e, 0xd4, 0xa0, 0x3e, 0xc6, 0xe6, 0x7f,
-	0xb3, 0xa3, 0x13, 0xb8, 0x35, 0xc6, 0x26, 0x1b, 0xe1, 0xdb, 0x50, 0x74, 0xb0, 0x29, 0xe7, 0xd7,
-	0x61, 0xf3, 0x1b, 0x63, 0x33, 0x1c, 0x1e, 0x73, 0xaf, 0x99, 0xdc, 0x57, 0x50, 0xa3, 0x79, 0x6e,
-	0x4e, 0x5e, 0x1f, 0x41, 0x75, 0x8c, 0xcd, 0x2d, 0xb4, 0xfe, 0x1b, 0xa7, 0xfb, 0xd5, 0xbe, 0xf2,
-	0x7e, 0xce, 0x2b, 0x4d, 0xfc, 0x0f, 0xef, 0x3b, 0xea, 0xb6, 0x88, 0x43, 0x84, 0x1b, 0xb8, 0x5b,
-	0x58, 0x86, 0xc1, 0xc3, 0x5f, 0x3a, 0x00, 0x63, 0x6c, 0x9e, 0x12, 0xef, 0x05, 0xed, 0xf2, 0x11,
-	0x93, 0x0b, 0xe7, 0x0b, 0x25, 0xb4, 0x7b, 0xd1, 0x4f, 0xca, 0x59, 0xcf, 0xa1, 0xf7, 0xa1, 0xcc,
-	0x35, 0x86, 0x9a, 0xd2, 0xcd, 0x05, 0x97, 0x1e, 0xfe, 0x1e, 0x54, 0xa4, 0xc6, 0x51, 0x5b, 0x06,
-	0x48, 0xc9, 0xf7, 0xeb, 0x2b, 0x0b, 0x8f, 0xe6, 0x15, 0xf1, 0x53, 0x1f, 0x56, 0xb4, 0xba, 0x05,
-	0xd2, 0xb7, 0xf8, 0x80, 0x81, 0x9e, 0xb1, 0x2e, 0x11, 0xc8, 0x88, 0x91, 0xd5, 0xbf, 0x33, 0xe0,
-	0xef, 0x0e, 0x03, 0xf9, 0xee, 0x30, 0xf8, 0x8c, 0xbe, 0x3b, 0xe8, 0x39, 0x74, 0x20, 0x9f, 0x0b,
-	0xa2, 0xf9, 0xb4, 0x8b, 0xf7, 0xa2, 0x9f, 0x7a, 0x1d, 0xeb, 0x39, 0xf4, 0x18, 0xaa, 0xab, 0xa7,
-	0x8a, 0xa8, 0x32, 0xfa, 0x94, 0x59, 0x8b, 0xfb, 0x10, 0x6a, 0x91, 0x27, 0x0a, 0xda, 0x89, 0x84,
-	0xad, 0x18, 0x69, 0x45, 0x8d, 0x1c, 0x76, 0x08, 0x0d, 0xb1, 0x16, 0xc4, 0x44, 0xf3, 0x87, 0xdc,
-	0xac, 0xdb, 0xf5, 0xe3, 0x15, 0x5c, 0x50, 0xd4, 0x88, 0x04, 0x6e, 0x64, 0xe9, 0x31, 0x74, 0x28,
-	0xd5, 0x42, 0xda, 0x82, 0xaa, 0x86, 0xf2, 0x77, 0xaa, 0x9f, 0xf8, 0x77, 0xa5, 0xe7, 0xd0, 0x13,
-	0x68, 0x46, 0x70, 0x94, 0xa4, 0x1d, 0x25, 0x4a, 0xd0, 0x94, 0x06, 0x3d, 0x84, 0x56, 0x04, 0xca,
-	0x68, 0xea, 0x2a, 0x61, 0x92, 0x27, 0x14, 0xb5, 0xf2, 0xff, 0x7d, 0x89, 0x8a, 0x05, 0x5d, 0xd7,
-	0xa8, 0xf8, 0x13, 0x05, 0x27, 0x78, 0x6a, 0x29, 0x81, 0x1b, 0x99, 0x7a, 0x02, 0x5d, 0x41, 0x7c,
-	0x66, 0xb2, 0x0e, 0xa0, 0xa3, 0x42, 0x33, 0xf1, 0xf5, 0x29, 0x20, 0x15, 0x9d, 0x99, 0xb2, 0x44,
-	0xe9, 0xd7, 0x67, 0x6d, 0x18, 0x87, 0x66, 0x27, 0xee, 0x29, 0x74, 0xe9, 0x85, 0xb4, 0x8c, 0xab,
-	0xac, 0x1d, 0x7f, 0x81, 0xec, 0xa7, 0xbd, 0x52, 0xb2, 0x04, 0x1d, 0x35, 0xc1, 0x5a, 0xfa, 0xd6,
-	0x24, 0x38, 0x06, 0xa4, 0x26, 0xd8, 0xc0, 0xe0, 0xed, 0x58, 0x8a, 0x15, 0x89, 0x89, 0x36, 0x04,
-	0x89, 0xd7, 0x6e, 0x63, 0x18, 0x4f, 0x90, 0x9d, 0xca, 0x23, 0xb8, 0xcb, 0xa7, 0xf1, 0x12, 0x6c,
-	0x1e, 0xc9, 0x89, 0xbe, 0x04, 0xa1, 0x27, 0x70, 0x3b, 0x91, 0x63, 0x1b, 0x4e, 0xd3, 0xfa, 0xc9,
-	0x4a, 0xeb, 0xb3, 0x94, 0x1c, 0xd9, 0x99, 0x3d, 0x94, 0xac, 0xc8, 0x0f, 0x36, 0x0a, 0xad, 0x91,
-	0x4f, 0x48, 0xe2, 0xf9, 0x14, 0xfd, 0xae, 0xc3, 0xe0, 0x1d, 0x15, 0x1e, 0x32, 0xaa, 0x7e, 0xbc,
-	0x4a, 0x87, 0x3f, 0x8d, 0xef, 0x9e, 0xf5, 0x1a, 0xdf, 0x67, 0x47, 0x64, 0xbb, 0xda, 0xf7, 0xd9,
-	0x55, 0xbe, 0x5d, 0xe1, 0x07, 0xca, 0xbe, 0x19, 0xab, 0x3e, 0x6a, 0xff, 0x71, 0xb5, 0xab, 0xfd,
-	0x79, 0xb5, 0xab, 0xfd, 0x75, 0xb5, 0xab, 0xfd, 0xf0, 0xf7, 0x6e, 0xce, 0x2c, 0xb3, 0x98, 0x47,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x0a, 0x31, 0x72, 0x26, 0x14, 0x00, 0x00,
+	// 1219 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x5b, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x73, 0x69, 0x93, 0x93, 0xe6, 0x36, 0x4d, 0xb7, 0x21, 0x0b, 0xa5, 0x58, 0x5c, 0xfa,
+	0x00, 0xa9, 0xd8, 0xd5, 0x2e, 0x6c, 0x69, 0x59, 0xd2, 0x2e, 0x45, 0x16, 0x11, 0x08, 0x57, 0x2b,
+	0x1e, 0xa3, 0x71, 0x3c, 0x2d, 0x96, 0x9c, 0x38, 0xb5, 0x9d, 0x95, 0xf2, 0xcc, 0x4f, 0x40, 0x48,
+	0x08, 0x09, 0xf1, 0xc0, 0xaf, 0xe0, 0x15, 0x09, 0x89, 0x47, 0x7e, 0x02, 0x2a, 0x7f, 0x04, 0xcd,
+	0xcd, 0xb7, 0xb8, 0xd9, 0x38, 0x5b, 0x21, 0x56, 0xbc, 0x79, 0xce, 0x6d, 0xce, 0xf9, 0xce, 0x37,
+	0x67, 0x6c, 0x43, 0xd5, 0xc6, 0xc6, 0x81, 0x8d, 0x8d, 0xee, 0xc4, 0x75, 0x7c, 0x07, 0xe5, 0x6d,
+	0x6c, 0x74, 0xee, 0x5e, 0x3a, 0xce, 0xa5, 0x4d, 0x0e, 0x98, 0xc8, 0x98, 0x5e, 0x1c, 0x90, 0xd1,
+	0xc4, 0x9f, 0x71, 0x0b, 0x75, 0x00, 0xe8, 0x7c, 0x6a, 0x9c, 0x62, 0x9f, 0x5c, 0x3a, 0xee, 0xec,
+	0xcc, 0x1a, 0x9b, 0x3a, 0xb9, 0x42, 0x2d, 0x28, 0xda, 0xd6, 0xc8, 0xf2, 0xdb, 0xca, 0x9e, 0xb2,
+	0x9f, 0xd7, 0xf9, 0x02, 0x21, 0x28, 0x4c, 0xf0, 0x25, 0x69, 0xe7, 0x98, 0x90, 0x3d, 0xa3, 0xd7,
+	0xa1, 0x32, 0x14, 0xce, 0x03, 0xcb, 0x6c, 0xe7, 0xf7, 0x94, 0xfd, 0xb2, 0x0e, 0x52, 0xa4, 0x99,
+	0xea, 0x11, 0xd4, 0x7a, 0x63, 0x6c, 0xcf, 0x3c, 0xcb, 0xfb, 0x8c, 0xf8, 0x22, 0xf8, 0x85, 0x45,
+	0x6c, 0x93, 0x05, 0x2f, 0xeb, 0x7c, 0x41, 0xa5, 0xcf, 0xb0, 0x3d, 0xe5, 0xd1, 0xcb, 0x3a, 0x5f,
+	0xa8, 0xdf, 0x2b, 0x50, 0x91, 0xee, 0xd4, 0xb7, 0x06, 0x39, 0x4b, 0x3a, 0xe6, 0x2c, 0x13, 0xdd,
+	0x85, 0xf2, 0xd0, 0xb6, 0xc8, 0xd8, 0xa7, 0x9b, 0xf3, 0xbc, 0x4a, 0x5c, 0xa0, 0x31, 0x25, 0x9e,
+	0x60, 0x17, 0xfb, 0x61, 0x66, 0x25, 0x2e, 0xd0, 0x4c, 0xf4, 0x06, 0x6c, 0x62, 0x11, 0x78, 0x30,
+	0x75, 0xed, 0x76, 0x81, 0xe9, 0x2b, 0x52, 0xf6, 0xd4, 0xb5, 0xd1, 0x2b, 0x50, 0xc2, 0x43, 0xdf,
+	0x71, 0xa9, 0x7b, 0x91, 0xa9, 0x37, 0xd8, 0x5a, 0x33, 0xd5, 0x5f, 0x15, 0xd8, 0x0c, 0xf3, 0xf2,
+	0x26, 0xff, 0x6e, 0x62, 0xaf, 0x01, 0x0c, 0x5d, 0x82, 0x7d, 0x62, 0x0e, 0xb0, 0x2f, 0x52, 0x2b,
+	0x0b, 0x49, 0xcf, 0xa7, 0xea, 0xe9, 0xc4, 0x94, 0xea, 0x75, 0xae, 0x16, 0x92, 0x9e, 0xaf, 0x7e,
+	0x05, 0x8d, 0xb0, 0xe5, 0x16, 0xa1, 0xf9, 0xa3, 0x77, 0xa0, 0x60, 0x8d, 0x2f, 0x9c, 0xb6, 0xb2,
+	0x97, 0xdf, 0xaf, 0xdc, 0xdb, 0xea, 0x52, 0x0a, 0x85, 0x46, 0x33, 0x9d, 0x78, 0x3a, 0x33, 0xa0,
+	0x6d, 0x1a, 0x3a, 0xd3, 0xb1, 0x2f, 0x6a, 0xe2, 0x0b, 0x55, 0x87, 0x4a, 0xc4, 0x7a, 0x0e, 0x0c,
+	0x04, 0x85, 0x31, 0x1e, 0xc9, 0xd6, 0xb2, 0xe7, 0xe7, 0x13, 0xe7, 0x3b, 0x05, 0x6a, 0xf1, 0x14,
+	0x6e, 0x25, 0x6e, 0x02, 0xbc, 0xc2, 0x62, 0xf0, 0x8a, 0x49, 0xf0, 0xba, 0x50, 0xca, 0x52, 0xa6,
+	0xea, 0x40, 0x25, 0x6b, 0x05, 0xf1, 0x04, 0xf3, 0x8b, 0x13, 0x2c, 0x24, 0x13, 0x7c, 0x15, 0xe0,
+	0x34, 0x2c, 0x36, 0xb1, 0x1f, 0x3d, 0x8d, 0x52, 0xbb, 0xc2, 0x69, 0x3c, 0x87, 0xfa, 0xea, 0x93,
+	0xe2, 0x0e, 0xac, 0x7b, 0x04, 0xbb, 0xc3, 0x6f, 0x44, 0x49, 0x62, 0xa5, 0x7e, 0x0e, 0xd5, 0x38,
+	0x17, 0xdf, 0x8c, 0x71, 0xb1, 0xc1, 0xb8, 0xb8, 0x2c, 0x11, 0x7f, 0x57, 0xa0, 0xde, 0x63, 0x27,
+	0xe9, 0x94, 0x01, 0x96, 0x36, 0x33, 0xd2, 0x30, 0x6f, 0x41, 0x71, 0xe2, 0x5a, 0x43, 0xc2, 0x72,
+	0x53, 0x74, 0xbe, 0xa0, 0x96, 0xfe, 0x6c, 0x42, 0x04, 0xc8, 0xec, 0x19, 0xbd, 0x0d, 0x75, 0x6f,
+	0x6a, 0x0c, 0xa2, 0x1c, 0xe3, 0x24, 0xa9, 0x7a, 0x21, 0x59, 0xf9, 0x19, 0x37, 0x1d, 0x39, 0x3d,
+	0xf8, 0x19, 0x2c, 0x71, 0x81, 0x66, 0x52, 0x92, 0xba, 0x8e, 0x33, 0x1a, 0x8c, 0xa7, 0x23, 0x83,
+	0xb8, 0xed, 0x0d, 0xa6, 0x06, 0x2a, 0xfa, 0x82, 0x49, 0xd4, 0x6f, 0x73, 0xc9, 0x3a, 0xbc, 0x97,
+	0xb1, 0x8e, 0x04, 0x97, 0x4b, 0x8b, 0xb9, 0x5c, 0x4e, 0x72, 0xf9, 0x10, 0x36, 0x39, 0x08, 0x2b,
+	0x70, 0x55, 0x87, 0x1a, 0xf7, 0xf5, 0x6e, 0x8f, 0xaa, 0x3a, 0x80, 0x88, 0x49, 0xfb, 0xd1, 0x85,
+	0x0d, 0x3e, 0xb4, 0x3d, 0x41, 0xd5, 0x16, 0xa3, 0x6a, 0xa2, 0x6d, 0xba, 0x34, 0xba, 0x81, 0xb1,
+	0x3f, 0x05, 0x8c, 0x7d, 0xca, 0xea, 0xbe, 0x7d, 0xc6, 0xc6, 0x3a, 0x58, 0x5c, 0xdc, 0xc1, 0xf5,
+	0x39, 0x26, 0x76, 0xa0, 0xd4, 0x93, 0x57, 0x53, 0x72, 0x9a, 0xec, 0x40, 0xb1, 0x8f, 0x8d, 0x14,
+	0xc5, 0x8f, 0x0a, 0x6c, 0xf6, 0xb1, 0xf1, 0xdf, 0xac, 0x68, 0x17, 0xe0, 0x9c, 0xb8, 0xcf, 0xac,
+	0x21, 0xd1, 0x4c, 0x0f, 0x35, 0x20, 0x6f, 0x99, 0xbc, 0x83, 0x65, 0x9d, 0x3e, 0xaa, 0x5f, 0x43,
+	0xb5, 0x8f, 0x0d, 0xef, 0x64, 0xa6, 0x99, 0xfc, 0x6e, 0x7f, 0x0b, 0x0a, 0x36, 0x36, 0x64, 0x97,
+	0x9b, 0xac, 0xcb, 0x7d, 0x6c, 0x84, 0x2d, 0x66, 0x6a, 0xba, 0xf1, 0xc8, 0xf2, 0x3c, 0x6b, 0x7c,
+	0x39, 0xa0, 0x11, 0x73, 0x2c, 0x22, 0x08, 0x91, 0x66, 0x7a, 0xea, 0x10, 0x1a, 0x82, 0x3e, 0x61,
+	0xec, 0xac, 0x24, 0x7a, 0xee, 0x26, 0x67, 0xb0, 0x41, 0xb3, 0xa7, 0x04, 0x5d, 0x32, 0xef, 0x74,
+	0x5e, 0x7e, 0x09, 0x15, 0x1a, 0xe7, 0xf6, 0x0e, 0xcf, 0x07, 0x50, 0xee, 0x63, 0x63, 0x85, 0x93,
+	0xfc, 0x1b, 0x27, 0xd3, 0xcb, 0x3d, 0xd0, 0x7f, 0xce, 0xc5, 0x8a, 0xf8, 0x1f, 0x4e, 0x73, 0xaa,
+	0x36, 0x89, 0x4d, 0x84, 0x1a, 0xb8, 0x5a, 0x48, 0x7a, 0xfe, 0xbd, 0x5f, 0x10, 0x40, 0x1f, 0x1b,
+	0xe2, 0x68, 0xa2, 0xfb, 0x50, 0x0e, 0xf0, 0x42, 0x73, 0xdc, 0xbd, 0xea, 0xcc, 0x89, 0x3c, 0x75,
+	0x0d, 0xbd, 0x07, 0xeb, 0x9c, 0x63, 0xa8, 0x26, 0xd5, 0x9c, 0x70, 0xe9, 0xe6, 0xef, 0x42, 0x49,
+	0x72, 0x1c, 0x35, 0xa4, 0x81, 0xa4, 0x7c, 0x67, 0x33, 0x90, 0x70, 0xeb, 0x07, 0xac, 0x83, 0xf4,
+	0x2b, 0x86, 0x1d, 0x5f, 0x54, 0xe7, 0x6f, 0xc9, 0xc1, 0x28, 0xe9, 0xa0, 0xc0, 0x21, 0x38, 0xdf,
+	0xea, 0x9a, 0x28, 0x84, 0x8f, 0xc2, 0xb0, 0x90, 0x60, 0x34, 0xa6, 0x67, 0xf6, 0x3e, 0x73, 0x7a,
+	0xc2, 0xc0, 0x41, 0x20, 0x2d, 0x34, 0xb3, 0x73, 0xa7, 0xcb, 0xbf, 0xe6, 0xba, 0xf2, 0x6b, 0xae,
+	0xfb, 0x29, 0xfd, 0x9a, 0x53, 0xd7, 0xd0, 0x91, 0xbc, 0x2c, 0x05, 0x66, 0x69, 0x83, 0xe4, 0xaa,
+	0x93, 0x26, 0xa5, 0x1b, 0x3e, 0x84, 0x72, 0x70, 0xd5, 0x8a, 0x2c, 0xa3, 0x57, 0xef, 0x8d, 0x7e,
+	0x0f, 0xa0, 0x12, 0xb9, 0x66, 0xd1, 0x56, 0xc4, 0x2c, 0x00, 0xb2, 0x1e, 0x15, 0x72, 0xb7, 0x8f,
+	0xe4, 0xa5, 0xb7, 0x00, 0xce, 0xed, 0xa8, 0x5b, 0x14, 0xd1, 0x63, 0xa8, 0x0a, 0xa9, 0x40, 0x35,
+	0x9a, 0x5c, 0x08, 0xec, 0x4d, 0x29, 0x7f, 0x18, 0xb8, 0x0b, 0x7c, 0xab, 0x11, 0xc3, 0x85, 0x10,
+	0x3f, 0x84, 0x66, 0x1f, 0x07, 0xc7, 0x49, 0xe0, 0x5c, 0x8d, 0xbd, 0xa0, 0x76, 0xe6, 0xde, 0x57,
+	0xd5, 0x35, 0xf4, 0x08, 0x6a, 0x11, 0x3f, 0x8a, 0xf0, 0x56, 0xcc, 0x4a, 0x60, 0x9c, 0xe6, 0x7a,
+	0x0c, 0xf5, 0x88, 0x2b, 0xc3, 0xb8, 0x15, 0x33, 0x93, 0x20, 0xa3, 0xa8, 0x94, 0xbf, 0x49, 0xcf,
+	0x65, 0x2c, 0xe0, 0x5a, 0x22, 0xe3, 0x8f, 0x63, 0x7e, 0x02, 0xa7, 0x7a, 0xcc, 0x70, 0x21, 0x52,
+	0x8f, 0xa0, 0x25, 0x80, 0xcf, 0x0c, 0xd6, 0x11, 0x34, 0xe3, 0xae, 0x99, 0xf0, 0xfa, 0x04, 0x50,
+	0xdc, 0x3b, 0x33, 0x64, 0x73, 0xa9, 0x2f, 0x8f, 0x5a, 0x2f, 0xe9, 0x9a, 0x1d, 0xb8, 0xc7, 0xd0,
+	0xa2, 0x43, 0x70, 0x9a, 0x64, 0x59, 0x23, 0xf9, 0x49, 0xde, 0x49, 0xfb, 0x48, 0x67, 0x01, 0x9a,
+	0xf1, 0x00, 0x37, 0xc2, 0x77, 0x43, 0x80, 0x33, 0x40, 0xf1, 0x00, 0x0c, 0xc1, 0x9d, 0xa4, 0xb1,
+	0x04, 0x71, 0x3b, 0xa1, 0x08, 0x70, 0x9c, 0xab, 0x44, 0xe0, 0xb8, 0x74, 0x25, 0xbd, 0x64, 0x80,
+	0xec, 0x68, 0x9e, 0xc0, 0x0e, 0x6f, 0xc8, 0x0b, 0x00, 0x7a, 0x22, 0x9b, 0xfa, 0x02, 0x98, 0x6a,
+	0xb0, 0x3d, 0x17, 0x63, 0x45, 0x58, 0xd3, 0x4a, 0xca, 0x8a, 0xec, 0x93, 0x94, 0x18, 0xd9, 0xc1,
+	0x3d, 0x96, 0xc0, 0xc8, 0x1f, 0x61, 0x31, 0x64, 0x23, 0x7f, 0xed, 0x3a, 0xcd, 0x84, 0x44, 0x4c,
+	0xf1, 0x66, 0xdc, 0x3d, 0x04, 0x35, 0xfe, 0xc3, 0x30, 0xdd, 0xfd, 0x71, 0x72, 0xf7, 0xac, 0xc3,
+	0xfc, 0x90, 0x1d, 0x94, 0xd5, 0x72, 0x3f, 0x64, 0x03, 0x7d, 0xb5, 0xc4, 0x8f, 0x62, 0xfb, 0x66,
+	0xcc, 0xfa, 0xa4, 0xf1, 0xc7, 0xf5, 0xae, 0xf2, 0xe7, 0xf5, 0xae, 0xf2, 0xd7, 0xf5, 0xae, 0xf2,
+	0xc3, 0xdf, 0xbb, 0x6b, 0xc6, 0x3a, 0xb3, 0xb9, 0xff, 0xcf, 0x00, 0x61, 0x8a, 0xaf, 0x40, 0xfb,
+	0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1938,12 +2181,14 @@ type LabServiceClient interface {
 	LabCreate(ctx context.Context, in *LabCreateReq, opts ...grpc.CallOption) (*LabCreateRes, error)
 	LabGet(ctx context.Context, in *LabGetReq, opts ...grpc.CallOption) (*LabCreateRes, error)
 	LabsFind(ctx context.Context, in *LabsFindReq, opts ...grpc.CallOption) (*LabsRes, error)
+	LabsGetByIds(ctx context.Context, in *ServiceIds, opts ...grpc.CallOption) (*LabsByIdsResp, error)
 	LabUpdate(ctx context.Context, in *LabUpdateReq, opts ...grpc.CallOption) (*LabCreateRes, error)
 	LabDelete(ctx context.Context, in *LabId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Aparat
 	AparatCreate(ctx context.Context, in *AparatCreateReq, opts ...grpc.CallOption) (*AparatCreateRes, error)
 	AparatGet(ctx context.Context, in *AparatGetReq, opts ...grpc.CallOption) (*AparatCreateRes, error)
 	AparatsFind(ctx context.Context, in *AparatsFindReq, opts ...grpc.CallOption) (*AparatsRes, error)
+	AparatsGetByIds(ctx context.Context, in *ServiceIds, opts ...grpc.CallOption) (*AparatsByIdsResp, error)
 	AparatsUpdate(ctx context.Context, in *AparatUpdateReq, opts ...grpc.CallOption) (*AparatCreateRes, error)
 	AparatsDelete(ctx context.Context, in *AparatId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Lab categoty
@@ -1961,13 +2206,13 @@ type LabServiceClient interface {
 	// Lab sub category
 	LabSubCategoryCreate(ctx context.Context, in *SubCategory, opts ...grpc.CallOption) (*SubCategoryRes, error)
 	LabSubCategoryGet(ctx context.Context, in *CategoryGetReq, opts ...grpc.CallOption) (*SubCategoryRes, error)
-	LabSubCategoryFind(ctx context.Context, in *CategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error)
+	LabSubCategoryFind(ctx context.Context, in *SubCategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error)
 	LabSubCategoryUpdate(ctx context.Context, in *SubCategory, opts ...grpc.CallOption) (*SubCategoryRes, error)
 	LabSubCategoryDelete(ctx context.Context, in *CategoryId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Aparat sub category
 	AparatSubCategoryCreate(ctx context.Context, in *SubCategory, opts ...grpc.CallOption) (*SubCategoryRes, error)
 	AparatSubCategoryGet(ctx context.Context, in *CategoryGetReq, opts ...grpc.CallOption) (*SubCategoryRes, error)
-	AparatSubCategoryFind(ctx context.Context, in *CategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error)
+	AparatSubCategoryFind(ctx context.Context, in *SubCategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error)
 	AparatSubCategoryUpdate(ctx context.Context, in *SubCategory, opts ...grpc.CallOption) (*SubCategoryRes, error)
 	AparatSubCategoryDelete(ctx context.Context, in *CategoryId, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Aparat analysis
@@ -2015,6 +2260,15 @@ func (c *labServiceClient) LabsFind(ctx context.Context, in *LabsFindReq, opts .
 	return out, nil
 }
 
+func (c *labServiceClient) LabsGetByIds(ctx context.Context, in *ServiceIds, opts ...grpc.CallOption) (*LabsByIdsResp, error) {
+	out := new(LabsByIdsResp)
+	err := c.cc.Invoke(ctx, "/lab.LabService/LabsGetByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *labServiceClient) LabUpdate(ctx context.Context, in *LabUpdateReq, opts ...grpc.CallOption) (*LabCreateRes, error) {
 	out := new(LabCreateRes)
 	err := c.cc.Invoke(ctx, "/lab.LabService/LabUpdate", in, out, opts...)
@@ -2060,6 +2314,15 @@ func (c *labServiceClient) AparatsFind(ctx context.Context, in *AparatsFindReq,
 	return out, nil
 }
 
+func (c *labServiceClient) AparatsGetByIds(ctx context.Context, in *ServiceIds, opts ...grpc.CallOption) (*AparatsByIdsResp, error) {
+	out := new(AparatsByIdsResp)
+	err := c.cc.Invoke(ctx, "/lab.LabService/AparatsGetByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *labServiceClient) AparatsUpdate(ctx context.Context, in *AparatUpdateReq, opts ...grpc.CallOption) (*AparatCreateRes, error) {
 	out := new(AparatCreateRes)
 	err := c.cc.Invoke(ctx, "/lab.LabService/AparatsUpdate", in, out, opts...)
@@ -2186,7 +2449,7 @@ func (c *labServiceClient) LabSubCategoryGet(ctx context.Context, in *CategoryGe
 	return out, nil
 }
 
-func (c *labServiceClient) LabSubCategoryFind(ctx context.Context, in *CategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error) {
+func (c *labServiceClient) LabSubCategoryFind(ctx context.Context, in *SubCategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error) {
 	out := new(SubCategoriesRes)
 	err := c.cc.Invoke(ctx, "/lab.LabService/LabSubCategoryFind", in, out, opts...)
 	if err != nil {
@@ -2231,7 +2494,7 @@ func (c *labServiceClient) AparatSubCategoryGet(ctx context.Context, in *Categor
 	return out, nil
 }
 
-func (c *labServiceClient) AparatSubCategoryFind(ctx context.Context, in *CategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error) {
+func (c *labServiceClient) AparatSubCategoryFind(ctx context.Context, in *SubCategoryFindReq, opts ...grpc.CallOption) (*SubCategoriesRes, error) {
 	out := new(SubCategoriesRes)
 	err := c.cc.Invoke(ctx, "/lab.LabService/AparatSubCategoryFind", in, out, opts...)
 	if err != nil {
@@ -2318,12 +2581,14 @@ type LabServiceServer interface {
 	LabCreate(context.Context, *LabCreateReq) (*LabCreateRes, error)
 	LabGet(context.Context, *LabGetReq) (*LabCreateRes, error)
 	LabsFind(context.Context, *LabsFindReq) (*LabsRes, error)
+	LabsGetByIds(context.Context, *ServiceIds) (*LabsByIdsResp, error)
 	LabUpdate(context.Context, *LabUpdateReq) (*LabCreateRes, error)
 	LabDelete(context.Context, *LabId) (*empty.Empty, error)
 	// Aparat
 	AparatCreate(context.Context, *AparatCreateReq) (*AparatCreateRes, error)
 	AparatGet(context.Context, *AparatGetReq) (*AparatCreateRes, error)
 	AparatsFind(context.Context, *AparatsFindReq) (*AparatsRes, error)
+	AparatsGetByIds(context.Context, *ServiceIds) (*AparatsByIdsResp, error)
 	AparatsUpdate(context.Context, *AparatUpdateReq) (*AparatCreateRes, error)
 	AparatsDelete(context.Context, *AparatId) (*empty.Empty, error)
 	// Lab categoty
@@ -2341,13 +2606,13 @@ type LabServiceServer interface {
 	// Lab sub category
 	LabSubCategoryCreate(context.Context, *SubCategory) (*SubCategoryRes, error)
 	LabSubCategoryGet(context.Context, *CategoryGetReq) (*SubCategoryRes, error)
-	LabSubCategoryFind(context.Context, *CategoryFindReq) (*SubCategoriesRes, error)
+	LabSubCategoryFind(context.Context, *SubCategoryFindReq) (*SubCategoriesRes, error)
 	LabSubCategoryUpdate(context.Context, *SubCategory) (*SubCategoryRes, error)
 	LabSubCategoryDelete(context.Context, *CategoryId) (*empty.Empty, error)
 	// Aparat sub category
 	AparatSubCategoryCreate(context.Context, *SubCategory) (*SubCategoryRes, error)
 	AparatSubCategoryGet(context.Context, *CategoryGetReq) (*SubCategoryRes, error)
-	AparatSubCategoryFind(context.Context, *CategoryFindReq) (*SubCategoriesRes, error)
+	AparatSubCategoryFind(context.Context, *SubCategoryFindReq) (*SubCategoriesRes, error)
 	AparatSubCategoryUpdate(context.Context, *SubCategory) (*SubCategoryRes, error)
 	AparatSubCategoryDelete(context.Context, *CategoryId) (*empty.Empty, error)
 	// Aparat analysis
@@ -2373,6 +2638,9 @@ func (*UnimplementedLabServiceServer) LabGet(ctx context.Context, req *LabGetReq
 func (*UnimplementedLabServiceServer) LabsFind(ctx context.Context, req *LabsFindReq) (*LabsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LabsFind not implemented")
 }
+func (*UnimplementedLabServiceServer) LabsGetByIds(ctx context.Context, req *ServiceIds) (*LabsByIdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LabsGetByIds not implemented")
+}
 func (*UnimplementedLabServiceServer) LabUpdate(ctx context.Context, req *LabUpdateReq) (*LabCreateRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LabUpdate not implemented")
 }
@@ -2388,6 +2656,9 @@ func (*UnimplementedLabServiceServer) AparatGet(ctx context.Context, req *Aparat
 func (*UnimplementedLabServiceServer) AparatsFind(ctx context.Context, req *AparatsFindReq) (*AparatsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AparatsFind not implemented")
 }
+func (*UnimplementedLabServiceServer) AparatsGetByIds(ctx context.Context, req *ServiceIds) (*AparatsByIdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AparatsGetByIds not implemented")
+}
 func (*UnimplementedLabServiceServer) AparatsUpdate(ctx context.Context, req *AparatUpdateReq) (*AparatCreateRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AparatsUpdate not implemented")
 }
@@ -2430,7 +2701,7 @@ func (*UnimplementedLabServiceServer) LabSubCategoryCreate(ctx context.Context,
 func (*UnimplementedLabServiceServer) LabSubCategoryGet(ctx context.Context, req *CategoryGetReq) (*SubCategoryRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LabSubCategoryGet not implemented")
 }
-func (*UnimplementedLabServiceServer) LabSubCategoryFind(ctx context.Context, req *CategoryFindReq) (*SubCategoriesRes, error) {
+func (*UnimplementedLabServiceServer) LabSubCategoryFind(ctx context.Context, req *SubCategoryFindReq) (*SubCategoriesRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LabSubCategoryFind not implemented")
 }
 func (*UnimplementedLabServiceServer) LabSubCategoryUpdate(ctx context.Context, req *SubCategory) (*SubCategoryRes, error) {
@@ -2445,7 +2716,7 @@ func (*UnimplementedLabServiceServer) AparatSubCategoryCreate(ctx context.Contex
 func (*UnimplementedLabServiceServer) AparatSubCategoryGet(ctx context.Context, req *CategoryGetReq) (*SubCategoryRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AparatSubCategoryGet not implemented")
 }
-func (*UnimplementedLabServiceServer) AparatSubCategoryFind(ctx context.Context, req *CategoryFindReq) (*SubCategoriesRes, error) {
+func (*UnimplementedLabServiceServer) AparatSubCategoryFind(ctx context.Context, req *SubCategoryFindReq) (*SubCategoriesRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AparatSubCategoryFind not implemented")
 }
 func (*UnimplementedLabServiceServer) AparatSubCategoryUpdate(ctx context.Context, req *SubCategory) (*SubCategoryRes, error) {
@@ -2531,6 +2802,24 @@ func _LabService_LabsFind_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LabService_LabsGetByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabServiceServer).LabsGetByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lab.LabService/LabsGetByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabServiceServer).LabsGetByIds(ctx, req.(*ServiceIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LabService_LabUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LabUpdateReq)
 	if err := dec(in); err != nil {
@@ -2621,6 +2910,24 @@ func _LabService_AparatsFind_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LabService_AparatsGetByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabServiceServer).AparatsGetByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lab.LabService/AparatsGetByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabServiceServer).AparatsGetByIds(ctx, req.(*ServiceIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LabService_AparatsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AparatUpdateReq)
 	if err := dec(in); err != nil {
@@ -2874,7 +3181,7 @@ func _LabService_LabSubCategoryGet_Handler(srv interface{}, ctx context.Context,
 }
 
 func _LabService_LabSubCategoryFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryFindReq)
+	in := new(SubCategoryFindReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2886,7 +3193,7 @@ func _LabService_LabSubCategoryFind_Handler(srv interface{}, ctx context.Context
 		FullMethod: "/lab.LabService/LabSubCategoryFind",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabServiceServer).LabSubCategoryFind(ctx, req.(*CategoryFindReq))
+		return srv.(LabServiceServer).LabSubCategoryFind(ctx, req.(*SubCategoryFindReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2964,7 +3271,7 @@ func _LabService_AparatSubCategoryGet_Handler(srv interface{}, ctx context.Conte
 }
 
 func _LabService_AparatSubCategoryFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryFindReq)
+	in := new(SubCategoryFindReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2976,7 +3283,7 @@ func _LabService_AparatSubCategoryFind_Handler(srv interface{}, ctx context.Cont
 		FullMethod: "/lab.LabService/AparatSubCategoryFind",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabServiceServer).AparatSubCategoryFind(ctx, req.(*CategoryFindReq))
+		return srv.(LabServiceServer).AparatSubCategoryFind(ctx, req.(*SubCategoryFindReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3141,6 +3448,10 @@ var _LabService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LabsFind",
 			Handler:    _LabService_LabsFind_Handler,
 		},
+		{
+			MethodName: "LabsGetByIds",
+			Handler:    _LabService_LabsGetByIds_Handler,
+		},
 		{
 			MethodName: "LabUpdate",
 			Handler:    _LabService_LabUpdate_Handler,
@@ -3161,6 +3472,10 @@ var _LabService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AparatsFind",
 			Handler:    _LabService_AparatsFind_Handler,
 		},
+		{
+			MethodName: "AparatsGetByIds",
+			Handler:    _LabService_AparatsGetByIds_Handler,
+		},
 		{
 			MethodName: "AparatsUpdate",
 			Handler:    _LabService_AparatsUpdate_Handler,
@@ -3278,43 +3593,95 @@ var _LabService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lab/lab.proto",
 }
 
-func (m *AnalysisGetReq) Marshal() (dAtA []byte, err error) {
+func (m *SubCategoryFindReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *AnalysisGetReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *SubCategoryFindReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubCategoryFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Field) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CategoryId) > 0 {
+		i -= len(m.CategoryId)
+		copy(dAtA[i:], m.CategoryId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.CategoryId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Page != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnalysisGetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalysisGetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalysisGetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AnalysisReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3322,43 +3689,59 @@ func (m *AnalysisReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AnalysisReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalysisReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ClientId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.ClientId))
+	if len(m.AnalysisUrl) > 0 {
+		i -= len(m.AnalysisUrl)
+		copy(dAtA[i:], m.AnalysisUrl)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.AnalysisUrl)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.AparatId) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.AparatId)
+		copy(dAtA[i:], m.AparatId)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.AparatId)))
-		i += copy(dAtA[i:], m.AparatId)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.AnalysisUrl) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.AnalysisUrl)))
-		i += copy(dAtA[i:], m.AnalysisUrl)
+	if m.ClientId != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.ClientId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AnalysisResp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3366,55 +3749,66 @@ func (m *AnalysisResp) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AnalysisResp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalysisResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ClientId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.ClientId))
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.AparatId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.AparatId)))
-		i += copy(dAtA[i:], m.AparatId)
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.AnalysisUrl) > 0 {
-		dAtA[i] = 0x22
-		i++
+		i -= len(m.AnalysisUrl)
+		copy(dAtA[i:], m.AnalysisUrl)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.AnalysisUrl)))
-		i += copy(dAtA[i:], m.AnalysisUrl)
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
+	if len(m.AparatId) > 0 {
+		i -= len(m.AparatId)
+		copy(dAtA[i:], m.AparatId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.AparatId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
+	if m.ClientId != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.ClientId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *SubCategoriesRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3422,37 +3816,45 @@ func (m *SubCategoriesRes) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *SubCategoriesRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubCategoriesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Info) > 0 {
-		for _, msg := range m.Info {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintLab(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintLab(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Info) > 0 {
+		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Info[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLab(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *SubCategory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3460,38 +3862,47 @@ func (m *SubCategory) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *SubCategory) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.CategoryId) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.CategoryId)
+		copy(dAtA[i:], m.CategoryId)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.CategoryId)))
-		i += copy(dAtA[i:], m.CategoryId)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *SubCategoryRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3499,50 +3910,61 @@ func (m *SubCategoryRes) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *SubCategoryRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubCategoryRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.CategoryId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.CategoryId)))
-		i += copy(dAtA[i:], m.CategoryId)
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x22
-		i++
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
+	if len(m.CategoryId) > 0 {
+		i -= len(m.CategoryId)
+		copy(dAtA[i:], m.CategoryId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.CategoryId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Category) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3550,32 +3972,40 @@ func (m *Category) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Category) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Category) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CategoryRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3583,44 +4013,54 @@ func (m *CategoryRes) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CategoryRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CategoryId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3628,26 +4068,33 @@ func (m *CategoryId) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CategoryId) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CategoryGetReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3655,32 +4102,40 @@ func (m *CategoryGetReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CategoryGetReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryGetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Field) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CategoryFindReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3688,36 +4143,43 @@ func (m *CategoryFindReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CategoryFindReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.Page))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Search) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Search)))
-		i += copy(dAtA[i:], m.Search)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Page != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CategoriesRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3725,37 +4187,45 @@ func (m *CategoriesRes) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CategoriesRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoriesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Info) > 0 {
-		for _, msg := range m.Info {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintLab(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintLab(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Info) > 0 {
+		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Info[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLab(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AparatCreateReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3763,62 +4233,74 @@ func (m *AparatCreateReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AparatCreateReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatCreateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Price != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
+	if len(m.RoomNumber) > 0 {
+		i -= len(m.RoomNumber)
+		copy(dAtA[i:], m.RoomNumber)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.SubCategoryId) > 0 {
-		dAtA[i] = 0x2a
-		i++
+		i -= len(m.SubCategoryId)
+		copy(dAtA[i:], m.SubCategoryId)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.SubCategoryId)))
-		i += copy(dAtA[i:], m.SubCategoryId)
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.RoomNumber) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
-		i += copy(dAtA[i:], m.RoomNumber)
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AparatCreateRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3826,74 +4308,88 @@ func (m *AparatCreateRes) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AparatCreateRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatCreateRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Price != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.SubCategoryId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.SubCategoryId)))
-		i += copy(dAtA[i:], m.SubCategoryId)
+	if len(m.RoomNumber) > 0 {
+		i -= len(m.RoomNumber)
+		copy(dAtA[i:], m.RoomNumber)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x32
-		i++
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.RoomNumber) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
-		i += copy(dAtA[i:], m.RoomNumber)
+	if len(m.SubCategoryId) > 0 {
+		i -= len(m.SubCategoryId)
+		copy(dAtA[i:], m.SubCategoryId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.SubCategoryId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return i, nil
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *AparatGetReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3901,32 +4397,40 @@ func (m *AparatGetReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AparatGetReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatGetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Field) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AparatsFindReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3934,36 +4438,43 @@ func (m *AparatsFindReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AparatsFindReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.Page))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Search) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Search)))
-		i += copy(dAtA[i:], m.Search)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Page != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AparatsRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -3971,37 +4482,45 @@ func (m *AparatsRes) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AparatsRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Aparats) > 0 {
-		for _, msg := range m.Aparats {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintLab(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintLab(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Aparats) > 0 {
+		for iNdEx := len(m.Aparats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aparats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLab(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AparatUpdateReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4009,56 +4528,67 @@ func (m *AparatUpdateReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AparatUpdateReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatUpdateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if len(m.RoomNumber) > 0 {
+		i -= len(m.RoomNumber)
+		copy(dAtA[i:], m.RoomNumber)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Price != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.Type) > 0 {
-		dAtA[i] = 0x22
-		i++
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if len(m.RoomNumber) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
-		i += copy(dAtA[i:], m.RoomNumber)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *AparatId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4066,26 +4596,33 @@ func (m *AparatId) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *AparatId) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *LabId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4093,26 +4630,33 @@ func (m *LabId) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *LabId) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *LabUpdateReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4120,94 +4664,249 @@ func (m *LabUpdateReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *LabUpdateReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabUpdateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if len(m.RoomNumber) > 0 {
+		i -= len(m.RoomNumber)
+		copy(dAtA[i:], m.RoomNumber)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Price != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.Type) > 0 {
-		dAtA[i] = 0x22
-		i++
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if len(m.RoomNumber) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
-		i += copy(dAtA[i:], m.RoomNumber)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintLab(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func (m *LabsRes) Marshal() (dAtA []byte, err error) {
+func (m *LabsByIdsResp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *LabsRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+func (m *LabsByIdsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabsByIdsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingIds) > 0 {
+		for iNdEx := len(m.MissingIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingIds[iNdEx])
+			copy(dAtA[i:], m.MissingIds[iNdEx])
+			i = encodeVarintLab(dAtA, i, uint64(len(m.MissingIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Labs) > 0 {
-		for _, msg := range m.Labs {
+		for iNdEx := len(m.Labs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Labs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLab(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
-			i++
-			i = encodeVarintLab(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AparatsByIdsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AparatsByIdsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AparatsByIdsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingIds) > 0 {
+		for iNdEx := len(m.MissingIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingIds[iNdEx])
+			copy(dAtA[i:], m.MissingIds[iNdEx])
+			i = encodeVarintLab(dAtA, i, uint64(len(m.MissingIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Aparats) > 0 {
+		for iNdEx := len(m.Aparats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aparats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLab(dAtA, i, uint64(size))
 			}
-			i += n
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LabsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintLab(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Labs) > 0 {
+		for iNdEx := len(m.Labs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Labs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLab(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *LabsFindReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4215,36 +4914,43 @@ func (m *LabsFindReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *LabsFindReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabsFindReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.Limit))
-	}
-	if m.Page != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(m.Page))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Search) > 0 {
-		dAtA[i] = 0x1a
-		i++
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Search)))
-		i += copy(dAtA[i:], m.Search)
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Page != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintLab(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *LabGetReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4252,32 +4958,40 @@ func (m *LabGetReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *LabGetReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabGetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Field) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
-		i += copy(dAtA[i:], m.Field)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *LabCreateReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4285,62 +4999,74 @@ func (m *LabCreateReq) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *LabCreateReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabCreateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Price != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
+	if len(m.RoomNumber) > 0 {
+		i -= len(m.RoomNumber)
+		copy(dAtA[i:], m.RoomNumber)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+	if len(m.DoctorId) > 0 {
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.SubCategoryId) > 0 {
-		dAtA[i] = 0x2a
-		i++
+		i -= len(m.SubCategoryId)
+		copy(dAtA[i:], m.SubCategoryId)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.SubCategoryId)))
-		i += copy(dAtA[i:], m.SubCategoryId)
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.RoomNumber) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
-		i += copy(dAtA[i:], m.RoomNumber)
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *LabCreateRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -4348,85 +5074,124 @@ func (m *LabCreateRes) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *LabCreateRes) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabCreateRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Price != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
-		i += 8
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.Type) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.SubCategoryId) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.SubCategoryId)))
-		i += copy(dAtA[i:], m.SubCategoryId)
+	if len(m.RoomNumber) > 0 {
+		i -= len(m.RoomNumber)
+		copy(dAtA[i:], m.RoomNumber)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if len(m.DoctorId) > 0 {
-		dAtA[i] = 0x32
-		i++
+		i -= len(m.DoctorId)
+		copy(dAtA[i:], m.DoctorId)
 		i = encodeVarintLab(dAtA, i, uint64(len(m.DoctorId)))
-		i += copy(dAtA[i:], m.DoctorId)
-	}
-	if len(m.RoomNumber) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.RoomNumber)))
-		i += copy(dAtA[i:], m.RoomNumber)
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
+	if len(m.SubCategoryId) > 0 {
+		i -= len(m.SubCategoryId)
+		copy(dAtA[i:], m.SubCategoryId)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.SubCategoryId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.UpdatedAt) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.UpdatedAt)))
-		i += copy(dAtA[i:], m.UpdatedAt)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.DeletedAt) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintLab(dAtA, i, uint64(len(m.DeletedAt)))
-		i += copy(dAtA[i:], m.DeletedAt)
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLab(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintLab(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLab(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
+}
+func (m *SubCategoryFindReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovLab(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovLab(uint64(m.Page))
+	}
+	l = len(m.CategoryId)
+	if l > 0 {
+		n += 1 + l + sovLab(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
+
 func (m *AnalysisGetReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4468,6 +5233,10 @@ func (m *AnalysisReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLab(uint64(l))
 	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovLab(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4964,6 +5733,72 @@ func (m *LabUpdateReq) Size() (n int) {
 	return n
 }
 
+func (m *ServiceIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovLab(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LabsByIdsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labs) > 0 {
+		for _, e := range m.Labs {
+			l = e.Size()
+			n += 1 + l + sovLab(uint64(l))
+		}
+	}
+	if len(m.MissingIds) > 0 {
+		for _, s := range m.MissingIds {
+			l = len(s)
+			n += 1 + l + sovLab(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AparatsByIdsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Aparats) > 0 {
+		for _, e := range m.Aparats {
+			l = e.Size()
+			n += 1 + l + sovLab(uint64(l))
+		}
+	}
+	if len(m.MissingIds) > 0 {
+		for _, s := range m.MissingIds {
+			l = len(s)
+			n += 1 + l + sovLab(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *LabsRes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5123,6 +5958,127 @@ func sovLab(x uint64) (n int) {
 func sozLab(x uint64) (n int) {
 	return sovLab(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SubCategoryFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLab
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubCategoryFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubCategoryFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLab(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLab
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AnalysisGetReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5222,10 +6178,7 @@ func (m *AnalysisGetReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -5385,16 +6338,45 @@ func (m *AnalysisReq) Unmarshal(dAtA []byte) error {
 			}
 			m.AnalysisUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLab(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -5624,10 +6606,7 @@ func (m *AnalysisResp) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -5731,10 +6710,7 @@ func (m *SubCategoriesRes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -5881,10 +6857,7 @@ func (m *SubCategory) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6095,10 +7068,7 @@ func (m *SubCategoryRes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6213,10 +7183,7 @@ func (m *Category) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6395,10 +7362,7 @@ func (m *CategoryRes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6481,10 +7445,7 @@ func (m *CategoryId) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6599,10 +7560,7 @@ func (m *CategoryGetReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6723,10 +7681,7 @@ func (m *CategoryFindReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6773,9 +7728,252 @@ func (m *CategoriesRes) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, &CategoryRes{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLab(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLab
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AparatCreateReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLab
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AparatCreateReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AparatCreateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubCategoryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubCategoryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLab
@@ -6785,31 +7983,29 @@ func (m *CategoriesRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLab
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLab
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, &CategoryRes{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLab
@@ -6819,21 +8015,31 @@ func (m *CategoriesRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLab(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -6849,7 +8055,7 @@ func (m *CategoriesRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AparatCreateReq) Unmarshal(dAtA []byte) error {
+func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6872,10 +8078,10 @@ func (m *AparatCreateReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AparatCreateReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AparatCreateRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AparatCreateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AparatCreateRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7081,16 +8287,77 @@ func (m *AparatCreateReq) Unmarshal(dAtA []byte) error {
 			}
 			m.RoomNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLab(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -7106,7 +8373,7 @@ func (m *AparatCreateReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
+func (m *AparatGetReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7129,15 +8396,15 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AparatCreateRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: AparatGetReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AparatCreateRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AparatGetReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7165,11 +8432,11 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7197,22 +8464,100 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLab(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLab
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Price = float64(math.Float64frombits(v))
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AparatsFindReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLab
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AparatsFindReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AparatsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7237,16 +8582,67 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthLab
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLab(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLab
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AparatsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLab
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AparatsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AparatsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubCategoryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aparats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLab
@@ -7256,29 +8652,31 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLab
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLab
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubCategoryId = string(dAtA[iNdEx:postIndex])
+			m.Aparats = append(m.Aparats, &AparatCreateRes{})
+			if err := m.Aparats[len(m.Aparats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLab
@@ -7288,27 +8686,65 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLab
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLab(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DoctorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AparatUpdateReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLab
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AparatUpdateReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AparatUpdateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7336,11 +8772,11 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoomNumber = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7368,11 +8804,22 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7400,65 +8847,11 @@ func (m *AparatCreateRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLab(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AparatGetReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLab
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AparatGetReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AparatGetReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7486,11 +8879,11 @@ func (m *AparatGetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.DoctorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7518,7 +8911,7 @@ func (m *AparatGetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.RoomNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7526,10 +8919,7 @@ func (m *AparatGetReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -7545,7 +8935,7 @@ func (m *AparatGetReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AparatsFindReq) Unmarshal(dAtA []byte) error {
+func (m *AparatId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7568,53 +8958,15 @@ func (m *AparatsFindReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AparatsFindReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AparatId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AparatsFindReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AparatId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLab
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLab
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7642,7 +8994,7 @@ func (m *AparatsFindReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Search = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7650,10 +9002,7 @@ func (m *AparatsFindReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -7669,7 +9018,7 @@ func (m *AparatsFindReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AparatsRes) Unmarshal(dAtA []byte) error {
+func (m *LabId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7692,17 +9041,17 @@ func (m *AparatsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AparatsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: LabId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AparatsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LabId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aparats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLab
@@ -7712,55 +9061,31 @@ func (m *AparatsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLab
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLab
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aparats = append(m.Aparats, &AparatCreateRes{})
-			if err := m.Aparats[len(m.Aparats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLab
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLab(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -7776,7 +9101,7 @@ func (m *AparatsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AparatUpdateReq) Unmarshal(dAtA []byte) error {
+func (m *LabUpdateReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7799,10 +9124,10 @@ func (m *AparatUpdateReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AparatUpdateReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LabUpdateReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AparatUpdateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LabUpdateReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7982,10 +9307,7 @@ func (m *AparatUpdateReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -8001,7 +9323,7 @@ func (m *AparatUpdateReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AparatId) Unmarshal(dAtA []byte) error {
+func (m *ServiceIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8024,15 +9346,15 @@ func (m *AparatId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AparatId: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AparatId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8060,7 +9382,7 @@ func (m *AparatId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8068,10 +9390,7 @@ func (m *AparatId) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -8087,7 +9406,7 @@ func (m *AparatId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabId) Unmarshal(dAtA []byte) error {
+func (m *LabsByIdsResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8110,15 +9429,49 @@ func (m *LabId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabId: wiretype end group for non-group")
+			return fmt.Errorf("proto: LabsByIdsResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LabsByIdsResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLab
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLab
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLab
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labs = append(m.Labs, &LabCreateRes{})
+			if err := m.Labs[len(m.Labs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8146,7 +9499,7 @@ func (m *LabId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.MissingIds = append(m.MissingIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8154,10 +9507,7 @@ func (m *LabId) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -8173,7 +9523,7 @@ func (m *LabId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabUpdateReq) Unmarshal(dAtA []byte) error {
+func (m *AparatsByIdsResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8196,92 +9546,17 @@ func (m *LabUpdateReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabUpdateReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AparatsByIdsResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabUpdateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AparatsByIdsResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLab
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLab
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLab
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLab
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLab
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLab
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Price = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aparats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLab
@@ -8291,59 +9566,29 @@ func (m *LabUpdateReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLab
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLab
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoctorId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLab
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLab
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLab
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Aparats = append(m.Aparats, &AparatCreateRes{})
+			if err := m.Aparats[len(m.Aparats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DoctorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8371,7 +9616,7 @@ func (m *LabUpdateReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoomNumber = string(dAtA[iNdEx:postIndex])
+			m.MissingIds = append(m.MissingIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8379,10 +9624,7 @@ func (m *LabUpdateReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -8486,10 +9728,7 @@ func (m *LabsRes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -8610,10 +9849,7 @@ func (m *LabsFindReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -8728,10 +9964,7 @@ func (m *LabGetReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -8985,10 +10218,7 @@ func (m *LabCreateReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -9338,10 +10568,7 @@ func (m *LabCreateRes) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthLab
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLab
 			}
 			if (iNdEx + skippy) > l {
@@ -9360,6 +10587,7 @@ func (m *LabCreateRes) Unmarshal(dAtA []byte) error {
 func skipLab(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -9391,10 +10619,8 @@ func skipLab(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -9415,55 +10641,30 @@ func skipLab(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthLab
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthLab
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowLab
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipLab(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthLab
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLab
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLab
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthLab = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowLab   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthLab        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLab          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLab = fmt.Errorf("proto: unexpected end of group")
 )
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "specialty_slots";
+DROP TABLE IF EXISTS "doctor_work_hours";
@@ -0,0 +1,17 @@
+-- weekly working hours of the doctors, breaks are cut out of the hours of their weekday
+CREATE TABLE IF NOT EXISTS "doctor_work_hours"(
+    "doctor_id" UUID NOT NULL,
+    "weekday" SMALLINT NOT NULL CHECK ("weekday" BETWEEN 1 AND 7),
+    "start_time" TIME NOT NULL,
+    "end_time" TIME NOT NULL,
+    "is_break" BOOLEAN NOT NULL DEFAULT FALSE,
+    CHECK ("start_time" < "end_time")
+);
+
+CREATE INDEX IF NOT EXISTS "doctor_work_hours_doctor_idx" ON "doctor_work_hours"("doctor_id", "weekday");
+
+-- length of an appointment of the specialty, doctors of other specialties get the default
+CREATE TABLE IF NOT EXISTS "specialty_slots"(
+    "specialty" VARCHAR(255) PRIMARY KEY,
+    "slot_minutes" INTEGER NOT NULL CHECK ("slot_minutes" > 0)
+);
@@ -0,0 +1,17 @@
+-- exceptions of the weekly working hours: vacations, sick days, absences and other hours on dates
+CREATE TABLE IF NOT EXISTS "doctor_exceptions"(
+    "id" UUID PRIMARY KEY,
+    "doctor_id" UUID NOT NULL,
+    "kind" VARCHAR(32) NOT NULL CHECK ("kind" IN ('vacation', 'sick', 'absent', 'hours')),
+    "date_from" DATE NOT NULL,
+    "date_to" DATE NOT NULL,
+    "start_time" TIME,
+    "end_time" TIME,
+    "substitute_id" UUID,
+    "note" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ("date_from" <= "date_to"),
+    CHECK (("start_time" IS NULL) = ("end_time" IS NULL) AND ("start_time" IS NULL OR "start_time" < "end_time"))
+);
+
+CREATE INDEX IF NOT EXISTS "doctor_exceptions_doctor_dates_idx" ON "doctor_exceptions"("doctor_id", "date_from", "date_to");
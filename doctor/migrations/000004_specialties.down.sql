@@ -0,0 +1,20 @@
+ALTER TABLE "doctors" ADD COLUMN IF NOT EXISTS "cpecialety" VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE "doctors" d SET "cpecialety" = s."name"
+FROM "doctor_specialties" ds
+JOIN "specialties" s ON s."id" = ds."specialty_id"
+WHERE ds."doctor_id" = d."id" AND ds."position" = 1;
+
+ALTER TABLE "doctors" ALTER COLUMN "cpecialety" DROP DEFAULT;
+
+CREATE TABLE IF NOT EXISTS "specialty_slots"(
+    "specialty" VARCHAR(255) PRIMARY KEY,
+    "slot_minutes" INTEGER NOT NULL CHECK ("slot_minutes" > 0)
+);
+
+INSERT INTO "specialty_slots"("specialty", "slot_minutes")
+SELECT "name", "slot_minutes" FROM "specialties" WHERE "deleted_at" IS NULL
+ON CONFLICT DO NOTHING;
+
+DROP TABLE IF EXISTS "doctor_specialties";
+DROP TABLE IF EXISTS "specialties";
@@ -0,0 +1,55 @@
+-- catalog of the specialties, doctors of a specialty get its price and appointment length
+CREATE TABLE IF NOT EXISTS "specialties"(
+    "id" UUID PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL,
+    "price" double precision NOT NULL DEFAULT 0 CHECK ("price" >= 0),
+    "slot_minutes" INTEGER NOT NULL DEFAULT 30 CHECK ("slot_minutes" BETWEEN 1 AND 1440),
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "specialties_name_idx" ON "specialties"(lower("name")) WHERE "deleted_at" IS NULL;
+
+-- specialties of the doctors, the one at position 1 is the primary specialty
+CREATE TABLE IF NOT EXISTS "doctor_specialties"(
+    "doctor_id" UUID NOT NULL,
+    "specialty_id" UUID NOT NULL REFERENCES "specialties"("id"),
+    "position" SMALLINT NOT NULL,
+    PRIMARY KEY ("doctor_id", "specialty_id")
+);
+
+CREATE INDEX IF NOT EXISTS "doctor_specialties_specialty_idx" ON "doctor_specialties"("specialty_id");
+
+-- the strings differing only in case and spaces become one specialty, its price is the
+-- most common price of its doctors
+WITH "names" AS (
+    SELECT
+        lower(trim("cpecialety")) AS "key",
+        min(trim("cpecialety")) AS "name",
+        mode() WITHIN GROUP (ORDER BY "price") AS "price"
+    FROM "doctors"
+    WHERE "deleted_at" IS NULL AND trim("cpecialety") <> ''
+    GROUP BY lower(trim("cpecialety"))
+)
+INSERT INTO "specialties"("id", "name", "price", "slot_minutes")
+SELECT
+    md5(n."key")::uuid,
+    n."name",
+    n."price",
+    COALESCE((
+        SELECT max(ss."slot_minutes") FROM "specialty_slots" ss
+        WHERE lower(trim(ss."specialty")) = n."key"
+    ), 30)
+FROM "names" n
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "doctor_specialties"("doctor_id", "specialty_id", "position")
+SELECT d."id", s."id", 1
+FROM "doctors" d
+JOIN "specialties" s ON s."id" = md5(lower(trim(d."cpecialety")))::uuid
+ON CONFLICT DO NOTHING;
+
+DROP TABLE IF EXISTS "specialty_slots";
+
+ALTER TABLE "doctors" DROP COLUMN IF EXISTS "cpecialety";
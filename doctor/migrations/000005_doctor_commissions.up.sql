@@ -0,0 +1,15 @@
+-- rules of what the doctors earn from the services paid by the clients, the most specific
+-- rule of the doctor applies: the service, then the type of the service, then any service
+CREATE TABLE IF NOT EXISTS "doctor_commissions"(
+    "id" UUID PRIMARY KEY,
+    "doctor_id" UUID NOT NULL,
+    "service_type" VARCHAR(16) NOT NULL DEFAULT '' CHECK ("service_type" IN ('', 'doctor', 'lab', 'aparat')),
+    "service_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "kind" VARCHAR(16) NOT NULL CHECK ("kind" IN ('percent', 'fixed')),
+    "value" DOUBLE PRECISION NOT NULL CHECK ("value" >= 0),
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ("service_id" = '' OR "service_type" <> ''),
+    CHECK ("kind" <> 'percent' OR "value" <= 100),
+    UNIQUE ("doctor_id", "service_type", "service_id")
+);
@@ -0,0 +1,7 @@
+ALTER TABLE "sqlad" ADD COLUMN IF NOT EXISTS "count" INTEGER NOT NULL DEFAULT 0;
+
+UPDATE "sqlad" SET "count" = COALESCE((SELECT SUM("quantity") FROM "sqlad_movements" m WHERE m."sqlad_id" = "sqlad"."id"), 0);
+
+ALTER TABLE "sqlad" ALTER COLUMN "count" DROP DEFAULT;
+
+DROP TABLE IF EXISTS "sqlad_movements";
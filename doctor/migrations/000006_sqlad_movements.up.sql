@@ -0,0 +1,25 @@
+-- every change of the stock of the sqlad products, the stock is the sum of the quantities
+CREATE TABLE IF NOT EXISTS "sqlad_movements"(
+    "id" UUID PRIMARY KEY,
+    "sqlad_id" UUID NOT NULL,
+    "kind" VARCHAR(16) NOT NULL CHECK ("kind" IN ('receipt', 'issue', 'write_off', 'adjustment')),
+    "quantity" INTEGER NOT NULL CHECK ("quantity" <> 0),
+    "unit_cost" DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK ("unit_cost" >= 0),
+    "target_type" VARCHAR(16) NOT NULL DEFAULT '' CHECK ("target_type" IN ('', 'doctor', 'lab', 'aparat')),
+    "target_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "actor_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "note" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ("kind" <> 'receipt' OR "quantity" > 0),
+    CHECK ("kind" NOT IN ('issue', 'write_off') OR "quantity" < 0),
+    CHECK (("kind" = 'issue') = ("target_type" <> ''))
+);
+
+CREATE INDEX IF NOT EXISTS "sqlad_movements_sqlad_created_idx" ON "sqlad_movements"("sqlad_id", "created_at");
+
+-- the counts so far become the opening balances
+INSERT INTO "sqlad_movements"("id", "sqlad_id", "kind", "quantity", "unit_cost", "note", "created_at")
+SELECT md5('sqlad-opening-' || "id"::text)::uuid, "id", 'adjustment', "count", "price", 'opening balance', COALESCE("updated_at", "created_at", NOW())
+FROM "sqlad" WHERE "count" <> 0;
+
+ALTER TABLE "sqlad" DROP COLUMN IF EXISTS "count";
@@ -0,0 +1,12 @@
+ALTER TABLE "sqlad" ADD COLUMN IF NOT EXISTS "expiration_date" DATE;
+
+UPDATE "sqlad" SET "expiration_date" = COALESCE((
+    SELECT MIN(l."expiration_date") FROM "sqlad_lots" l
+    WHERE l."sqlad_id" = "sqlad"."id" AND (SELECT SUM(a."quantity") FROM "sqlad_lot_movements" a WHERE a."lot_id" = l."id") > 0
+), CURRENT_DATE);
+
+ALTER TABLE "sqlad" ALTER COLUMN "expiration_date" SET NOT NULL;
+
+DROP TABLE IF EXISTS "sqlad_lot_movements";
+
+DROP TABLE IF EXISTS "sqlad_lots";
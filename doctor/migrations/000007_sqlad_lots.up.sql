@@ -0,0 +1,33 @@
+-- every receipt of a sqlad is a lot with its own expiry and purchase price
+CREATE TABLE IF NOT EXISTS "sqlad_lots"(
+    "id" UUID PRIMARY KEY,
+    "sqlad_id" UUID NOT NULL,
+    "number" VARCHAR(64) NOT NULL DEFAULT '',
+    "expiration_date" DATE,
+    "unit_cost" DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK ("unit_cost" >= 0),
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "sqlad_lots_sqlad_expiration_idx" ON "sqlad_lots"("sqlad_id", "expiration_date");
+
+-- the part of each movement taken from or added to each lot, the stock of a lot is their sum
+CREATE TABLE IF NOT EXISTS "sqlad_lot_movements"(
+    "movement_id" UUID NOT NULL REFERENCES "sqlad_movements"("id"),
+    "lot_id" UUID NOT NULL REFERENCES "sqlad_lots"("id"),
+    "quantity" INTEGER NOT NULL CHECK ("quantity" <> 0),
+    PRIMARY KEY ("movement_id", "lot_id")
+);
+
+CREATE INDEX IF NOT EXISTS "sqlad_lot_movements_lot_idx" ON "sqlad_lot_movements"("lot_id");
+
+-- the stock so far is one lot of each sqlad expiring on its expiration date
+INSERT INTO "sqlad_lots"("id", "sqlad_id", "expiration_date", "unit_cost", "created_at")
+SELECT md5('sqlad-lot-' || s."id"::text)::uuid, s."id", s."expiration_date", s."price", s."created_at"
+FROM "sqlad" s
+WHERE EXISTS (SELECT 1 FROM "sqlad_movements" m WHERE m."sqlad_id" = s."id");
+
+INSERT INTO "sqlad_lot_movements"("movement_id", "lot_id", "quantity")
+SELECT m."id", md5('sqlad-lot-' || m."sqlad_id"::text)::uuid, m."quantity"
+FROM "sqlad_movements" m;
+
+ALTER TABLE "sqlad" DROP COLUMN IF EXISTS "expiration_date";
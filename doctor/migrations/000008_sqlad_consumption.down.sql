@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "sqlad_usages";
+
+DROP TABLE IF EXISTS "sqlad_boms";
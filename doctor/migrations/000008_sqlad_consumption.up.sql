@@ -0,0 +1,22 @@
+-- the sqlad each lab and aparat service consumes every time it is performed
+CREATE TABLE IF NOT EXISTS "sqlad_boms"(
+    "service_type" VARCHAR(16) NOT NULL CHECK ("service_type" IN ('lab', 'aparat')),
+    "service_id" VARCHAR(64) NOT NULL,
+    "sqlad_id" UUID NOT NULL,
+    "quantity" INTEGER NOT NULL CHECK ("quantity" > 0),
+    PRIMARY KEY ("service_type", "service_id", "sqlad_id")
+);
+
+-- what each performed service should have consumed and the issue that took it off the stock
+CREATE TABLE IF NOT EXISTS "sqlad_usages"(
+    "analysis_id" VARCHAR(64) NOT NULL,
+    "sqlad_id" UUID NOT NULL,
+    "service_type" VARCHAR(16) NOT NULL,
+    "service_id" VARCHAR(64) NOT NULL,
+    "expected" INTEGER NOT NULL,
+    "movement_id" UUID REFERENCES "sqlad_movements"("id"),
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("analysis_id", "sqlad_id")
+);
+
+CREATE INDEX IF NOT EXISTS "sqlad_usages_created_idx" ON "sqlad_usages"("created_at");
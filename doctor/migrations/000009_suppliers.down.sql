@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS "purchase_order_receipts";
+
+DROP TABLE IF EXISTS "purchase_order_items";
+
+DROP TABLE IF EXISTS "purchase_orders";
+
+ALTER TABLE "sqlad" ADD COLUMN IF NOT EXISTS "provider" VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE "sqlad" SET "provider" = s."name" FROM "suppliers" s WHERE s."id" = "sqlad"."supplier_id";
+
+ALTER TABLE "sqlad" ALTER COLUMN "provider" DROP DEFAULT;
+
+ALTER TABLE "sqlad" DROP COLUMN IF EXISTS "supplier_id";
+
+DROP TABLE IF EXISTS "suppliers";
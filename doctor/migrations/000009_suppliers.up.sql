@@ -0,0 +1,64 @@
+-- directory of the suppliers the sqlad is ordered from
+CREATE TABLE IF NOT EXISTS "suppliers"(
+    "id" UUID PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL,
+    "contact_person" VARCHAR(255) NOT NULL DEFAULT '',
+    "phone_number" VARCHAR(64) NOT NULL DEFAULT '',
+    "email" VARCHAR(255) NOT NULL DEFAULT '',
+    "address" TEXT NOT NULL DEFAULT '',
+    "payment_terms" TEXT NOT NULL DEFAULT '',
+    "payment_days" INTEGER NOT NULL DEFAULT 0 CHECK ("payment_days" >= 0),
+    "note" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "suppliers_name_idx" ON "suppliers"(lower("name")) WHERE "deleted_at" IS NULL;
+
+-- the providers differing only in case and spaces become one supplier
+INSERT INTO "suppliers"("id", "name")
+SELECT md5('supplier-' || lower(trim("provider")))::uuid, min(trim("provider"))
+FROM "sqlad"
+WHERE trim("provider") <> ''
+GROUP BY lower(trim("provider"))
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE "sqlad" ADD COLUMN IF NOT EXISTS "supplier_id" UUID REFERENCES "suppliers"("id");
+
+UPDATE "sqlad" SET "supplier_id" = md5('supplier-' || lower(trim("provider")))::uuid
+WHERE trim("provider") <> '';
+
+ALTER TABLE "sqlad" DROP COLUMN IF EXISTS "provider";
+
+CREATE TABLE IF NOT EXISTS "purchase_orders"(
+    "id" UUID PRIMARY KEY,
+    "number" BIGSERIAL UNIQUE,
+    "supplier_id" UUID NOT NULL REFERENCES "suppliers"("id"),
+    "status" VARCHAR(32) NOT NULL DEFAULT 'draft' CHECK ("status" IN ('draft', 'ordered', 'partially_received', 'received', 'cancelled')),
+    "note" TEXT NOT NULL DEFAULT '',
+    "actor_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "ordered_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "purchase_orders_supplier_idx" ON "purchase_orders"("supplier_id", "created_at");
+
+CREATE TABLE IF NOT EXISTS "purchase_order_items"(
+    "id" UUID PRIMARY KEY,
+    "order_id" UUID NOT NULL REFERENCES "purchase_orders"("id") ON DELETE CASCADE,
+    "sqlad_id" UUID NOT NULL,
+    "quantity" INTEGER NOT NULL CHECK ("quantity" > 0),
+    "unit_cost" DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK ("unit_cost" >= 0),
+    UNIQUE ("order_id", "sqlad_id")
+);
+
+-- the receipt movements the items of the orders were received by
+CREATE TABLE IF NOT EXISTS "purchase_order_receipts"(
+    "movement_id" UUID PRIMARY KEY REFERENCES "sqlad_movements"("id"),
+    "item_id" UUID NOT NULL REFERENCES "purchase_order_items"("id"),
+    "quantity" INTEGER NOT NULL CHECK ("quantity" > 0)
+);
+
+CREATE INDEX IF NOT EXISTS "purchase_order_receipts_item_idx" ON "purchase_order_receipts"("item_id");
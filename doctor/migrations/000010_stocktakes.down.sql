@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "stocktake_lines";
+
+DROP TABLE IF EXISTS "stocktake_snapshots";
+
+DROP TABLE IF EXISTS "stocktake_counts";
+
+DROP TABLE IF EXISTS "stocktakes";
@@ -0,0 +1,47 @@
+-- physical counts of the storeroom, only one is open at a time
+CREATE TABLE IF NOT EXISTS "stocktakes"(
+    "id" UUID PRIMARY KEY,
+    "number" BIGSERIAL UNIQUE,
+    "status" VARCHAR(16) NOT NULL DEFAULT 'open' CHECK ("status" IN ('open', 'posted', 'cancelled')),
+    "note" TEXT NOT NULL DEFAULT '',
+    "actor_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "posted_by" VARCHAR(64) NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "posted_at" TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "stocktakes_open_idx" ON "stocktakes"("status") WHERE "status" = 'open';
+
+-- what each staff member counted of a sqlad, of one of its lots when lot_id is not empty
+CREATE TABLE IF NOT EXISTS "stocktake_counts"(
+    "stocktake_id" UUID NOT NULL REFERENCES "stocktakes"("id"),
+    "sqlad_id" UUID NOT NULL,
+    "lot_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "counter_id" VARCHAR(64) NOT NULL,
+    "quantity" INTEGER NOT NULL CHECK ("quantity" >= 0),
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("stocktake_id", "sqlad_id", "lot_id", "counter_id")
+);
+
+-- the balance of a sqlad, or of its lot, when it was first counted, the count is set against it
+-- so the movements made while the stocktake is open stay on the balance at posting
+CREATE TABLE IF NOT EXISTS "stocktake_snapshots"(
+    "stocktake_id" UUID NOT NULL REFERENCES "stocktakes"("id"),
+    "sqlad_id" UUID NOT NULL,
+    "lot_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "expected" INTEGER NOT NULL,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("stocktake_id", "sqlad_id", "lot_id")
+);
+
+-- the counted stock against its snapshot and the adjustment of the difference
+CREATE TABLE IF NOT EXISTS "stocktake_lines"(
+    "stocktake_id" UUID NOT NULL REFERENCES "stocktakes"("id"),
+    "sqlad_id" UUID NOT NULL,
+    "lot_id" VARCHAR(64) NOT NULL DEFAULT '',
+    "expected" INTEGER NOT NULL,
+    "counted" INTEGER NOT NULL,
+    "unit_cost" DOUBLE PRECISION NOT NULL DEFAULT 0,
+    "movement_id" UUID REFERENCES "sqlad_movements"("id"),
+    PRIMARY KEY ("stocktake_id", "sqlad_id", "lot_id")
+);
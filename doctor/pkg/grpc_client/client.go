@@ -0,0 +1,41 @@
+package grpc_client
+
+import (
+	"fmt"
+
+	"gitlab.com/clinic-crm/doctor/config"
+	pbp "gitlab.com/clinic-crm/doctor/genproto/patient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type ServiceManager interface {
+	PatientService() pbp.PatientServiceClient
+}
+
+type GrpcClient struct {
+	cfg         config.Config
+	connections map[string]interface{}
+}
+
+func New(cfg config.Config) (ServiceManager, error) {
+	connPatientService, err := grpc.Dial(
+		fmt.Sprintf("%s:%s", cfg.PatientServiceHost, cfg.PatientServicePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("patient service dial host: %s port:%s err: %v",
+			cfg.PatientServiceHost, cfg.PatientServicePort, err)
+	}
+
+	return &GrpcClient{
+		cfg: cfg,
+		connections: map[string]interface{}{
+			"patient_service": pbp.NewPatientServiceClient(connPatientService),
+		},
+	}, nil
+}
+
+func (g *GrpcClient) PatientService() pbp.PatientServiceClient {
+	return g.connections["patient_service"].(pbp.PatientServiceClient)
+}
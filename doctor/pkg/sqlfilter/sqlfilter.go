@@ -0,0 +1,112 @@
+// Package sqlfilter builds parameterized WHERE clauses, request values are
+// always passed as query args and never end up in the SQL text.
+package sqlfilter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownField is returned by Lookup for a field that is not allowed.
+var ErrUnknownField = errors.New("unknown lookup field")
+
+type Filter struct {
+	conds []string
+	args  []interface{}
+}
+
+// New starts a filter with conditions that take no args, e.g. "deleted_at IS NULL".
+func New(conds ...string) *Filter {
+	return &Filter{
+		conds: conds,
+	}
+}
+
+// Where adds the condition, every ? in it is replaced with the placeholder
+// of the next arg.
+func (f *Filter) Where(cond string, args ...interface{}) *Filter {
+	f.conds = append(f.conds, f.bind(cond, args))
+	return f
+}
+
+// Search adds an ILIKE match of search against any of the columns.
+// Nothing is added for an empty search.
+func (f *Filter) Search(search string, columns ...string) *Filter {
+	if search == "" || len(columns) == 0 {
+		return f
+	}
+
+	f.args = append(f.args, Contains(search))
+	placeholder := fmt.Sprintf("$%d", len(f.args))
+
+	matches := make([]string, 0, len(columns))
+	for _, column := range columns {
+		matches = append(matches, column+" ILIKE "+placeholder)
+	}
+	f.conds = append(f.conds, "("+strings.Join(matches, " OR ")+")")
+
+	return f
+}
+
+// String returns the WHERE clause, empty if there are no conditions.
+func (f *Filter) String() string {
+	if len(f.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(f.conds, " AND ")
+}
+
+// Args returns the args of the conditions in placeholder order.
+func (f *Filter) Args() []interface{} {
+	return f.args
+}
+
+// Page returns the LIMIT OFFSET clause of the page and the args of the filter
+// followed by the paging ones. The filter itself is left unchanged so it can
+// still be used for the count query.
+func (f *Filter) Page(limit, page int64) (string, []interface{}) {
+	if page < 1 {
+		page = 1
+	}
+
+	args := make([]interface{}, 0, len(f.args)+2)
+	args = append(args, f.args...)
+	args = append(args, limit, (page-1)*limit)
+
+	return fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args)), args
+}
+
+func (f *Filter) bind(cond string, args []interface{}) string {
+	var (
+		result strings.Builder
+		next   int
+	)
+	for _, r := range cond {
+		if r == '?' && next < len(args) {
+			f.args = append(f.args, args[next])
+			next++
+			fmt.Fprintf(&result, "$%d", len(f.args))
+			continue
+		}
+		result.WriteRune(r)
+	}
+
+	return result.String()
+}
+
+// Lookup returns the column for the requested field if it is one of allowed,
+// so Field/Value style requests can't put anything else into the query.
+func Lookup(field string, allowed ...string) (string, error) {
+	for _, column := range allowed {
+		if field == column {
+			return column, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownField, field)
+}
+
+// Contains returns the ILIKE pattern matching s anywhere, wildcards in s are escaped.
+func Contains(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s) + "%"
+}
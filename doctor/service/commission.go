@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/genproto/patient"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// commissionError maps storage errors of commissions to grpc statuses.
+func commissionError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this commission")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check commission info")
+}
+
+func commissionValidate(req *doctor.DoctorCommission) error {
+	switch req.ServiceType {
+	case "", repo.ServiceDoctor, repo.ServiceLab, repo.ServiceAparat:
+	default:
+		return errors.New("service_type must be one of doctor, lab, aparat or empty for any service")
+	}
+	switch {
+	case req.DoctorId == "":
+		return errors.New("doctor_id is required")
+	case req.ServiceId != "" && req.ServiceType == "":
+		return errors.New("service_type is required with service_id")
+	case req.Kind != repo.CommissionPercent && req.Kind != repo.CommissionFixed:
+		return errors.New("kind must be one of percent, fixed")
+	case req.Value < 0:
+		return errors.New("value can not be negative")
+	case req.Kind == repo.CommissionPercent && req.Value > 100:
+		return errors.New("percent can not be more than 100")
+	}
+	return nil
+}
+
+// commissionFor returns the most specific rule for the service: of the service itself,
+// then of its type, then of any service. Nil when the doctor has none of them.
+func commissionFor(rules []*doctor.DoctorCommission, serviceType, serviceId string) *doctor.DoctorCommission {
+	var result *doctor.DoctorCommission
+	rank := 0
+	for _, rule := range rules {
+		current := 0
+		switch {
+		case rule.ServiceType == serviceType && rule.ServiceId == serviceId:
+			current = 3
+		case rule.ServiceType == serviceType && rule.ServiceId == "":
+			current = 2
+		case rule.ServiceType == "":
+			current = 1
+		}
+		if current > rank {
+			result, rank = rule, current
+		}
+	}
+	return result
+}
+
+// Commissions...
+// DoctorCommissionSet creates the rule of the doctor for the service, or replaces the rule
+// the doctor already has for it.
+func (s *DoctorService) DoctorCommissionSet(ctx context.Context, req *doctor.DoctorCommission) (*doctor.DoctorCommission, error) {
+	if err := commissionValidate(req); err != nil {
+		return &doctor.DoctorCommission{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: req.DoctorId}); err != nil {
+		return &doctor.DoctorCommission{}, doctorError(err)
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Commission().CommissionSet(req)
+	if err != nil {
+		return &doctor.DoctorCommission{}, commissionError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) DoctorCommissionsFind(ctx context.Context, req *doctor.DoctorCommissionsFindReq) (*doctor.DoctorCommissions, error) {
+	resp, err := s.storage.Commission().CommissionsFind(req.DoctorId)
+	if err != nil {
+		return &doctor.DoctorCommissions{}, commissionError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) DoctorCommissionDelete(ctx context.Context, req *doctor.DoctorCommissionId) (*empty.Empty, error) {
+	if err := s.storage.Commission().CommissionDelete(req.Id); err != nil {
+		return &emptypb.Empty{}, commissionError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// PayrollReportGet computes what the doctors earned over the dates from the cashbox items
+// paid in them (reception service), doctor, lab and aparat services alike. Services without
+// a rule of the doctor are listed earning nothing.
+func (s *DoctorService) PayrollReportGet(ctx context.Context, req *doctor.PayrollReportReq) (*doctor.PayrollReport, error) {
+	for _, date := range []string{req.FromDate, req.ToDate} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return &doctor.PayrollReport{}, status.Error(codes.InvalidArgument, "from_date and to_date must be like 2006-01-02")
+		}
+	}
+
+	paid, err := s.service.PatientService().CashboxPaidItems(ctx, &patient.CashboxPaidItemsReq{
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		DoctorId: req.DoctorId,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		if status.Code(err) == codes.InvalidArgument {
+			return &doctor.PayrollReport{}, err
+		}
+		return &doctor.PayrollReport{}, status.Error(codes.Internal, "something went wrong, please check payroll info")
+	}
+
+	commissions, err := s.storage.Commission().CommissionsFind(req.DoctorId)
+	if err != nil {
+		return &doctor.PayrollReport{}, commissionError(err)
+	}
+	rules := make(map[string][]*doctor.DoctorCommission)
+	for _, rule := range commissions.Commissions {
+		rules[rule.DoctorId] = append(rules[rule.DoctorId], rule)
+	}
+
+	result := payrollCompute(req.FromDate, req.ToDate, paid.Items, rules)
+
+	ids := make([]string, 0, len(result.Doctors))
+	doctors := make(map[string]*doctor.PayrollDoctor, len(result.Doctors))
+	for _, payroll := range result.Doctors {
+		ids = append(ids, payroll.DoctorId)
+		doctors[payroll.DoctorId] = payroll
+	}
+
+	found, err := s.storage.Doctor().DoctorsGetByIds(&doctor.DoctorIds{Ids: ids})
+	if err != nil {
+		return &doctor.PayrollReport{}, doctorError(err)
+	}
+	for _, doc := range found.Doctors {
+		doctors[doc.Id].FirstName, doctors[doc.Id].LastName = doc.FirstName, doc.LastName
+	}
+	sort.Slice(result.Doctors, func(i, j int) bool {
+		if result.Doctors[i].LastName != result.Doctors[j].LastName {
+			return result.Doctors[i].LastName < result.Doctors[j].LastName
+		}
+		return result.Doctors[i].FirstName < result.Doctors[j].FirstName
+	})
+
+	return result, nil
+}
+
+// commissionEarnings is what the rule pays for the quantity sold for the revenue, rounded to the som.
+func commissionEarnings(rule *doctor.DoctorCommission, quantity, revenue int64) int64 {
+	switch rule.Kind {
+	case repo.CommissionPercent:
+		return int64(math.Round(float64(revenue) * rule.Value / 100))
+	case repo.CommissionFixed:
+		return int64(math.Round(float64(quantity) * rule.Value))
+	}
+	return 0
+}
+
+// payrollCompute sums the paid items by doctor and service and applies the rules of the
+// doctors to them. The names of the doctors are left to the caller.
+func payrollCompute(fromDate, toDate string, items []*patient.CashboxPaidItem, rules map[string][]*doctor.DoctorCommission) *doctor.PayrollReport {
+	result := doctor.PayrollReport{
+		FromDate: fromDate,
+		ToDate:   toDate,
+		Doctors:  make([]*doctor.PayrollDoctor, 0),
+	}
+	doctors := make(map[string]*doctor.PayrollDoctor)
+	lines := make(map[[3]string]*doctor.PayrollLine)
+	for _, item := range items {
+		payroll, ok := doctors[item.DoctorId]
+		if !ok {
+			payroll = &doctor.PayrollDoctor{
+				DoctorId: item.DoctorId,
+				Lines:    make([]*doctor.PayrollLine, 0),
+			}
+			doctors[item.DoctorId] = payroll
+			result.Doctors = append(result.Doctors, payroll)
+		}
+
+		key := [3]string{item.DoctorId, item.ServiceType, item.ServiceId}
+		line, ok := lines[key]
+		if !ok {
+			line = &doctor.PayrollLine{
+				ServiceType: item.ServiceType,
+				ServiceId:   item.ServiceId,
+				Name:        item.Name,
+			}
+			lines[key] = line
+			payroll.Lines = append(payroll.Lines, line)
+		}
+		line.Quantity += item.Quantity
+		line.Revenue += item.Amount
+	}
+
+	for _, payroll := range result.Doctors {
+		for _, line := range payroll.Lines {
+			if rule := commissionFor(rules[payroll.DoctorId], line.ServiceType, line.ServiceId); rule != nil {
+				line.CommissionId, line.Kind, line.Value = rule.Id, rule.Kind, rule.Value
+				line.Earnings = commissionEarnings(rule, line.Quantity, line.Revenue)
+			}
+			payroll.Revenue += line.Revenue
+			payroll.Earnings += line.Earnings
+		}
+		sort.Slice(payroll.Lines, func(i, j int) bool {
+			if payroll.Lines[i].ServiceType != payroll.Lines[j].ServiceType {
+				return payroll.Lines[i].ServiceType < payroll.Lines[j].ServiceType
+			}
+			return payroll.Lines[i].Name < payroll.Lines[j].Name
+		})
+		result.Revenue += payroll.Revenue
+		result.Earnings += payroll.Earnings
+	}
+
+	return &result
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"testing"
+
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/genproto/patient"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+func TestPayrollComputeLab(t *testing.T) {
+	items := []*patient.CashboxPaidItem{
+		{ServiceType: repo.ServiceLab, ServiceId: "blood", Name: "Blood test", Quantity: 1, Amount: 50000, DoctorId: "d1"},
+		{ServiceType: repo.ServiceLab, ServiceId: "blood", Name: "Blood test", Quantity: 2, Amount: 90000, DoctorId: "d1"},
+		{ServiceType: repo.ServiceAparat, ServiceId: "xray", Name: "X-ray", Quantity: 1, Amount: 80000, DoctorId: "d1"},
+	}
+	rules := map[string][]*doctor.DoctorCommission{
+		"d1": {
+			{Id: "r1", DoctorId: "d1", ServiceType: repo.ServiceLab, Kind: repo.CommissionPercent, Value: 10},
+		},
+	}
+
+	report := payrollCompute("2001-01-01", "2001-01-31", items, rules)
+	if len(report.Doctors) != 1 || report.Doctors[0].DoctorId != "d1" {
+		t.Fatalf("doctors = %v, want d1", report.Doctors)
+	}
+	lines := report.Doctors[0].Lines
+	if len(lines) != 2 {
+		t.Fatalf("%d lines, want the aparat and the lab", len(lines))
+	}
+	aparat, lab := lines[0], lines[1]
+	if lab.ServiceType != repo.ServiceLab || lab.Quantity != 3 || lab.Revenue != 140000 || lab.Earnings != 14000 || lab.CommissionId != "r1" {
+		t.Errorf("lab line = %+v, want 3 for 140000 earning 14000 by r1", lab)
+	}
+	if aparat.ServiceType != repo.ServiceAparat || aparat.Revenue != 80000 || aparat.Earnings != 0 || aparat.CommissionId != "" {
+		t.Errorf("aparat line = %+v, want 80000 earning nothing", aparat)
+	}
+	if report.Revenue != 220000 || report.Earnings != 14000 {
+		t.Errorf("report revenue, earnings = %d, %d, want 220000, 14000", report.Revenue, report.Earnings)
+	}
+}
+
+func TestCommissionFor(t *testing.T) {
+	var (
+		general = &doctor.DoctorCommission{Id: "general"}
+		labs    = &doctor.DoctorCommission{Id: "labs", ServiceType: repo.ServiceLab}
+		blood   = &doctor.DoctorCommission{Id: "blood", ServiceType: repo.ServiceLab, ServiceId: "blood"}
+	)
+
+	tests := []struct {
+		name        string
+		rules       []*doctor.DoctorCommission
+		serviceType string
+		serviceId   string
+		want        string
+	}{
+		{"service over type and general", []*doctor.DoctorCommission{general, labs, blood}, repo.ServiceLab, "blood", "blood"},
+		{"service in any order", []*doctor.DoctorCommission{blood, general, labs}, repo.ServiceLab, "blood", "blood"},
+		{"type over general", []*doctor.DoctorCommission{blood, labs, general}, repo.ServiceLab, "urine", "labs"},
+		{"general for another type", []*doctor.DoctorCommission{blood, labs, general}, repo.ServiceAparat, "xray", "general"},
+		{"no rule matching", []*doctor.DoctorCommission{blood, labs}, repo.ServiceDoctor, "d1", ""},
+		{"no rules", nil, repo.ServiceLab, "blood", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if rule := commissionFor(tt.rules, tt.serviceType, tt.serviceId); rule != nil {
+				got = rule.Id
+			}
+			if got != tt.want {
+				t.Errorf("commissionFor(%s, %s) = %q, want %q", tt.serviceType, tt.serviceId, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommissionEarnings(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    float64
+		quantity int64
+		revenue  int64
+		want     int64
+	}{
+		{"percent", repo.CommissionPercent, 10, 1, 50000, 5000},
+		{"percent rounded up", repo.CommissionPercent, 12.5, 1, 33333, 4167},
+		{"percent rounded down", repo.CommissionPercent, 15, 1, 10001, 1500},
+		{"percent of a half som rounded up", repo.CommissionPercent, 50, 1, 3, 2},
+		{"fixed per service", repo.CommissionFixed, 7000, 3, 150000, 21000},
+		{"fixed of a half som rounded up", repo.CommissionFixed, 2500.5, 3, 90000, 7502},
+		{"unknown kind", "", 10, 1, 50000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &doctor.DoctorCommission{Kind: tt.kind, Value: tt.value}
+			if got := commissionEarnings(rule, tt.quantity, tt.revenue); got != tt.want {
+				t.Errorf("commissionEarnings(%s %v, %d, %d) = %d, want %d", tt.kind, tt.value, tt.quantity, tt.revenue, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func consumerValidate(serviceType, serviceId string) error {
+	switch {
+	case serviceType != repo.ServiceLab && serviceType != repo.ServiceAparat:
+		return errors.New("service_type must be one of lab, aparat")
+	case serviceId == "":
+		return errors.New("service_id is required")
+	}
+	return nil
+}
+
+func bomValidate(req *doctor.SqladBom) error {
+	if err := consumerValidate(req.ServiceType, req.ServiceId); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(req.Items))
+	for _, item := range req.Items {
+		switch {
+		case item.SqladId == "":
+			return errors.New("sqlad_id of the items is required")
+		case item.Quantity <= 0:
+			return errors.New("quantity of the items must be positive")
+		case seen[item.SqladId]:
+			return errors.New("sqlad " + item.SqladId + " is listed twice")
+		}
+		seen[item.SqladId] = true
+	}
+	return nil
+}
+
+// Sqlad consumption...
+// SqladBomSet replaces what the lab or aparat service consumes each time it is performed,
+// an empty list stops the service consuming.
+func (s *DoctorService) SqladBomSet(ctx context.Context, req *doctor.SqladBom) (*doctor.SqladBom, error) {
+	if err := bomValidate(req); err != nil {
+		return &doctor.SqladBom{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.storage.Sqlad().BomSet(req)
+	if err != nil {
+		return &doctor.SqladBom{}, sqladError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SqladBomGet(ctx context.Context, req *doctor.SqladBomReq) (*doctor.SqladBom, error) {
+	if err := consumerValidate(req.ServiceType, req.ServiceId); err != nil {
+		return &doctor.SqladBom{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.storage.Sqlad().BomGet(req.ServiceType, req.ServiceId)
+	if err != nil {
+		return &doctor.SqladBom{}, sqladError(err)
+	}
+
+	return resp, nil
+}
+
+// SqladConsume takes the bill of materials of the service performed off the stock, once
+// for the analysis: calling it again returns what the analysis consumed. The service is
+// performed already, so sqlad out of stock does not fail it but is taken as far as there is.
+func (s *DoctorService) SqladConsume(ctx context.Context, req *doctor.SqladConsumeReq) (*doctor.SqladConsumeResp, error) {
+	if err := consumerValidate(req.ServiceType, req.ServiceId); err != nil {
+		return &doctor.SqladConsumeResp{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.AnalysisId == "" {
+		return &doctor.SqladConsumeResp{}, status.Error(codes.InvalidArgument, "analysis_id is required")
+	}
+
+	resp, err := s.storage.Sqlad().Consume(req)
+	if err != nil {
+		return &doctor.SqladConsumeResp{}, sqladError(err)
+	}
+
+	return resp, nil
+}
+
+// ConsumptionReportGet compares what the lab and aparat services performed over the dates
+// should have consumed with what was issued to them.
+func (s *DoctorService) ConsumptionReportGet(ctx context.Context, req *doctor.ConsumptionReportReq) (*doctor.ConsumptionReport, error) {
+	for _, date := range []string{req.FromDate, req.ToDate} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return &doctor.ConsumptionReport{}, status.Error(codes.InvalidArgument, "from_date and to_date must be like 2006-01-02")
+		}
+	}
+	if req.ServiceType != "" && req.ServiceType != repo.ServiceLab && req.ServiceType != repo.ServiceAparat {
+		return &doctor.ConsumptionReport{}, status.Error(codes.InvalidArgument, "service_type must be one of lab, aparat")
+	}
+
+	lines, err := s.storage.Sqlad().ConsumptionReport(req)
+	if err != nil {
+		return &doctor.ConsumptionReport{}, sqladError(err)
+	}
+
+	return &doctor.ConsumptionReport{
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		Lines:    lines,
+	}, nil
+}
@@ -5,8 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"log"
+	"strings"
+	"time"
 
 	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/genproto/patient"
+	"gitlab.com/clinic-crm/doctor/pkg/grpc_client"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
 	"gitlab.com/clinic-crm/doctor/storage"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,18 +22,38 @@ import (
 
 type DoctorService struct {
 	doctor.UnimplementedDoctorServiceServer
+	service grpc_client.ServiceManager
 	storage storage.StorageI
 }
 
-func NewDoctorService(strg storage.StorageI) *DoctorService {
+func NewDoctorService(strg storage.StorageI, service grpc_client.ServiceManager) *DoctorService {
 	return &DoctorService{
+		service:                          service,
 		storage:                          strg,
 		UnimplementedDoctorServiceServer: doctor.UnimplementedDoctorServiceServer{},
 	}
 }
 
+// doctorError maps storage errors of doctors to grpc statuses.
+func doctorError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this doctor")
+	} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, "something went wrong, please check doctor info")
+}
+
 // Doctor...
 func (s *DoctorService) DoctorCreate(ctx context.Context, req *doctor.Doctor) (*doctor.Doctor, error) {
+	if len(req.SpecialtyIds) == 0 && req.Cpecialety == "" {
+		return &doctor.Doctor{}, status.Error(codes.InvalidArgument, "specialty_ids are required")
+	}
+	if err := s.doctorSpecialties(req); err != nil {
+		return &doctor.Doctor{}, err
+	}
+
 	resp, err := s.storage.Doctor().DoctorCreate(req)
 	if err != nil {
 		log.Println(err.Error())
@@ -47,6 +72,8 @@ func (s *DoctorService) DoctorGet(ctx context.Context, req *doctor.GetDoctorReq)
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &doctor.Doctor{}, status.Error(codes.NotFound, "something went wrong, please not found this doctor")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &doctor.Doctor{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &doctor.Doctor{}, status.Error(codes.Internal, "something went wrong, please not found this doctor")
 	}
@@ -67,7 +94,22 @@ func (s *DoctorService) DoctorsFind(ctx context.Context, req *doctor.DoctorsFind
 	return resp, nil
 }
 
+// DoctorsGetByIds returns many doctors in one call, the ids not found are listed in missing_ids.
+func (s *DoctorService) DoctorsGetByIds(ctx context.Context, req *doctor.DoctorIds) (*doctor.DoctorsByIdsResp, error) {
+	resp, err := s.storage.Doctor().DoctorsGetByIds(req)
+	if err != nil {
+		log.Println(err.Error())
+		return &doctor.DoctorsByIdsResp{}, status.Error(codes.Internal, "something went wrong, please check doctor ids")
+	}
+
+	return resp, nil
+}
+
 func (s *DoctorService) DoctorUpdate(ctx context.Context, req *doctor.Doctor) (*doctor.Doctor, error) {
+	if err := s.doctorSpecialties(req); err != nil {
+		return &doctor.Doctor{}, err
+	}
+
 	resp, err := s.storage.Doctor().DoctorUpdate(req)
 	if err != nil {
 		log.Println(err.Error())
@@ -113,6 +155,8 @@ func (s *DoctorService) DoctorReportGet(ctx context.Context, req *doctor.GetDoct
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &doctor.DoctorReportRes{}, status.Error(codes.NotFound, "something went wrong, please not found this doctor report")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &doctor.DoctorReportRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &doctor.DoctorReportRes{}, status.Error(codes.Internal, "something went wrong, please not found this doctor report")
 	}
@@ -148,6 +192,16 @@ func (s *DoctorService) DoctorReportDelete(ctx context.Context, req *doctor.Repo
 
 // Sqlad
 func (s *DoctorService) SqladCreate(ctx context.Context, req *doctor.SqladReq) (*doctor.SqladRes, error) {
+	if req.Count < 0 {
+		return &doctor.SqladRes{}, status.Error(codes.InvalidArgument, "count can not be negative")
+	}
+	if _, err := time.Parse("2006-01-02", req.ExpirationDate); req.ExpirationDate != "" && err != nil {
+		return &doctor.SqladRes{}, status.Error(codes.InvalidArgument, "expiration_date must be like 2006-01-02")
+	}
+	if err := s.sqladSupplier(req); err != nil {
+		return &doctor.SqladRes{}, err
+	}
+
 	resp, err := s.storage.Doctor().SqladCreate(req)
 	if err != nil {
 		log.Println(err.Error())
@@ -166,6 +220,8 @@ func (s *DoctorService) SqladGet(ctx context.Context, req *doctor.SqladGetReq) (
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &doctor.SqladRes{}, status.Error(codes.NotFound, "something went wrong, please not found this info")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &doctor.SqladRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &doctor.SqladRes{}, status.Error(codes.Internal, "something went wrong, please not found this info")
 	}
@@ -174,6 +230,10 @@ func (s *DoctorService) SqladGet(ctx context.Context, req *doctor.SqladGetReq) (
 }
 
 func (s *DoctorService) SqladUpdate(ctx context.Context, req *doctor.SqladReq) (*doctor.SqladRes, error) {
+	if err := s.sqladSupplier(req); err != nil {
+		return &doctor.SqladRes{}, err
+	}
+
 	resp, err := s.storage.Doctor().SqladUpdate(req)
 	if err != nil {
 		log.Println(err.Error())
@@ -212,17 +272,61 @@ func (s *DoctorService) LowStock(context.Context, *emptypb.Empty) (*doctor.LowSt
 	return resp, nil
 }
 
+// DoctorPageFilter joins patients queued to the doctor (reception service)
+// with the reports the doctor wrote for them.
 func (s *DoctorService) DoctorPageFilter(ctx context.Context, req *doctor.DocPageFilter) (*doctor.DocPageFilterRes, error) {
-	resp, err := s.storage.Doctor().DoctorPageFilter(req)
+	if req.DoctorId == "" {
+		return &doctor.DocPageFilterRes{}, status.Error(codes.InvalidArgument, "doctor_id is required")
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	// a page is one call to the reception service and one to the reports, it is kept small
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+
+	queue, err := s.service.PatientService().FindQueuePatients(ctx, &patient.QueueFilter{
+		ServiceId:   req.DoctorId,
+		ServiceType: "doctor",
+		ClientId:    req.ClientId,
+		Page:        req.Page,
+		Limit:       req.Limit,
+		FromDate:    req.FromDate,
+		ToDate:      req.ToDate,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		return &doctor.DocPageFilterRes{}, status.Error(codes.Internal, "something went wrong, please check doctor page info")
+	}
+
+	resp := doctor.DocPageFilterRes{
+		PatientInfo: make([]*doctor.DocPage, 0, len(queue.Patients)),
+		Count:       queue.Count,
+	}
+	patientIds := make([]string, 0, len(queue.Patients))
+	for _, p := range queue.Patients {
+		resp.PatientInfo = append(resp.PatientInfo, &doctor.DocPage{
+			QueueNumber:   p.QueueNumber,
+			FullName:      strings.TrimSpace(p.FirstName + " " + p.LastName),
+			PhoneNumber:   p.PhoneNumber,
+			DateLastVisit: p.DateLastVisit,
+			ClientId:      p.ClientId,
+			PatientId:     p.PatientId,
+		})
+		patientIds = append(patientIds, p.PatientId)
+	}
+
+	resp.DoctorReport, err = s.storage.Doctor().DoctorPageReports(req.DoctorId, patientIds)
 	if err != nil {
 		log.Println(err.Error())
-		if errors.Is(err, sql.ErrNoRows) {
-			return &doctor.DocPageFilterRes{}, status.Error(codes.NotFound, "something went wrong, please not found this info")
-		}
 		return &doctor.DocPageFilterRes{}, status.Error(codes.Internal, "something went wrong, please not found this info")
 	}
 
-	return resp, nil
+	return &resp, nil
 }
 
 func (s *DoctorService) DoctorTypeGet(context.Context, *emptypb.Empty) (*doctor.DoctorTypes, error) {
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purchaseError maps storage errors of purchase orders to grpc statuses.
+func purchaseError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this purchase order, supplier or sqlad")
+	} else if errors.Is(err, repo.ErrOrderStatus) {
+		return status.Error(codes.FailedPrecondition, "purchase order can not be changed in its status")
+	} else if errors.Is(err, repo.ErrOverReceipt) {
+		return status.Error(codes.FailedPrecondition, "more received than is left to receive of the item")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check purchase order info")
+}
+
+func orderValidate(req *doctor.PurchaseOrder) error {
+	switch {
+	case req.SupplierId == "":
+		return errors.New("supplier_id is required")
+	case len(req.Items) == 0:
+		return errors.New("items are required")
+	}
+	seen := make(map[string]bool, len(req.Items))
+	for _, item := range req.Items {
+		switch {
+		case item.SqladId == "":
+			return errors.New("sqlad_id of the items is required")
+		case item.Quantity <= 0:
+			return errors.New("quantity of the items must be positive")
+		case item.UnitCost < 0:
+			return errors.New("unit_cost of the items can not be negative")
+		case seen[item.SqladId]:
+			return errors.New("sqlad " + item.SqladId + " is listed twice")
+		}
+		seen[item.SqladId] = true
+	}
+	return nil
+}
+
+func receiptValidate(req *doctor.PurchaseOrderReceipt) error {
+	switch {
+	case req.OrderId == "":
+		return errors.New("order_id is required")
+	case len(req.Items) == 0:
+		return errors.New("items are required")
+	}
+	seen := make(map[string]bool, len(req.Items))
+	for _, item := range req.Items {
+		switch {
+		case item.ItemId == "":
+			return errors.New("item_id of the items is required")
+		case item.Quantity <= 0:
+			return errors.New("quantity of the items must be positive")
+		case seen[item.ItemId]:
+			return errors.New("item " + item.ItemId + " is listed twice")
+		}
+		seen[item.ItemId] = true
+		if _, err := time.Parse("2006-01-02", item.ExpirationDate); item.ExpirationDate != "" && err != nil {
+			return errors.New("expiration_date must be like 2006-01-02")
+		}
+	}
+	return nil
+}
+
+// Purchase orders...
+// PurchaseOrderCreate drafts the order, items without a unit_cost cost the price of the sqlad.
+func (s *DoctorService) PurchaseOrderCreate(ctx context.Context, req *doctor.PurchaseOrder) (*doctor.PurchaseOrder, error) {
+	if err := orderValidate(req); err != nil {
+		return &doctor.PurchaseOrder{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Purchase().OrderCreate(req)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, purchaseError(err)
+	}
+
+	return resp, nil
+}
+
+// LowStockOrder drafts an order for each supplier of the sqlad low on stock, bringing the
+// stock up to twice the low stock level less what the open orders are still to bring.
+func (s *DoctorService) LowStockOrder(ctx context.Context, req *doctor.LowStockOrderReq) (*doctor.LowStockOrders, error) {
+	resp, err := s.storage.Purchase().LowStockOrder(req.SupplierId, req.ActorId)
+	if err != nil {
+		return &doctor.LowStockOrders{}, purchaseError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) PurchaseOrderGet(ctx context.Context, req *doctor.PurchaseOrderId) (*doctor.PurchaseOrder, error) {
+	resp, err := s.storage.Purchase().OrderGet(req.Id)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, purchaseError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) PurchaseOrdersFind(ctx context.Context, req *doctor.PurchaseOrdersFindReq) (*doctor.PurchaseOrders, error) {
+	for _, date := range []string{req.FromDate, req.ToDate} {
+		if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+			return &doctor.PurchaseOrders{}, status.Error(codes.InvalidArgument, "from_date and to_date must be like 2006-01-02")
+		}
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.storage.Purchase().OrdersFind(req)
+	if err != nil {
+		return &doctor.PurchaseOrders{}, purchaseError(err)
+	}
+
+	return resp, nil
+}
+
+// PurchaseOrderUpdate replaces the supplier, note and items of a draft order.
+func (s *DoctorService) PurchaseOrderUpdate(ctx context.Context, req *doctor.PurchaseOrder) (*doctor.PurchaseOrder, error) {
+	if err := orderValidate(req); err != nil {
+		return &doctor.PurchaseOrder{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.storage.Purchase().OrderUpdate(req)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, purchaseError(err)
+	}
+
+	return resp, nil
+}
+
+// PurchaseOrderStatusSet sends a draft order to the supplier, cancels an order nothing was
+// received of yet or closes an order received short.
+func (s *DoctorService) PurchaseOrderStatusSet(ctx context.Context, req *doctor.PurchaseOrderStatusReq) (*doctor.PurchaseOrder, error) {
+	switch req.Status {
+	case repo.OrderOrdered, repo.OrderCancelled, repo.OrderReceived:
+	default:
+		return &doctor.PurchaseOrder{}, status.Error(codes.InvalidArgument, "status must be one of ordered, cancelled, received")
+	}
+
+	resp, err := s.storage.Purchase().OrderStatusSet(req.Id, req.Status)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, purchaseError(err)
+	}
+
+	return resp, nil
+}
+
+// PurchaseOrderReceive adds what arrived of the order to the stock, each item as a new lot.
+// Items can arrive in parts until the quantity ordered.
+func (s *DoctorService) PurchaseOrderReceive(ctx context.Context, req *doctor.PurchaseOrderReceipt) (*doctor.PurchaseOrder, error) {
+	if err := receiptValidate(req); err != nil {
+		return &doctor.PurchaseOrder{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.storage.Purchase().OrderReceive(req)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, purchaseError(err)
+	}
+
+	return resp, nil
+}
+
+// SupplierSpendReportGet sums what was received from the suppliers over the dates at the
+// cost of the orders.
+func (s *DoctorService) SupplierSpendReportGet(ctx context.Context, req *doctor.SupplierSpendReq) (*doctor.SupplierSpendReport, error) {
+	for _, date := range []string{req.FromDate, req.ToDate} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return &doctor.SupplierSpendReport{}, status.Error(codes.InvalidArgument, "from_date and to_date must be like 2006-01-02")
+		}
+	}
+
+	suppliers, err := s.storage.Purchase().SpendReport(req)
+	if err != nil {
+		return &doctor.SupplierSpendReport{}, purchaseError(err)
+	}
+
+	result := doctor.SupplierSpendReport{
+		FromDate:  req.FromDate,
+		ToDate:    req.ToDate,
+		Suppliers: suppliers,
+	}
+	for _, supplier := range suppliers {
+		result.Total += supplier.Spent
+	}
+
+	return &result, nil
+}
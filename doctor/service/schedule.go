@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultSlotMinutes is the appointment length of new specialties and of the doctors without one.
+const defaultSlotMinutes = 30
+
+func scheduleError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this doctor")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check doctor schedule")
+}
+
+// minutes parses a clinic local time like 15:04 to the minutes since midnight.
+func minutes(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("time %q must be like 15:04", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func clock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+type span struct {
+	start, end int
+}
+
+// spans returns the intervals of the weekday in minutes, sorted by their start.
+func spans(intervals []*doctor.WorkInterval, weekday int32) ([]span, error) {
+	result := make([]span, 0)
+	for _, interval := range intervals {
+		if interval.Weekday < 1 || interval.Weekday > 7 {
+			return nil, fmt.Errorf("weekday %d must be from 1 (monday) to 7 (sunday)", interval.Weekday)
+		}
+		if interval.Weekday != weekday {
+			continue
+		}
+		start, err := minutes(interval.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		end, err := minutes(interval.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		if start >= end {
+			return nil, fmt.Errorf("interval %s-%s must end after it starts", interval.StartTime, interval.EndTime)
+		}
+		result = append(result, span{start, end})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].start < result[j].start })
+	return result, nil
+}
+
+func workHoursValidate(req *doctor.DoctorWorkHours) error {
+	for weekday := int32(1); weekday <= 7; weekday++ {
+		for _, intervals := range [][]*doctor.WorkInterval{req.Hours, req.Breaks} {
+			list, err := spans(intervals, weekday)
+			if err != nil {
+				return err
+			}
+			for i := 1; i < len(list); i++ {
+				if list[i].start < list[i-1].end {
+					return fmt.Errorf("intervals of weekday %d overlap", weekday)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// weekday returns the weekday of t counted from monday as 1.
+func weekday(t time.Time) int32 {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int32(t.Weekday())
+}
+
+// slotsOf cuts the working hours into slots of the length.
+func slotsOf(work []span, length int) []*doctor.TimeSlot {
+	result := make([]*doctor.TimeSlot, 0)
+	for _, interval := range work {
+		for start := interval.start; start+length <= interval.end; start += length {
+			result = append(result, &doctor.TimeSlot{StartTime: clock(start), EndTime: clock(start + length)})
+		}
+	}
+	return result
+}
+
+// subtract returns the parts of the spans out of the cuts.
+func subtract(list, cuts []span) []span {
+	for _, cut := range cuts {
+		next := make([]span, 0, len(list))
+		for _, interval := range list {
+			if cut.end <= interval.start || interval.end <= cut.start {
+				next = append(next, interval)
+				continue
+			}
+			if interval.start < cut.start {
+				next = append(next, span{interval.start, cut.start})
+			}
+			if cut.end < interval.end {
+				next = append(next, span{cut.end, interval.end})
+			}
+		}
+		list = next
+	}
+	return list
+}
+
+// intersect returns the parts of the spans within the interval.
+func intersect(list []span, interval span) []span {
+	result := make([]span, 0)
+	for _, item := range list {
+		start, end := item.start, item.end
+		if start < interval.start {
+			start = interval.start
+		}
+		if end > interval.end {
+			end = interval.end
+		}
+		if start < end {
+			result = append(result, span{start, end})
+		}
+	}
+	return result
+}
+
+func timeSlots(list []span) []*doctor.TimeSlot {
+	result := make([]*doctor.TimeSlot, 0, len(list))
+	for _, interval := range list {
+		result = append(result, &doctor.TimeSlot{StartTime: clock(interval.start), EndTime: clock(interval.end)})
+	}
+	return result
+}
+
+// workDay is the schedule of a doctor on one date.
+type workDay struct {
+	// the hours of the date before the absences
+	planned []span
+	// the hours the doctor is at work
+	work []span
+	// the exception the doctor is absent the whole day for
+	absence *doctor.DoctorException
+	// the exceptions the doctor is absent part of the day for
+	absences []*doctor.DoctorException
+	// false when the doctor has no working hours set, the whole day is planned then
+	scheduled bool
+}
+
+// exceptionSpan returns the times of the exception, false when it has none.
+func exceptionSpan(exception *doctor.DoctorException) (span, bool, error) {
+	if exception.StartTime == "" && exception.EndTime == "" {
+		return span{}, false, nil
+	}
+	start, err := minutes(exception.StartTime)
+	if err != nil {
+		return span{}, false, err
+	}
+	end, err := minutes(exception.EndTime)
+	if err != nil {
+		return span{}, false, err
+	}
+	if start >= end {
+		return span{}, false, fmt.Errorf("exception %s-%s must end after it starts", exception.StartTime, exception.EndTime)
+	}
+	return span{start, end}, true, nil
+}
+
+// dayOf applies the exceptions of the date to the weekly working hours.
+func dayOf(hours *doctor.DoctorWorkHours, exceptions []*doctor.DoctorException, date time.Time) (*workDay, error) {
+	var (
+		result = &workDay{scheduled: len(hours.Hours) > 0}
+		day    = date.Format("2006-01-02")
+		custom = make([]span, 0)
+		cuts   = make([]span, 0)
+	)
+
+	for _, exception := range exceptions {
+		if exception.DateFrom > day || exception.DateTo < day {
+			continue
+		}
+		interval, timed, err := exceptionSpan(exception)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case exception.Kind == repo.ExceptionHours && timed:
+			custom = append(custom, interval)
+			result.scheduled = true
+		case exception.Kind == repo.ExceptionHours:
+			return nil, fmt.Errorf("exception %s of kind hours has no times", exception.Id)
+		case timed:
+			result.absences = append(result.absences, exception)
+			cuts = append(cuts, interval)
+		default:
+			result.absence = exception
+		}
+	}
+
+	switch {
+	case len(custom) > 0:
+		sort.Slice(custom, func(i, j int) bool { return custom[i].start < custom[j].start })
+		result.planned = custom
+	case result.scheduled:
+		work, err := spans(hours.Hours, weekday(date))
+		if err != nil {
+			return nil, err
+		}
+		breaks, err := spans(hours.Breaks, weekday(date))
+		if err != nil {
+			return nil, err
+		}
+		result.planned = subtract(work, breaks)
+	default:
+		result.planned = []span{{0, 24 * 60}}
+	}
+
+	if result.absence == nil {
+		result.work = subtract(result.planned, cuts)
+	}
+	return result, nil
+}
+
+// doctorDay returns the schedule of the doctor on the date.
+func (s *DoctorService) doctorDay(doctorId string, date time.Time) (*workDay, error) {
+	hours, err := s.storage.Schedule().WorkHoursGet(doctorId)
+	if err != nil {
+		return nil, err
+	}
+
+	day := date.Format("2006-01-02")
+	exceptions, err := s.storage.Schedule().ExceptionsFind(doctorId, day, day)
+	if err != nil {
+		return nil, err
+	}
+
+	return dayOf(hours, exceptions.Exceptions, date)
+}
+
+// Working hours...
+func (s *DoctorService) DoctorWorkHoursSet(ctx context.Context, req *doctor.DoctorWorkHours) (*doctor.DoctorWorkHours, error) {
+	if err := workHoursValidate(req); err != nil {
+		return &doctor.DoctorWorkHours{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: req.DoctorId}); err != nil {
+		return &doctor.DoctorWorkHours{}, scheduleError(err)
+	}
+
+	resp, err := s.storage.Schedule().WorkHoursSet(req)
+	if err != nil {
+		return &doctor.DoctorWorkHours{}, scheduleError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) DoctorWorkHoursGet(ctx context.Context, req *doctor.DoctorId) (*doctor.DoctorWorkHours, error) {
+	resp, err := s.storage.Schedule().WorkHoursGet(req.DoctorId)
+	if err != nil {
+		return &doctor.DoctorWorkHours{}, scheduleError(err)
+	}
+
+	return resp, nil
+}
+
+// DoctorSlotsGet returns the appointment slots of the doctor on the date, the length of
+// a slot is the one of the doctor's primary specialty. Breaks and absences are left out.
+func (s *DoctorService) DoctorSlotsGet(ctx context.Context, req *doctor.DoctorSlotsReq) (*doctor.DoctorSlots, error) {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return &doctor.DoctorSlots{}, status.Error(codes.InvalidArgument, "date must be like 2006-01-02")
+	}
+
+	doc, err := s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: req.DoctorId})
+	if err != nil {
+		return &doctor.DoctorSlots{}, scheduleError(err)
+	}
+
+	length := int64(defaultSlotMinutes)
+	if len(doc.Specialties) > 0 {
+		length = doc.Specialties[0].SlotMinutes
+	}
+
+	day, err := s.doctorDay(req.DoctorId, date)
+	if err != nil {
+		return &doctor.DoctorSlots{}, scheduleError(err)
+	}
+
+	// a doctor without working hours has no slots to book
+	slots := make([]*doctor.TimeSlot, 0)
+	if day.scheduled {
+		slots = slotsOf(day.work, int(length))
+	}
+
+	return &doctor.DoctorSlots{
+		DoctorId:    req.DoctorId,
+		Date:        req.Date,
+		SlotMinutes: length,
+		Slots:       slots,
+	}, nil
+}
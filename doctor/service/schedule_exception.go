@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Reasons of the availability of a doctor besides the kinds of exceptions
+const (
+	reasonOffHours   = "off_hours"
+	reasonNoSchedule = "no_schedule"
+)
+
+func exceptionValidate(req *doctor.DoctorException) error {
+	switch req.Kind {
+	case repo.ExceptionVacation, repo.ExceptionSick, repo.ExceptionAbsent, repo.ExceptionHours:
+	default:
+		return fmt.Errorf("kind must be one of vacation, sick, absent, hours")
+	}
+
+	from, err := time.Parse("2006-01-02", req.DateFrom)
+	if err != nil {
+		return fmt.Errorf("date_from must be like 2006-01-02")
+	}
+	to, err := time.Parse("2006-01-02", req.DateTo)
+	if err != nil {
+		return fmt.Errorf("date_to must be like 2006-01-02")
+	}
+	if to.Before(from) {
+		return fmt.Errorf("date_to must not be before date_from")
+	}
+
+	_, timed, err := exceptionSpan(req)
+	if err != nil {
+		return err
+	}
+	switch {
+	case req.Kind == repo.ExceptionHours && !timed:
+		return fmt.Errorf("start_time and end_time are required for kind hours")
+	case (req.Kind == repo.ExceptionVacation || req.Kind == repo.ExceptionSick) && timed:
+		return fmt.Errorf("%s takes whole days, it has no times", req.Kind)
+	case req.Kind == repo.ExceptionHours && req.SubstituteId != "":
+		return fmt.Errorf("only absences have a substitute")
+	case req.SubstituteId == req.DoctorId && req.SubstituteId != "":
+		return fmt.Errorf("a doctor can not substitute itself")
+	}
+	return nil
+}
+
+// Schedule exceptions...
+func (s *DoctorService) DoctorExceptionCreate(ctx context.Context, req *doctor.DoctorException) (*doctor.DoctorException, error) {
+	if err := exceptionValidate(req); err != nil {
+		return &doctor.DoctorException{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, id := range []string{req.DoctorId, req.SubstituteId} {
+		if id == "" {
+			continue
+		}
+		if _, err := s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: id}); err != nil {
+			return &doctor.DoctorException{}, scheduleError(err)
+		}
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Schedule().ExceptionCreate(req)
+	if err != nil {
+		return &doctor.DoctorException{}, scheduleError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) DoctorExceptionsFind(ctx context.Context, req *doctor.DoctorExceptionsFindReq) (*doctor.DoctorExceptions, error) {
+	for _, date := range []string{req.FromDate, req.ToDate} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return &doctor.DoctorExceptions{}, status.Error(codes.InvalidArgument, "from_date and to_date must be like 2006-01-02")
+		}
+	}
+
+	resp, err := s.storage.Schedule().ExceptionsFind(req.DoctorId, req.FromDate, req.ToDate)
+	if err != nil {
+		return &doctor.DoctorExceptions{}, scheduleError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) DoctorExceptionDelete(ctx context.Context, req *doctor.DoctorExceptionId) (*empty.Empty, error) {
+	if err := s.storage.Schedule().ExceptionDelete(req.Id); err != nil {
+		return &emptypb.Empty{}, scheduleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DoctorAvailabilityGet tells whether the doctor works at the time, and who substitutes
+// the doctor when absent.
+func (s *DoctorService) DoctorAvailabilityGet(ctx context.Context, req *doctor.DoctorAvailabilityReq) (*doctor.DoctorAvailability, error) {
+	at, err := time.Parse("2006-01-02 15:04", req.At)
+	if err != nil {
+		return &doctor.DoctorAvailability{}, status.Error(codes.InvalidArgument, "at must be like 2006-01-02 15:04")
+	}
+	if _, err := s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: req.DoctorId}); err != nil {
+		return &doctor.DoctorAvailability{}, scheduleError(err)
+	}
+
+	day, err := s.doctorDay(req.DoctorId, at)
+	if err != nil {
+		return &doctor.DoctorAvailability{}, scheduleError(err)
+	}
+
+	result := doctor.DoctorAvailability{
+		DoctorId: req.DoctorId,
+		At:       req.At,
+	}
+	minute := at.Hour()*60 + at.Minute()
+
+	for _, interval := range day.work {
+		if interval.start <= minute && minute < interval.end {
+			result.Available = true
+		}
+		if interval.start > minute && result.NextStart == "" {
+			result.NextStart = clock(interval.start)
+		}
+	}
+
+	switch {
+	case day.absence != nil:
+		result.Reason, result.SubstituteId = day.absence.Kind, day.absence.SubstituteId
+	case result.Available && !day.scheduled:
+		result.Reason = reasonNoSchedule
+	case !result.Available:
+		result.Reason = reasonOffHours
+		for _, absence := range day.absences {
+			if interval, _, _ := exceptionSpan(absence); interval.start <= minute && minute < interval.end {
+				result.Reason, result.SubstituteId = absence.Kind, absence.SubstituteId
+			}
+		}
+	}
+
+	if result.SubstituteId != "" {
+		result.Substitute, err = s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: result.SubstituteId})
+		if err != nil {
+			return &doctor.DoctorAvailability{}, scheduleError(err)
+		}
+	}
+
+	return &result, nil
+}
+
+// DoctorsWorking returns the doctors of the specialty working on the date with their hours,
+// substitutes are listed with the hours of the doctor they take the place of. Doctors
+// without working hours set are listed without hours.
+func (s *DoctorService) DoctorsWorking(ctx context.Context, req *doctor.DoctorsWorkingReq) (*doctor.DoctorsWorkingResp, error) {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return &doctor.DoctorsWorkingResp{}, status.Error(codes.InvalidArgument, "date must be like 2006-01-02")
+	}
+	if _, err := s.storage.Specialty().SpecialtyGet(req.SpecialtyId); err != nil {
+		return &doctor.DoctorsWorkingResp{}, specialtyError(err)
+	}
+
+	doctors, err := s.storage.Schedule().SpecialtyDoctors(req.SpecialtyId)
+	if err != nil {
+		return &doctor.DoctorsWorkingResp{}, scheduleError(err)
+	}
+
+	result := doctor.DoctorsWorkingResp{
+		Doctors: make([]*doctor.WorkingDoctor, 0, len(doctors)),
+	}
+	substitute := func(absence *doctor.DoctorException, hours []span) error {
+		if absence.SubstituteId == "" || len(hours) == 0 {
+			return nil
+		}
+		doc, err := s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: absence.SubstituteId})
+		if err != nil {
+			return err
+		}
+		result.Doctors = append(result.Doctors, &doctor.WorkingDoctor{
+			Doctor:        doc,
+			Hours:         timeSlots(hours),
+			SubstituteFor: absence.DoctorId,
+		})
+		return nil
+	}
+
+	for _, doc := range doctors {
+		day, err := s.doctorDay(doc.Id, date)
+		if err != nil {
+			return &doctor.DoctorsWorkingResp{}, scheduleError(err)
+		}
+
+		if day.absence != nil {
+			if day.scheduled {
+				err = substitute(day.absence, day.planned)
+			}
+			if err != nil {
+				return &doctor.DoctorsWorkingResp{}, scheduleError(err)
+			}
+			continue
+		}
+
+		switch {
+		case !day.scheduled:
+			result.Doctors = append(result.Doctors, &doctor.WorkingDoctor{Doctor: doc, Hours: []*doctor.TimeSlot{}})
+		case len(day.work) > 0:
+			result.Doctors = append(result.Doctors, &doctor.WorkingDoctor{Doctor: doc, Hours: timeSlots(day.work)})
+		}
+
+		for _, absence := range day.absences {
+			interval, _, _ := exceptionSpan(absence)
+			if day.scheduled {
+				err = substitute(absence, intersect(day.planned, interval))
+			}
+			if err != nil {
+				return &doctor.DoctorsWorkingResp{}, scheduleError(err)
+			}
+		}
+	}
+
+	return &result, nil
+}
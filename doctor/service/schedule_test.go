@@ -0,0 +1,118 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+func TestSpans(t *testing.T) {
+	interval := func(weekday int32, start, end string) *doctor.WorkInterval {
+		return &doctor.WorkInterval{Weekday: weekday, StartTime: start, EndTime: end}
+	}
+
+	tests := []struct {
+		name      string
+		intervals []*doctor.WorkInterval
+		want      []span
+		wantErr   bool
+	}{
+		{"sorted by start", []*doctor.WorkInterval{interval(1, "14:00", "18:00"), interval(1, "08:00", "12:00")},
+			[]span{{8 * 60, 12 * 60}, {14 * 60, 18 * 60}}, false},
+		{"other weekdays left out", []*doctor.WorkInterval{interval(2, "08:00", "12:00"), interval(1, "09:30", "10:15")},
+			[]span{{9*60 + 30, 10*60 + 15}}, false},
+		{"up to the last minute of the day", []*doctor.WorkInterval{interval(1, "00:00", "23:59")},
+			[]span{{0, 23*60 + 59}}, false},
+		{"crossing midnight", []*doctor.WorkInterval{interval(1, "22:00", "02:00")}, nil, true},
+		{"ending at midnight", []*doctor.WorkInterval{interval(1, "20:00", "00:00")}, nil, true},
+		{"empty", []*doctor.WorkInterval{interval(1, "10:00", "10:00")}, nil, true},
+		{"bad time", []*doctor.WorkInterval{interval(1, "8am", "12:00")}, nil, true},
+		{"bad weekday", []*doctor.WorkInterval{interval(8, "08:00", "12:00")}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := spans(tt.intervals, 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("spans error = %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("spans = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlotsOf(t *testing.T) {
+	slot := func(start, end string) *doctor.TimeSlot {
+		return &doctor.TimeSlot{StartTime: start, EndTime: end}
+	}
+
+	tests := []struct {
+		name   string
+		work   []span
+		breaks []span
+		length int
+		want   []*doctor.TimeSlot
+	}{
+		{"whole slots", []span{{9 * 60, 10 * 60}}, nil, 30,
+			[]*doctor.TimeSlot{slot("09:00", "09:30"), slot("09:30", "10:00")}},
+		{"a part shorter than a slot left out", []span{{9 * 60, 10*60 + 20}}, nil, 30,
+			[]*doctor.TimeSlot{slot("09:00", "09:30"), slot("09:30", "10:00")}},
+		{"slots start again after a break", []span{{9 * 60, 12 * 60}}, []span{{10*60 + 15, 11 * 60}}, 30,
+			[]*doctor.TimeSlot{slot("09:00", "09:30"), slot("09:30", "10:00"), slot("11:00", "11:30"), slot("11:30", "12:00")}},
+		{"break at the start", []span{{9 * 60, 10 * 60}}, []span{{8 * 60, 9*60 + 30}}, 30,
+			[]*doctor.TimeSlot{slot("09:30", "10:00")}},
+		{"break over the hours", []span{{9 * 60, 10 * 60}}, []span{{9 * 60, 10 * 60}}, 30,
+			[]*doctor.TimeSlot{}},
+		{"the last slot of the day", []span{{23 * 60, 23*60 + 59}}, nil, 30,
+			[]*doctor.TimeSlot{slot("23:00", "23:30")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slotsOf(subtract(tt.work, tt.breaks), tt.length)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("slots = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayOf(t *testing.T) {
+	hours := &doctor.DoctorWorkHours{
+		Hours:  []*doctor.WorkInterval{{Weekday: 1, StartTime: "09:00", EndTime: "18:00"}},
+		Breaks: []*doctor.WorkInterval{{Weekday: 1, StartTime: "13:00", EndTime: "14:00"}},
+	}
+	monday := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		exceptions  []*doctor.DoctorException
+		date        time.Time
+		wantPlanned []span
+		wantWork    []span
+	}{
+		{"hours less the break", nil, monday,
+			[]span{{9 * 60, 13 * 60}, {14 * 60, 18 * 60}}, []span{{9 * 60, 13 * 60}, {14 * 60, 18 * 60}}},
+		{"no hours on another weekday", nil, monday.AddDate(0, 0, 1), []span{}, []span{}},
+		{"absence across the break", []*doctor.DoctorException{
+			{Id: "e1", Kind: repo.ExceptionAbsent, DateFrom: "2001-01-01", DateTo: "2001-01-01", StartTime: "12:00", EndTime: "15:00"},
+		}, monday, []span{{9 * 60, 13 * 60}, {14 * 60, 18 * 60}}, []span{{9 * 60, 12 * 60}, {15 * 60, 18 * 60}}},
+		{"custom hours in place of the week", []*doctor.DoctorException{
+			{Id: "e2", Kind: repo.ExceptionHours, DateFrom: "2001-01-01", DateTo: "2001-01-07", StartTime: "10:00", EndTime: "12:00"},
+		}, monday, []span{{10 * 60, 12 * 60}}, []span{{10 * 60, 12 * 60}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, err := dayOf(hours, tt.exceptions, tt.date)
+			if err != nil {
+				t.Fatalf("dayOf: %v", err)
+			}
+			if !reflect.DeepEqual(day.planned, tt.wantPlanned) || !reflect.DeepEqual(day.work, tt.wantWork) {
+				t.Errorf("planned %v, work %v, want %v, %v", day.planned, day.work, tt.wantPlanned, tt.wantWork)
+			}
+		})
+	}
+}
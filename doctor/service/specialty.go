@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// specialtyError maps storage errors of specialties to grpc statuses.
+func specialtyError(err error) error {
+	log.Println(err.Error())
+	var pqErr *pq.Error
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this specialty")
+	} else if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return status.Error(codes.AlreadyExists, "specialty with this name already exists")
+	} else if errors.Is(err, repo.ErrSpecialtyInUse) {
+		return status.Error(codes.FailedPrecondition, "specialty has doctors, move them to another specialty first")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check specialty info")
+}
+
+func specialtyValidate(req *doctor.Specialty) error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.SlotMinutes == 0 {
+		req.SlotMinutes = defaultSlotMinutes
+	}
+	switch {
+	case req.Name == "":
+		return errors.New("name is required")
+	case req.Price < 0:
+		return errors.New("price can not be negative")
+	case req.SlotMinutes < 0 || req.SlotMinutes > 24*60:
+		return errors.New("slot_minutes must be up to a day")
+	}
+	return nil
+}
+
+// doctorSpecialties checks the specialties of the doctor are in the catalog, a doctor
+// given only the cpecialety name gets the specialty of the name. A doctor without
+// a price gets the price of the primary specialty.
+func (s *DoctorService) doctorSpecialties(req *doctor.Doctor) error {
+	if len(req.SpecialtyIds) == 0 && req.Cpecialety != "" {
+		specialty, err := s.storage.Specialty().SpecialtyByName(req.Cpecialety)
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.InvalidArgument, "specialty "+req.Cpecialety+" is not in the catalog")
+		} else if err != nil {
+			return specialtyError(err)
+		}
+		req.SpecialtyIds = []string{specialty.Id}
+	}
+
+	seen := make(map[string]bool, len(req.SpecialtyIds))
+	for i, id := range req.SpecialtyIds {
+		if seen[id] {
+			return status.Error(codes.InvalidArgument, "specialty "+id+" is given twice")
+		}
+		seen[id] = true
+
+		specialty, err := s.storage.Specialty().SpecialtyGet(id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.InvalidArgument, "specialty "+id+" is not in the catalog")
+		} else if err != nil {
+			return specialtyError(err)
+		}
+		if i == 0 && req.Price == 0 {
+			req.Price = specialty.Price
+		}
+	}
+	return nil
+}
+
+// Specialties...
+func (s *DoctorService) SpecialtyCreate(ctx context.Context, req *doctor.Specialty) (*doctor.Specialty, error) {
+	if err := specialtyValidate(req); err != nil {
+		return &doctor.Specialty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Specialty().SpecialtyCreate(req)
+	if err != nil {
+		return &doctor.Specialty{}, specialtyError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SpecialtyGet(ctx context.Context, req *doctor.SpecialtyId) (*doctor.Specialty, error) {
+	resp, err := s.storage.Specialty().SpecialtyGet(req.Id)
+	if err != nil {
+		return &doctor.Specialty{}, specialtyError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SpecialtiesFind(ctx context.Context, req *doctor.SpecialtiesFindReq) (*doctor.Specialties, error) {
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.storage.Specialty().SpecialtiesFind(req)
+	if err != nil {
+		return &doctor.Specialties{}, specialtyError(err)
+	}
+
+	return resp, nil
+}
+
+// SpecialtyUpdate renames the specialty for all its doctors, the price and the slot length
+// are defaults, prices already set on the doctors stay.
+func (s *DoctorService) SpecialtyUpdate(ctx context.Context, req *doctor.Specialty) (*doctor.Specialty, error) {
+	if err := specialtyValidate(req); err != nil {
+		return &doctor.Specialty{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.storage.Specialty().SpecialtyUpdate(req)
+	if err != nil {
+		return &doctor.Specialty{}, specialtyError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SpecialtyDelete(ctx context.Context, req *doctor.SpecialtyId) (*empty.Empty, error) {
+	if err := s.storage.Specialty().SpecialtyDelete(req.Id); err != nil {
+		return &emptypb.Empty{}, specialtyError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
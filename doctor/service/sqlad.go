@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sqladError maps storage errors of the sqlad stock to grpc statuses.
+func sqladError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this sqlad")
+	} else if errors.Is(err, repo.ErrStockShort) {
+		return status.Error(codes.FailedPrecondition, "not enough stock of the sqlad")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check sqlad info")
+}
+
+func movementValidate(req *doctor.SqladMovement) error {
+	switch req.Kind {
+	case repo.MovementReceipt, repo.MovementAdjustment:
+	case repo.MovementIssue, repo.MovementWriteOff:
+		if req.Quantity > 0 {
+			req.Quantity = -req.Quantity
+		}
+	default:
+		return errors.New("kind must be one of receipt, issue, write_off, adjustment")
+	}
+
+	switch {
+	case req.SqladId == "":
+		return errors.New("sqlad_id is required")
+	case req.Quantity == 0:
+		return errors.New("quantity is required")
+	case req.Kind == repo.MovementReceipt && req.Quantity < 0:
+		return errors.New("quantity of a receipt must be positive")
+	case req.UnitCost < 0:
+		return errors.New("unit_cost can not be negative")
+	case req.Kind != repo.MovementIssue && (req.TargetType != "" || req.TargetId != ""):
+		return errors.New("only issues have a target")
+	case (req.Quantity < 0 || req.LotId != "") && (req.LotNumber != "" || req.ExpirationDate != ""):
+		return errors.New("lot_number and expiration_date are only for stock added as a new lot")
+	}
+	if _, err := time.Parse("2006-01-02", req.ExpirationDate); req.ExpirationDate != "" && err != nil {
+		return errors.New("expiration_date must be like 2006-01-02")
+	}
+
+	if req.Kind == repo.MovementIssue {
+		switch req.TargetType {
+		case repo.ServiceDoctor, repo.ServiceLab, repo.ServiceAparat:
+		default:
+			return errors.New("target_type of an issue must be one of doctor, lab, aparat")
+		}
+		if req.TargetId == "" {
+			return errors.New("target_id of an issue is required")
+		}
+	}
+	return nil
+}
+
+// Sqlad stock...
+// SqladMovementCreate moves the stock of the sqlad, the stock can not go below zero. Stock
+// taken without a lot given comes from the lots expiring first.
+func (s *DoctorService) SqladMovementCreate(ctx context.Context, req *doctor.SqladMovement) (*doctor.SqladMovement, error) {
+	if err := movementValidate(req); err != nil {
+		return &doctor.SqladMovement{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.TargetType == repo.ServiceDoctor {
+		if _, err := s.storage.Doctor().DoctorGet(&doctor.GetDoctorReq{Field: "id", Value: req.TargetId}); err != nil {
+			return &doctor.SqladMovement{}, scheduleError(err)
+		}
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Sqlad().MovementCreate(req)
+	if err != nil {
+		return &doctor.SqladMovement{}, sqladError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SqladMovementsFind(ctx context.Context, req *doctor.SqladMovementsFindReq) (*doctor.SqladMovements, error) {
+	for _, date := range []string{req.FromDate, req.ToDate} {
+		if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+			return &doctor.SqladMovements{}, status.Error(codes.InvalidArgument, "from_date and to_date must be like 2006-01-02")
+		}
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.storage.Sqlad().MovementsFind(req)
+	if err != nil {
+		return &doctor.SqladMovements{}, sqladError(err)
+	}
+
+	return resp, nil
+}
+
+// SqladBalanceGet returns the stock at the end of the date, today when no date is given.
+func (s *DoctorService) SqladBalanceGet(ctx context.Context, req *doctor.SqladBalanceReq) (*doctor.SqladBalances, error) {
+	if req.Date == "" {
+		req.Date = time.Now().Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", req.Date); err != nil {
+		return &doctor.SqladBalances{}, status.Error(codes.InvalidArgument, "date must be like 2006-01-02")
+	}
+
+	balances, err := s.storage.Sqlad().BalancesAt(req.SqladId, req.Date)
+	if err != nil {
+		return &doctor.SqladBalances{}, sqladError(err)
+	}
+
+	return &doctor.SqladBalances{
+		Date:     req.Date,
+		Balances: balances,
+	}, nil
+}
+
+func (s *DoctorService) SqladLotsFind(ctx context.Context, req *doctor.SqladLotsFindReq) (*doctor.SqladLots, error) {
+	if req.SqladId == "" {
+		return &doctor.SqladLots{}, status.Error(codes.InvalidArgument, "sqlad_id is required")
+	}
+
+	lots, err := s.storage.Sqlad().LotsFind(req.SqladId, req.WithEmpty)
+	if err != nil {
+		return &doctor.SqladLots{}, sqladError(err)
+	}
+
+	return &doctor.SqladLots{
+		Lots:  lots,
+		Count: int64(len(lots)),
+	}, nil
+}
+
+// ExpiringLots returns the lots in stock expiring within the days first expiring first,
+// the expired ones to write off included.
+func (s *DoctorService) ExpiringLots(ctx context.Context, req *doctor.ExpiringLotsReq) (*doctor.SqladLots, error) {
+	if req.Days < 0 {
+		return &doctor.SqladLots{}, status.Error(codes.InvalidArgument, "days can not be negative")
+	}
+
+	lots, err := s.storage.Sqlad().ExpiringLots(req.Days)
+	if err != nil {
+		return &doctor.SqladLots{}, sqladError(err)
+	}
+
+	return &doctor.SqladLots{
+		Lots:  lots,
+		Count: int64(len(lots)),
+	}, nil
+}
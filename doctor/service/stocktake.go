@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stocktakeError maps storage errors of stocktakes to grpc statuses.
+func stocktakeError(err error) error {
+	log.Println(err.Error())
+	var pqErr *pq.Error
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this stocktake, sqlad or lot of the sqlad")
+	} else if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return status.Error(codes.AlreadyExists, "a stocktake is open already, post or cancel it first")
+	} else if errors.Is(err, repo.ErrStocktakeClosed) {
+		return status.Error(codes.FailedPrecondition, "stocktake is posted or cancelled already")
+	} else if errors.Is(err, repo.ErrCountMixed) {
+		return status.Error(codes.FailedPrecondition, "sqlad is counted by lots and as a whole, count it one way")
+	} else if errors.Is(err, repo.ErrStockShort) {
+		return status.Error(codes.FailedPrecondition, "stock changed while counting, review the stocktake again")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check stocktake info")
+}
+
+func countValidate(req *doctor.StocktakeCountReq) error {
+	switch {
+	case req.StocktakeId == "":
+		return errors.New("stocktake_id is required")
+	case req.CounterId == "":
+		return errors.New("counter_id is required")
+	case len(req.Items) == 0:
+		return errors.New("items are required")
+	}
+	seen := make(map[string]bool, len(req.Items))
+	for _, item := range req.Items {
+		key := item.SqladId + "/" + item.LotId
+		switch {
+		case item.SqladId == "":
+			return errors.New("sqlad_id of the items is required")
+		case item.Quantity < 0:
+			return errors.New("quantity of the items can not be negative")
+		case seen[key]:
+			return errors.New("sqlad " + item.SqladId + " is listed twice")
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Stocktakes...
+// StocktakeStart opens a stocktake, only one is open at a time.
+func (s *DoctorService) StocktakeStart(ctx context.Context, req *doctor.Stocktake) (*doctor.Stocktake, error) {
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Stocktake().StocktakeStart(req)
+	if err != nil {
+		return &doctor.Stocktake{}, stocktakeError(err)
+	}
+
+	return resp, nil
+}
+
+// StocktakeCountEnter records what the staff member counted, counting the same sqlad or lot
+// again replaces their count. The counts of several staff members add up.
+func (s *DoctorService) StocktakeCountEnter(ctx context.Context, req *doctor.StocktakeCountReq) (*doctor.Stocktake, error) {
+	if err := countValidate(req); err != nil {
+		return &doctor.Stocktake{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.storage.Stocktake().CountEnter(req)
+	if err != nil {
+		return &doctor.Stocktake{}, stocktakeError(err)
+	}
+
+	return resp, nil
+}
+
+// StocktakeGet returns the stocktake to review, with only_discrepancies only the counted lines
+// differing from the balance.
+func (s *DoctorService) StocktakeGet(ctx context.Context, req *doctor.StocktakeGetReq) (*doctor.Stocktake, error) {
+	resp, err := s.storage.Stocktake().StocktakeGet(req.Id)
+	if err != nil {
+		return &doctor.Stocktake{}, stocktakeError(err)
+	}
+
+	if req.OnlyDiscrepancies {
+		lines := make([]*doctor.StocktakeLine, 0)
+		for _, line := range resp.Lines {
+			if line.IsCounted && line.Difference != 0 {
+				lines = append(lines, line)
+			}
+		}
+		resp.Lines = lines
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) StocktakesFind(ctx context.Context, req *doctor.StocktakesFindReq) (*doctor.Stocktakes, error) {
+	switch req.Status {
+	case "", repo.StocktakeOpen, repo.StocktakePosted, repo.StocktakeCancelled:
+	default:
+		return &doctor.Stocktakes{}, status.Error(codes.InvalidArgument, "status must be one of open, posted, cancelled")
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.storage.Stocktake().StocktakesFind(req)
+	if err != nil {
+		return &doctor.Stocktakes{}, stocktakeError(err)
+	}
+
+	return resp, nil
+}
+
+// StocktakePost adjusts the stock of everything counted to the count and closes the stocktake.
+func (s *DoctorService) StocktakePost(ctx context.Context, req *doctor.StocktakePostReq) (*doctor.Stocktake, error) {
+	resp, err := s.storage.Stocktake().StocktakePost(req.Id, req.ActorId)
+	if err != nil {
+		return &doctor.Stocktake{}, stocktakeError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) StocktakeCancel(ctx context.Context, req *doctor.StocktakeId) (*doctor.Stocktake, error) {
+	resp, err := s.storage.Stocktake().StocktakeCancel(req.Id)
+	if err != nil {
+		return &doctor.Stocktake{}, stocktakeError(err)
+	}
+
+	return resp, nil
+}
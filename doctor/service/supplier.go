@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// supplierError maps storage errors of suppliers to grpc statuses.
+func supplierError(err error) error {
+	log.Println(err.Error())
+	var pqErr *pq.Error
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this supplier")
+	} else if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return status.Error(codes.AlreadyExists, "supplier with this name already exists")
+	} else if errors.Is(err, repo.ErrSupplierInUse) {
+		return status.Error(codes.FailedPrecondition, "supplier has open purchase orders, receive or cancel them first")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check supplier info")
+}
+
+func supplierValidate(req *doctor.Supplier) error {
+	req.Name = strings.TrimSpace(req.Name)
+	switch {
+	case req.Name == "":
+		return errors.New("name is required")
+	case req.PaymentDays < 0:
+		return errors.New("payment_days can not be negative")
+	}
+	return nil
+}
+
+// sqladSupplier checks the supplier of the sqlad is in the directory, a sqlad given only
+// the provider name gets the supplier of the name.
+func (s *DoctorService) sqladSupplier(req *doctor.SqladReq) error {
+	if req.SupplierId == "" && strings.TrimSpace(req.Provider) != "" {
+		supplier, err := s.storage.Supplier().SupplierByName(req.Provider)
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.InvalidArgument, "supplier "+req.Provider+" is not in the directory")
+		} else if err != nil {
+			return supplierError(err)
+		}
+		req.SupplierId = supplier.Id
+	}
+
+	if req.SupplierId != "" {
+		_, err := s.storage.Supplier().SupplierGet(req.SupplierId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.InvalidArgument, "supplier "+req.SupplierId+" is not in the directory")
+		} else if err != nil {
+			return supplierError(err)
+		}
+	}
+	return nil
+}
+
+// Suppliers...
+func (s *DoctorService) SupplierCreate(ctx context.Context, req *doctor.Supplier) (*doctor.Supplier, error) {
+	if err := supplierValidate(req); err != nil {
+		return &doctor.Supplier{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Supplier().SupplierCreate(req)
+	if err != nil {
+		return &doctor.Supplier{}, supplierError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SupplierGet(ctx context.Context, req *doctor.SupplierId) (*doctor.Supplier, error) {
+	resp, err := s.storage.Supplier().SupplierGet(req.Id)
+	if err != nil {
+		return &doctor.Supplier{}, supplierError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SuppliersFind(ctx context.Context, req *doctor.SuppliersFindReq) (*doctor.Suppliers, error) {
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.storage.Supplier().SuppliersFind(req)
+	if err != nil {
+		return &doctor.Suppliers{}, supplierError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *DoctorService) SupplierUpdate(ctx context.Context, req *doctor.Supplier) (*doctor.Supplier, error) {
+	if err := supplierValidate(req); err != nil {
+		return &doctor.Supplier{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.storage.Supplier().SupplierUpdate(req)
+	if err != nil {
+		return &doctor.Supplier{}, supplierError(err)
+	}
+
+	return resp, nil
+}
+
+// SupplierDelete removes the supplier from the directory, the sqlad ordered from it
+// is left without a supplier.
+func (s *DoctorService) SupplierDelete(ctx context.Context, req *doctor.SupplierId) (*empty.Empty, error) {
+	if err := s.storage.Supplier().SupplierDelete(req.Id); err != nil {
+		return &emptypb.Empty{}, supplierError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+type commissionRepo struct {
+	db *sqlx.DB
+}
+
+func NewCommission(db *sqlx.DB) repo.CommissionStorageI {
+	return &commissionRepo{
+		db: db,
+	}
+}
+
+const commissionColumns = `
+	id,
+	doctor_id,
+	service_type,
+	service_id,
+	kind,
+	value,
+	created_at::text,
+	updated_at::text`
+
+func scanCommission(row interface{ Scan(...interface{}) error }) (*doctor.DoctorCommission, error) {
+	var result doctor.DoctorCommission
+	err := row.Scan(
+		&result.Id,
+		&result.DoctorId,
+		&result.ServiceType,
+		&result.ServiceId,
+		&result.Kind,
+		&result.Value,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
+		return &doctor.DoctorCommission{}, err
+	}
+	return &result, nil
+}
+
+func (r *commissionRepo) CommissionSet(req *doctor.DoctorCommission) (*doctor.DoctorCommission, error) {
+	query := `
+		INSERT INTO doctor_commissions(
+			id,
+			doctor_id,
+			service_type,
+			service_id,
+			kind,
+			value
+		) VALUES($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (doctor_id, service_type, service_id) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			value = EXCLUDED.value,
+			updated_at = NOW()
+		RETURNING ` + commissionColumns
+
+	return scanCommission(r.db.QueryRow(query,
+		req.Id,
+		req.DoctorId,
+		req.ServiceType,
+		req.ServiceId,
+		req.Kind,
+		req.Value,
+	))
+}
+
+func (r *commissionRepo) CommissionsFind(doctorId string) (*doctor.DoctorCommissions, error) {
+	result := doctor.DoctorCommissions{
+		Commissions: make([]*doctor.DoctorCommission, 0),
+	}
+
+	query := `SELECT ` + commissionColumns + ` FROM doctor_commissions
+		WHERE ($1 = '' OR doctor_id::text = $1)
+		ORDER BY doctor_id, service_type, service_id`
+
+	rows, err := r.db.Query(query, doctorId)
+	if err != nil {
+		return &doctor.DoctorCommissions{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanCommission(rows)
+		if err != nil {
+			return &doctor.DoctorCommissions{}, err
+		}
+		result.Commissions = append(result.Commissions, temp)
+	}
+
+	return &result, rows.Err()
+}
+
+func (r *commissionRepo) CommissionDelete(id string) error {
+	res, err := r.db.Exec(`DELETE FROM doctor_commissions WHERE id::text = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
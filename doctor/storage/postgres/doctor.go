@@ -3,12 +3,13 @@ package postgres
 import (
 	"database/sql"
 	"errors"
-	"fmt"
 	"log"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
 	"gitlab.com/clinic-crm/doctor/storage/repo"
 )
 
@@ -22,8 +23,65 @@ func NewDoctor(db *sqlx.DB) repo.DoctorStorageI {
 	}
 }
 
-func (dr *doctorRepo) DoctorCreate(req *doctor.Doctor) (*doctor.Doctor, error) {
+const doctorColumns = `
+	id,
+	COALESCE(first_name,'') as first_name,
+	COALESCE(last_name,'') as last_name,
+	gender,
+	COALESCE(work_time,'') as work_time,
+	price,
+	room_number,
+	COALESCE(phone_number,'') as phone_number,
+	created_at,
+	updated_at`
+
+// primarySpecialty is the name of the doctor's primary specialty, for the lookups by cpecialety.
+const primarySpecialty = `(
+	SELECT s.name FROM doctor_specialties ds
+	JOIN specialties s ON s.id = ds.specialty_id
+	WHERE ds.doctor_id = doctors.id
+	ORDER BY ds.position LIMIT 1
+)`
+
+func scanDoctor(row interface{ Scan(...interface{}) error }) (*doctor.Doctor, error) {
 	var result doctor.Doctor
+	err := row.Scan(
+		&result.Id,
+		&result.FirstName,
+		&result.LastName,
+		&result.Gender,
+		&result.WorkTime,
+		&result.Price,
+		&result.RoomNumber,
+		&result.PhoneNumber,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
+		return &doctor.Doctor{}, err
+	}
+	return &result, nil
+}
+
+// doctorGet returns the doctor of the id with its specialties.
+func doctorGet(q sqlx.Queryer, id string) (*doctor.Doctor, error) {
+	result, err := scanDoctor(q.QueryRowx(`SELECT `+doctorColumns+` FROM doctors WHERE id = $1 and deleted_at IS NULL`, id))
+	if err != nil {
+		return &doctor.Doctor{}, err
+	}
+	if err := attachSpecialties(q, []*doctor.Doctor{result}); err != nil {
+		return &doctor.Doctor{}, err
+	}
+	return result, nil
+}
+
+func (dr *doctorRepo) DoctorCreate(req *doctor.Doctor) (*doctor.Doctor, error) {
+	tx, err := dr.db.Beginx()
+	if err != nil {
+		return &doctor.Doctor{}, err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO doctors(
 			id,
@@ -32,99 +90,124 @@ func (dr *doctorRepo) DoctorCreate(req *doctor.Doctor) (*doctor.Doctor, error) {
 			gender,
 			work_time,
 			price,
-			cpecialety,
 			room_number,
 			phone_number
-		) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING 
-			id,
-			COALESCE(first_name,'') as first_name,
-			COALESCE(last_name,'') as last_name,
-			gender,
-			COALESCE(work_time,'') as work_time,
-			price,
-			COALESCE(cpecialety,'') as cpecialety,
-			room_number,
-			COALESCE(phone_number,'') as phone_number,
-			created_at,
-			updated_at
+		) VALUES($1, $2, $3, $4, $5, $6, $7, $8)
 		`
 
-	if err := dr.db.DB.QueryRow(query,
+	_, err = tx.Exec(query,
 		req.Id,
 		req.FirstName,
 		req.LastName,
 		req.Gender,
 		req.WorkTime,
 		req.Price,
-		req.Cpecialety,
 		req.RoomNumber,
 		req.PhoneNumber,
-	).Scan(
-		&result.Id,
-		&result.FirstName,
-		&result.LastName,
-		&result.Gender,
-		&result.WorkTime,
-		&result.Price,
-		&result.Cpecialety,
-		&result.RoomNumber,
-		&result.PhoneNumber,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	); err != nil {
+	)
+	if err != nil {
 		return &doctor.Doctor{}, err
 	}
 
-	return &result, nil
+	if err := doctorSpecialtiesSet(tx, req.Id, req.SpecialtyIds); err != nil {
+		return &doctor.Doctor{}, err
+	}
+
+	result, err := doctorGet(tx, req.Id)
+	if err != nil {
+		return &doctor.Doctor{}, err
+	}
+
+	return result, tx.Commit()
 }
 
 func (dr *doctorRepo) DoctorGet(req *doctor.GetDoctorReq) (*doctor.Doctor, error) {
-	var result doctor.Doctor
-
 	_, err := uuid.Parse(req.Value)
 
 	if (err == nil && req.Field != "id") || (err != nil && req.Field == "id") {
 		return &doctor.Doctor{}, sql.ErrNoRows
 	}
 
+	field, err := sqlfilter.Lookup(req.Field, "id", "first_name", "last_name", "phone_number", "room_number", "cpecialety")
+	if err != nil {
+		return &doctor.Doctor{}, err
+	}
+	if field == "cpecialety" {
+		field = primarySpecialty
+	}
+
 	query := `
-		SELECT
-			id,
-			COALESCE(first_name,'') as first_name,
-			COALESCE(last_name,'') as last_name,
-			gender,
-			COALESCE(work_time,'') as work_time,
-			price,
-			COALESCE(cpecialety,'') as cpecialety,
-			room_number,
-			COALESCE(phone_number,'') as phone_number,
-			created_at,
-			updated_at
+		SELECT` + doctorColumns + `
 		FROM doctors
-		WHERE ` + req.Field + `= '` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
 
-	err = dr.db.DB.QueryRow(query).Scan(
-		&result.Id,
-		&result.FirstName,
-		&result.LastName,
-		&result.Gender,
-		&result.WorkTime,
-		&result.Price,
-		&result.Cpecialety,
-		&result.RoomNumber,
-		&result.PhoneNumber,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	result, err := scanDoctor(dr.db.QueryRow(query, req.Value))
 	if errors.Is(err, sql.ErrNoRows) {
 		return &doctor.Doctor{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
+		return &doctor.Doctor{}, err
+	}
+
+	if err := attachSpecialties(dr.db, []*doctor.Doctor{result}); err != nil {
 		return &doctor.Doctor{}, err
 	}
 
+	return result, nil
+}
+
+// DoctorsGetByIds returns the doctors of the ids in the order of the ids,
+// the ids no doctor was found for are returned as missing.
+func (dr *doctorRepo) DoctorsGetByIds(req *doctor.DoctorIds) (*doctor.DoctorsByIdsResp, error) {
+	result := doctor.DoctorsByIdsResp{
+		Doctors:    make([]*doctor.Doctor, 0, len(req.Ids)),
+		MissingIds: make([]string, 0),
+	}
+	if len(req.Ids) == 0 {
+		return &result, nil
+	}
+
+	query := `
+		SELECT` + doctorColumns + `
+		FROM doctors
+		WHERE id::text = ANY($1) and deleted_at IS NULL
+	`
+	rows, err := dr.db.Query(query, pq.Array(req.Ids))
+	if err != nil {
+		return &doctor.DoctorsByIdsResp{}, err
+	}
+	defer rows.Close()
+
+	found := make(map[string]*doctor.Doctor, len(req.Ids))
+	for rows.Next() {
+		temp, err := scanDoctor(rows)
+		if err != nil {
+			return &doctor.DoctorsByIdsResp{}, err
+		}
+		found[temp.Id] = temp
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.DoctorsByIdsResp{}, err
+	}
+
+	seen := make(map[string]bool, len(req.Ids))
+	for _, id := range req.Ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if temp, ok := found[id]; ok {
+			result.Doctors = append(result.Doctors, temp)
+		} else {
+			result.MissingIds = append(result.MissingIds, id)
+		}
+	}
+
+	if err := attachSpecialties(dr.db, result.Doctors); err != nil {
+		return &doctor.DoctorsByIdsResp{}, err
+	}
+
 	return &result, nil
 }
 
@@ -133,38 +216,26 @@ func (dr *doctorRepo) DoctorsFind(req *doctor.DoctorsFindReq) (*doctor.DoctorsRe
 		Doctors: make([]*doctor.Doctor, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-
-	filter := "WHERE deleted_at IS NULL"
+	filter := sqlfilter.New("deleted_at IS NULL")
 	if req.Search != "" {
-		str := "%" + req.Search + "%"
-		filter += fmt.Sprintf(`
-			AND (cpecialety ILIKE '%s')
-				`,
-			str,
-		)
+		filter.Where(`EXISTS(
+			SELECT 1 FROM doctor_specialties ds
+			JOIN specialties s ON s.id = ds.specialty_id
+			WHERE ds.doctor_id = doctors.id AND s.name ILIKE ?
+		)`, sqlfilter.Contains(req.Search))
 	}
+	if req.SpecialtyId != "" {
+		filter.Where("id IN (SELECT doctor_id FROM doctor_specialties WHERE specialty_id::text = ?)", req.SpecialtyId)
+	}
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
-	SELECT 
-		id,
-		COALESCE(first_name,'') as first_name,
-		COALESCE(last_name,'') as last_name,
-		gender,
-		COALESCE(work_time,'') as work_time,
-		price,
-		COALESCE(cpecialety,'') as cpecialety,
-		room_number,
-		COALESCE(phone_number,'') as phone_number,
-		created_at,
-		updated_at
-	FROM doctors 
-	` + filter + `
+	SELECT` + doctorColumns + `
+	FROM doctors
+	` + filter.String() + `
 	ORDER BY created_at desc
 	` + limit
-	rows, err := dr.db.Query(query)
+	rows, err := dr.db.Query(query, args...)
 	if err != nil {
 		return &doctor.DoctorsResp{}, err
 	}
@@ -172,27 +243,22 @@ func (dr *doctorRepo) DoctorsFind(req *doctor.DoctorsFindReq) (*doctor.DoctorsRe
 	defer rows.Close()
 
 	for rows.Next() {
-		temp := doctor.Doctor{}
-		err = rows.Scan(
-			&temp.Id,
-			&temp.FirstName,
-			&temp.LastName,
-			&temp.Gender,
-			&temp.WorkTime,
-			&temp.Price,
-			&temp.Cpecialety,
-			&temp.RoomNumber,
-			&temp.PhoneNumber,
-			&temp.CreatedAt,
-			&temp.UpdatedAt,
-		)
+		temp, err := scanDoctor(rows)
 		if err != nil {
 			return &doctor.DoctorsResp{}, err
 		}
-		result.Doctors = append(result.Doctors, &temp)
+		result.Doctors = append(result.Doctors, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.DoctorsResp{}, err
 	}
-	queryCount := `SELECT count(1) FROM doctors ` + filter
-	err = dr.db.QueryRow(queryCount).Scan(&result.Count)
+
+	if err := attachSpecialties(dr.db, result.Doctors); err != nil {
+		return &doctor.DoctorsResp{}, err
+	}
+
+	queryCount := `SELECT count(1) FROM doctors ` + filter.String()
+	err = dr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &doctor.DoctorsResp{}, err
 	}
@@ -200,8 +266,14 @@ func (dr *doctorRepo) DoctorsFind(req *doctor.DoctorsFindReq) (*doctor.DoctorsRe
 	return &result, nil
 }
 
+// DoctorUpdate keeps the specialties of the doctor when none are given.
 func (dr *doctorRepo) DoctorUpdate(req *doctor.Doctor) (*doctor.Doctor, error) {
-	var result doctor.Doctor
+	tx, err := dr.db.Beginx()
+	if err != nil {
+		return &doctor.Doctor{}, err
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE
 			doctors
@@ -211,55 +283,44 @@ func (dr *doctorRepo) DoctorUpdate(req *doctor.Doctor) (*doctor.Doctor, error) {
 			gender=$3,
 			work_time=$4,
 			price=$5,
-			cpecialety=$6,
-			room_number=$7,
-			phone_number=$8,
-			updated_at= ()
+			room_number=$6,
+			phone_number=$7,
+			updated_at=NOW()
 		WHERE
-			id=$9 AND deleted_at IS NULL
-		RETURNING
-			id,
-			COALESCE(first_name,'') as first_name,
-			COALESCE(last_name,'') as last_name,
-			gender,
-			COALESCE(work_time,'') as work_time,
-			price,
-			COALESCE(cpecialety,'') as cpecialety,
-			room_number,
-			COALESCE(phone_number,'') as phone_number,
-			created_at,
-			updated_at
+			id=$8 AND deleted_at IS NULL
 	`
-	err := dr.db.DB.QueryRow(query,
+	res, err := tx.Exec(query,
 		req.FirstName,
 		req.LastName,
 		req.Gender,
 		req.WorkTime,
 		req.Price,
-		req.Cpecialety,
 		req.RoomNumber,
 		req.PhoneNumber,
 		req.Id,
-	).Scan(
-		&result.Id,
-		&result.FirstName,
-		&result.LastName,
-		&result.Gender,
-		&result.WorkTime,
-		&result.Price,
-		&result.Cpecialety,
-		&result.RoomNumber,
-		&result.PhoneNumber,
-		&result.CreatedAt,
-		&result.UpdatedAt,
 	)
-
 	if err != nil {
 		log.Println(err.Error())
 		return &doctor.Doctor{}, err
 	}
+	if n, err := res.RowsAffected(); err != nil {
+		return &doctor.Doctor{}, err
+	} else if n == 0 {
+		return &doctor.Doctor{}, sql.ErrNoRows
+	}
 
-	return &result, nil
+	if len(req.SpecialtyIds) > 0 {
+		if err := doctorSpecialtiesSet(tx, req.Id, req.SpecialtyIds); err != nil {
+			return &doctor.Doctor{}, err
+		}
+	}
+
+	result, err := doctorGet(tx, req.Id)
+	if err != nil {
+		return &doctor.Doctor{}, err
+	}
+
+	return result, tx.Commit()
 }
 
 func (dr *doctorRepo) DoctorDelete(req *doctor.DoctorId) error {
@@ -325,6 +386,12 @@ func (dr *doctorRepo) DoctorReportCreate(req *doctor.DoctorReport) (*doctor.Doct
 
 func (dr *doctorRepo) DoctorReportGet(req *doctor.GetDoctorReport) (*doctor.DoctorReportRes, error) {
 	var result doctor.DoctorReportRes
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "client_id", "doctor_id")
+	if err != nil {
+		return &doctor.DoctorReportRes{}, err
+	}
+
 	query := `
 		SELECT
 			id,
@@ -334,10 +401,10 @@ func (dr *doctorRepo) DoctorReportGet(req *doctor.GetDoctorReport) (*doctor.Doct
 			created_at,
 			updated_at
 		FROM doctor_reports
-		WHERE ` + req.Field + `= '` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
 
-	err := dr.db.DB.QueryRow(query).Scan(
+	err = dr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.ClientId,
 		&result.DoctorId,
@@ -348,7 +415,7 @@ func (dr *doctorRepo) DoctorReportGet(req *doctor.GetDoctorReport) (*doctor.Doct
 	if errors.Is(err, sql.ErrNoRows) {
 		return &doctor.DoctorReportRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &doctor.DoctorReportRes{}, err
 	}
 
@@ -360,19 +427,9 @@ func (dr *doctorRepo) DoctorReportsFind(req *doctor.DoctorReportsFindReq) (*doct
 		DoctorReports: make([]*doctor.DoctorReportRes, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-
-	filter := "WHERE deleted_at IS NULL"
-	if req.Search != "" {
-		str := "%" + req.Search + "%"
-		filter += fmt.Sprintf(`
-			AND (client_id ILIKE '%s' OR doctor_id ILIKE '%s')
-			`,
-			str, str,
-		)
-	}
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "client_id::text", "doctor_id::text")
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 	SELECT 
@@ -382,11 +439,11 @@ func (dr *doctorRepo) DoctorReportsFind(req *doctor.DoctorReportsFindReq) (*doct
 		COALESCE(text,'') as text,
 		created_at,
 		updated_at
-	FROM doctor_reports 
-	` + filter + `
+	FROM doctor_reports
+	` + filter.String() + `
 	ORDER BY created_at desc
 	` + limit
-	rows, err := dr.db.Query(query)
+	rows, err := dr.db.Query(query, args...)
 	if err != nil {
 		return &doctor.DoctorReportsResp{}, err
 	}
@@ -408,8 +465,8 @@ func (dr *doctorRepo) DoctorReportsFind(req *doctor.DoctorReportsFindReq) (*doct
 		}
 		result.DoctorReports = append(result.DoctorReports, &temp)
 	}
-	queryCount := `SELECT count(1) FROM doctor_reports ` + filter
-	err = dr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT count(1) FROM doctor_reports ` + filter.String()
+	err = dr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &doctor.DoctorReportsResp{}, err
 	}
@@ -441,145 +498,148 @@ func (dr *doctorRepo) DoctorReportDelete(req *doctor.ReportId) error {
 }
 
 // Sqlad
-func (dr *doctorRepo) SqladCreate(req *doctor.SqladReq) (*doctor.SqladRes, error) {
+const sqladColumns = `
+	id,
+	COALESCE(name,'') as name,
+	(SELECT COALESCE(SUM(quantity), 0) FROM sqlad_movements m WHERE m.sqlad_id = sqlad.id) as count,
+	price,
+	low_stock,
+	COALESCE((
+		SELECT MIN(l.expiration_date)::text FROM sqlad_lots l
+		WHERE l.sqlad_id = sqlad.id AND (SELECT SUM(a.quantity) FROM sqlad_lot_movements a WHERE a.lot_id = l.id) > 0
+	), '') as expiration_date,
+	COALESCE((SELECT name FROM suppliers WHERE suppliers.id = sqlad.supplier_id), '') as provider,
+	created_at,
+	updated_at,
+	COALESCE(supplier_id::text, '') as supplier_id`
+
+func scanSqlad(row interface{ Scan(...interface{}) error }) (*doctor.SqladRes, error) {
 	var result doctor.SqladRes
+	err := row.Scan(
+		&result.Id,
+		&result.Name,
+		&result.Count,
+		&result.Price,
+		&result.LowStock,
+		&result.ExpirationDate,
+		&result.Provider,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.SupplierId,
+	)
+	if err != nil {
+		return &doctor.SqladRes{}, err
+	}
+	return &result, nil
+}
+
+// SqladCreate adds the product with its count received as the opening lot.
+func (dr *doctorRepo) SqladCreate(req *doctor.SqladReq) (*doctor.SqladRes, error) {
+	tx, err := dr.db.Beginx()
+	if err != nil {
+		return &doctor.SqladRes{}, err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO sqlad(
 			id,
 			name,
-			count,
-			price,
-			low_stock,
-			expiration_date,
-			provider
-		) VALUES($1, $2, $3, $4, $5, $6, $7)
-		RETURNING 
-			id,
-			COALESCE(name,'') as name,
-			count,
 			price,
 			low_stock,
-			expiration_date,
-			COALESCE(provider,'') as provider,
-			created_at,
-			updated_at
+			supplier_id
+		) VALUES($1, $2, $3, $4, NULLIF($5, '')::uuid)
 		`
 
-	if err := dr.db.DB.QueryRow(query,
+	_, err = tx.Exec(query,
 		req.Id,
 		req.Name,
-		req.Count,
 		req.Price,
 		req.LowStock,
-		req.ExpirationDate,
-		req.Provider,
-	).Scan(
-		&result.Id,
-		&result.Name,
-		&result.Count,
-		&result.Price,
-		&result.LowStock,
-		&result.ExpirationDate,
-		&result.Provider,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	); err != nil {
+		req.SupplierId,
+	)
+	if err != nil {
 		return &doctor.SqladRes{}, err
 	}
 
-	return &result, nil
+	if req.Count != 0 {
+		_, err = movementInsert(tx, &doctor.SqladMovement{
+			Id:             uuid.New().String(),
+			SqladId:        req.Id,
+			Kind:           repo.MovementReceipt,
+			Quantity:       req.Count,
+			UnitCost:       req.Price,
+			ExpirationDate: req.ExpirationDate,
+			ActorId:        req.ActorId,
+			Note:           "opening stock",
+		})
+		if err != nil {
+			return &doctor.SqladRes{}, err
+		}
+	}
+
+	result, err := scanSqlad(tx.QueryRow(`SELECT `+sqladColumns+` FROM sqlad WHERE id = $1`, req.Id))
+	if err != nil {
+		return &doctor.SqladRes{}, err
+	}
+
+	return result, tx.Commit()
 }
 
 func (dr *doctorRepo) SqladGet(req *doctor.SqladGetReq) (*doctor.SqladRes, error) {
-	var result doctor.SqladRes
+	field, err := sqlfilter.Lookup(req.Field, "id", "name", "provider", "supplier_id")
+	if err != nil {
+		return &doctor.SqladRes{}, err
+	}
+	if field == "provider" {
+		field = "(SELECT name FROM suppliers WHERE suppliers.id = sqlad.supplier_id)"
+	}
+
 	query := `
-		SELECT
-			id,
-			COALESCE(name,'') as name,
-			count,
-			price,
-			low_stock,
-			expiration_date,
-			COALESCE(provider,'') as provider,
-			created_at,
-			updated_at
+		SELECT` + sqladColumns + `
 		FROM sqlad
-		WHERE ` + req.Field + `= '` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
 
-	err := dr.db.DB.QueryRow(query).Scan(
-		&result.Id,
-		&result.Name,
-		&result.Count,
-		&result.Price,
-		&result.LowStock,
-		&result.ExpirationDate,
-		&result.Provider,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	result, err := scanSqlad(dr.db.QueryRow(query, req.Value))
 	if errors.Is(err, sql.ErrNoRows) {
 		return &doctor.SqladRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &doctor.SqladRes{}, err
 	}
 
-	return &result, nil
+	return result, nil
 }
 
+// SqladUpdate changes the product, its count and expiry are left to the lots.
 func (dr *doctorRepo) SqladUpdate(req *doctor.SqladReq) (*doctor.SqladRes, error) {
-	var result doctor.SqladRes
 	query := `
 		UPDATE
 			sqlad
 		SET
 			name=$1,
-			count=$2,
-			price=$3,
-			low_stock=$4,
-			expiration_date=$5,
-			provider=$6,	
+			price=$2,
+			low_stock=$3,
+			supplier_id=NULLIF($4, '')::uuid,
 			updated_at=NOW()
 		WHERE
-			id=$7 AND deleted_at IS NULL
-		RETURNING
-			id,
-			COALESCE(name,'') as name,
-			count,
-			price,
-			low_stock,
-			expiration_date,
-			COALESCE(provider,'') as provider,
-			created_at,
-			updated_at
-	`
-	err := dr.db.DB.QueryRow(query,
+			id=$5 AND deleted_at IS NULL
+		RETURNING` + sqladColumns
+
+	result, err := scanSqlad(dr.db.QueryRow(query,
 		req.Name,
-		req.Count,
 		req.Price,
 		req.LowStock,
-		req.ExpirationDate,
-		req.Provider,
+		req.SupplierId,
 		req.Id,
-	).Scan(
-		&result.Id,
-		&result.Name,
-		&result.Count,
-		&result.Price,
-		&result.LowStock,
-		&result.ExpirationDate,
-		&result.Provider,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
-
+	))
 	if err != nil {
 		log.Println(err.Error())
 		return &doctor.SqladRes{}, err
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 func (dr *doctorRepo) SqladDelete(req *doctor.SqladId) error {
@@ -611,18 +671,11 @@ func (dr *doctorRepo) LowStock() (*doctor.LowStockRes, error) {
 	}
 
 	query := `
-		SELECT 
-			id,
-			COALESCE(name,'') as name,
-			count,
-			price,
-			low_stock,
-			expiration_date,
-			COALESCE(provider,'') as provider,
-			created_at,
-			updated_at
-	FROM sqlad WHERE count < low_stock AND deleted_at IS NULL
-	ORDER BY created_at desc
+		SELECT * FROM (
+			SELECT` + sqladColumns + `
+			FROM sqlad WHERE deleted_at IS NULL
+		) s WHERE count < low_stock
+		ORDER BY created_at desc
 	`
 	rows, err := dr.db.Query(query)
 	if err != nil {
@@ -632,40 +685,66 @@ func (dr *doctorRepo) LowStock() (*doctor.LowStockRes, error) {
 	defer rows.Close()
 
 	for rows.Next() {
-		temp := doctor.SqladRes{}
-		err = rows.Scan(
-			&temp.Id,
-			&temp.Name,
-			&temp.Count,
-			&temp.Price,
-			&temp.LowStock,
-			&temp.ExpirationDate,
-			&temp.Provider,
-			&temp.CreatedAt,
-			&temp.UpdatedAt,
-		)
+		temp, err := scanSqlad(rows)
 		if err != nil {
 			return &doctor.LowStockRes{}, err
 		}
-		result.LowStock = append(result.LowStock, &temp)
+		result.LowStock = append(result.LowStock, temp)
 	}
-	queryCount := `SELECT count(1) FROM sqlad WHERE count < low_stock AND deleted_at IS NULL`
-	err = dr.db.QueryRow(queryCount).Scan(&result.Count)
-	if err != nil {
+	if err := rows.Err(); err != nil {
 		return &doctor.LowStockRes{}, err
 	}
+	result.Count = int64(len(result.LowStock))
 
 	return &result, nil
 }
 
 // Doctor page
-func (dr *doctorRepo) DoctorPageFilter(req *doctor.DocPageFilter) (*doctor.DocPageFilterRes, error) {
-	var (
-		result doctor.DocPageFilterRes
-	)
-	return &result, nil
+// DoctorPageReports returns reports written by the doctor for the given patients.
+func (dr *doctorRepo) DoctorPageReports(doctorId string, patientIds []string) ([]*doctor.DoctorReportRes, error) {
+	result := make([]*doctor.DoctorReportRes, 0)
+	if len(patientIds) == 0 {
+		return result, nil
+	}
+
+	query := `
+	SELECT
+		id,
+		client_id,
+		doctor_id,
+		COALESCE(text,'') as text,
+		created_at,
+		updated_at
+	FROM doctor_reports
+	WHERE doctor_id = $1 AND client_id = ANY($2::uuid[]) AND deleted_at IS NULL
+	ORDER BY created_at desc
+	`
+	rows, err := dr.db.Query(query, doctorId, pq.Array(patientIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := doctor.DoctorReportRes{}
+		err = rows.Scan(
+			&temp.Id,
+			&temp.ClientId,
+			&temp.DoctorId,
+			&temp.Text,
+			&temp.CreatedAt,
+			&temp.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &temp)
+	}
+
+	return result, rows.Err()
 }
 
+// DoctorTypeGet lists the names of the specialties catalog.
 func (dr *doctorRepo) DoctorTypeGet() (*doctor.DoctorTypes, error) {
 	result := doctor.DoctorTypes{
 		DoctorTypes: make([]*doctor.DoctorType, 0),
@@ -673,12 +752,12 @@ func (dr *doctorRepo) DoctorTypeGet() (*doctor.DoctorTypes, error) {
 
 	query := `
 	SELECT 
-    	cpecialety
+		name
 	FROM 
-		doctors 
+		specialties 
 	WHERE 
 		deleted_at IS NULL
-	ORDER BY created_at desc
+	ORDER BY name
 	`
 	rows, err := dr.db.Query(query)
 	if err != nil {
@@ -696,27 +775,10 @@ func (dr *doctorRepo) DoctorTypeGet() (*doctor.DoctorTypes, error) {
 		}
 		result.DoctorTypes = append(result.DoctorTypes, &temp)
 	}
-
-	result.DoctorTypes = removeDuplicates(result.DoctorTypes)
-
-	queryCount := `SELECT count(1) FROM doctors WHERE deleted_at IS NULL`
-	err = dr.db.QueryRow(queryCount).Scan(&result.Count)
-	if err != nil {
+	if err := rows.Err(); err != nil {
 		return &doctor.DoctorTypes{}, err
 	}
+	result.Count = int64(len(result.DoctorTypes))
 
 	return &result, nil
 }
-
-func removeDuplicates(slice []*doctor.DoctorType) []*doctor.DoctorType {
-	encountered := make(map[string]bool)
-	result := []*doctor.DoctorType{}
-	for _, item := range slice {
-		if encountered[item.DoctorType] {
-			continue
-		}
-		encountered[item.DoctorType] = true
-		result = append(result, item)
-	}
-	return result
-}
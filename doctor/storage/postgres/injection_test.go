@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
+)
+
+// recorder is a database that keeps the statements sent to it and has no rows.
+type recorder struct {
+	mu         sync.Mutex
+	statements []statement
+}
+
+type statement struct {
+	query string
+	args  []driver.Value
+}
+
+func recordDB() (*sqlx.DB, *recorder) {
+	r := &recorder{}
+	return sqlx.NewDb(sql.OpenDB(r), "postgres"), r
+}
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return recordConn{r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return nil }
+
+func (r *recorder) record(query string, args []driver.Value) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = append(r.statements, statement{query, args})
+}
+
+type recordConn struct{ r *recorder }
+
+func (c recordConn) Prepare(query string) (driver.Stmt, error) { return recordStmt{c.r, query}, nil }
+func (c recordConn) Close() error                              { return nil }
+func (c recordConn) Begin() (driver.Tx, error)                 { return recordTx{}, nil }
+
+type recordTx struct{}
+
+func (recordTx) Commit() error   { return nil }
+func (recordTx) Rollback() error { return nil }
+
+type recordStmt struct {
+	r     *recorder
+	query string
+}
+
+func (s recordStmt) Close() error  { return nil }
+func (s recordStmt) NumInput() int { return -1 }
+
+func (s recordStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.r.record(s.query, args)
+	return driver.RowsAffected(0), nil
+}
+
+func (s recordStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.r.record(s.query, args)
+	return recordRows{}, nil
+}
+
+type recordRows struct{}
+
+func (recordRows) Columns() []string         { return nil }
+func (recordRows) Close() error              { return nil }
+func (recordRows) Next([]driver.Value) error { return io.EOF }
+
+var hostileValues = []string{
+	`x' OR '1'='1`,
+	`'; DROP TABLE doctors; --`,
+	`1 UNION SELECT login, password FROM staff`,
+}
+
+var hostileFields = []string{
+	"first_name; DROP TABLE doctors",
+	"id::text = id::text OR 1=1 --",
+	"(SELECT password FROM staff LIMIT 1)",
+	"NAME",
+}
+
+// assertBound checks the value went to the database only as an arg of the statements.
+func assertBound(t *testing.T, r *recorder, value string) {
+	t.Helper()
+	if len(r.statements) == 0 {
+		t.Fatal("no statement was sent")
+	}
+	var bound bool
+	for _, s := range r.statements {
+		if strings.Contains(s.query, value) {
+			t.Errorf("value %q is in the query %q", value, s.query)
+		}
+		for _, arg := range s.args {
+			if strings.Contains(fmt.Sprint(arg), value) {
+				bound = true
+			}
+		}
+	}
+	if !bound {
+		t.Errorf("value %q is not an arg of any statement", value)
+	}
+}
+
+// assertRejected checks the lookup failed before anything was sent.
+func assertRejected(t *testing.T, r *recorder, err error) {
+	t.Helper()
+	if !errors.Is(err, sqlfilter.ErrUnknownField) {
+		t.Errorf("err = %v, want ErrUnknownField", err)
+	}
+	if len(r.statements) != 0 {
+		t.Errorf("%d statements were sent, want none", len(r.statements))
+	}
+}
+
+func TestDoctorGetInjection(t *testing.T) {
+	for _, value := range hostileValues {
+		db, r := recordDB()
+		_, err := NewDoctor(db).DoctorGet(&doctor.GetDoctorReq{Field: "first_name", Value: value})
+		if !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("err = %v, want sql.ErrNoRows", err)
+		}
+		assertBound(t, r, value)
+	}
+	for _, field := range hostileFields {
+		db, r := recordDB()
+		_, err := NewDoctor(db).DoctorGet(&doctor.GetDoctorReq{Field: field, Value: "x"})
+		assertRejected(t, r, err)
+	}
+}
+
+func TestSqladGetInjection(t *testing.T) {
+	for _, value := range hostileValues {
+		db, r := recordDB()
+		_, err := NewDoctor(db).SqladGet(&doctor.SqladGetReq{Field: "name", Value: value})
+		if !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("err = %v, want sql.ErrNoRows", err)
+		}
+		assertBound(t, r, value)
+	}
+	for _, field := range hostileFields {
+		db, r := recordDB()
+		_, err := NewDoctor(db).SqladGet(&doctor.SqladGetReq{Field: field, Value: "x"})
+		assertRejected(t, r, err)
+	}
+}
+
+func TestFindSearchInjection(t *testing.T) {
+	for _, value := range hostileValues {
+		db, r := recordDB()
+		NewDoctor(db).DoctorsFind(&doctor.DoctorsFindReq{Search: value, Limit: 10, Page: 1})
+		assertBound(t, r, value)
+
+		db, r = recordDB()
+		NewSupplier(db).SuppliersFind(&doctor.SuppliersFindReq{Search: value, Limit: 10, Page: 1})
+		assertBound(t, r, value)
+
+		db, r = recordDB()
+		NewSpecialty(db).SpecialtiesFind(&doctor.SpecialtiesFindReq{Search: value, Limit: 10, Page: 1})
+		assertBound(t, r, value)
+	}
+}
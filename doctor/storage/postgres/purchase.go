@@ -0,0 +1,582 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+type purchaseRepo struct {
+	db *sqlx.DB
+}
+
+func NewPurchase(db *sqlx.DB) repo.PurchaseStorageI {
+	return &purchaseRepo{
+		db: db,
+	}
+}
+
+const orderColumns = `
+	o.id,
+	o.number,
+	o.supplier_id,
+	COALESCE(s.name, ''),
+	o.status,
+	o.note,
+	o.actor_id,
+	COALESCE((SELECT SUM(i.quantity * i.unit_cost) FROM purchase_order_items i WHERE i.order_id = o.id), 0),
+	COALESCE((
+		SELECT SUM(r.quantity * i.unit_cost) FROM purchase_order_receipts r
+		JOIN purchase_order_items i ON i.id = r.item_id
+		WHERE i.order_id = o.id
+	), 0),
+	COALESCE(o.ordered_at::text, ''),
+	o.created_at::text,
+	o.updated_at::text`
+
+const orderFrom = `
+	FROM purchase_orders o
+	LEFT JOIN suppliers s ON s.id = o.supplier_id`
+
+// openOrders are the statuses of the orders still to be received
+var openOrders = pq.Array([]string{repo.OrderDraft, repo.OrderOrdered, repo.OrderPartiallyReceived})
+
+func scanOrder(row interface{ Scan(...interface{}) error }) (*doctor.PurchaseOrder, error) {
+	var result doctor.PurchaseOrder
+	err := row.Scan(
+		&result.Id,
+		&result.Number,
+		&result.SupplierId,
+		&result.SupplierName,
+		&result.Status,
+		&result.Note,
+		&result.ActorId,
+		&result.Total,
+		&result.ReceivedTotal,
+		&result.OrderedAt,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	result.Items = make([]*doctor.PurchaseOrderItem, 0)
+	return &result, nil
+}
+
+// attachItems loads the items of the orders in one query.
+func attachItems(q sqlx.Queryer, orders []*doctor.PurchaseOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	byId := make(map[string]*doctor.PurchaseOrder, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		byId[order.Id] = order
+		ids = append(ids, order.Id)
+	}
+
+	query := `
+		SELECT
+			i.order_id::text,
+			i.id,
+			i.sqlad_id,
+			COALESCE(q.name, ''),
+			i.quantity,
+			i.unit_cost,
+			COALESCE((SELECT SUM(r.quantity) FROM purchase_order_receipts r WHERE r.item_id = i.id), 0)
+		FROM purchase_order_items i
+		LEFT JOIN sqlad q ON q.id = i.sqlad_id
+		WHERE i.order_id::text = ANY($1)
+		ORDER BY q.name, i.id`
+
+	rows, err := q.Query(query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderId string
+			item    doctor.PurchaseOrderItem
+		)
+		err := rows.Scan(
+			&orderId,
+			&item.Id,
+			&item.SqladId,
+			&item.SqladName,
+			&item.Quantity,
+			&item.UnitCost,
+			&item.Received,
+		)
+		if err != nil {
+			return err
+		}
+		byId[orderId].Items = append(byId[orderId].Items, &item)
+	}
+
+	return rows.Err()
+}
+
+func orderGet(q sqlx.Queryer, id string) (*doctor.PurchaseOrder, error) {
+	result, err := scanOrder(q.QueryRowx(`SELECT `+orderColumns+orderFrom+` WHERE o.id::text = $1`, id))
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	if err := attachItems(q, []*doctor.PurchaseOrder{result}); err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	return result, nil
+}
+
+// orderLock locks the order until the end of the transaction and returns its status.
+func orderLock(tx *sqlx.Tx, id string) (string, error) {
+	var status string
+	err := tx.QueryRow(`SELECT status FROM purchase_orders WHERE id::text = $1 FOR UPDATE`, id).Scan(&status)
+	return status, err
+}
+
+func supplierCheck(tx *sqlx.Tx, id string) error {
+	var exists bool
+	return tx.QueryRow(`SELECT true FROM suppliers WHERE id::text = $1 AND deleted_at IS NULL`, id).Scan(&exists)
+}
+
+// itemsInsert adds the items to the order, the ones without a cost cost the price of the sqlad.
+func itemsInsert(tx *sqlx.Tx, orderId string, items []*doctor.PurchaseOrderItem) error {
+	for _, item := range items {
+		res, err := tx.Exec(`
+			INSERT INTO purchase_order_items(id, order_id, sqlad_id, quantity, unit_cost)
+			SELECT $1, $2, id, $4, COALESCE(NULLIF($5::double precision, 0), price)
+			FROM sqlad WHERE id::text = $3 AND deleted_at IS NULL`,
+			uuid.New().String(), orderId, item.SqladId, item.Quantity, item.UnitCost,
+		)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return sql.ErrNoRows
+		}
+	}
+	return nil
+}
+
+func orderInsert(tx *sqlx.Tx, req *doctor.PurchaseOrder) error {
+	if err := supplierCheck(tx, req.SupplierId); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO purchase_orders(
+			id,
+			supplier_id,
+			status,
+			note,
+			actor_id
+		) VALUES($1, $2, $3, $4, $5)`
+
+	_, err := tx.Exec(query, req.Id, req.SupplierId, repo.OrderDraft, req.Note, req.ActorId)
+	if err != nil {
+		return err
+	}
+
+	return itemsInsert(tx, req.Id, req.Items)
+}
+
+func (r *purchaseRepo) OrderCreate(req *doctor.PurchaseOrder) (*doctor.PurchaseOrder, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	defer tx.Rollback()
+
+	if err := orderInsert(tx, req); err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	result, err := orderGet(tx, req.Id)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *purchaseRepo) LowStockOrder(supplierId, actorId string) (*doctor.LowStockOrders, error) {
+	result := doctor.LowStockOrders{
+		Orders:          make([]*doctor.PurchaseOrder, 0),
+		WithoutSupplier: make([]*doctor.SqladRes, 0),
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.LowStockOrders{}, err
+	}
+	defer tx.Rollback()
+
+	// what the open orders are still to bring
+	onOrder := make(map[string]int64)
+	rows, err := tx.Query(`
+		SELECT i.sqlad_id::text, SUM(i.quantity - COALESCE((SELECT SUM(r.quantity) FROM purchase_order_receipts r WHERE r.item_id = i.id), 0))
+		FROM purchase_order_items i
+		JOIN purchase_orders o ON o.id = i.order_id
+		WHERE o.status = ANY($1)
+		GROUP BY i.sqlad_id`, openOrders,
+	)
+	if err != nil {
+		return &doctor.LowStockOrders{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			sqladId  string
+			quantity int64
+		)
+		if err := rows.Scan(&sqladId, &quantity); err != nil {
+			return &doctor.LowStockOrders{}, err
+		}
+		onOrder[sqladId] = quantity
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.LowStockOrders{}, err
+	}
+
+	query := `
+		SELECT * FROM (
+			SELECT` + sqladColumns + `
+			FROM sqlad WHERE deleted_at IS NULL
+		) s WHERE count < low_stock AND ($1 = '' OR supplier_id = $1)
+		ORDER BY name`
+	rows, err = tx.Query(query, supplierId)
+	if err != nil {
+		return &doctor.LowStockOrders{}, err
+	}
+	defer rows.Close()
+
+	bySupplier := make(map[string]*doctor.PurchaseOrder)
+	for rows.Next() {
+		sqlad, err := scanSqlad(rows)
+		if err != nil {
+			return &doctor.LowStockOrders{}, err
+		}
+		if sqlad.SupplierId == "" {
+			result.WithoutSupplier = append(result.WithoutSupplier, sqlad)
+			continue
+		}
+
+		quantity := 2*sqlad.LowStock - sqlad.Count - onOrder[sqlad.Id]
+		if quantity <= 0 {
+			continue
+		}
+		order, ok := bySupplier[sqlad.SupplierId]
+		if !ok {
+			order = &doctor.PurchaseOrder{
+				Id:         uuid.New().String(),
+				SupplierId: sqlad.SupplierId,
+				Note:       "low stock",
+				ActorId:    actorId,
+			}
+			bySupplier[sqlad.SupplierId] = order
+			result.Orders = append(result.Orders, order)
+		}
+		order.Items = append(order.Items, &doctor.PurchaseOrderItem{
+			SqladId:  sqlad.Id,
+			Quantity: quantity,
+			UnitCost: sqlad.Price,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.LowStockOrders{}, err
+	}
+
+	for i, order := range result.Orders {
+		if err := orderInsert(tx, order); err != nil {
+			return &doctor.LowStockOrders{}, err
+		}
+		result.Orders[i], err = orderGet(tx, order.Id)
+		if err != nil {
+			return &doctor.LowStockOrders{}, err
+		}
+	}
+
+	return &result, tx.Commit()
+}
+
+func (r *purchaseRepo) OrderGet(id string) (*doctor.PurchaseOrder, error) {
+	return orderGet(r.db, id)
+}
+
+func (r *purchaseRepo) OrdersFind(req *doctor.PurchaseOrdersFindReq) (*doctor.PurchaseOrders, error) {
+	result := doctor.PurchaseOrders{
+		Orders: make([]*doctor.PurchaseOrder, 0),
+	}
+
+	filter := sqlfilter.New()
+	if req.SupplierId != "" {
+		filter.Where("o.supplier_id::text = ?", req.SupplierId)
+	}
+	if req.Status != "" {
+		filter.Where("o.status = ?", req.Status)
+	}
+	if req.FromDate != "" {
+		filter.Where("o.created_at >= ?::date", req.FromDate)
+	}
+	if req.ToDate != "" {
+		filter.Where("o.created_at < ?::date + 1", req.ToDate)
+	}
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	query := `SELECT ` + orderColumns + orderFrom + filter.String() + ` ORDER BY o.number DESC` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &doctor.PurchaseOrders{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanOrder(rows)
+		if err != nil {
+			return &doctor.PurchaseOrders{}, err
+		}
+		result.Orders = append(result.Orders, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.PurchaseOrders{}, err
+	}
+
+	queryCount := `SELECT count(1) FROM purchase_orders o` + filter.String()
+	if err := r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count); err != nil {
+		return &doctor.PurchaseOrders{}, err
+	}
+
+	return &result, attachItems(r.db, result.Orders)
+}
+
+func (r *purchaseRepo) OrderUpdate(req *doctor.PurchaseOrder) (*doctor.PurchaseOrder, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	defer tx.Rollback()
+
+	status, err := orderLock(tx, req.Id)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	if status != repo.OrderDraft {
+		return &doctor.PurchaseOrder{}, repo.ErrOrderStatus
+	}
+	if err := supplierCheck(tx, req.SupplierId); err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	_, err = tx.Exec(`UPDATE purchase_orders SET supplier_id = $2, note = $3, updated_at = NOW() WHERE id::text = $1`, req.Id, req.SupplierId, req.Note)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	if _, err := tx.Exec(`DELETE FROM purchase_order_items WHERE order_id::text = $1`, req.Id); err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	if err := itemsInsert(tx, req.Id, req.Items); err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	result, err := orderGet(tx, req.Id)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *purchaseRepo) OrderStatusSet(id, status string) (*doctor.PurchaseOrder, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	defer tx.Rollback()
+
+	current, err := orderLock(tx, id)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	// a cancelled order received nothing, closing short is left to partially received orders
+	switch {
+	case status == repo.OrderOrdered && current == repo.OrderDraft:
+		_, err = tx.Exec(`UPDATE purchase_orders SET status = $2, ordered_at = NOW(), updated_at = NOW() WHERE id::text = $1`, id, status)
+	case status == repo.OrderCancelled && (current == repo.OrderDraft || current == repo.OrderOrdered),
+		status == repo.OrderReceived && current == repo.OrderPartiallyReceived:
+		_, err = tx.Exec(`UPDATE purchase_orders SET status = $2, updated_at = NOW() WHERE id::text = $1`, id, status)
+	default:
+		return &doctor.PurchaseOrder{}, repo.ErrOrderStatus
+	}
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	result, err := orderGet(tx, id)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *purchaseRepo) OrderReceive(req *doctor.PurchaseOrderReceipt) (*doctor.PurchaseOrder, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	defer tx.Rollback()
+
+	status, err := orderLock(tx, req.OrderId)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+	if status != repo.OrderOrdered && status != repo.OrderPartiallyReceived {
+		return &doctor.PurchaseOrder{}, repo.ErrOrderStatus
+	}
+
+	var number int64
+	if err := tx.QueryRow(`SELECT number FROM purchase_orders WHERE id::text = $1`, req.OrderId).Scan(&number); err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	for _, receipt := range req.Items {
+		var (
+			item     doctor.PurchaseOrderItem
+			received int64
+		)
+		err := tx.QueryRow(`
+			SELECT
+				sqlad_id,
+				quantity,
+				unit_cost,
+				COALESCE((SELECT SUM(r.quantity) FROM purchase_order_receipts r WHERE r.item_id = i.id), 0)
+			FROM purchase_order_items i
+			WHERE id::text = $1 AND order_id::text = $2`, receipt.ItemId, req.OrderId,
+		).Scan(&item.SqladId, &item.Quantity, &item.UnitCost, &received)
+		if err != nil {
+			return &doctor.PurchaseOrder{}, err
+		}
+		if received+receipt.Quantity > item.Quantity {
+			return &doctor.PurchaseOrder{}, repo.ErrOverReceipt
+		}
+
+		if _, err := sqladLock(tx, item.SqladId); err != nil {
+			return &doctor.PurchaseOrder{}, err
+		}
+		movement, err := movementInsert(tx, &doctor.SqladMovement{
+			Id:             uuid.New().String(),
+			SqladId:        item.SqladId,
+			Kind:           repo.MovementReceipt,
+			Quantity:       receipt.Quantity,
+			UnitCost:       item.UnitCost,
+			ActorId:        req.ActorId,
+			Note:           fmt.Sprintf("purchase order %d", number),
+			LotNumber:      receipt.LotNumber,
+			ExpirationDate: receipt.ExpirationDate,
+		})
+		if err != nil {
+			return &doctor.PurchaseOrder{}, err
+		}
+
+		_, err = tx.Exec(`INSERT INTO purchase_order_receipts(movement_id, item_id, quantity) VALUES($1, $2, $3)`, movement.Id, receipt.ItemId, receipt.Quantity)
+		if err != nil {
+			return &doctor.PurchaseOrder{}, err
+		}
+	}
+
+	_, err = tx.Exec(`
+		UPDATE purchase_orders SET
+			status = CASE WHEN EXISTS(
+				SELECT 1 FROM purchase_order_items i
+				WHERE i.order_id = purchase_orders.id
+					AND i.quantity > COALESCE((SELECT SUM(r.quantity) FROM purchase_order_receipts r WHERE r.item_id = i.id), 0)
+			) THEN $2 ELSE $3 END,
+			updated_at = NOW()
+		WHERE id::text = $1`, req.OrderId, repo.OrderPartiallyReceived, repo.OrderReceived,
+	)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	result, err := orderGet(tx, req.OrderId)
+	if err != nil {
+		return &doctor.PurchaseOrder{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *purchaseRepo) SpendReport(req *doctor.SupplierSpendReq) ([]*doctor.SupplierSpend, error) {
+	result := make([]*doctor.SupplierSpend, 0)
+
+	// the receipts are dated by their movements, costed at the cost of the order
+	const received = `
+		FROM purchase_order_receipts r
+		JOIN sqlad_movements m ON m.id = r.movement_id
+		JOIN purchase_order_items i ON i.id = r.item_id
+		JOIN purchase_orders o ON o.id = i.order_id
+		LEFT JOIN sqlad q ON q.id = i.sqlad_id
+		WHERE m.created_at >= $1::date AND m.created_at < $2::date + 1
+			AND ($3 = '' OR o.supplier_id::text = $3)`
+
+	bySupplier := make(map[string]*doctor.SupplierSpend)
+	rows, err := r.db.Query(`
+		SELECT o.supplier_id::text, COALESCE(s.name, ''), COUNT(1)
+		FROM (SELECT DISTINCT o.id, o.supplier_id `+received+`) o
+		LEFT JOIN suppliers s ON s.id = o.supplier_id
+		GROUP BY 1, 2
+		ORDER BY 2, 1`, req.FromDate, req.ToDate, req.SupplierId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var temp doctor.SupplierSpend
+		if err := rows.Scan(&temp.SupplierId, &temp.SupplierName, &temp.Orders); err != nil {
+			return nil, err
+		}
+		temp.Items = make([]*doctor.SupplierSpendItem, 0)
+		bySupplier[temp.SupplierId] = &temp
+		result = append(result, &temp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.db.Query(`
+		SELECT o.supplier_id::text, i.sqlad_id::text, COALESCE(q.name, ''), SUM(r.quantity), SUM(r.quantity * m.unit_cost)
+		`+received+`
+		GROUP BY 1, 2, 3
+		ORDER BY 3, 2`, req.FromDate, req.ToDate, req.SupplierId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			supplierId string
+			item       doctor.SupplierSpendItem
+		)
+		if err := rows.Scan(&supplierId, &item.SqladId, &item.SqladName, &item.Quantity, &item.Spent); err != nil {
+			return nil, err
+		}
+		supplier := bySupplier[supplierId]
+		supplier.Items = append(supplier.Items, &item)
+		supplier.Spent += item.Spent
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+type scheduleRepo struct {
+	db *sqlx.DB
+}
+
+func NewSchedule(db *sqlx.DB) repo.ScheduleStorageI {
+	return &scheduleRepo{
+		db: db,
+	}
+}
+
+func (r *scheduleRepo) WorkHoursSet(req *doctor.DoctorWorkHours) (*doctor.DoctorWorkHours, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.DoctorWorkHours{}, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM doctor_work_hours WHERE doctor_id = $1`, req.DoctorId); err != nil {
+		return &doctor.DoctorWorkHours{}, err
+	}
+
+	query := `
+		INSERT INTO doctor_work_hours(
+			doctor_id,
+			weekday,
+			start_time,
+			end_time,
+			is_break
+		) VALUES($1, $2, $3, $4, $5)
+	`
+	for _, intervals := range []struct {
+		list    []*doctor.WorkInterval
+		isBreak bool
+	}{{req.Hours, false}, {req.Breaks, true}} {
+		for _, interval := range intervals.list {
+			if _, err := tx.Exec(query, req.DoctorId, interval.Weekday, interval.StartTime, interval.EndTime, intervals.isBreak); err != nil {
+				return &doctor.DoctorWorkHours{}, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &doctor.DoctorWorkHours{}, err
+	}
+
+	return r.WorkHoursGet(req.DoctorId)
+}
+
+func (r *scheduleRepo) WorkHoursGet(doctorId string) (*doctor.DoctorWorkHours, error) {
+	result := doctor.DoctorWorkHours{
+		DoctorId: doctorId,
+		Hours:    make([]*doctor.WorkInterval, 0),
+		Breaks:   make([]*doctor.WorkInterval, 0),
+	}
+
+	query := `
+		SELECT
+			weekday,
+			to_char(start_time, 'HH24:MI'),
+			to_char(end_time, 'HH24:MI'),
+			is_break
+		FROM doctor_work_hours
+		WHERE doctor_id = $1
+		ORDER BY weekday, start_time
+	`
+	rows, err := r.db.Query(query, doctorId)
+	if err != nil {
+		return &doctor.DoctorWorkHours{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			temp    doctor.WorkInterval
+			isBreak bool
+		)
+		if err := rows.Scan(&temp.Weekday, &temp.StartTime, &temp.EndTime, &isBreak); err != nil {
+			return &doctor.DoctorWorkHours{}, err
+		}
+		if isBreak {
+			result.Breaks = append(result.Breaks, &temp)
+		} else {
+			result.Hours = append(result.Hours, &temp)
+		}
+	}
+
+	return &result, rows.Err()
+}
+
+const exceptionColumns = `
+	id,
+	doctor_id,
+	kind,
+	date_from::text,
+	date_to::text,
+	COALESCE(to_char(start_time, 'HH24:MI'), ''),
+	COALESCE(to_char(end_time, 'HH24:MI'), ''),
+	COALESCE(substitute_id::text, ''),
+	note,
+	created_at::text`
+
+func scanException(row interface{ Scan(...interface{}) error }) (*doctor.DoctorException, error) {
+	var result doctor.DoctorException
+	err := row.Scan(
+		&result.Id,
+		&result.DoctorId,
+		&result.Kind,
+		&result.DateFrom,
+		&result.DateTo,
+		&result.StartTime,
+		&result.EndTime,
+		&result.SubstituteId,
+		&result.Note,
+		&result.CreatedAt,
+	)
+	if err != nil {
+		return &doctor.DoctorException{}, err
+	}
+	return &result, nil
+}
+
+func (r *scheduleRepo) ExceptionCreate(req *doctor.DoctorException) (*doctor.DoctorException, error) {
+	query := `
+		INSERT INTO doctor_exceptions(
+			id,
+			doctor_id,
+			kind,
+			date_from,
+			date_to,
+			start_time,
+			end_time,
+			substitute_id,
+			note
+		) VALUES($1, $2, $3, $4, $5, NULLIF($6, '')::time, NULLIF($7, '')::time, NULLIF($8, '')::uuid, $9)
+		RETURNING ` + exceptionColumns
+
+	return scanException(r.db.QueryRow(query,
+		req.Id,
+		req.DoctorId,
+		req.Kind,
+		req.DateFrom,
+		req.DateTo,
+		req.StartTime,
+		req.EndTime,
+		req.SubstituteId,
+		req.Note,
+	))
+}
+
+func (r *scheduleRepo) ExceptionsFind(doctorId, fromDate, toDate string) (*doctor.DoctorExceptions, error) {
+	result := doctor.DoctorExceptions{
+		Exceptions: make([]*doctor.DoctorException, 0),
+	}
+
+	query := `SELECT ` + exceptionColumns + ` FROM doctor_exceptions
+		WHERE ($1 = '' OR doctor_id::text = $1) AND date_from <= $3::date AND $2::date <= date_to
+		ORDER BY date_from, start_time NULLS FIRST`
+
+	rows, err := r.db.Query(query, doctorId, fromDate, toDate)
+	if err != nil {
+		return &doctor.DoctorExceptions{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanException(rows)
+		if err != nil {
+			return &doctor.DoctorExceptions{}, err
+		}
+		result.Exceptions = append(result.Exceptions, temp)
+	}
+
+	return &result, rows.Err()
+}
+
+func (r *scheduleRepo) ExceptionDelete(id string) error {
+	res, err := r.db.Exec(`DELETE FROM doctor_exceptions WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *scheduleRepo) SpecialtyDoctors(specialtyId string) ([]*doctor.Doctor, error) {
+	result := make([]*doctor.Doctor, 0)
+
+	query := `
+		SELECT` + doctorColumns + `
+		FROM doctors
+		WHERE id IN (SELECT doctor_id FROM doctor_specialties WHERE specialty_id::text = $1) and deleted_at IS NULL
+		ORDER BY last_name, first_name
+	`
+	rows, err := r.db.Query(query, specialtyId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanDoctor(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, attachSpecialties(r.db, result)
+}
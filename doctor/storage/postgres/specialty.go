@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+type specialtyRepo struct {
+	db *sqlx.DB
+}
+
+func NewSpecialty(db *sqlx.DB) repo.SpecialtyStorageI {
+	return &specialtyRepo{
+		db: db,
+	}
+}
+
+const specialtyColumns = `
+	id,
+	name,
+	price,
+	slot_minutes,
+	created_at::text,
+	updated_at::text,
+	(
+		SELECT count(1) FROM doctor_specialties ds
+		JOIN doctors d ON d.id = ds.doctor_id
+		WHERE ds.specialty_id = specialties.id AND d.deleted_at IS NULL
+	)`
+
+func scanSpecialty(row interface{ Scan(...interface{}) error }) (*doctor.Specialty, error) {
+	var result doctor.Specialty
+	err := row.Scan(
+		&result.Id,
+		&result.Name,
+		&result.Price,
+		&result.SlotMinutes,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.DoctorsCount,
+	)
+	if err != nil {
+		return &doctor.Specialty{}, err
+	}
+	return &result, nil
+}
+
+func (r *specialtyRepo) SpecialtyCreate(req *doctor.Specialty) (*doctor.Specialty, error) {
+	query := `
+		INSERT INTO specialties(
+			id,
+			name,
+			price,
+			slot_minutes
+		) VALUES($1, $2, $3, $4)
+		RETURNING ` + specialtyColumns
+
+	return scanSpecialty(r.db.QueryRow(query, req.Id, req.Name, req.Price, req.SlotMinutes))
+}
+
+func (r *specialtyRepo) SpecialtyGet(id string) (*doctor.Specialty, error) {
+	query := `SELECT ` + specialtyColumns + ` FROM specialties WHERE id::text = $1 AND deleted_at IS NULL`
+
+	return scanSpecialty(r.db.QueryRow(query, id))
+}
+
+func (r *specialtyRepo) SpecialtyByName(name string) (*doctor.Specialty, error) {
+	query := `SELECT ` + specialtyColumns + ` FROM specialties WHERE lower(name) = lower(trim($1)) AND deleted_at IS NULL`
+
+	return scanSpecialty(r.db.QueryRow(query, name))
+}
+
+func (r *specialtyRepo) SpecialtiesFind(req *doctor.SpecialtiesFindReq) (*doctor.Specialties, error) {
+	result := doctor.Specialties{
+		Specialties: make([]*doctor.Specialty, 0),
+	}
+
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "name")
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	query := `SELECT ` + specialtyColumns + ` FROM specialties` + filter.String() + ` ORDER BY name` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &doctor.Specialties{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanSpecialty(rows)
+		if err != nil {
+			return &doctor.Specialties{}, err
+		}
+		result.Specialties = append(result.Specialties, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.Specialties{}, err
+	}
+
+	queryCount := `SELECT count(1) FROM specialties` + filter.String()
+	if err := r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count); err != nil {
+		return &doctor.Specialties{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *specialtyRepo) SpecialtyUpdate(req *doctor.Specialty) (*doctor.Specialty, error) {
+	query := `
+		UPDATE specialties SET
+			name = $2,
+			price = $3,
+			slot_minutes = $4,
+			updated_at = NOW()
+		WHERE id::text = $1 AND deleted_at IS NULL
+		RETURNING ` + specialtyColumns
+
+	return scanSpecialty(r.db.QueryRow(query, req.Id, req.Name, req.Price, req.SlotMinutes))
+}
+
+func (r *specialtyRepo) SpecialtyDelete(id string) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRow(`SELECT true FROM specialties WHERE id::text = $1 AND deleted_at IS NULL FOR UPDATE`, id).Scan(&exists); err != nil {
+		return err
+	}
+
+	var inUse bool
+	err = tx.QueryRow(`
+		SELECT EXISTS(
+			SELECT 1 FROM doctor_specialties ds
+			JOIN doctors d ON d.id = ds.doctor_id
+			WHERE ds.specialty_id::text = $1 AND d.deleted_at IS NULL
+		)`, id,
+	).Scan(&inUse)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return repo.ErrSpecialtyInUse
+	}
+
+	if _, err := tx.Exec(`UPDATE specialties SET deleted_at = NOW() WHERE id::text = $1`, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// doctorSpecialtiesSet replaces the specialties of the doctor, the first one is the primary one.
+func doctorSpecialtiesSet(tx *sqlx.Tx, doctorId string, specialtyIds []string) error {
+	if _, err := tx.Exec(`DELETE FROM doctor_specialties WHERE doctor_id = $1`, doctorId); err != nil {
+		return err
+	}
+	for i, id := range specialtyIds {
+		_, err := tx.Exec(`INSERT INTO doctor_specialties(doctor_id, specialty_id, position) VALUES($1, $2, $3)`, doctorId, id, i+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachSpecialties loads the specialties of the doctors in one query, the name of the
+// primary one becomes the cpecialety of the doctor.
+func attachSpecialties(q sqlx.Queryer, doctors []*doctor.Doctor) error {
+	if len(doctors) == 0 {
+		return nil
+	}
+	byId := make(map[string][]*doctor.Doctor, len(doctors))
+	ids := make([]string, 0, len(doctors))
+	for _, doc := range doctors {
+		doc.SpecialtyIds, doc.Specialties = make([]string, 0), make([]*doctor.Specialty, 0)
+		if _, ok := byId[doc.Id]; !ok {
+			ids = append(ids, doc.Id)
+		}
+		byId[doc.Id] = append(byId[doc.Id], doc)
+	}
+
+	query := `
+		SELECT ds.doctor_id::text,` + specialtyColumns + `
+		FROM doctor_specialties ds
+		JOIN specialties ON specialties.id = ds.specialty_id
+		WHERE ds.doctor_id::text = ANY($1)
+		ORDER BY ds.position`
+
+	rows, err := q.Query(query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			doctorId  string
+			specialty doctor.Specialty
+		)
+		err := rows.Scan(
+			&doctorId,
+			&specialty.Id,
+			&specialty.Name,
+			&specialty.Price,
+			&specialty.SlotMinutes,
+			&specialty.CreatedAt,
+			&specialty.UpdatedAt,
+			&specialty.DoctorsCount,
+		)
+		if err != nil {
+			return err
+		}
+		for _, doc := range byId[doctorId] {
+			if len(doc.Specialties) == 0 {
+				doc.Cpecialety = specialty.Name
+			}
+			doc.SpecialtyIds = append(doc.SpecialtyIds, specialty.Id)
+			doc.Specialties = append(doc.Specialties, &specialty)
+		}
+	}
+
+	return rows.Err()
+}
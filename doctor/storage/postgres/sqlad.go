@@ -0,0 +1,422 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+type sqladRepo struct {
+	db *sqlx.DB
+}
+
+func NewSqlad(db *sqlx.DB) repo.SqladStorageI {
+	return &sqladRepo{
+		db: db,
+	}
+}
+
+const movementColumns = `
+	id,
+	sqlad_id,
+	kind,
+	quantity,
+	unit_cost,
+	target_type,
+	target_id,
+	actor_id,
+	note,
+	created_at::text`
+
+func scanMovement(row interface{ Scan(...interface{}) error }, balance bool) (*doctor.SqladMovement, error) {
+	var result doctor.SqladMovement
+	dest := []interface{}{
+		&result.Id,
+		&result.SqladId,
+		&result.Kind,
+		&result.Quantity,
+		&result.UnitCost,
+		&result.TargetType,
+		&result.TargetId,
+		&result.ActorId,
+		&result.Note,
+		&result.CreatedAt,
+	}
+	if balance {
+		dest = append(dest, &result.Balance)
+	}
+	if err := row.Scan(dest...); err != nil {
+		return &doctor.SqladMovement{}, err
+	}
+	return &result, nil
+}
+
+// sqladLock locks the sqlad not deleted against other movements until the end of
+// the transaction and returns its price.
+func sqladLock(tx *sqlx.Tx, sqladId string) (float64, error) {
+	var price float64
+	err := tx.QueryRow(`SELECT price FROM sqlad WHERE id::text = $1 AND deleted_at IS NULL FOR UPDATE`, sqladId).Scan(&price)
+	return price, err
+}
+
+const lotColumns = `
+	l.id,
+	l.sqlad_id,
+	COALESCE(s.name, ''),
+	l.number,
+	COALESCE(l.expiration_date::text, ''),
+	COALESCE(l.expiration_date - CURRENT_DATE, 0),
+	l.unit_cost,
+	COALESCE(SUM(a.quantity) FILTER (WHERE a.quantity > 0), 0),
+	COALESCE(SUM(a.quantity), 0),
+	l.created_at::text`
+
+// lotsQuery returns the lots of the filter first expiring first out, having filters on
+// the stock of the lot, SUM(a.quantity).
+func lotsQuery(q sqlx.Queryer, filter *sqlfilter.Filter, having string) ([]*doctor.SqladLot, error) {
+	result := make([]*doctor.SqladLot, 0)
+
+	query := `
+		SELECT` + lotColumns + `
+		FROM sqlad_lots l
+		JOIN sqlad s ON s.id = l.sqlad_id
+		LEFT JOIN sqlad_lot_movements a ON a.lot_id = l.id` + filter.String() + `
+		GROUP BY l.id, s.name` + having + `
+		ORDER BY l.expiration_date NULLS LAST, l.created_at, l.id
+	`
+	rows, err := q.Query(query, filter.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var temp doctor.SqladLot
+		err := rows.Scan(
+			&temp.Id,
+			&temp.SqladId,
+			&temp.SqladName,
+			&temp.Number,
+			&temp.ExpirationDate,
+			&temp.DaysLeft,
+			&temp.UnitCost,
+			&temp.Received,
+			&temp.Remaining,
+			&temp.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &temp)
+	}
+
+	return result, rows.Err()
+}
+
+// lotsMove puts the movement on the lots: stock added goes to the lot of the movement or
+// to a new lot, stock taken comes from the lot of the movement or from the lots expiring first,
+// an issue only from the ones not expired. Returns repo.ErrStockShort when they have too little.
+func lotsMove(tx *sqlx.Tx, req *doctor.SqladMovement) ([]*doctor.SqladLotTake, error) {
+	filter := sqlfilter.New().Where("l.sqlad_id::text = ?", req.SqladId)
+	if req.LotId != "" {
+		lots, err := lotsQuery(tx, sqlfilter.New().Where("l.sqlad_id::text = ? AND l.id::text = ?", req.SqladId, req.LotId), "")
+		if err != nil {
+			return nil, err
+		}
+		if len(lots) == 0 {
+			return nil, sql.ErrNoRows
+		}
+		filter.Where("l.id::text = ?", req.LotId)
+	}
+
+	var lots []*doctor.SqladLot
+	if req.Quantity > 0 && req.LotId == "" {
+		lot := doctor.SqladLot{
+			Id:             uuid.New().String(),
+			Number:         req.LotNumber,
+			ExpirationDate: req.ExpirationDate,
+		}
+		_, err := tx.Exec(`
+			INSERT INTO sqlad_lots(id, sqlad_id, number, expiration_date, unit_cost)
+			VALUES($1, $2, $3, NULLIF($4, '')::date, $5)`,
+			lot.Id, req.SqladId, req.LotNumber, req.ExpirationDate, req.UnitCost,
+		)
+		if err != nil {
+			return nil, err
+		}
+		lots = []*doctor.SqladLot{&lot}
+	} else if req.Quantity > 0 {
+		var err error
+		if lots, err = lotsQuery(tx, filter, ""); err != nil {
+			return nil, err
+		}
+	} else {
+		// expired stock is not given out, it is only written off or adjusted
+		if req.Kind == repo.MovementIssue && req.LotId == "" {
+			filter.Where("(l.expiration_date IS NULL OR l.expiration_date >= CURRENT_DATE)")
+		}
+		var err error
+		if lots, err = lotsQuery(tx, filter, " HAVING SUM(a.quantity) > 0"); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]*doctor.SqladLotTake, 0, 1)
+	left := req.Quantity
+	for _, lot := range lots {
+		take := left
+		if left < 0 && -left > lot.Remaining {
+			take = -lot.Remaining
+		}
+
+		_, err := tx.Exec(`INSERT INTO sqlad_lot_movements(movement_id, lot_id, quantity) VALUES($1, $2, $3)`, req.Id, lot.Id, take)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &doctor.SqladLotTake{
+			LotId:          lot.Id,
+			LotNumber:      lot.Number,
+			ExpirationDate: lot.ExpirationDate,
+			Quantity:       take,
+		})
+
+		if left -= take; left == 0 {
+			return result, nil
+		}
+	}
+
+	return nil, repo.ErrStockShort
+}
+
+// movementInsert adds the movement to the stock of the sqlad and its lots, the sqlad must
+// be locked or just created by the transaction.
+func movementInsert(tx *sqlx.Tx, req *doctor.SqladMovement) (*doctor.SqladMovement, error) {
+	var balance int64
+	err := tx.QueryRow(`SELECT COALESCE(SUM(quantity), 0) FROM sqlad_movements WHERE sqlad_id::text = $1`, req.SqladId).Scan(&balance)
+	if err != nil {
+		return &doctor.SqladMovement{}, err
+	}
+	if balance+req.Quantity < 0 {
+		return &doctor.SqladMovement{}, repo.ErrStockShort
+	}
+
+	query := `
+		INSERT INTO sqlad_movements(
+			id,
+			sqlad_id,
+			kind,
+			quantity,
+			unit_cost,
+			target_type,
+			target_id,
+			actor_id,
+			note
+		) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING ` + movementColumns
+
+	result, err := scanMovement(tx.QueryRow(query,
+		req.Id,
+		req.SqladId,
+		req.Kind,
+		req.Quantity,
+		req.UnitCost,
+		req.TargetType,
+		req.TargetId,
+		req.ActorId,
+		req.Note,
+	), false)
+	if err != nil {
+		return &doctor.SqladMovement{}, err
+	}
+	result.Balance = balance + req.Quantity
+
+	result.Lots, err = lotsMove(tx, req)
+	if err != nil {
+		return &doctor.SqladMovement{}, err
+	}
+
+	return result, nil
+}
+
+// attachLots fills the lots of the movements.
+func attachLots(q sqlx.Queryer, movements []*doctor.SqladMovement) error {
+	if len(movements) == 0 {
+		return nil
+	}
+	byId := make(map[string]*doctor.SqladMovement, len(movements))
+	ids := make([]string, 0, len(movements))
+	for _, movement := range movements {
+		movement.Lots = make([]*doctor.SqladLotTake, 0, 1)
+		byId[movement.Id] = movement
+		ids = append(ids, movement.Id)
+	}
+
+	query := `
+		SELECT a.movement_id, a.lot_id, l.number, COALESCE(l.expiration_date::text, ''), a.quantity
+		FROM sqlad_lot_movements a
+		JOIN sqlad_lots l ON l.id = a.lot_id
+		WHERE a.movement_id::text = ANY($1)
+		ORDER BY l.expiration_date NULLS LAST, l.created_at, l.id
+	`
+	rows, err := q.Query(query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			movementId string
+			temp       doctor.SqladLotTake
+		)
+		if err := rows.Scan(&movementId, &temp.LotId, &temp.LotNumber, &temp.ExpirationDate, &temp.Quantity); err != nil {
+			return err
+		}
+		byId[movementId].Lots = append(byId[movementId].Lots, &temp)
+	}
+
+	return rows.Err()
+}
+
+func (r *sqladRepo) MovementCreate(req *doctor.SqladMovement) (*doctor.SqladMovement, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.SqladMovement{}, err
+	}
+	defer tx.Rollback()
+
+	price, err := sqladLock(tx, req.SqladId)
+	if err != nil {
+		return &doctor.SqladMovement{}, err
+	}
+	if req.UnitCost == 0 {
+		req.UnitCost = price
+	}
+
+	result, err := movementInsert(tx, req)
+	if err != nil {
+		return &doctor.SqladMovement{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *sqladRepo) MovementsFind(req *doctor.SqladMovementsFindReq) (*doctor.SqladMovements, error) {
+	result := doctor.SqladMovements{
+		Movements: make([]*doctor.SqladMovement, 0),
+	}
+
+	filter := sqlfilter.New()
+	if req.SqladId != "" {
+		filter.Where("sqlad_id::text = ?", req.SqladId)
+	}
+	if req.Kind != "" {
+		filter.Where("kind = ?", req.Kind)
+	}
+	if req.TargetType != "" {
+		filter.Where("target_type = ?", req.TargetType)
+	}
+	if req.TargetId != "" {
+		filter.Where("target_id = ?", req.TargetId)
+	}
+	if req.FromDate != "" {
+		filter.Where("created_at >= ?::date", req.FromDate)
+	}
+	if req.ToDate != "" {
+		filter.Where("created_at < ?::date + 1", req.ToDate)
+	}
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	// the balance is summed over every movement of the sqlad before the filter applies
+	query := `
+		SELECT` + movementColumns + `, balance
+		FROM (
+			SELECT *, SUM(quantity) OVER (PARTITION BY sqlad_id ORDER BY created_at, id) AS balance
+			FROM sqlad_movements
+		) m` + filter.String() + `
+		ORDER BY m.created_at DESC, m.id DESC` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &doctor.SqladMovements{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanMovement(rows, true)
+		if err != nil {
+			return &doctor.SqladMovements{}, err
+		}
+		result.Movements = append(result.Movements, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.SqladMovements{}, err
+	}
+
+	queryCount := `SELECT count(1) FROM sqlad_movements` + filter.String()
+	if err := r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count); err != nil {
+		return &doctor.SqladMovements{}, err
+	}
+
+	return &result, attachLots(r.db, result.Movements)
+}
+
+func (r *sqladRepo) BalancesAt(sqladId, date string) ([]*doctor.SqladBalance, error) {
+	result := make([]*doctor.SqladBalance, 0)
+
+	// sqlad deleted after the date still had its stock on the date
+	query := `
+		SELECT
+			s.id,
+			COALESCE(s.name, ''),
+			COALESCE(SUM(m.quantity), 0),
+			COALESCE(SUM(m.quantity), 0) * s.price
+		FROM sqlad s
+		LEFT JOIN sqlad_movements m ON m.sqlad_id = s.id AND m.created_at < $2::date + 1
+		WHERE ($1 = '' OR s.id::text = $1) AND (s.deleted_at IS NULL OR s.deleted_at >= $2::date + 1)
+		GROUP BY s.id, s.name, s.price
+		ORDER BY s.name
+	`
+	rows, err := r.db.Query(query, sqladId, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var temp doctor.SqladBalance
+		if err := rows.Scan(&temp.SqladId, &temp.Name, &temp.Count, &temp.Value); err != nil {
+			return nil, err
+		}
+		result = append(result, &temp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if sqladId != "" && len(result) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return result, nil
+}
+
+func (r *sqladRepo) LotsFind(sqladId string, withEmpty bool) ([]*doctor.SqladLot, error) {
+	having := " HAVING SUM(a.quantity) > 0"
+	if withEmpty {
+		having = ""
+	}
+
+	return lotsQuery(r.db, sqlfilter.New().Where("l.sqlad_id::text = ?", sqladId), having)
+}
+
+func (r *sqladRepo) ExpiringLots(days int64) ([]*doctor.SqladLot, error) {
+	filter := sqlfilter.New("s.deleted_at IS NULL").
+		Where("l.expiration_date <= CURRENT_DATE + ?::int", days)
+
+	return lotsQuery(r.db, filter, " HAVING SUM(a.quantity) > 0")
+}
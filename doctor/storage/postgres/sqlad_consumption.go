@@ -0,0 +1,249 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+func bomGet(q sqlx.Queryer, serviceType, serviceId string) (*doctor.SqladBom, error) {
+	result := doctor.SqladBom{
+		ServiceType: serviceType,
+		ServiceId:   serviceId,
+		Items:       make([]*doctor.SqladBomItem, 0),
+	}
+
+	query := `
+		SELECT b.sqlad_id, COALESCE(s.name, ''), b.quantity
+		FROM sqlad_boms b
+		JOIN sqlad s ON s.id = b.sqlad_id
+		WHERE b.service_type = $1 AND b.service_id = $2
+		ORDER BY b.sqlad_id
+	`
+	rows, err := q.Query(query, serviceType, serviceId)
+	if err != nil {
+		return &doctor.SqladBom{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var temp doctor.SqladBomItem
+		if err := rows.Scan(&temp.SqladId, &temp.SqladName, &temp.Quantity); err != nil {
+			return &doctor.SqladBom{}, err
+		}
+		result.Items = append(result.Items, &temp)
+	}
+
+	return &result, rows.Err()
+}
+
+func (r *sqladRepo) BomSet(req *doctor.SqladBom) (*doctor.SqladBom, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.SqladBom{}, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`DELETE FROM sqlad_boms WHERE service_type = $1 AND service_id = $2`, req.ServiceType, req.ServiceId)
+	if err != nil {
+		return &doctor.SqladBom{}, err
+	}
+
+	for _, item := range req.Items {
+		res, err := tx.Exec(`
+			INSERT INTO sqlad_boms(service_type, service_id, sqlad_id, quantity)
+			SELECT $1, $2, id, $4 FROM sqlad WHERE id::text = $3 AND deleted_at IS NULL`,
+			req.ServiceType, req.ServiceId, item.SqladId, item.Quantity,
+		)
+		if err != nil {
+			return &doctor.SqladBom{}, err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return &doctor.SqladBom{}, sql.ErrNoRows
+		}
+	}
+
+	result, err := bomGet(tx, req.ServiceType, req.ServiceId)
+	if err != nil {
+		return &doctor.SqladBom{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *sqladRepo) BomGet(serviceType, serviceId string) (*doctor.SqladBom, error) {
+	return bomGet(r.db, serviceType, serviceId)
+}
+
+// usagesGet returns what the analysis consumed, nothing when it did not consume yet.
+func usagesGet(q sqlx.Queryer, analysisId string) ([]*doctor.SqladUsage, error) {
+	result := make([]*doctor.SqladUsage, 0)
+
+	query := `
+		SELECT u.sqlad_id, COALESCE(s.name, ''), u.expected, COALESCE(-m.quantity, 0)
+		FROM sqlad_usages u
+		LEFT JOIN sqlad s ON s.id = u.sqlad_id
+		LEFT JOIN sqlad_movements m ON m.id = u.movement_id
+		WHERE u.analysis_id = $1
+		ORDER BY u.sqlad_id
+	`
+	rows, err := q.Query(query, analysisId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var temp doctor.SqladUsage
+		if err := rows.Scan(&temp.SqladId, &temp.SqladName, &temp.Expected, &temp.Taken); err != nil {
+			return nil, err
+		}
+		result = append(result, &temp)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *sqladRepo) Consume(req *doctor.SqladConsumeReq) (*doctor.SqladConsumeResp, error) {
+	result := doctor.SqladConsumeResp{
+		AnalysisId: req.AnalysisId,
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.SqladConsumeResp{}, err
+	}
+	defer tx.Rollback()
+
+	result.Usages, err = usagesGet(tx, req.AnalysisId)
+	if err != nil {
+		return &doctor.SqladConsumeResp{}, err
+	}
+	if len(result.Usages) > 0 {
+		return &result, nil
+	}
+
+	// the bill of materials is ordered by sqlad so concurrent consumptions lock in the same order
+	bom, err := bomGet(tx, req.ServiceType, req.ServiceId)
+	if err != nil {
+		return &doctor.SqladConsumeResp{}, err
+	}
+
+	for _, item := range bom.Items {
+		usage := doctor.SqladUsage{
+			SqladId:   item.SqladId,
+			SqladName: item.SqladName,
+			Expected:  item.Quantity,
+		}
+		var movementId string
+
+		price, err := sqladLock(tx, item.SqladId)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return &doctor.SqladConsumeResp{}, err
+		}
+		if err == nil {
+			var balance int64
+			err := tx.QueryRow(`SELECT COALESCE(SUM(quantity), 0) FROM sqlad_movements WHERE sqlad_id::text = $1`, item.SqladId).Scan(&balance)
+			if err != nil {
+				return &doctor.SqladConsumeResp{}, err
+			}
+
+			usage.Taken = item.Quantity
+			if balance < usage.Taken {
+				usage.Taken = balance
+			}
+			if usage.Taken > 0 {
+				movement, err := movementInsert(tx, &doctor.SqladMovement{
+					Id:         uuid.New().String(),
+					SqladId:    item.SqladId,
+					Kind:       repo.MovementIssue,
+					Quantity:   -usage.Taken,
+					UnitCost:   price,
+					TargetType: req.ServiceType,
+					TargetId:   req.ServiceId,
+					ActorId:    req.ActorId,
+					Note:       "analysis " + req.AnalysisId,
+				})
+				if err != nil {
+					return &doctor.SqladConsumeResp{}, err
+				}
+				movementId = movement.Id
+			}
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO sqlad_usages(analysis_id, sqlad_id, service_type, service_id, expected, movement_id)
+			VALUES($1, $2, $3, $4, $5, NULLIF($6, '')::uuid)`,
+			req.AnalysisId, item.SqladId, req.ServiceType, req.ServiceId, item.Quantity, movementId,
+		)
+		if err != nil {
+			return &doctor.SqladConsumeResp{}, err
+		}
+		result.Usages = append(result.Usages, &usage)
+	}
+
+	return &result, tx.Commit()
+}
+
+func (r *sqladRepo) ConsumptionReport(req *doctor.ConsumptionReportReq) ([]*doctor.ConsumptionLine, error) {
+	result := make([]*doctor.ConsumptionLine, 0)
+
+	// actual is every issue to the service, the ones of the services performed and the ones by hand
+	query := `
+		WITH expected AS (
+			SELECT service_type, service_id, sqlad_id, COUNT(DISTINCT analysis_id) AS performed, SUM(expected) AS expected
+			FROM sqlad_usages
+			WHERE created_at >= $1::date AND created_at < $2::date + 1
+				AND ($3 = '' OR service_type = $3) AND ($4 = '' OR service_id = $4)
+			GROUP BY service_type, service_id, sqlad_id
+		), actual AS (
+			SELECT target_type AS service_type, target_id AS service_id, sqlad_id, -SUM(quantity) AS actual
+			FROM sqlad_movements
+			WHERE kind = 'issue' AND target_type IN ('lab', 'aparat')
+				AND created_at >= $1::date AND created_at < $2::date + 1
+				AND ($3 = '' OR target_type = $3) AND ($4 = '' OR target_id = $4)
+			GROUP BY target_type, target_id, sqlad_id
+		)
+		SELECT
+			COALESCE(e.service_type, a.service_type),
+			COALESCE(e.service_id, a.service_id),
+			COALESCE(e.sqlad_id, a.sqlad_id),
+			COALESCE(s.name, ''),
+			COALESCE(e.performed, 0),
+			COALESCE(e.expected, 0),
+			COALESCE(a.actual, 0)
+		FROM expected e
+		FULL JOIN actual a ON a.service_type = e.service_type AND a.service_id = e.service_id AND a.sqlad_id = e.sqlad_id
+		LEFT JOIN sqlad s ON s.id = COALESCE(e.sqlad_id, a.sqlad_id)
+		ORDER BY 1, 2, 4
+	`
+	rows, err := r.db.Query(query, req.FromDate, req.ToDate, req.ServiceType, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var temp doctor.ConsumptionLine
+		err := rows.Scan(
+			&temp.ServiceType,
+			&temp.ServiceId,
+			&temp.SqladId,
+			&temp.SqladName,
+			&temp.Performed,
+			&temp.Expected,
+			&temp.Actual,
+		)
+		if err != nil {
+			return nil, err
+		}
+		temp.Difference = temp.Actual - temp.Expected
+		result = append(result, &temp)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+func TestMovementIssueExpired(t *testing.T) {
+	db := testDB(t)
+	sqlads := NewSqlad(db)
+
+	sqladId := uuid.New().String()
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM sqlad_lot_movements WHERE lot_id IN (SELECT id FROM sqlad_lots WHERE sqlad_id = $1)`, sqladId)
+		db.Exec(`DELETE FROM sqlad_movements WHERE sqlad_id = $1`, sqladId)
+		db.Exec(`DELETE FROM sqlad_lots WHERE sqlad_id = $1`, sqladId)
+		db.Exec(`DELETE FROM sqlad WHERE id = $1`, sqladId)
+	})
+	if _, err := db.Exec(`INSERT INTO sqlad(id, name, price, low_stock) VALUES($1, 'Syringes', 500, 0)`, sqladId); err != nil {
+		t.Fatalf("seed sqlad: %v", err)
+	}
+
+	for _, receipt := range []struct {
+		quantity   int64
+		expiration time.Time
+	}{
+		{5, time.Now().AddDate(0, 0, -1)},
+		{3, time.Now().AddDate(0, 1, 0)},
+	} {
+		_, err := sqlads.MovementCreate(&doctor.SqladMovement{Id: uuid.New().String(), SqladId: sqladId,
+			Kind: repo.MovementReceipt, Quantity: receipt.quantity, ExpirationDate: receipt.expiration.Format("2006-01-02")})
+		if err != nil {
+			t.Fatalf("receipt: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		quantity int64
+		wantErr  error
+	}{
+		{"more than the stock not expired", -4, repo.ErrStockShort},
+		{"the stock not expired", -3, nil},
+		{"only expired stock left", -1, repo.ErrStockShort},
+	}
+	for _, tt := range tests {
+		movement, err := sqlads.MovementCreate(&doctor.SqladMovement{Id: uuid.New().String(), SqladId: sqladId,
+			Kind: repo.MovementIssue, Quantity: tt.quantity})
+		if !errors.Is(err, tt.wantErr) {
+			t.Fatalf("%s: MovementCreate = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		for _, lot := range movement.GetLots() {
+			if lot.ExpirationDate < time.Now().Format("2006-01-02") {
+				t.Errorf("%s: issued from the expired lot %s", tt.name, lot.LotId)
+			}
+		}
+	}
+}
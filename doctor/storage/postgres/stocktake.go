@@ -0,0 +1,605 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+type stocktakeRepo struct {
+	db *sqlx.DB
+}
+
+func NewStocktake(db *sqlx.DB) repo.StocktakeStorageI {
+	return &stocktakeRepo{
+		db: db,
+	}
+}
+
+const stocktakeColumns = `
+	id,
+	number,
+	status,
+	note,
+	actor_id,
+	posted_by,
+	created_at::text,
+	COALESCE(posted_at::text, '')`
+
+func scanStocktake(row interface{ Scan(...interface{}) error }) (*doctor.Stocktake, error) {
+	var result doctor.Stocktake
+	err := row.Scan(
+		&result.Id,
+		&result.Number,
+		&result.Status,
+		&result.Note,
+		&result.ActorId,
+		&result.PostedBy,
+		&result.CreatedAt,
+		&result.PostedAt,
+	)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+	result.Lines = make([]*doctor.StocktakeLine, 0)
+	result.Counts = make([]*doctor.StocktakeCount, 0)
+	return &result, nil
+}
+
+// stocktakeLock locks the stocktake until the end of the transaction and returns its number,
+// ErrStocktakeClosed when it is not open.
+func stocktakeLock(tx *sqlx.Tx, id string) (int64, error) {
+	var (
+		number int64
+		status string
+	)
+	err := tx.QueryRow(`SELECT number, status FROM stocktakes WHERE id::text = $1 FOR UPDATE`, id).Scan(&number, &status)
+	if err != nil {
+		return 0, err
+	}
+	if status != repo.StocktakeOpen {
+		return 0, repo.ErrStocktakeClosed
+	}
+	return number, nil
+}
+
+func countsGet(q sqlx.Queryer, id string) ([]*doctor.StocktakeCount, error) {
+	result := make([]*doctor.StocktakeCount, 0)
+
+	query := `
+		SELECT
+			c.sqlad_id,
+			COALESCE(s.name, ''),
+			c.lot_id,
+			c.quantity,
+			c.counter_id,
+			c.updated_at::text
+		FROM stocktake_counts c
+		LEFT JOIN sqlad s ON s.id = c.sqlad_id
+		WHERE c.stocktake_id::text = $1
+		ORDER BY s.name, c.sqlad_id, c.lot_id, c.counter_id`
+
+	rows, err := q.Query(query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var temp doctor.StocktakeCount
+		err := rows.Scan(
+			&temp.SqladId,
+			&temp.SqladName,
+			&temp.LotId,
+			&temp.Quantity,
+			&temp.CounterId,
+			&temp.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &temp)
+	}
+
+	return result, rows.Err()
+}
+
+// snapshotKey keys the snapshots by sqlad and lot, the lot is empty for a sqlad counted as a whole.
+func snapshotKey(sqladId, lotId string) string {
+	return sqladId + "/" + lotId
+}
+
+func snapshotsGet(q sqlx.Queryer, id string) (map[string]int64, error) {
+	result := make(map[string]int64)
+
+	rows, err := q.Query(`SELECT sqlad_id, lot_id, expected FROM stocktake_snapshots WHERE stocktake_id::text = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			sqladId, lotId string
+			expected       int64
+		)
+		if err := rows.Scan(&sqladId, &lotId, &expected); err != nil {
+			return nil, err
+		}
+		result[snapshotKey(sqladId, lotId)] = expected
+	}
+
+	return result, rows.Err()
+}
+
+// snapshotTake keeps the balance of the counted sqlad, or of the counted lot, the first time it is
+// counted. The first count of a sqlad by lots keeps the balance of all of its lots in stock, a lot
+// received later is kept when it is counted.
+func snapshotTake(tx *sqlx.Tx, id, sqladId, lotId string) error {
+	if lotId == "" {
+		_, err := tx.Exec(`
+			INSERT INTO stocktake_snapshots(stocktake_id, sqlad_id, lot_id, expected)
+			SELECT $1, $2, '', COALESCE(SUM(quantity), 0) FROM sqlad_movements WHERE sqlad_id::text = $2
+			ON CONFLICT DO NOTHING`, id, sqladId,
+		)
+		return err
+	}
+
+	var first bool
+	err := tx.QueryRow(`SELECT NOT EXISTS(SELECT 1 FROM stocktake_snapshots WHERE stocktake_id::text = $1 AND sqlad_id::text = $2)`,
+		id, sqladId,
+	).Scan(&first)
+	if err != nil {
+		return err
+	}
+
+	lots, err := lotsQuery(tx, sqlfilter.New().Where("l.sqlad_id::text = ?", sqladId), "")
+	if err != nil {
+		return err
+	}
+	for _, lot := range lots {
+		if lot.Id != lotId && !(first && lot.Remaining != 0) {
+			continue
+		}
+		_, err := tx.Exec(`
+			INSERT INTO stocktake_snapshots(stocktake_id, sqlad_id, lot_id, expected)
+			VALUES($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING`, id, sqladId, lot.Id, lot.Remaining,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linesLive sets the counts against the balance kept when they were first counted: the sqlad
+// counted by lots line by lot, the rest line as a whole. Sqlad in stock nobody counted is listed
+// not counted against the balance now.
+func linesLive(q sqlx.Queryer, id string, counts []*doctor.StocktakeCount) ([]*doctor.StocktakeLine, error) {
+	var (
+		whole  = make(map[string]int64)
+		byLot  = make(map[string]map[string]int64)
+		lotted = make([]string, 0)
+	)
+	snapshots, err := snapshotsGet(q, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range counts {
+		if count.LotId == "" {
+			whole[count.SqladId] += count.Quantity
+			continue
+		}
+		if byLot[count.SqladId] == nil {
+			byLot[count.SqladId] = make(map[string]int64)
+			lotted = append(lotted, count.SqladId)
+		}
+		byLot[count.SqladId][count.LotId] += count.Quantity
+	}
+
+	lots, err := lotsQuery(q, sqlfilter.New().Where("l.sqlad_id::text = ANY(?)", pq.Array(lotted)), "")
+	if err != nil {
+		return nil, err
+	}
+	lotsOf := make(map[string][]*doctor.SqladLot)
+	for _, lot := range lots {
+		lotsOf[lot.SqladId] = append(lotsOf[lot.SqladId], lot)
+	}
+
+	query := `
+		SELECT
+			s.id,
+			s.name,
+			s.price,
+			COALESCE((SELECT SUM(m.quantity) FROM sqlad_movements m WHERE m.sqlad_id = s.id), 0)
+		FROM sqlad s
+		WHERE s.deleted_at IS NULL
+		ORDER BY s.name, s.id`
+
+	rows, err := q.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*doctor.StocktakeLine, 0)
+	for rows.Next() {
+		var (
+			sqladId, name string
+			price         float64
+			balance       int64
+		)
+		if err := rows.Scan(&sqladId, &name, &price, &balance); err != nil {
+			return nil, err
+		}
+
+		if counted, ok := byLot[sqladId]; ok {
+			for _, lot := range lotsOf[sqladId] {
+				// a lot received after the sqlad was counted and not counted itself is left as is
+				expected, ok := snapshots[snapshotKey(sqladId, lot.Id)]
+				if !ok {
+					continue
+				}
+				quantity, ok := counted[lot.Id]
+				if !ok && expected == 0 {
+					continue
+				}
+				result = append(result, &doctor.StocktakeLine{
+					SqladId:        sqladId,
+					SqladName:      name,
+					LotId:          lot.Id,
+					LotNumber:      lot.Number,
+					ExpirationDate: lot.ExpirationDate,
+					Expected:       expected,
+					Counted:        quantity,
+					Difference:     quantity - expected,
+					IsCounted:      true,
+					UnitCost:       lot.UnitCost,
+				})
+			}
+		} else if quantity, ok := whole[sqladId]; ok {
+			expected := snapshots[snapshotKey(sqladId, "")]
+			result = append(result, &doctor.StocktakeLine{
+				SqladId:    sqladId,
+				SqladName:  name,
+				Expected:   expected,
+				Counted:    quantity,
+				Difference: quantity - expected,
+				IsCounted:  true,
+				UnitCost:   price,
+			})
+		} else if balance != 0 {
+			result = append(result, &doctor.StocktakeLine{
+				SqladId:   sqladId,
+				SqladName: name,
+				Expected:  balance,
+				UnitCost:  price,
+			})
+		}
+	}
+
+	return result, rows.Err()
+}
+
+// linesPosted returns the lines kept at posting.
+func linesPosted(q sqlx.Queryer, id string) ([]*doctor.StocktakeLine, error) {
+	result := make([]*doctor.StocktakeLine, 0)
+
+	query := `
+		SELECT
+			l.sqlad_id,
+			COALESCE(s.name, ''),
+			l.lot_id,
+			COALESCE(t.number, ''),
+			COALESCE(t.expiration_date::text, ''),
+			l.expected,
+			l.counted,
+			l.unit_cost,
+			COALESCE(l.movement_id::text, '')
+		FROM stocktake_lines l
+		LEFT JOIN sqlad s ON s.id = l.sqlad_id
+		LEFT JOIN sqlad_lots t ON t.id::text = l.lot_id
+		WHERE l.stocktake_id::text = $1
+		ORDER BY s.name, l.sqlad_id, t.expiration_date NULLS LAST, t.created_at, l.lot_id`
+
+	rows, err := q.Query(query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := doctor.StocktakeLine{
+			IsCounted: true,
+		}
+		err := rows.Scan(
+			&temp.SqladId,
+			&temp.SqladName,
+			&temp.LotId,
+			&temp.LotNumber,
+			&temp.ExpirationDate,
+			&temp.Expected,
+			&temp.Counted,
+			&temp.UnitCost,
+			&temp.MovementId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		temp.Difference = temp.Counted - temp.Expected
+		result = append(result, &temp)
+	}
+
+	return result, rows.Err()
+}
+
+// stocktakeGet returns the stocktake with its counts and lines, a cancelled one has no lines.
+func stocktakeGet(q sqlx.Queryer, id string) (*doctor.Stocktake, error) {
+	result, err := scanStocktake(q.QueryRowx(`SELECT `+stocktakeColumns+` FROM stocktakes WHERE id::text = $1`, id))
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	if result.Counts, err = countsGet(q, id); err != nil {
+		return &doctor.Stocktake{}, err
+	}
+	switch result.Status {
+	case repo.StocktakeOpen:
+		result.Lines, err = linesLive(q, id, result.Counts)
+	case repo.StocktakePosted:
+		result.Lines, err = linesPosted(q, id)
+	}
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	for _, line := range result.Lines {
+		line.ValueDifference = float64(line.Difference) * line.UnitCost
+		result.ValueDifference += line.ValueDifference
+	}
+
+	return result, nil
+}
+
+func (r *stocktakeRepo) StocktakeStart(req *doctor.Stocktake) (*doctor.Stocktake, error) {
+	query := `
+		INSERT INTO stocktakes(
+			id,
+			status,
+			note,
+			actor_id
+		) VALUES($1, $2, $3, $4)`
+
+	_, err := r.db.Exec(query, req.Id, repo.StocktakeOpen, req.Note, req.ActorId)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	return stocktakeGet(r.db, req.Id)
+}
+
+func (r *stocktakeRepo) CountEnter(req *doctor.StocktakeCountReq) (*doctor.Stocktake, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+	defer tx.Rollback()
+
+	if _, err := stocktakeLock(tx, req.StocktakeId); err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	for _, item := range req.Items {
+		var exists bool
+		err := tx.QueryRow(`SELECT true FROM sqlad WHERE id::text = $1 AND deleted_at IS NULL`, item.SqladId).Scan(&exists)
+		if err != nil {
+			return &doctor.Stocktake{}, err
+		}
+		if item.LotId != "" {
+			err := tx.QueryRow(`SELECT true FROM sqlad_lots WHERE id::text = $1 AND sqlad_id::text = $2`, item.LotId, item.SqladId).Scan(&exists)
+			if err != nil {
+				return &doctor.Stocktake{}, err
+			}
+		}
+
+		// a sqlad is counted either by lots or as a whole, by whoever counts it
+		err = tx.QueryRow(`
+			SELECT EXISTS(
+				SELECT 1 FROM stocktake_counts
+				WHERE stocktake_id::text = $1 AND sqlad_id::text = $2 AND (lot_id = '') <> ($3 = '')
+			)`, req.StocktakeId, item.SqladId, item.LotId,
+		).Scan(&exists)
+		if err != nil {
+			return &doctor.Stocktake{}, err
+		}
+		if exists {
+			return &doctor.Stocktake{}, repo.ErrCountMixed
+		}
+
+		// locked against the movements, the snapshot of the lots is one balance
+		if _, err := sqladLock(tx, item.SqladId); err != nil {
+			return &doctor.Stocktake{}, err
+		}
+		if err := snapshotTake(tx, req.StocktakeId, item.SqladId, item.LotId); err != nil {
+			return &doctor.Stocktake{}, err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO stocktake_counts(stocktake_id, sqlad_id, lot_id, counter_id, quantity)
+			VALUES($1, $2, $3, $4, $5)
+			ON CONFLICT (stocktake_id, sqlad_id, lot_id, counter_id)
+			DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW()`,
+			req.StocktakeId, item.SqladId, item.LotId, req.CounterId, item.Quantity,
+		)
+		if err != nil {
+			return &doctor.Stocktake{}, err
+		}
+	}
+
+	result, err := stocktakeGet(tx, req.StocktakeId)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *stocktakeRepo) StocktakeGet(id string) (*doctor.Stocktake, error) {
+	return stocktakeGet(r.db, id)
+}
+
+func (r *stocktakeRepo) StocktakesFind(req *doctor.StocktakesFindReq) (*doctor.Stocktakes, error) {
+	result := doctor.Stocktakes{
+		Stocktakes: make([]*doctor.Stocktake, 0),
+	}
+
+	filter := sqlfilter.New()
+	if req.Status != "" {
+		filter.Where("status = ?", req.Status)
+	}
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	query := `SELECT ` + stocktakeColumns + ` FROM stocktakes` + filter.String() + ` ORDER BY number DESC` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &doctor.Stocktakes{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanStocktake(rows)
+		if err != nil {
+			return &doctor.Stocktakes{}, err
+		}
+		result.Stocktakes = append(result.Stocktakes, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.Stocktakes{}, err
+	}
+
+	queryCount := `SELECT count(1) FROM stocktakes` + filter.String()
+	if err := r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count); err != nil {
+		return &doctor.Stocktakes{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *stocktakeRepo) StocktakePost(id, actorId string) (*doctor.Stocktake, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+	defer tx.Rollback()
+
+	number, err := stocktakeLock(tx, id)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	counts, err := countsGet(tx, id)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	// the counted sqlad is locked in one order against other postings, the deleted is left out
+	seen := make(map[string]bool, len(counts))
+	ids := make([]string, 0, len(counts))
+	for _, count := range counts {
+		if !seen[count.SqladId] {
+			seen[count.SqladId] = true
+			ids = append(ids, count.SqladId)
+		}
+	}
+	sort.Strings(ids)
+	for _, sqladId := range ids {
+		if _, err := sqladLock(tx, sqladId); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return &doctor.Stocktake{}, err
+		}
+	}
+
+	// the differences from the snapshots go on top of the balance now
+	lines, err := linesLive(tx, id, counts)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	for _, line := range lines {
+		if !line.IsCounted {
+			continue
+		}
+
+		var movementId sql.NullString
+		if line.Difference != 0 {
+			movement, err := movementInsert(tx, &doctor.SqladMovement{
+				Id:       uuid.New().String(),
+				SqladId:  line.SqladId,
+				Kind:     repo.MovementAdjustment,
+				Quantity: line.Difference,
+				UnitCost: line.UnitCost,
+				ActorId:  actorId,
+				Note:     fmt.Sprintf("stocktake %d", number),
+				LotId:    line.LotId,
+			})
+			if err != nil {
+				return &doctor.Stocktake{}, err
+			}
+			movementId = sql.NullString{String: movement.Id, Valid: true}
+		}
+
+		_, err := tx.Exec(`
+			INSERT INTO stocktake_lines(stocktake_id, sqlad_id, lot_id, expected, counted, unit_cost, movement_id)
+			VALUES($1, $2, $3, $4, $5, $6, $7)`,
+			id, line.SqladId, line.LotId, line.Expected, line.Counted, line.UnitCost, movementId,
+		)
+		if err != nil {
+			return &doctor.Stocktake{}, err
+		}
+	}
+
+	_, err = tx.Exec(`UPDATE stocktakes SET status = $2, posted_by = $3, posted_at = NOW() WHERE id::text = $1`, id, repo.StocktakePosted, actorId)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	result, err := stocktakeGet(tx, id)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *stocktakeRepo) StocktakeCancel(id string) (*doctor.Stocktake, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+	defer tx.Rollback()
+
+	if _, err := stocktakeLock(tx, id); err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	_, err = tx.Exec(`UPDATE stocktakes SET status = $2 WHERE id::text = $1`, id, repo.StocktakeCancelled)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	result, err := stocktakeGet(tx, id)
+	if err != nil {
+		return &doctor.Stocktake{}, err
+	}
+
+	return result, tx.Commit()
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+// testDB connects to the migrated database of TEST_DATABASE_URL, the test is skipped without it.
+func testDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sqlx.Connect("postgres", url)
+	if err != nil {
+		t.Fatalf("connect test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestStocktakePostMovedWhileOpen(t *testing.T) {
+	db := testDB(t)
+	var (
+		sqlads     = NewSqlad(db)
+		stocktakes = NewStocktake(db)
+
+		sqladId     = uuid.New().String()
+		stocktakeId = uuid.New().String()
+	)
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM stocktake_lines WHERE stocktake_id = $1`, stocktakeId)
+		db.Exec(`DELETE FROM stocktake_snapshots WHERE stocktake_id = $1`, stocktakeId)
+		db.Exec(`DELETE FROM stocktake_counts WHERE stocktake_id = $1`, stocktakeId)
+		db.Exec(`DELETE FROM stocktakes WHERE id = $1`, stocktakeId)
+		db.Exec(`DELETE FROM sqlad_lot_movements WHERE lot_id IN (SELECT id FROM sqlad_lots WHERE sqlad_id = $1)`, sqladId)
+		db.Exec(`DELETE FROM sqlad_movements WHERE sqlad_id = $1`, sqladId)
+		db.Exec(`DELETE FROM sqlad_lots WHERE sqlad_id = $1`, sqladId)
+		db.Exec(`DELETE FROM sqlad WHERE id = $1`, sqladId)
+	})
+
+	if _, err := db.Exec(`INSERT INTO sqlad(id, name, price, low_stock) VALUES($1, 'Gloves', 1000, 0)`, sqladId); err != nil {
+		t.Fatalf("seed sqlad: %v", err)
+	}
+	move := func(kind string, quantity int64) {
+		t.Helper()
+		_, err := sqlads.MovementCreate(&doctor.SqladMovement{Id: uuid.New().String(), SqladId: sqladId, Kind: kind, Quantity: quantity})
+		if err != nil {
+			t.Fatalf("MovementCreate %s %d: %v", kind, quantity, err)
+		}
+	}
+
+	move(repo.MovementReceipt, 10)
+	if _, err := stocktakes.StocktakeStart(&doctor.Stocktake{Id: stocktakeId}); err != nil {
+		t.Fatalf("StocktakeStart: %v", err)
+	}
+	_, err := stocktakes.CountEnter(&doctor.StocktakeCountReq{
+		StocktakeId: stocktakeId,
+		CounterId:   "counter",
+		Items:       []*doctor.StocktakeCountItem{{SqladId: sqladId, Quantity: 8}},
+	})
+	if err != nil {
+		t.Fatalf("CountEnter: %v", err)
+	}
+	// issued after the count, the posting must not undo it
+	move(repo.MovementIssue, -3)
+
+	posted, err := stocktakes.StocktakePost(stocktakeId, "actor")
+	if err != nil {
+		t.Fatalf("StocktakePost: %v", err)
+	}
+	if len(posted.Lines) != 1 || posted.Lines[0].Expected != 10 || posted.Lines[0].Difference != -2 {
+		t.Fatalf("lines = %v, want expected 10 and difference -2", posted.Lines)
+	}
+
+	var balance int64
+	if err := db.QueryRow(`SELECT SUM(quantity) FROM sqlad_movements WHERE sqlad_id = $1`, sqladId).Scan(&balance); err != nil {
+		t.Fatalf("balance: %v", err)
+	}
+	if balance != 5 {
+		t.Errorf("balance = %d, want 10 received, 3 issued and 2 short", balance)
+	}
+}
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/doctor/genproto/doctor"
+	"gitlab.com/clinic-crm/doctor/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/doctor/storage/repo"
+)
+
+type supplierRepo struct {
+	db *sqlx.DB
+}
+
+func NewSupplier(db *sqlx.DB) repo.SupplierStorageI {
+	return &supplierRepo{
+		db: db,
+	}
+}
+
+const supplierColumns = `
+	id,
+	name,
+	contact_person,
+	phone_number,
+	email,
+	address,
+	payment_terms,
+	payment_days,
+	note,
+	created_at::text,
+	updated_at::text,
+	(SELECT count(1) FROM sqlad WHERE sqlad.supplier_id = suppliers.id AND sqlad.deleted_at IS NULL)`
+
+func scanSupplier(row interface{ Scan(...interface{}) error }) (*doctor.Supplier, error) {
+	var result doctor.Supplier
+	err := row.Scan(
+		&result.Id,
+		&result.Name,
+		&result.ContactPerson,
+		&result.PhoneNumber,
+		&result.Email,
+		&result.Address,
+		&result.PaymentTerms,
+		&result.PaymentDays,
+		&result.Note,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.SqladCount,
+	)
+	if err != nil {
+		return &doctor.Supplier{}, err
+	}
+	return &result, nil
+}
+
+func (r *supplierRepo) SupplierCreate(req *doctor.Supplier) (*doctor.Supplier, error) {
+	query := `
+		INSERT INTO suppliers(
+			id,
+			name,
+			contact_person,
+			phone_number,
+			email,
+			address,
+			payment_terms,
+			payment_days,
+			note
+		) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING ` + supplierColumns
+
+	return scanSupplier(r.db.QueryRow(query,
+		req.Id,
+		req.Name,
+		req.ContactPerson,
+		req.PhoneNumber,
+		req.Email,
+		req.Address,
+		req.PaymentTerms,
+		req.PaymentDays,
+		req.Note,
+	))
+}
+
+func (r *supplierRepo) SupplierGet(id string) (*doctor.Supplier, error) {
+	query := `SELECT ` + supplierColumns + ` FROM suppliers WHERE id::text = $1 AND deleted_at IS NULL`
+
+	return scanSupplier(r.db.QueryRow(query, id))
+}
+
+func (r *supplierRepo) SupplierByName(name string) (*doctor.Supplier, error) {
+	query := `SELECT ` + supplierColumns + ` FROM suppliers WHERE lower(name) = lower(trim($1)) AND deleted_at IS NULL`
+
+	return scanSupplier(r.db.QueryRow(query, name))
+}
+
+func (r *supplierRepo) SuppliersFind(req *doctor.SuppliersFindReq) (*doctor.Suppliers, error) {
+	result := doctor.Suppliers{
+		Suppliers: make([]*doctor.Supplier, 0),
+	}
+
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "name", "contact_person", "phone_number", "email")
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	query := `SELECT ` + supplierColumns + ` FROM suppliers` + filter.String() + ` ORDER BY name` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &doctor.Suppliers{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanSupplier(rows)
+		if err != nil {
+			return &doctor.Suppliers{}, err
+		}
+		result.Suppliers = append(result.Suppliers, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &doctor.Suppliers{}, err
+	}
+
+	queryCount := `SELECT count(1) FROM suppliers` + filter.String()
+	if err := r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count); err != nil {
+		return &doctor.Suppliers{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *supplierRepo) SupplierUpdate(req *doctor.Supplier) (*doctor.Supplier, error) {
+	query := `
+		UPDATE suppliers SET
+			name = $2,
+			contact_person = $3,
+			phone_number = $4,
+			email = $5,
+			address = $6,
+			payment_terms = $7,
+			payment_days = $8,
+			note = $9,
+			updated_at = NOW()
+		WHERE id::text = $1 AND deleted_at IS NULL
+		RETURNING ` + supplierColumns
+
+	return scanSupplier(r.db.QueryRow(query,
+		req.Id,
+		req.Name,
+		req.ContactPerson,
+		req.PhoneNumber,
+		req.Email,
+		req.Address,
+		req.PaymentTerms,
+		req.PaymentDays,
+		req.Note,
+	))
+}
+
+func (r *supplierRepo) SupplierDelete(id string) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRow(`SELECT true FROM suppliers WHERE id::text = $1 AND deleted_at IS NULL FOR UPDATE`, id).Scan(&exists); err != nil {
+		return err
+	}
+
+	var inUse bool
+	err = tx.QueryRow(`
+		SELECT EXISTS(
+			SELECT 1 FROM purchase_orders
+			WHERE supplier_id::text = $1 AND status IN ($2, $3, $4)
+		)`, id, repo.OrderDraft, repo.OrderOrdered, repo.OrderPartiallyReceived,
+	).Scan(&inUse)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return repo.ErrSupplierInUse
+	}
+
+	if _, err := tx.Exec(`UPDATE sqlad SET supplier_id = NULL, updated_at = NOW() WHERE supplier_id::text = $1`, id); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`UPDATE suppliers SET deleted_at = NOW() WHERE id::text = $1`, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
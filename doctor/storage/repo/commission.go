@@ -0,0 +1,28 @@
+package repo
+
+import (
+	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+)
+
+// Kinds of doctor commissions
+const (
+	// share of what the clients paid for the service, in percents
+	CommissionPercent = "percent"
+	// amount for each service performed
+	CommissionFixed = "fixed"
+)
+
+// Service types the clinic bills, as reception names them
+const (
+	ServiceDoctor = "doctor"
+	ServiceLab    = "lab"
+	ServiceAparat = "aparat"
+)
+
+type CommissionStorageI interface {
+	// CommissionSet creates the rule or replaces the rule the doctor already has for the service.
+	CommissionSet(*pb.DoctorCommission) (*pb.DoctorCommission, error)
+	// CommissionsFind returns the rules of the doctor, of every doctor when doctorId is empty.
+	CommissionsFind(doctorId string) (*pb.DoctorCommissions, error)
+	CommissionDelete(id string) error
+}
@@ -9,6 +9,7 @@ type DoctorStorageI interface {
 	DoctorCreate(*pb.Doctor) (*pb.Doctor, error)
 	DoctorGet(*pb.GetDoctorReq) (*pb.Doctor, error)
 	DoctorsFind(*pb.DoctorsFindReq) (*pb.DoctorsResp, error)
+	DoctorsGetByIds(*pb.DoctorIds) (*pb.DoctorsByIdsResp, error)
 	DoctorUpdate(*pb.Doctor) (*pb.Doctor, error)
 	DoctorDelete(*pb.DoctorId) error
 
@@ -21,13 +22,16 @@ type DoctorStorageI interface {
 	DoctorReportDelete(*pb.ReportId) error
 
 	// Sqlad
+	// SqladCreate records the count of the request as the opening receipt, a lot expiring
+	// on the expiration date of the request.
 	SqladCreate(*pb.SqladReq) (*pb.SqladRes, error)
 	SqladGet(*pb.SqladGetReq) (*pb.SqladRes, error)
+	// SqladUpdate leaves the count and the expiry alone, the stock changes by movements only.
 	SqladUpdate(*pb.SqladReq) (*pb.SqladRes, error)
 	SqladDelete(*pb.SqladId) error
 	LowStock() (*pb.LowStockRes, error)
 
 	// Doctor page
-	DoctorPageFilter(*pb.DocPageFilter) (*pb.DocPageFilterRes, error)
+	DoctorPageReports(doctorId string, patientIds []string) ([]*pb.DoctorReportRes, error)
 }
 
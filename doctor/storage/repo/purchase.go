@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"errors"
+
+	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+)
+
+// Statuses of purchase orders
+const (
+	// being put together, the items can still change
+	OrderDraft = "draft"
+	// sent to the supplier
+	OrderOrdered = "ordered"
+	// some of the items arrived
+	OrderPartiallyReceived = "partially_received"
+	// everything arrived, or the rest is not coming
+	OrderReceived = "received"
+	// called off before anything arrived
+	OrderCancelled = "cancelled"
+)
+
+var (
+	// ErrOrderStatus is returned when the purchase order can not be changed in its status.
+	ErrOrderStatus = errors.New("purchase order can not be changed in its status")
+	// ErrOverReceipt is returned when receiving more of an item than is left to receive.
+	ErrOverReceipt = errors.New("more received than ordered")
+)
+
+type PurchaseStorageI interface {
+	// OrderCreate adds the draft order, sql.ErrNoRows when the supplier or a sqlad is not found.
+	OrderCreate(*pb.PurchaseOrder) (*pb.PurchaseOrder, error)
+	// LowStockOrder drafts an order for each supplier of the sqlad low on stock, of the
+	// supplier only when supplierId is given. The quantities bring the stock up to twice
+	// the low stock level, what is on open orders already counted.
+	LowStockOrder(supplierId, actorId string) (*pb.LowStockOrders, error)
+	OrderGet(id string) (*pb.PurchaseOrder, error)
+	OrdersFind(*pb.PurchaseOrdersFindReq) (*pb.PurchaseOrders, error)
+	// OrderUpdate replaces the supplier, note and items of the order, ErrOrderStatus unless it is a draft.
+	OrderUpdate(*pb.PurchaseOrder) (*pb.PurchaseOrder, error)
+	// OrderStatusSet moves the order to the status, ErrOrderStatus when it can not go there.
+	OrderStatusSet(id, status string) (*pb.PurchaseOrder, error)
+	// OrderReceive adds the items received to the stock as receipts, the order becomes
+	// partially received or received. Returns ErrOrderStatus unless the order is ordered
+	// and ErrOverReceipt when an item would be received more than ordered.
+	OrderReceive(*pb.PurchaseOrderReceipt) (*pb.PurchaseOrder, error)
+	// SpendReport returns what was received from each supplier over the dates and its cost.
+	SpendReport(*pb.SupplierSpendReq) ([]*pb.SupplierSpend, error)
+}
@@ -0,0 +1,26 @@
+package repo
+
+import (
+	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+)
+
+// Kinds of schedule exceptions
+const (
+	ExceptionVacation = "vacation"
+	ExceptionSick     = "sick"
+	ExceptionAbsent   = "absent"
+	ExceptionHours    = "hours"
+)
+
+type ScheduleStorageI interface {
+	// WorkHoursSet replaces the weekly working hours and breaks of the doctor.
+	WorkHoursSet(*pb.DoctorWorkHours) (*pb.DoctorWorkHours, error)
+	WorkHoursGet(doctorId string) (*pb.DoctorWorkHours, error)
+
+	ExceptionCreate(*pb.DoctorException) (*pb.DoctorException, error)
+	// ExceptionsFind returns the exceptions overlapping the dates, of every doctor when doctorId is empty.
+	ExceptionsFind(doctorId, fromDate, toDate string) (*pb.DoctorExceptions, error)
+	ExceptionDelete(id string) error
+	// SpecialtyDoctors returns the doctors having the specialty, not deleted.
+	SpecialtyDoctors(specialtyId string) ([]*pb.Doctor, error)
+}
@@ -0,0 +1,21 @@
+package repo
+
+import (
+	"errors"
+
+	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+)
+
+// ErrSpecialtyInUse is returned when deleting a specialty doctors still have.
+var ErrSpecialtyInUse = errors.New("specialty has doctors")
+
+type SpecialtyStorageI interface {
+	SpecialtyCreate(*pb.Specialty) (*pb.Specialty, error)
+	SpecialtyGet(id string) (*pb.Specialty, error)
+	// SpecialtyByName looks the specialty up ignoring case and surrounding spaces.
+	SpecialtyByName(name string) (*pb.Specialty, error)
+	SpecialtiesFind(*pb.SpecialtiesFindReq) (*pb.Specialties, error)
+	SpecialtyUpdate(*pb.Specialty) (*pb.Specialty, error)
+	// SpecialtyDelete returns ErrSpecialtyInUse while doctors not deleted have the specialty.
+	SpecialtyDelete(id string) error
+}
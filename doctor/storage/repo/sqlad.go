@@ -0,0 +1,45 @@
+package repo
+
+import (
+	"errors"
+
+	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+)
+
+// Kinds of sqlad stock movements
+const (
+	// received from a supplier
+	MovementReceipt = "receipt"
+	// given out to a doctor, lab or aparat
+	MovementIssue = "issue"
+	// thrown away: expired, broken or lost
+	MovementWriteOff = "write_off"
+	// correction of the stock to what is really on the shelves
+	MovementAdjustment = "adjustment"
+)
+
+// ErrStockShort is returned when a movement would take the stock below zero.
+var ErrStockShort = errors.New("not enough stock")
+
+type SqladStorageI interface {
+	// MovementCreate adds the movement to the stock of the sqlad and its lots, the quantity
+	// is signed. Returns ErrStockShort when the stock, or the stock of the lot, would go below zero,
+	// or when an issue of no lot finds too little stock not expired.
+	MovementCreate(*pb.SqladMovement) (*pb.SqladMovement, error)
+	// MovementsFind returns the movements newest first with the stock after each of them.
+	MovementsFind(*pb.SqladMovementsFindReq) (*pb.SqladMovements, error)
+	// LotsFind returns the lots of the sqlad first expiring first, only the ones in stock unless withEmpty.
+	LotsFind(sqladId string, withEmpty bool) ([]*pb.SqladLot, error)
+	// ExpiringLots returns the lots in stock expiring within the days, the expired ones included.
+	ExpiringLots(days int64) ([]*pb.SqladLot, error)
+	// BalancesAt returns the stock at the end of the date, of every sqlad when sqladId is empty.
+	BalancesAt(sqladId, date string) ([]*pb.SqladBalance, error)
+
+	// BomSet replaces the bill of materials of the service, sql.ErrNoRows when a sqlad is not found.
+	BomSet(*pb.SqladBom) (*pb.SqladBom, error)
+	BomGet(serviceType, serviceId string) (*pb.SqladBom, error)
+	// Consume issues the bill of materials of the service to it once for the analysis, what is
+	// out of stock is recorded as expected but not taken.
+	Consume(*pb.SqladConsumeReq) (*pb.SqladConsumeResp, error)
+	ConsumptionReport(*pb.ConsumptionReportReq) ([]*pb.ConsumptionLine, error)
+}
@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"errors"
+
+	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+)
+
+// Statuses of stocktakes
+const (
+	// being counted
+	StocktakeOpen = "open"
+	// the differences are adjusted
+	StocktakePosted = "posted"
+	// called off, the stock is left as is
+	StocktakeCancelled = "cancelled"
+)
+
+var (
+	// ErrStocktakeClosed is returned when counting, posting or cancelling a stocktake not open.
+	ErrStocktakeClosed = errors.New("stocktake is not open")
+	// ErrCountMixed is returned when a sqlad would be counted both by lots and as a whole.
+	ErrCountMixed = errors.New("sqlad counted both by lots and as a whole")
+)
+
+type StocktakeStorageI interface {
+	// StocktakeStart opens the stocktake, a unique violation when one is open already.
+	StocktakeStart(*pb.Stocktake) (*pb.Stocktake, error)
+	// CountEnter replaces what the staff member counted of the sqlad and lots. Returns
+	// ErrStocktakeClosed unless the stocktake is open, sql.ErrNoRows for a sqlad or a lot
+	// of it not found and ErrCountMixed for a sqlad counted by lots and as a whole.
+	CountEnter(*pb.StocktakeCountReq) (*pb.Stocktake, error)
+	// StocktakeGet returns the stocktake with its counts and lines: against the balance now
+	// while open, against the balance at posting once posted.
+	StocktakeGet(id string) (*pb.Stocktake, error)
+	// StocktakesFind returns the stocktakes newest first without their lines and counts.
+	StocktakesFind(*pb.StocktakesFindReq) (*pb.Stocktakes, error)
+	// StocktakePost adjusts the stock of everything counted to the count and closes the
+	// stocktake, what nobody counted is left as is.
+	StocktakePost(id, actorId string) (*pb.Stocktake, error)
+	StocktakeCancel(id string) (*pb.Stocktake, error)
+}
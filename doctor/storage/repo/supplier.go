@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"errors"
+
+	pb "gitlab.com/clinic-crm/doctor/genproto/doctor"
+)
+
+// ErrSupplierInUse is returned when deleting a supplier with purchase orders still open.
+var ErrSupplierInUse = errors.New("supplier has open purchase orders")
+
+type SupplierStorageI interface {
+	SupplierCreate(*pb.Supplier) (*pb.Supplier, error)
+	SupplierGet(id string) (*pb.Supplier, error)
+	// SupplierByName looks the supplier up ignoring case and surrounding spaces.
+	SupplierByName(name string) (*pb.Supplier, error)
+	SuppliersFind(*pb.SuppliersFindReq) (*pb.Suppliers, error)
+	SupplierUpdate(*pb.Supplier) (*pb.Supplier, error)
+	// SupplierDelete returns ErrSupplierInUse while the supplier has open orders, the sqlad
+	// ordered from it is left without a supplier.
+	SupplierDelete(id string) error
+}
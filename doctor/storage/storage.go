@@ -9,18 +9,67 @@ import (
 
 type StorageI interface {
 	Doctor() repo.DoctorStorageI
+	Schedule() repo.ScheduleStorageI
+	Specialty() repo.SpecialtyStorageI
+	Commission() repo.CommissionStorageI
+	Sqlad() repo.SqladStorageI
+	Supplier() repo.SupplierStorageI
+	Purchase() repo.PurchaseStorageI
+	Stocktake() repo.StocktakeStorageI
 }
 
 type storagePg struct {
-	doctorRepo repo.DoctorStorageI
+	doctorRepo     repo.DoctorStorageI
+	scheduleRepo   repo.ScheduleStorageI
+	specialtyRepo  repo.SpecialtyStorageI
+	commissionRepo repo.CommissionStorageI
+	sqladRepo      repo.SqladStorageI
+	supplierRepo   repo.SupplierStorageI
+	purchaseRepo   repo.PurchaseStorageI
+	stocktakeRepo  repo.StocktakeStorageI
 }
 
 func NewStoragePg(db *sqlx.DB) StorageI {
 	return &storagePg{
-		doctorRepo: postgres.NewDoctor(db),
+		doctorRepo:     postgres.NewDoctor(db),
+		scheduleRepo:   postgres.NewSchedule(db),
+		specialtyRepo:  postgres.NewSpecialty(db),
+		commissionRepo: postgres.NewCommission(db),
+		sqladRepo:      postgres.NewSqlad(db),
+		supplierRepo:   postgres.NewSupplier(db),
+		purchaseRepo:   postgres.NewPurchase(db),
+		stocktakeRepo:  postgres.NewStocktake(db),
 	}
 }
 
 func (s *storagePg) Doctor() repo.DoctorStorageI {
 	return s.doctorRepo
+}
+
+func (s *storagePg) Schedule() repo.ScheduleStorageI {
+	return s.scheduleRepo
+}
+
+func (s *storagePg) Specialty() repo.SpecialtyStorageI {
+	return s.specialtyRepo
+}
+
+func (s *storagePg) Commission() repo.CommissionStorageI {
+	return s.commissionRepo
+}
+
+func (s *storagePg) Sqlad() repo.SqladStorageI {
+	return s.sqladRepo
+}
+
+func (s *storagePg) Supplier() repo.SupplierStorageI {
+	return s.supplierRepo
+}
+
+func (s *storagePg) Purchase() repo.PurchaseStorageI {
+	return s.purchaseRepo
+}
+
+func (s *storagePg) Stocktake() repo.StocktakeStorageI {
+	return s.stocktakeRepo
 }
\ No newline at end of file
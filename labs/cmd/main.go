@@ -1,14 +1,17 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"gitlab.com/clinic-crm/labs/config"
 	"gitlab.com/clinic-crm/labs/genproto/lab"
+	"gitlab.com/clinic-crm/labs/pkg/grpc_client"
 	"gitlab.com/clinic-crm/labs/service"
 	"gitlab.com/clinic-crm/labs/storage"
 	"google.golang.org/grpc"
@@ -34,7 +37,14 @@ func main() {
 
 	strg := storage.NewStoragePg(psqlConn)
 
-	labService := service.NewLabService(strg)
+	grpcClient, err := grpc_client.New(cfg)
+	if err != nil {
+		log.Fatalf("failed to connect services: %v", err)
+	}
+
+	labService := service.NewLabService(strg, grpcClient)
+	go labService.ConsumeRetry(context.Background(), time.Duration(cfg.ConsumptionInterval)*time.Second)
+
 	lis, err := net.Listen("tcp", ":"+cfg.LabServicePort)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
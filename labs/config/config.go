@@ -17,6 +17,11 @@ type Config struct {
 	DatabaseUrl      string
 	LabServiceHost   string
 	LabServicePort   string
+
+	DoctorServiceHost string
+	DoctorServicePort string
+
+	ConsumptionInterval int // seconds between the retries of the consumption outbox
 }
 
 func Load() Config {
@@ -33,6 +38,11 @@ func Load() Config {
 	c.LabServiceHost = cast.ToString(GetOrReturnDefault("LAB_SERVICE_HOST", "localhost"))
 	c.LabServicePort = cast.ToString(GetOrReturnDefault("LAB_SERVICE_PORT", "5002"))
 
+	c.DoctorServiceHost = cast.ToString(GetOrReturnDefault("DOCTOR_SERVICE_HOST", "localhost"))
+	c.DoctorServicePort = cast.ToString(GetOrReturnDefault("DOCTOR_SERVICE_PORT", "5001"))
+
+	c.ConsumptionInterval = cast.ToInt(GetOrReturnDefault("CONSUMPTION_OUTBOX_INTERVAL", 15))
+
 	return c
 }
 
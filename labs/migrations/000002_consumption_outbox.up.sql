@@ -0,0 +1,13 @@
+-- analyses whose sqlad is not taken off the stock yet, written in the tx of the analysis
+CREATE TABLE IF NOT EXISTS "consumption_outbox"(
+    "analysis_id" UUID PRIMARY KEY,
+    "service_type" VARCHAR(255) NOT NULL,
+    "service_id" UUID NOT NULL,
+    "actor_id" VARCHAR(255) NOT NULL DEFAULT '',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "last_error" TEXT NOT NULL DEFAULT '',
+    "next_attempt_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "consumption_outbox_next_attempt_at_idx" ON "consumption_outbox"("next_attempt_at");
@@ -0,0 +1,7 @@
+ALTER TABLE "labs"
+    DROP COLUMN IF EXISTS "doctor_id",
+    DROP COLUMN IF EXISTS "room_number";
+
+ALTER TABLE "aparats"
+    DROP COLUMN IF EXISTS "doctor_id",
+    DROP COLUMN IF EXISTS "room_number";
@@ -0,0 +1,8 @@
+-- the doctor who is paid for the lab or aparat service and the room it is done in
+ALTER TABLE "labs"
+    ADD COLUMN IF NOT EXISTS "doctor_id" VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "room_number" VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE "aparats"
+    ADD COLUMN IF NOT EXISTS "doctor_id" VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "room_number" VARCHAR(255) NOT NULL DEFAULT '';
@@ -10,7 +10,7 @@ import (
 )
 
 func ConnectToDBForSuite(cfg config.Config) (*sqlx.DB, func()) {
-	psqlString := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+	psqlString := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		cfg.PostgresHost,
 		cfg.PostgresPort,
 		cfg.PostgresUser,
@@ -30,7 +30,7 @@ func ConnectToDBForSuite(cfg config.Config) (*sqlx.DB, func()) {
 }
 
 func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
-	psqlString := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+	psqlString := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		cfg.PostgresHost,
 		cfg.PostgresPort,
 		cfg.PostgresUser,
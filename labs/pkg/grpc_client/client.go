@@ -0,0 +1,41 @@
+package grpc_client
+
+import (
+	"fmt"
+
+	"gitlab.com/clinic-crm/labs/config"
+	pbd "gitlab.com/clinic-crm/labs/genproto/doctor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type ServiceManager interface {
+	DoctorService() pbd.DoctorServiceClient
+}
+
+type GrpcClient struct {
+	cfg         config.Config
+	connections map[string]interface{}
+}
+
+func New(cfg config.Config) (ServiceManager, error) {
+	connDoctorService, err := grpc.Dial(
+		fmt.Sprintf("%s:%s", cfg.DoctorServiceHost, cfg.DoctorServicePort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("doctor service dial host: %s port:%s err: %v",
+			cfg.DoctorServiceHost, cfg.DoctorServicePort, err)
+	}
+
+	return &GrpcClient{
+		cfg: cfg,
+		connections: map[string]interface{}{
+			"doctor_service": pbd.NewDoctorServiceClient(connDoctorService),
+		},
+	}, nil
+}
+
+func (g *GrpcClient) DoctorService() pbd.DoctorServiceClient {
+	return g.connections["doctor_service"].(pbd.DoctorServiceClient)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gitlab.com/clinic-crm/labs/genproto/doctor"
+)
+
+const (
+	// analyses consumed in one pass of the outbox
+	consumptionBatch = 50
+	// how long a claimed analysis is hidden from the other passes
+	consumptionLease = time.Minute
+	// first retry after a failure, doubled on every next one
+	consumptionRetry = 30 * time.Second
+	// an analysis waits for the doctor service this long, then it is left to the outbox
+	consumptionTimeout = 5 * time.Second
+)
+
+// consume takes the sqlad of the analyses off the stock right after they are recorded.
+// The ones that fail stay in the outbox for ConsumeRetry, consuming again for the same
+// analysis takes nothing twice.
+func (s *LabService) consume(ctx context.Context, analysisIds ...string) {
+	if len(analysisIds) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, consumptionTimeout)
+	defer cancel()
+
+	s.consumeOutbox(ctx, analysisIds)
+}
+
+// ConsumeRetry consumes the analyses left in the outbox every interval until ctx is done.
+func (s *LabService) ConsumeRetry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for s.consumeOutbox(ctx, nil) == consumptionBatch {
+		}
+	}
+}
+
+// consumeOutbox claims the due analyses of the outbox, all of them when analysisIds is nil,
+// and consumes their sqlad. It returns how many were claimed.
+func (s *LabService) consumeOutbox(ctx context.Context, analysisIds []string) int {
+	consumptions, err := s.storage.Consumption().ConsumptionClaim(analysisIds, consumptionBatch, consumptionLease)
+	if err != nil {
+		log.Println("consumption outbox:", err.Error())
+		return 0
+	}
+
+	for _, c := range consumptions {
+		_, err := s.service.DoctorService().SqladConsume(ctx, &doctor.SqladConsumeReq{
+			ServiceType: c.ServiceType,
+			ServiceId:   c.ServiceId,
+			AnalysisId:  c.AnalysisId,
+			ActorId:     c.ActorId,
+		})
+		if err != nil {
+			log.Printf("consuming sqlad of %s analysis %s: %v", c.ServiceType, c.AnalysisId, err)
+			if err := s.storage.Consumption().ConsumptionFailed(c.AnalysisId, err.Error(), consumptionRetry); err != nil {
+				log.Println("consumption outbox:", err.Error())
+			}
+			continue
+		}
+
+		if err := s.storage.Consumption().ConsumptionDone(c.AnalysisId); err != nil {
+			// the lease runs out and the doctor service takes nothing twice for the analysis
+			log.Println("consumption outbox:", err.Error())
+		}
+	}
+
+	return len(consumptions)
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"gitlab.com/clinic-crm/labs/genproto/lab"
+	"gitlab.com/clinic-crm/labs/pkg/grpc_client"
+	"gitlab.com/clinic-crm/labs/pkg/sqlfilter"
 	"gitlab.com/clinic-crm/labs/storage"
 
 	"google.golang.org/grpc/codes"
@@ -20,12 +22,14 @@ import (
 
 type LabService struct {
 	storage storage.StorageI
+	service grpc_client.ServiceManager
 	lab.UnimplementedLabServiceServer
 }
 
-func NewLabService(strg storage.StorageI) *LabService {
+func NewLabService(strg storage.StorageI, service grpc_client.ServiceManager) *LabService {
 	return &LabService{
 		storage:                       strg,
+		service:                       service,
 		UnimplementedLabServiceServer: lab.UnimplementedLabServiceServer{},
 	}
 }
@@ -50,6 +54,8 @@ func (s *LabService) LabGet(ctx context.Context, req *lab.LabGetReq) (*lab.LabCr
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.LabCreateRes{}, status.Error(codes.NotFound, "something went wrong, please not found this lab")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.LabCreateRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.LabCreateRes{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
@@ -70,6 +76,17 @@ func (s *LabService) LabsFind(ctx context.Context, req *lab.LabsFindReq) (*lab.L
 	return resp, nil
 }
 
+// LabsGetByIds returns many labs in one call, the ids not found are listed in missing_ids.
+func (s *LabService) LabsGetByIds(ctx context.Context, req *lab.ServiceIds) (*lab.LabsByIdsResp, error) {
+	resp, err := s.storage.Lab().LabsGetByIds(req)
+	if err != nil {
+		log.Println(err.Error())
+		return &lab.LabsByIdsResp{}, status.Error(codes.Internal, "something went wrong, please check lab ids")
+	}
+
+	return resp, nil
+}
+
 func (s *LabService) LabUpdate(ctx context.Context, req *lab.LabUpdateReq) (*lab.LabCreateRes, error) {
 	resp, err := s.storage.Lab().LabUpdate(req)
 	if err != nil {
@@ -116,6 +133,8 @@ func (s *LabService) AparatGet(ctx context.Context, req *lab.AparatGetReq) (*lab
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.AparatCreateRes{}, status.Error(codes.NotFound, "something went wrong, please not found this")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.AparatCreateRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.AparatCreateRes{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
@@ -136,6 +155,17 @@ func (s *LabService) AparatsFind(ctx context.Context, req *lab.AparatsFindReq) (
 	return resp, nil
 }
 
+// AparatsGetByIds returns many aparats in one call, the ids not found are listed in missing_ids.
+func (s *LabService) AparatsGetByIds(ctx context.Context, req *lab.ServiceIds) (*lab.AparatsByIdsResp, error) {
+	resp, err := s.storage.Lab().AparatsGetByIds(req)
+	if err != nil {
+		log.Println(err.Error())
+		return &lab.AparatsByIdsResp{}, status.Error(codes.Internal, "something went wrong, please check aparat ids")
+	}
+
+	return resp, nil
+}
+
 func (s *LabService) AparatsUpdate(ctx context.Context, req *lab.AparatUpdateReq) (*lab.AparatCreateRes, error) {
 	resp, err := s.storage.Lab().AparatsUpdate(req)
 	fmt.Println(err)
@@ -183,6 +213,8 @@ func (s *LabService) LabCategoryGet(ctx context.Context, req *lab.CategoryGetReq
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.CategoryRes{}, status.Error(codes.NotFound, "something went wrong, please not found this")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.CategoryRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.CategoryRes{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
@@ -249,6 +281,8 @@ func (s *LabService) AparatCategoryGet(ctx context.Context, req *lab.CategoryGet
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.CategoryRes{}, status.Error(codes.NotFound, "something went wrong, please not found this")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.CategoryRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.CategoryRes{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
@@ -316,6 +350,8 @@ func (s *LabService) LabSubCategoryGet(ctx context.Context, req *lab.CategoryGet
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.SubCategoryRes{}, status.Error(codes.NotFound, "something went wrong, please not found this")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.SubCategoryRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.SubCategoryRes{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
@@ -382,6 +418,8 @@ func (s *LabService) AparatSubCategoryGet(ctx context.Context, req *lab.Category
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.SubCategoryRes{}, status.Error(codes.NotFound, "something went wrong, please not found this")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.SubCategoryRes{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.SubCategoryRes{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
@@ -440,6 +478,8 @@ func (s *LabService) AparatAnalysisCreate(ctx context.Context, req *lab.Analysis
 		return &lab.AnalysisResp{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
 
+	s.consume(ctx, resp.Id)
+
 	return resp, nil
 }
 
@@ -449,6 +489,8 @@ func (s *LabService) AparatAnalysisGet(ctx context.Context, req *lab.AnalysisGet
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.AnalysisResp{}, status.Error(codes.NotFound, "something went wrong, please not found this aparat analysis")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.AnalysisResp{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.AnalysisResp{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
@@ -481,6 +523,8 @@ func (s *LabService) LabAnalysisCreate(ctx context.Context, req *lab.AnalysisReq
 		return &lab.AnalysisResp{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
 
+	s.consume(ctx, resp.Id)
+
 	return resp, nil
 }
 
@@ -490,6 +534,8 @@ func (s *LabService) LabAnalysisGet(ctx context.Context, req *lab.AnalysisGetReq
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &lab.AnalysisResp{}, status.Error(codes.NotFound, "something went wrong, please not found this lab analysis")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &lab.AnalysisResp{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &lab.AnalysisResp{}, status.Error(codes.Internal, "something went wrong, please check info")
 	}
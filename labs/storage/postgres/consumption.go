@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/labs/genproto/lab"
+	"gitlab.com/clinic-crm/labs/storage/repo"
+)
+
+type consumptionRepo struct {
+	db *sqlx.DB
+}
+
+func NewConsumption(db *sqlx.DB) repo.ConsumptionStorageI {
+	return &consumptionRepo{
+		db: db,
+	}
+}
+
+// consumptionOutboxAdd queues the analysis of the tx for taking its sqlad off the stock.
+func consumptionOutboxAdd(tx *sqlx.Tx, serviceType string, req *lab.AnalysisReq) error {
+	_, err := tx.Exec(`
+		INSERT INTO consumption_outbox(analysis_id, service_type, service_id, actor_id)
+		VALUES($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING`, req.Id, serviceType, req.AparatId, req.ActorId)
+	return err
+}
+
+func (r *consumptionRepo) ConsumptionClaim(ids []string, limit int, lease time.Duration) ([]*repo.Consumption, error) {
+	if ids == nil {
+		ids = []string{}
+	}
+
+	rows, err := r.db.Query(`
+		UPDATE consumption_outbox SET
+			attempts = attempts + 1,
+			next_attempt_at = NOW() + $3 * interval '1 second'
+		WHERE analysis_id IN (
+			SELECT analysis_id FROM consumption_outbox
+			WHERE next_attempt_at <= NOW()
+				AND (cardinality($1::uuid[]) = 0 OR analysis_id = ANY($1::uuid[]))
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING analysis_id, service_type, service_id, actor_id`, pq.Array(ids), limit, int64(lease.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*repo.Consumption, 0)
+	for rows.Next() {
+		var temp repo.Consumption
+		if err := rows.Scan(
+			&temp.AnalysisId,
+			&temp.ServiceType,
+			&temp.ServiceId,
+			&temp.ActorId,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &temp)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *consumptionRepo) ConsumptionDone(analysisId string) error {
+	_, err := r.db.Exec(`DELETE FROM consumption_outbox WHERE analysis_id = $1`, analysisId)
+	return err
+}
+
+func (r *consumptionRepo) ConsumptionFailed(analysisId, reason string, retry time.Duration) error {
+	_, err := r.db.Exec(`
+		UPDATE consumption_outbox SET
+			last_error = $2,
+			next_attempt_at = NOW() + LEAST($3 * POWER(2, attempts - 1), 3600) * interval '1 second'
+		WHERE analysis_id = $1`, analysisId, reason, int64(retry.Seconds()))
+	return err
+}
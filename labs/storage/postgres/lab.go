@@ -7,7 +7,9 @@ import (
 	"log"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"gitlab.com/clinic-crm/labs/genproto/lab"
+	"gitlab.com/clinic-crm/labs/pkg/sqlfilter"
 	"gitlab.com/clinic-crm/labs/storage/repo"
 	// "github.com/google/uuid"
 )
@@ -31,25 +33,31 @@ func (lr *labRepo) LabCreate(req *lab.LabCreateReq) (*lab.LabCreateRes, error) {
 			name,
 			price,
 			type,
-			sub_category_id
-		) VALUES($1, $2, $3, $4, $5)
+			sub_category_id,
+			doctor_id,
+			room_number
+		) VALUES($1, $2, $3, $4, $5, NULLIF($6, ''), $7)
 		RETURNING 
 			id,
 			COALESCE(name,'') as name,
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 		`
 	if err := lr.db.DB.QueryRow(query,
-		req.Id, req.Name, req.Price, req.Type, req.SubCategoryId,
+		req.Id, req.Name, req.Price, req.Type, req.SubCategoryId, req.DoctorId, req.RoomNumber,
 	).Scan(
 		&result.Id,
 		&result.Name,
 		&result.Price,
 		&result.Type,
 		&result.SubCategoryId,
+		&result.DoctorId,
+		&result.RoomNumber,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	); err != nil {
@@ -61,6 +69,12 @@ func (lr *labRepo) LabCreate(req *lab.LabCreateReq) (*lab.LabCreateRes, error) {
 
 func (lr *labRepo) LabGet(req *lab.LabGetReq) (*lab.LabCreateRes, error) {
 	var result lab.LabCreateRes
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "name", "type", "sub_category_id", "doctor_id")
+	if err != nil {
+		return &lab.LabCreateRes{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -68,24 +82,28 @@ func (lr *labRepo) LabGet(req *lab.LabGetReq) (*lab.LabCreateRes, error) {
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 		FROM labs
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.Name,
 		&result.Price,
 		&result.Type,
 		&result.SubCategoryId,
+		&result.DoctorId,
+		&result.RoomNumber,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.LabCreateRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.LabCreateRes{}, err
 	}
 
@@ -97,18 +115,9 @@ func (lr *labRepo) LabsFind(req *lab.LabsFindReq) (*lab.LabsRes, error) {
 		Labs: make([]*lab.LabCreateRes, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-	filter := "WHERE deleted_at IS NULL"
-
-	if req.Search != "" {
-		str := "%" + req.Search + "%"
-		filter += fmt.Sprintf(`
-			AND (type ILIKE '%s' OR name ILIKE '%s' OR price ILIKE '%s')
-				`,
-			str, str, str,
-		)
-	}
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "type", "name", "price::text")
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 		SELECT
@@ -117,13 +126,15 @@ func (lr *labRepo) LabsFind(req *lab.LabsFindReq) (*lab.LabsRes, error) {
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 		FROM labs
-		` + filter + `
+		` + filter.String() + `
 		ORDER BY created_at DESC
 		` + limit
-	rows, err := lr.db.Query(query)
+	rows, err := lr.db.Query(query, args...)
 	if err != nil {
 		return &lab.LabsRes{}, err
 	}
@@ -137,6 +148,8 @@ func (lr *labRepo) LabsFind(req *lab.LabsFindReq) (*lab.LabsRes, error) {
 			&temp.Price,
 			&temp.Type,
 			&temp.SubCategoryId,
+			&temp.DoctorId,
+			&temp.RoomNumber,
 			&temp.CreatedAt,
 			&temp.UpdatedAt,
 		)
@@ -145,8 +158,8 @@ func (lr *labRepo) LabsFind(req *lab.LabsFindReq) (*lab.LabsRes, error) {
 		}
 		result.Labs = append(result.Labs, &temp)
 	}
-	queryCount := `SELECT COUNT(1) FROM labs ` + filter
-	err = lr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT COUNT(1) FROM labs ` + filter.String()
+	err = lr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &lab.LabsRes{}, err
 	}
@@ -154,6 +167,70 @@ func (lr *labRepo) LabsFind(req *lab.LabsFindReq) (*lab.LabsRes, error) {
 	return &result, nil
 }
 
+// LabsGetByIds returns the labs of the ids in the order of the ids,
+// the ids no lab was found for are returned as missing.
+func (lr *labRepo) LabsGetByIds(req *lab.ServiceIds) (*lab.LabsByIdsResp, error) {
+	result := lab.LabsByIdsResp{
+		Labs:       make([]*lab.LabCreateRes, 0, len(req.Ids)),
+		MissingIds: make([]string, 0),
+	}
+	if len(req.Ids) == 0 {
+		return &result, nil
+	}
+
+	query := `
+		SELECT
+			id,
+			COALESCE(name,'') as name,
+			price,
+			COALESCE(type,'') as type,
+			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
+			created_at,
+			updated_at
+		FROM labs
+		WHERE id::text = ANY($1) and deleted_at IS NULL
+	`
+	rows, err := lr.db.Query(query, pq.Array(req.Ids))
+	if err != nil {
+		return &lab.LabsByIdsResp{}, err
+	}
+	defer rows.Close()
+
+	found := make(map[string]*lab.LabCreateRes, len(req.Ids))
+	for rows.Next() {
+		var temp lab.LabCreateRes
+		if err := rows.Scan(
+			&temp.Id,
+			&temp.Name,
+			&temp.Price,
+			&temp.Type,
+			&temp.SubCategoryId,
+			&temp.DoctorId,
+			&temp.RoomNumber,
+			&temp.CreatedAt,
+			&temp.UpdatedAt,
+		); err != nil {
+			return &lab.LabsByIdsResp{}, err
+		}
+		found[temp.Id] = &temp
+	}
+	if err := rows.Err(); err != nil {
+		return &lab.LabsByIdsResp{}, err
+	}
+
+	for _, id := range uniqueIds(req.Ids) {
+		if temp, ok := found[id]; ok {
+			result.Labs = append(result.Labs, temp)
+		} else {
+			result.MissingIds = append(result.MissingIds, id)
+		}
+	}
+
+	return &result, nil
+}
+
 func (lr *labRepo) LabUpdate(req *lab.LabUpdateReq) (*lab.LabCreateRes, error) {
 	var result lab.LabCreateRes
 	query := `
@@ -163,6 +240,8 @@ func (lr *labRepo) LabUpdate(req *lab.LabUpdateReq) (*lab.LabCreateRes, error) {
 		    name=$1,
 			price=$2,
 			type=$3,
+			doctor_id=NULLIF($5, ''),
+			room_number=$6,
 			updated_at=NOW()
 		WHERE
 			id=$4 AND deleted_at IS NULL
@@ -172,6 +251,8 @@ func (lr *labRepo) LabUpdate(req *lab.LabUpdateReq) (*lab.LabCreateRes, error) {
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 	`
@@ -180,12 +261,16 @@ func (lr *labRepo) LabUpdate(req *lab.LabUpdateReq) (*lab.LabCreateRes, error) {
 		req.Price,
 		req.Type,
 		req.Id,
+		req.DoctorId,
+		req.RoomNumber,
 	).Scan(
 		&result.Id,
 		&result.Name,
 		&result.Price,
 		&result.Type,
 		&result.SubCategoryId,
+		&result.DoctorId,
+		&result.RoomNumber,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
@@ -231,25 +316,31 @@ func (lr *labRepo) AparatCreate(req *lab.AparatCreateReq) (*lab.AparatCreateRes,
 			name,
 			price,
 			type,
-			sub_category_id
-		) VALUES($1, $2, $3, $4, $5)
+			sub_category_id,
+			doctor_id,
+			room_number
+		) VALUES($1, $2, $3, $4, $5, NULLIF($6, ''), $7)
 		RETURNING 
 			id,
 			COALESCE(name,'') as name,
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 		`
 	if err := lr.db.DB.QueryRow(query,
-		req.Id, req.Name, req.Price, req.Type, req.SubCategoryId,
+		req.Id, req.Name, req.Price, req.Type, req.SubCategoryId, req.DoctorId, req.RoomNumber,
 	).Scan(
 		&result.Id,
 		&result.Name,
 		&result.Price,
 		&result.Type,
 		&result.SubCategoryId,
+		&result.DoctorId,
+		&result.RoomNumber,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	); err != nil {
@@ -261,6 +352,12 @@ func (lr *labRepo) AparatCreate(req *lab.AparatCreateReq) (*lab.AparatCreateRes,
 
 func (lr *labRepo) AparatGet(req *lab.AparatGetReq) (*lab.AparatCreateRes, error) {
 	var result lab.AparatCreateRes
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "name", "type", "sub_category_id", "doctor_id")
+	if err != nil {
+		return &lab.AparatCreateRes{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -268,24 +365,28 @@ func (lr *labRepo) AparatGet(req *lab.AparatGetReq) (*lab.AparatCreateRes, error
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 		FROM aparats
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.Name,
 		&result.Price,
 		&result.Type,
 		&result.SubCategoryId,
+		&result.DoctorId,
+		&result.RoomNumber,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.AparatCreateRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.AparatCreateRes{}, err
 	}
 
@@ -297,18 +398,9 @@ func (lr *labRepo) AparatsFind(req *lab.AparatsFindReq) (*lab.AparatsRes, error)
 		Aparats	: make([]*lab.AparatCreateRes, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-	filter := "WHERE deleted_at IS NULL"
-
-	if req.Search != "" {
-		str := "%" + req.Search + "%"
-		filter += fmt.Sprintf(`
-			AND (type ILIKE '%s' OR name ILIKE '%s' OR price ILIKE '%s')
-				`,
-			str, str, str,
-		)
-	}
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "type", "name", "price::text")
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 		SELECT
@@ -317,13 +409,15 @@ func (lr *labRepo) AparatsFind(req *lab.AparatsFindReq) (*lab.AparatsRes, error)
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 		FROM aparats
-		` + filter + `
+		` + filter.String() + `
 		ORDER BY created_at DESC
 		` + limit
-	rows, err := lr.db.Query(query)
+	rows, err := lr.db.Query(query, args...)
 	if err != nil {
 		return &lab.AparatsRes{}, err
 	}
@@ -337,6 +431,8 @@ func (lr *labRepo) AparatsFind(req *lab.AparatsFindReq) (*lab.AparatsRes, error)
 			&temp.Price,
 			&temp.Type,
 			&temp.SubCategoryId,
+			&temp.DoctorId,
+			&temp.RoomNumber,
 			&temp.CreatedAt,
 			&temp.UpdatedAt,
 		)
@@ -345,8 +441,8 @@ func (lr *labRepo) AparatsFind(req *lab.AparatsFindReq) (*lab.AparatsRes, error)
 		}
 		result.Aparats = append(result.Aparats, &temp)
 	}
-	queryCount := `SELECT COUNT(1) FROM labs ` + filter
-	err = lr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT COUNT(1) FROM aparats ` + filter.String()
+	err = lr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &lab.AparatsRes{}, err
 	}
@@ -354,6 +450,85 @@ func (lr *labRepo) AparatsFind(req *lab.AparatsFindReq) (*lab.AparatsRes, error)
 	return &result, nil
 }
 
+// AparatsGetByIds returns the aparats of the ids in the order of the ids,
+// the ids no aparat was found for are returned as missing.
+func (lr *labRepo) AparatsGetByIds(req *lab.ServiceIds) (*lab.AparatsByIdsResp, error) {
+	result := lab.AparatsByIdsResp{
+		Aparats:    make([]*lab.AparatCreateRes, 0, len(req.Ids)),
+		MissingIds: make([]string, 0),
+	}
+	if len(req.Ids) == 0 {
+		return &result, nil
+	}
+
+	query := `
+		SELECT
+			id,
+			COALESCE(name,'') as name,
+			price,
+			COALESCE(type,'') as type,
+			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
+			created_at,
+			updated_at
+		FROM aparats
+		WHERE id::text = ANY($1) and deleted_at IS NULL
+	`
+	rows, err := lr.db.Query(query, pq.Array(req.Ids))
+	if err != nil {
+		return &lab.AparatsByIdsResp{}, err
+	}
+	defer rows.Close()
+
+	found := make(map[string]*lab.AparatCreateRes, len(req.Ids))
+	for rows.Next() {
+		var temp lab.AparatCreateRes
+		if err := rows.Scan(
+			&temp.Id,
+			&temp.Name,
+			&temp.Price,
+			&temp.Type,
+			&temp.SubCategoryId,
+			&temp.DoctorId,
+			&temp.RoomNumber,
+			&temp.CreatedAt,
+			&temp.UpdatedAt,
+		); err != nil {
+			return &lab.AparatsByIdsResp{}, err
+		}
+		found[temp.Id] = &temp
+	}
+	if err := rows.Err(); err != nil {
+		return &lab.AparatsByIdsResp{}, err
+	}
+
+	for _, id := range uniqueIds(req.Ids) {
+		if temp, ok := found[id]; ok {
+			result.Aparats = append(result.Aparats, temp)
+		} else {
+			result.MissingIds = append(result.MissingIds, id)
+		}
+	}
+
+	return &result, nil
+}
+
+// uniqueIds returns the ids without the repeated ones keeping their order.
+func uniqueIds(ids []string) []string {
+	var (
+		seen   = make(map[string]bool, len(ids))
+		result = make([]string, 0, len(ids))
+	)
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
 func (lr *labRepo) AparatsUpdate(req *lab.AparatUpdateReq) (*lab.AparatCreateRes, error) {
 	var result lab.AparatCreateRes
 	query := `
@@ -363,6 +538,8 @@ func (lr *labRepo) AparatsUpdate(req *lab.AparatUpdateReq) (*lab.AparatCreateRes
 		    name=$1,
 			price=$2,
 			type=$3,
+			doctor_id=NULLIF($5, ''),
+			room_number=$6,
 			updated_at=NOW()
 		WHERE
 			id=$4 AND deleted_at IS NULL
@@ -372,6 +549,8 @@ func (lr *labRepo) AparatsUpdate(req *lab.AparatUpdateReq) (*lab.AparatCreateRes
 			price,
 			COALESCE(type,'') as type,
 			sub_category_id,
+			COALESCE(doctor_id,'') as doctor_id,
+			COALESCE(room_number,'') as room_number,
 			created_at,
 			updated_at
 	`
@@ -380,12 +559,16 @@ func (lr *labRepo) AparatsUpdate(req *lab.AparatUpdateReq) (*lab.AparatCreateRes
 		req.Price,
 		req.Type,
 		req.Id,
+		req.DoctorId,
+		req.RoomNumber,
 	).Scan(
 		&result.Id,
 		&result.Name,
 		&result.Price,
 		&result.Type,
 		&result.SubCategoryId,
+		&result.DoctorId,
+		&result.RoomNumber,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
@@ -452,6 +635,12 @@ func (lr *labRepo) LabCategoryCreate(req *lab.Category) (*lab.CategoryRes, error
 
 func (lr *labRepo) LabCategoryGet(req *lab.CategoryGetReq) (*lab.CategoryRes, error) {
 	var result lab.CategoryRes
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "name")
+	if err != nil {
+		return &lab.CategoryRes{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -459,9 +648,9 @@ func (lr *labRepo) LabCategoryGet(req *lab.CategoryGetReq) (*lab.CategoryRes, er
 			created_at,
 			updated_at
 		FROM lab_category
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.Name,
 		&result.CreatedAt,
@@ -470,7 +659,7 @@ func (lr *labRepo) LabCategoryGet(req *lab.CategoryGetReq) (*lab.CategoryRes, er
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.CategoryRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.CategoryRes{}, err
 	}
 
@@ -482,18 +671,9 @@ func (lr *labRepo) LabCategoryFind(req *lab.CategoryFindReq) (*lab.CategoriesRes
 		Info: make([]*lab.CategoryRes, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-	filter := "WHERE deleted_at IS NULL"
-
-	if req.Search != "" {
-		str := "%" + req.Search + "%"
-		filter += fmt.Sprintf(`
-			AND (name ILIKE '%s')
-				`,
-			str,
-		)
-	}
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "name")
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 		SELECT
@@ -502,10 +682,10 @@ func (lr *labRepo) LabCategoryFind(req *lab.CategoryFindReq) (*lab.CategoriesRes
 			created_at,
 			updated_at
 		FROM lab_category
-		` + filter + `
+		` + filter.String() + `
 		ORDER BY created_at DESC
 		` + limit
-	rows, err := lr.db.Query(query)
+	rows, err := lr.db.Query(query, args...)
 	if err != nil {
 		return &lab.CategoriesRes{}, err
 	}
@@ -524,8 +704,8 @@ func (lr *labRepo) LabCategoryFind(req *lab.CategoryFindReq) (*lab.CategoriesRes
 		}
 		result.Info = append(result.Info, &temp)
 	}
-	queryCount := `SELECT COUNT(1) FROM labs ` + filter
-	err = lr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT COUNT(1) FROM lab_category ` + filter.String()
+	err = lr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &lab.CategoriesRes{}, err
 	}
@@ -620,6 +800,12 @@ func (lr *labRepo) AparatCategoryCreate(req *lab.Category) (*lab.CategoryRes, er
 
 func (lr *labRepo) AparatCategoryGet(req *lab.CategoryGetReq) (*lab.CategoryRes, error) {
 	var result lab.CategoryRes
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "name")
+	if err != nil {
+		return &lab.CategoryRes{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -627,9 +813,9 @@ func (lr *labRepo) AparatCategoryGet(req *lab.CategoryGetReq) (*lab.CategoryRes,
 			created_at,
 			updated_at
 		FROM aparat_category
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.Name,
 		&result.CreatedAt,
@@ -638,7 +824,7 @@ func (lr *labRepo) AparatCategoryGet(req *lab.CategoryGetReq) (*lab.CategoryRes,
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.CategoryRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.CategoryRes{}, err
 	}
 
@@ -650,18 +836,9 @@ func (lr *labRepo) AparatCategoryFind(req *lab.CategoryFindReq) (*lab.Categories
 		Info: make([]*lab.CategoryRes, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-	filter := "WHERE deleted_at IS NULL"
-
-	if req.Search != "" {
-		str := "%" + req.Search + "%"
-		filter += fmt.Sprintf(`
-			AND (name ILIKE '%s')
-				`,
-			str,
-		)
-	}
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "name")
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 		SELECT
@@ -670,10 +847,10 @@ func (lr *labRepo) AparatCategoryFind(req *lab.CategoryFindReq) (*lab.Categories
 			created_at,
 			updated_at
 		FROM aparat_category
-		` + filter + `
+		` + filter.String() + `
 		ORDER BY created_at DESC
 		` + limit
-	rows, err := lr.db.Query(query)
+	rows, err := lr.db.Query(query, args...)
 	if err != nil {
 		return &lab.CategoriesRes{}, err
 	}
@@ -692,8 +869,8 @@ func (lr *labRepo) AparatCategoryFind(req *lab.CategoryFindReq) (*lab.Categories
 		}
 		result.Info = append(result.Info, &temp)
 	}
-	queryCount := `SELECT COUNT(1) FROM aparat_category ` + filter
-	err = lr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT COUNT(1) FROM aparat_category ` + filter.String()
+	err = lr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &lab.CategoriesRes{}, err
 	}
@@ -792,6 +969,12 @@ func (lr *labRepo) LabSubCategoryCreate(req *lab.SubCategory) (*lab.SubCategoryR
 
 func (lr *labRepo) LabSubCategoryGet(req *lab.CategoryGetReq) (*lab.SubCategoryRes, error) {
 	var result lab.SubCategoryRes
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "name", "category_id")
+	if err != nil {
+		return &lab.SubCategoryRes{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -800,9 +983,9 @@ func (lr *labRepo) LabSubCategoryGet(req *lab.CategoryGetReq) (*lab.SubCategoryR
 			created_at,
 			updated_at
 		FROM lab_sub_category
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.Name,
 		&result.CategoryId,
@@ -812,7 +995,7 @@ func (lr *labRepo) LabSubCategoryGet(req *lab.CategoryGetReq) (*lab.SubCategoryR
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.SubCategoryRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.SubCategoryRes{}, err
 	}
 
@@ -824,15 +1007,11 @@ func (lr *labRepo) LabSubCategoryFind(req *lab.SubCategoryFindReq) (*lab.SubCate
 		Info: make([]*lab.SubCategoryRes, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-	filter := "WHERE deleted_at IS NULL"
-
+	filter := sqlfilter.New("deleted_at IS NULL")
 	if req.CategoryId != "" {
-		filter += fmt.Sprintf(`
-			AND (category_id = '%s')`,req.CategoryId,
-		)
+		filter.Where("category_id = ?", req.CategoryId)
 	}
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 		SELECT
@@ -842,11 +1021,11 @@ func (lr *labRepo) LabSubCategoryFind(req *lab.SubCategoryFindReq) (*lab.SubCate
 			created_at,
 			updated_at
 		FROM lab_sub_category
-		` + filter + `
+		` + filter.String() + `
 		ORDER BY created_at DESC
 		` + limit
 
-	rows, err := lr.db.Query(query)
+	rows, err := lr.db.Query(query, args...)
 	if err != nil {
 		return &lab.SubCategoriesRes{}, err
 	}
@@ -866,8 +1045,8 @@ func (lr *labRepo) LabSubCategoryFind(req *lab.SubCategoryFindReq) (*lab.SubCate
 		}
 		result.Info = append(result.Info, &temp)
 	}
-	queryCount := `SELECT COUNT(1) FROM lab_sub_category ` + filter
-	err = lr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT COUNT(1) FROM lab_sub_category ` + filter.String()
+	err = lr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &lab.SubCategoriesRes{}, err
 	}
@@ -967,6 +1146,12 @@ func (lr *labRepo) AparatSubCategoryCreate(req *lab.SubCategory) (*lab.SubCatego
 
 func (lr *labRepo) AparatSubCategoryGet(req *lab.CategoryGetReq) (*lab.SubCategoryRes, error) {
 	var result lab.SubCategoryRes
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "name", "category_id")
+	if err != nil {
+		return &lab.SubCategoryRes{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -975,9 +1160,9 @@ func (lr *labRepo) AparatSubCategoryGet(req *lab.CategoryGetReq) (*lab.SubCatego
 			created_at,
 			updated_at
 		FROM aparat_sub_category
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.Name,
 		&result.CategoryId,
@@ -987,7 +1172,7 @@ func (lr *labRepo) AparatSubCategoryGet(req *lab.CategoryGetReq) (*lab.SubCatego
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.SubCategoryRes{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.SubCategoryRes{}, err
 	}
 
@@ -999,13 +1184,11 @@ func (lr *labRepo) AparatSubCategoryFind(req *lab.SubCategoryFindReq) (*lab.SubC
 		Info: make([]*lab.SubCategoryRes, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-	filter := "WHERE deleted_at IS NULL"
-
+	filter := sqlfilter.New("deleted_at IS NULL")
 	if req.CategoryId != "" {
-		filter += fmt.Sprintf(` AND category_id = '%s' `, req.CategoryId)	
+		filter.Where("category_id = ?", req.CategoryId)
 	}
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 		SELECT
@@ -1015,10 +1198,10 @@ func (lr *labRepo) AparatSubCategoryFind(req *lab.SubCategoryFindReq) (*lab.SubC
 			created_at,
 			updated_at
 		FROM aparat_sub_category
-		` + filter + `
+		` + filter.String() + `
 		ORDER BY created_at DESC
 		` + limit
-	rows, err := lr.db.Query(query)
+	rows, err := lr.db.Query(query, args...)
 	if err != nil {
 		return &lab.SubCategoriesRes{}, err
 	}
@@ -1038,8 +1221,8 @@ func (lr *labRepo) AparatSubCategoryFind(req *lab.SubCategoryFindReq) (*lab.SubC
 		}
 		result.Info = append(result.Info, &temp)
 	}
-	queryCount := `SELECT COUNT(1) FROM aparat_sub_category ` + filter
-	err = lr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT COUNT(1) FROM aparat_sub_category ` + filter.String()
+	err = lr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &lab.SubCategoriesRes{}, err
 	}
@@ -1110,6 +1293,13 @@ func (lr *labRepo) AparatSubCategoryDelete(req *lab.CategoryId) error {
 // Aparat analysis
 func (lr *labRepo) AparatAnalysisCreate(req *lab.AnalysisReq) (*lab.AnalysisResp, error) {
 	var result lab.AnalysisResp
+
+	tx, err := lr.db.Beginx()
+	if err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO aparat_analysis(
 			id,
@@ -1125,7 +1315,7 @@ func (lr *labRepo) AparatAnalysisCreate(req *lab.AnalysisReq) (*lab.AnalysisResp
 			created_at,
 			updated_at
 		`
-	if err := lr.db.DB.QueryRow(query,
+	if err := tx.QueryRow(query,
 		req.Id, req.ClientId, req.AparatId, req.AnalysisUrl,
 	).Scan(
 		&result.Id,
@@ -1138,11 +1328,25 @@ func (lr *labRepo) AparatAnalysisCreate(req *lab.AnalysisReq) (*lab.AnalysisResp
 		return &lab.AnalysisResp{}, err
 	}
 
+	if err := consumptionOutboxAdd(tx, repo.ServiceAparat, req); err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+
 	return &result, nil
 }
 
 func (lr *labRepo) AparatAnalysisGet(req *lab.AnalysisGetReq) (*lab.AnalysisResp, error) {
 	var result lab.AnalysisResp
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "client_id", "aparat_id")
+	if err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -1152,9 +1356,9 @@ func (lr *labRepo) AparatAnalysisGet(req *lab.AnalysisGetReq) (*lab.AnalysisResp
 			created_at,
 			updated_at
 		FROM aparat_analysis
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.ClientId,
 		&result.AparatId,
@@ -1165,7 +1369,7 @@ func (lr *labRepo) AparatAnalysisGet(req *lab.AnalysisGetReq) (*lab.AnalysisResp
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.AnalysisResp{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.AnalysisResp{}, err
 	}
 
@@ -1198,6 +1402,13 @@ func (lr *labRepo) AparatAnalysisDelete(req *lab.AparatId) error {
 // Lab analysis
 func (lr *labRepo) LabAnalysisCreate(req *lab.AnalysisReq) (*lab.AnalysisResp, error) {
 	var result lab.AnalysisResp
+
+	tx, err := lr.db.Beginx()
+	if err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO lab_analysis(
 			id,
@@ -1213,7 +1424,7 @@ func (lr *labRepo) LabAnalysisCreate(req *lab.AnalysisReq) (*lab.AnalysisResp, e
 			created_at,
 			updated_at
 		`
-	if err := lr.db.DB.QueryRow(query,
+	if err := tx.QueryRow(query,
 		req.Id, req.ClientId, req.AparatId, req.AnalysisUrl,
 	).Scan(
 		&result.Id,
@@ -1226,11 +1437,25 @@ func (lr *labRepo) LabAnalysisCreate(req *lab.AnalysisReq) (*lab.AnalysisResp, e
 		return &lab.AnalysisResp{}, err
 	}
 
+	if err := consumptionOutboxAdd(tx, repo.ServiceLab, req); err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+
 	return &result, nil
 }
 
 func (lr *labRepo) LabAnalysisGet(req *lab.AnalysisGetReq) (*lab.AnalysisResp, error) {
 	var result lab.AnalysisResp
+
+	field, err := sqlfilter.Lookup(req.Field, "id", "client_id", "aparat_id")
+	if err != nil {
+		return &lab.AnalysisResp{}, err
+	}
+
 	query := `
 		SELECT 
 			id,
@@ -1240,9 +1465,9 @@ func (lr *labRepo) LabAnalysisGet(req *lab.AnalysisGetReq) (*lab.AnalysisResp, e
 			created_at,
 			updated_at
 		FROM lab_analysis
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
-	err := lr.db.DB.QueryRow(query).Scan(
+	err = lr.db.DB.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.ClientId,
 		&result.AparatId,
@@ -1253,7 +1478,7 @@ func (lr *labRepo) LabAnalysisGet(req *lab.AnalysisGetReq) (*lab.AnalysisResp, e
 	if errors.Is(err, sql.ErrNoRows) {
 		return &lab.AnalysisResp{}, err
 	} else if err != nil {
-		log.Fatalln(err.Error())
+		log.Println(err.Error())
 		return &lab.AnalysisResp{}, err
 	}
 
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"gitlab.com/clinic-crm/labs/genproto/lab"
+)
+
+// testDB connects to the migrated database of TEST_DATABASE_URL, the test is skipped without it.
+func testDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sqlx.Connect("postgres", url)
+	if err != nil {
+		t.Fatalf("connect test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestServiceDoctorAndRoom(t *testing.T) {
+	db := testDB(t)
+	r := NewLab(db)
+
+	var (
+		labId    = uuid.New().String()
+		aparatId = uuid.New().String()
+		doctorId = uuid.New().String()
+	)
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM labs WHERE id = $1`, labId)
+		db.Exec(`DELETE FROM aparats WHERE id = $1`, aparatId)
+	})
+
+	if _, err := r.LabCreate(&lab.LabCreateReq{Id: labId, Name: "Blood test", Price: 50000, Type: "blood",
+		SubCategoryId: uuid.New().String(), DoctorId: doctorId, RoomNumber: "12"}); err != nil {
+		t.Fatalf("LabCreate: %v", err)
+	}
+	if _, err := r.AparatCreate(&lab.AparatCreateReq{Id: aparatId, Name: "X-ray", Price: 80000, Type: "xray",
+		SubCategoryId: uuid.New().String(), DoctorId: doctorId, RoomNumber: "7"}); err != nil {
+		t.Fatalf("AparatCreate: %v", err)
+	}
+
+	labs, err := r.LabsGetByIds(&lab.ServiceIds{Ids: []string{labId}})
+	if err != nil || len(labs.Labs) != 1 {
+		t.Fatalf("LabsGetByIds = %v, %v", labs, err)
+	}
+	if got := labs.Labs[0]; got.DoctorId != doctorId || got.RoomNumber != "12" {
+		t.Errorf("lab doctor, room = %q, %q, want %q, 12", got.DoctorId, got.RoomNumber, doctorId)
+	}
+	aparats, err := r.AparatsGetByIds(&lab.ServiceIds{Ids: []string{aparatId}})
+	if err != nil || len(aparats.Aparats) != 1 {
+		t.Fatalf("AparatsGetByIds = %v, %v", aparats, err)
+	}
+	if got := aparats.Aparats[0]; got.DoctorId != doctorId || got.RoomNumber != "7" {
+		t.Errorf("aparat doctor, room = %q, %q, want %q, 7", got.DoctorId, got.RoomNumber, doctorId)
+	}
+
+	// the reception queue of a lab or aparat shows the room of its get
+	gotLab, err := r.LabGet(&lab.LabGetReq{Field: "id", Value: labId})
+	if err != nil || gotLab.RoomNumber != "12" {
+		t.Errorf("LabGet room = %v, %v, want 12", gotLab, err)
+	}
+	gotAparat, err := r.AparatGet(&lab.AparatGetReq{Field: "id", Value: aparatId})
+	if err != nil || gotAparat.RoomNumber != "7" {
+		t.Errorf("AparatGet room = %v, %v, want 7", gotAparat, err)
+	}
+
+	// a lab moved to another room without a doctor
+	updated, err := r.LabUpdate(&lab.LabUpdateReq{Id: labId, Name: "Blood test", Price: 50000, Type: "blood", RoomNumber: "14"})
+	if err != nil {
+		t.Fatalf("LabUpdate: %v", err)
+	}
+	if updated.DoctorId != "" || updated.RoomNumber != "14" {
+		t.Errorf("updated doctor, room = %q, %q, want empty, 14", updated.DoctorId, updated.RoomNumber)
+	}
+}
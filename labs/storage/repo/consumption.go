@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"time"
+)
+
+// Service types of the analyses, as the bills of materials know them
+const (
+	ServiceAparat = "aparat"
+	ServiceLab    = "lab"
+)
+
+// Consumption is an analysis waiting for its sqlad to be taken off the stock.
+type Consumption struct {
+	AnalysisId  string
+	ServiceType string
+	ServiceId   string
+	ActorId     string
+}
+
+type ConsumptionStorageI interface {
+	// ConsumptionClaim takes up to limit analyses due in the consumption outbox, only the ones
+	// of ids when any are given, and hides them from other claims for lease.
+	ConsumptionClaim(ids []string, limit int, lease time.Duration) ([]*Consumption, error)
+	// ConsumptionDone removes the analysis from the outbox.
+	ConsumptionDone(analysisId string) error
+	// ConsumptionFailed records the error and retries the analysis after retry, doubled on every attempt.
+	ConsumptionFailed(analysisId, reason string, retry time.Duration) error
+}
@@ -9,6 +9,7 @@ type LabStorageI interface {
 	LabCreate(*lab.LabCreateReq) (*lab.LabCreateRes, error)
 	LabGet(*lab.LabGetReq) (*lab.LabCreateRes, error)
 	LabsFind(*lab.LabsFindReq) (*lab.LabsRes, error)
+	LabsGetByIds(*lab.ServiceIds) (*lab.LabsByIdsResp, error)
 	LabUpdate(*lab.LabUpdateReq) (*lab.LabCreateRes, error)
 	LabDelete(*lab.LabId) error
 
@@ -16,6 +17,7 @@ type LabStorageI interface {
 	AparatCreate(*lab.AparatCreateReq) (*lab.AparatCreateRes, error)
 	AparatGet(*lab.AparatGetReq) (*lab.AparatCreateRes, error) 
 	AparatsFind(*lab.AparatsFindReq) (*lab.AparatsRes, error)
+	AparatsGetByIds(*lab.ServiceIds) (*lab.AparatsByIdsResp, error)
 	AparatsUpdate(*lab.AparatUpdateReq) (*lab.AparatCreateRes, error)
 	AparatsDelete(*lab.AparatId) error
 
@@ -9,18 +9,25 @@ import (
 
 type StorageI interface {
 	Lab() repo.LabStorageI
+	Consumption() repo.ConsumptionStorageI
 }
 
 type storagePg struct {
-	labRepo repo.LabStorageI
+	labRepo         repo.LabStorageI
+	consumptionRepo repo.ConsumptionStorageI
 }
 
 func NewStoragePg(db *sqlx.DB) StorageI {
 	return &storagePg{
-		labRepo: postgres.NewLab(db),
+		labRepo:         postgres.NewLab(db),
+		consumptionRepo: postgres.NewConsumption(db),
 	}
 }
 
 func (s *storagePg) Lab() repo.LabStorageI {
 	return s.labRepo
 }
+
+func (s *storagePg) Consumption() repo.ConsumptionStorageI {
+	return s.consumptionRepo
+}
@@ -1,14 +1,20 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	pb "gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/events"
+	"gitlab.com/clinic-crm/reception/pkg/fiscal"
 	"gitlab.com/clinic-crm/reception/pkg/grpc_client"
+	"gitlab.com/clinic-crm/reception/pkg/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
@@ -35,9 +41,38 @@ func main() {
 
 	grpcClient, _ := grpc_client.New(cfg)
 
-	strg := storage.NewStoragePg(psqlConn)
+	queueDay, err := utils.NewQueueDay(cfg.ClinicTimeZone, cfg.QueueDayStart)
+	if err != nil {
+		log.Fatalf("failed to load queue day config: %v", err)
+	}
+
+	strg := storage.NewStoragePg(psqlConn, queueDay.Location())
+
+	queueHub, err := events.NewQueueHub(psqlUrl)
+	if err != nil {
+		log.Fatalf("failed to listen queue events: %v", err)
+	}
+
+	// no provider is assumed, the file provider fiscalizes nothing and is for development only
+	var fiscalProvider fiscal.FiscalProvider
+	switch cfg.FiscalProvider {
+	case "":
+		log.Fatal("FISCAL_PROVIDER is required, file writes the receipts to FISCAL_DIR in development")
+	case "file":
+		fiscalProvider, err = fiscal.NewFileProvider(cfg.FiscalDir)
+		if err != nil {
+			log.Fatalf("failed to create fiscal provider: %v", err)
+		}
+	default:
+		log.Fatalf("unknown fiscal provider %q", cfg.FiscalProvider)
+	}
+
+	patientService := service.NewPatientService(strg, &grpcClient, queueDay, queueHub, cfg.DebtTermDays, fiscalProvider)
+	if err := patientService.CreateDefaultAdmin(cfg.AdminLogin, cfg.AdminPassword); err != nil {
+		log.Fatalf("failed to create default admin: %v", err)
+	}
+	go patientService.FiscalRetry(context.Background(), time.Duration(cfg.FiscalInterval)*time.Second)
 
-	patientService := service.NewPatientService(strg, &grpcClient)
 	lis, err := net.Listen("tcp", ":"+cfg.PatientServicePort)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
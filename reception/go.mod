@@ -4,11 +4,13 @@ go 1.20
 
 require (
 	github.com/golang/protobuf v1.5.3
+	github.com/google/uuid v1.3.1
 	github.com/jmoiron/sqlx v1.3.5
 	github.com/joho/godotenv v1.5.1
 	github.com/lib/pq v1.10.9
 	github.com/spf13/cast v1.5.1
 	go.uber.org/zap v1.26.0
+	golang.org/x/crypto v0.11.0
 	google.golang.org/grpc v1.58.3
 	google.golang.org/protobuf v1.31.0
 )
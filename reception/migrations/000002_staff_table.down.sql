@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "staff_login_idx";
+DROP TABLE IF EXISTS "staff";
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "staff"(
+    "id" UUID NOT NULL PRIMARY KEY,
+    "first_name" VARCHAR(255) NOT NULL,
+    "last_name" VARCHAR(255) NOT NULL,
+    "login" VARCHAR(255) NOT NULL,
+    "password" VARCHAR(255) NOT NULL,
+    "phone_number" VARCHAR(255) DEFAULT '',
+    "role" VARCHAR(64) NOT NULL,
+    "doctor_id" UUID,
+    "refresh_token_id" VARCHAR(255) DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP,
+    CONSTRAINT "staff_role_check" CHECK ("role" IN ('receptionist', 'cashier', 'doctor', 'lab_technician', 'admin'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "staff_login_idx" ON "staff"("login") WHERE "deleted_at" IS NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "queue_counters";
+
+DROP INDEX IF EXISTS "queues_service_day_number_key";
+ALTER TABLE "queues" ALTER COLUMN "queue_number" DROP NOT NULL;
+ALTER TABLE "queues" DROP COLUMN IF EXISTS "queue_day";
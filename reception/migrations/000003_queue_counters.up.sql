@@ -0,0 +1,41 @@
+ALTER TABLE "queues" ADD COLUMN IF NOT EXISTS "queue_day" DATE;
+
+-- existing rows are assigned to the queue days of the service, the days start at QUEUE_DAY_START in
+-- CLINIC_TIME_ZONE. `make migrate-up` passes them as the clinic.queue_day_start and clinic.time_zone
+-- settings, without them the days start at 00:00 in Asia/Tashkent, the defaults of the service.
+UPDATE "queues" SET "queue_day" = DATE(
+    ("created_at" AT TIME ZONE COALESCE(NULLIF(current_setting('clinic.time_zone', true), ''), 'Asia/Tashkent'))
+    - COALESCE(NULLIF(current_setting('clinic.queue_day_start', true), ''), '00:00')::interval
+)
+WHERE "queue_day" IS NULL;
+
+ALTER TABLE "queues" ALTER COLUMN "queue_day" SET NOT NULL;
+ALTER TABLE "queues" ALTER COLUMN "queue_number" SET NOT NULL;
+
+-- the numbers patients were given keep, even the ones the old count + 1 allocation handed out twice,
+-- so a number is unique only on the days after the existing ones
+DO $$
+DECLARE
+    "last_day" DATE;
+BEGIN
+    SELECT MAX("queue_day") INTO "last_day" FROM "queues";
+    EXECUTE format(
+        'CREATE UNIQUE INDEX IF NOT EXISTS "queues_service_day_number_key" ON "queues"("service_id", "service_type", "queue_day", "queue_number") WHERE "queue_day" > %L',
+        COALESCE("last_day", '-infinity'::DATE)
+    );
+END $$;
+
+CREATE TABLE IF NOT EXISTS "queue_counters"(
+    "service_id" UUID NOT NULL,
+    "service_type" VARCHAR(255) NOT NULL,
+    "queue_day" DATE NOT NULL,
+    "last_number" INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY ("service_id", "service_type", "queue_day")
+);
+
+-- the counters go on from the highest number given on each day
+INSERT INTO "queue_counters"("service_id", "service_type", "queue_day", "last_number")
+SELECT "service_id", "service_type", "queue_day", MAX("queue_number")
+FROM "queues"
+GROUP BY "service_id", "service_type", "queue_day"
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS "queues_service_day_status_idx";
+
+ALTER TABLE "queues" DROP CONSTRAINT IF EXISTS "queues_status_check";
+
+ALTER TABLE "queues"
+    DROP COLUMN IF EXISTS "status",
+    DROP COLUMN IF EXISTS "called_at",
+    DROP COLUMN IF EXISTS "in_service_at",
+    DROP COLUMN IF EXISTS "done_at",
+    DROP COLUMN IF EXISTS "skipped_at",
+    DROP COLUMN IF EXISTS "no_show_at",
+    DROP COLUMN IF EXISTS "recalled_at";
@@ -0,0 +1,15 @@
+ALTER TABLE "queues"
+    ADD COLUMN IF NOT EXISTS "status" VARCHAR(32) NOT NULL DEFAULT 'waiting',
+    ADD COLUMN IF NOT EXISTS "called_at" TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS "in_service_at" TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS "done_at" TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS "skipped_at" TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS "no_show_at" TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS "recalled_at" TIMESTAMP WITH TIME ZONE;
+
+UPDATE "queues" SET "status" = 'done', "done_at" = "updated_at" WHERE "turn_passed" = TRUE;
+
+ALTER TABLE "queues" ADD CONSTRAINT "queues_status_check"
+    CHECK ("status" IN ('waiting', 'called', 'in_service', 'done', 'skipped', 'no_show', 'recalled'));
+
+CREATE INDEX IF NOT EXISTS "queues_service_day_status_idx" ON "queues"("service_id", "service_type", "queue_day", "status");
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS "queues_notify_event" ON "queues";
+DROP FUNCTION IF EXISTS "notify_queue_event"();
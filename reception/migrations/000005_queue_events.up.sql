@@ -0,0 +1,10 @@
+CREATE OR REPLACE FUNCTION "notify_queue_event"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('queue_events', NEW."service_type" || ':' || NEW."service_id");
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "queues_notify_event"
+    AFTER INSERT OR UPDATE ON "queues"
+    FOR EACH ROW EXECUTE FUNCTION "notify_queue_event"();
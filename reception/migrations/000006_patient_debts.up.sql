@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "patient_debts"(
+    "id" UUID NOT NULL PRIMARY KEY,
+    "client_id" INTEGER NOT NULL,
+    "cashbox_id" UUID,
+    "initial_amount" INTEGER NOT NULL CHECK ("initial_amount" > 0),
+    "amount" INTEGER NOT NULL CHECK ("amount" >= 0),
+    "term_date" DATE NOT NULL,
+    "paid_at" TIMESTAMP WITH TIME ZONE,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP
+);
+
+-- a cashbox can be left unpaid only once
+CREATE UNIQUE INDEX IF NOT EXISTS "patient_debts_cashbox_id_key"
+    ON "patient_debts"("cashbox_id") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "patient_debts_client_id_idx"
+    ON "patient_debts"("client_id") WHERE "deleted_at" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "patient_debts_term_date_idx"
+    ON "patient_debts"("term_date") WHERE "amount" > 0 AND "deleted_at" IS NULL;
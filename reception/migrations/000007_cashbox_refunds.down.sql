@@ -0,0 +1,17 @@
+UPDATE "queues" SET "status" = 'skipped' WHERE "status" = 'cancelled';
+
+ALTER TABLE "queues" DROP CONSTRAINT IF EXISTS "queues_status_check";
+ALTER TABLE "queues" ADD CONSTRAINT "queues_status_check"
+    CHECK ("status" IN ('waiting', 'called', 'in_service', 'done', 'skipped', 'no_show', 'recalled'));
+
+ALTER TABLE "queues" DROP COLUMN IF EXISTS "cancelled_at";
+
+DROP INDEX IF EXISTS "payment_history_cashbox_id_idx";
+
+ALTER TABLE "payment_history"
+    DROP COLUMN IF EXISTS "refund_reason",
+    DROP COLUMN IF EXISTS "staff_id",
+    DROP COLUMN IF EXISTS "service_type",
+    DROP COLUMN IF EXISTS "service_id";
+
+ALTER TABLE "cashbox" DROP COLUMN IF EXISTS "refunded";
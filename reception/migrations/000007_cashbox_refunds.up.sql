@@ -0,0 +1,16 @@
+ALTER TABLE "cashbox" ADD COLUMN IF NOT EXISTS "refunded" INTEGER NOT NULL DEFAULT 0;
+
+-- refunds are payment_history rows with a negative summa and a reason
+ALTER TABLE "payment_history"
+    ADD COLUMN IF NOT EXISTS "refund_reason" TEXT,
+    ADD COLUMN IF NOT EXISTS "staff_id" UUID,
+    ADD COLUMN IF NOT EXISTS "service_type" VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "service_id" VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS "payment_history_cashbox_id_idx" ON "payment_history"("cashbox_id");
+
+ALTER TABLE "queues" ADD COLUMN IF NOT EXISTS "cancelled_at" TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE "queues" DROP CONSTRAINT IF EXISTS "queues_status_check";
+ALTER TABLE "queues" ADD CONSTRAINT "queues_status_check"
+    CHECK ("status" IN ('waiting', 'called', 'in_service', 'done', 'skipped', 'no_show', 'recalled', 'cancelled'));
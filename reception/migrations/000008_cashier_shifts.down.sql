@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "payment_history_shift_id_idx";
+
+ALTER TABLE "payment_history" DROP COLUMN IF EXISTS "shift_id";
+
+DROP TABLE IF EXISTS "cashier_shifts";
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "cashier_shifts"(
+    "id" UUID PRIMARY KEY,
+    "staff_id" UUID NOT NULL,
+    "opening_cash" INTEGER NOT NULL DEFAULT 0 CHECK ("opening_cash" >= 0),
+    "opened_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "closed_at" TIMESTAMP WITH TIME ZONE,
+    -- Z-report, filled when the shift is closed
+    "counted_cash" INTEGER,
+    "expected_cash" INTEGER,
+    "receipts" INTEGER,
+    "refunds" INTEGER,
+    "refunds_count" INTEGER,
+    "totals" JSONB,
+    "unpaid_cashboxes" JSONB,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- a cashier has at most one open shift
+CREATE UNIQUE INDEX IF NOT EXISTS "cashier_shifts_staff_open_key" ON "cashier_shifts"("staff_id") WHERE "closed_at" IS NULL;
+CREATE INDEX IF NOT EXISTS "cashier_shifts_opened_at_idx" ON "cashier_shifts"("opened_at");
+
+ALTER TABLE "payment_history" ADD COLUMN IF NOT EXISTS "shift_id" UUID REFERENCES "cashier_shifts"("id");
+
+CREATE INDEX IF NOT EXISTS "payment_history_shift_id_idx" ON "payment_history"("shift_id");
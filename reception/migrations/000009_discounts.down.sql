@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "cashbox_discounts";
+
+ALTER TABLE "cashbox"
+    DROP COLUMN IF EXISTS "gross",
+    DROP COLUMN IF EXISTS "discount";
+
+DROP TABLE IF EXISTS "discounts";
@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS "discounts"(
+    "id" UUID PRIMARY KEY,
+    "name" VARCHAR(255) NOT NULL,
+    "kind" VARCHAR(16) NOT NULL CHECK ("kind" IN ('percent', 'fixed')),
+    "value" INTEGER NOT NULL CHECK ("value" > 0),
+    "cap" INTEGER NOT NULL DEFAULT 0 CHECK ("cap" >= 0),
+    -- the conditions, NULL matches any patient, service type and campaign
+    "client_id" INTEGER,
+    "service_type" VARCHAR(255),
+    "advertising_channel" VARCHAR(255),
+    "valid_from" DATE,
+    "valid_to" DATE,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP,
+    CHECK ("kind" <> 'percent' OR "value" <= 100),
+    CHECK ("valid_from" IS NULL OR "valid_to" IS NULL OR "valid_from" <= "valid_to")
+);
+
+CREATE INDEX IF NOT EXISTS "discounts_client_id_idx" ON "discounts"("client_id");
+
+-- summa of the cashbox becomes the net, gross minus discount
+ALTER TABLE "cashbox"
+    ADD COLUMN IF NOT EXISTS "gross" INTEGER,
+    ADD COLUMN IF NOT EXISTS "discount" INTEGER NOT NULL DEFAULT 0;
+
+UPDATE "cashbox" SET "gross" = COALESCE("summa", 0) WHERE "gross" IS NULL;
+
+CREATE TABLE IF NOT EXISTS "cashbox_discounts"(
+    "id" UUID PRIMARY KEY,
+    "cashbox_id" UUID NOT NULL,
+    "discount_id" UUID NOT NULL REFERENCES "discounts"("id"),
+    "name" VARCHAR(255) NOT NULL,
+    "service_type" VARCHAR(255) NOT NULL,
+    "service_id" VARCHAR(255) NOT NULL,
+    "gross" INTEGER NOT NULL,
+    "amount" INTEGER NOT NULL CHECK ("amount" > 0),
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "cashbox_discounts_cashbox_id_idx" ON "cashbox_discounts"("cashbox_id");
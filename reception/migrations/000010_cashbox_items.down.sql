@@ -0,0 +1,39 @@
+ALTER TABLE "cashbox"
+    ADD COLUMN IF NOT EXISTS "doctors_ids" VARCHAR{},
+    ADD COLUMN IF NOT EXISTS "labs_ids" VARCHAR{},
+    ADD COLUMN IF NOT EXISTS "aparats_ids" VARCHAR{};
+
+UPDATE "cashbox" c SET
+    "doctors_ids" = COALESCE(s."doctors_ids", '{}'),
+    "labs_ids" = COALESCE(s."labs_ids", '{}'),
+    "aparats_ids" = COALESCE(s."aparats_ids", '{}')
+FROM (
+    SELECT i."cashbox_id",
+        array_agg(i."service_id" ORDER BY i."position") FILTER (WHERE i."service_type" = 'doctor') AS "doctors_ids",
+        array_agg(i."service_id" ORDER BY i."position") FILTER (WHERE i."service_type" = 'lab') AS "labs_ids",
+        array_agg(i."service_id" ORDER BY i."position") FILTER (WHERE i."service_type" = 'aparat') AS "aparats_ids"
+    FROM "cashbox_items" i, generate_series(1, i."quantity")
+    GROUP BY i."cashbox_id"
+) s
+WHERE c."id" = s."cashbox_id";
+
+CREATE TABLE IF NOT EXISTS "cashbox_discounts"(
+    "id" UUID PRIMARY KEY,
+    "cashbox_id" UUID NOT NULL,
+    "discount_id" UUID NOT NULL REFERENCES "discounts"("id"),
+    "name" VARCHAR(255) NOT NULL,
+    "service_type" VARCHAR(255) NOT NULL,
+    "service_id" VARCHAR(255) NOT NULL,
+    "gross" INTEGER NOT NULL,
+    "amount" INTEGER NOT NULL CHECK ("amount" > 0),
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "cashbox_discounts_cashbox_id_idx" ON "cashbox_discounts"("cashbox_id");
+
+INSERT INTO "cashbox_discounts"("id", "cashbox_id", "discount_id", "name", "service_type", "service_id", "gross", "amount", "created_at")
+SELECT "id", "cashbox_id", "discount_id", "discount_name", "service_type", "service_id", "price", "discount", "created_at"
+FROM "cashbox_items"
+WHERE "discount" > 0 AND "discount_id" IS NOT NULL;
+
+DROP TABLE IF EXISTS "cashbox_items";
@@ -0,0 +1,89 @@
+CREATE TABLE IF NOT EXISTS "cashbox_items"(
+    "id" UUID PRIMARY KEY,
+    "cashbox_id" UUID NOT NULL,
+    "position" INTEGER NOT NULL DEFAULT 0,
+    "service_type" VARCHAR(255) NOT NULL,
+    "service_id" VARCHAR(255) NOT NULL,
+    -- name and unit price of the service when the cashbox was created
+    "name" VARCHAR(255) NOT NULL DEFAULT '',
+    "price" INTEGER NOT NULL DEFAULT 0,
+    "quantity" INTEGER NOT NULL DEFAULT 1 CHECK ("quantity" > 0),
+    "discount" INTEGER NOT NULL DEFAULT 0 CHECK ("discount" >= 0),
+    "discount_id" UUID REFERENCES "discounts"("id"),
+    "discount_name" VARCHAR(255) NOT NULL DEFAULT '',
+    "doctor_id" VARCHAR(255),
+    "refunded_quantity" INTEGER NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK ("refunded_quantity" BETWEEN 0 AND "quantity")
+);
+
+CREATE INDEX IF NOT EXISTS "cashbox_items_cashbox_id_idx" ON "cashbox_items"("cashbox_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "cashbox_items_cashbox_service_key" ON "cashbox_items"("cashbox_id", "service_type", "service_id");
+
+-- one item per distinct service of the arrays, repeated ids are its quantity
+INSERT INTO "cashbox_items"("id", "cashbox_id", "position", "service_type", "service_id", "quantity", "created_at")
+SELECT
+    md5(s."cashbox_id"::text || s."service_type" || s."service_id")::uuid,
+    s."cashbox_id",
+    ROW_NUMBER() OVER (PARTITION BY s."cashbox_id" ORDER BY MIN(s."ord")),
+    s."service_type",
+    s."service_id",
+    count(1),
+    MIN(s."created_at")
+FROM (
+    SELECT c."id" AS "cashbox_id", c."created_at", 'doctor' AS "service_type", d."service_id", d."ord"
+    FROM "cashbox" c, unnest(c."doctors_ids") WITH ORDINALITY AS d("service_id", "ord")
+    UNION ALL
+    SELECT c."id", c."created_at", 'lab', l."service_id", 1000 + l."ord"
+    FROM "cashbox" c, unnest(c."labs_ids") WITH ORDINALITY AS l("service_id", "ord")
+    UNION ALL
+    SELECT c."id", c."created_at", 'aparat', a."service_id", 2000 + a."ord"
+    FROM "cashbox" c, unnest(c."aparats_ids") WITH ORDINALITY AS a("service_id", "ord")
+) s
+WHERE COALESCE(s."service_id", '') <> ''
+GROUP BY s."cashbox_id", s."service_type", s."service_id"
+ON CONFLICT DO NOTHING;
+
+-- the arrays kept no prices, the discounted lines have theirs
+UPDATE "cashbox_items" i SET
+    "price" = d."gross",
+    "discount" = d."amount",
+    "discount_id" = d."discount_id",
+    "discount_name" = d."name"
+FROM (
+    SELECT "cashbox_id", "service_type", "service_id",
+        MAX("gross") AS "gross", SUM("amount") AS "amount",
+        MIN("discount_id"::text)::uuid AS "discount_id", MIN("name") AS "name"
+    FROM "cashbox_discounts"
+    GROUP BY "cashbox_id", "service_type", "service_id"
+) d
+WHERE i."cashbox_id" = d."cashbox_id" AND i."service_type" = d."service_type" AND i."service_id" = d."service_id";
+
+-- the other items share what is left of the gross, a unit of each the same part. The remainder
+-- of the division goes to an item sold once, one without a price first, so the items add up to
+-- the gross; only a cashbox with every item sold more than once can be short of it.
+UPDATE "cashbox_items" i SET "price" = i."price"
+    + CASE WHEN i."price" = 0 THEN s."unit" ELSE 0 END
+    + CASE WHEN s."rn" = 1 THEN s."rest" / i."quantity" ELSE 0 END
+FROM (
+    SELECT z."id", t."left" / t."units" AS "unit", t."left" % t."units" AS "rest",
+        ROW_NUMBER() OVER (PARTITION BY z."cashbox_id" ORDER BY z."quantity" = 1 DESC, z."price" = 0 DESC, z."position" DESC) AS "rn"
+    FROM "cashbox_items" z
+    JOIN (
+        SELECT i."cashbox_id",
+            COALESCE(c."gross", c."summa", 0) - SUM(i."price" * i."quantity") AS "left",
+            SUM(i."quantity") FILTER (WHERE i."price" = 0) AS "units"
+        FROM "cashbox_items" i
+        JOIN "cashbox" c ON c."id" = i."cashbox_id"
+        GROUP BY i."cashbox_id", c."gross", c."summa"
+    ) t ON t."cashbox_id" = z."cashbox_id"
+    WHERE t."units" > 0 AND t."left" > 0
+) s
+WHERE i."id" = s."id" AND (i."price" = 0 OR s."rn" = 1);
+
+DROP TABLE IF EXISTS "cashbox_discounts";
+
+ALTER TABLE "cashbox"
+    DROP COLUMN IF EXISTS "doctors_ids",
+    DROP COLUMN IF EXISTS "labs_ids",
+    DROP COLUMN IF EXISTS "aparats_ids";
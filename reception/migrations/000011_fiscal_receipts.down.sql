@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "fiscal_outbox";
+
+ALTER TABLE "payment_history"
+    DROP COLUMN IF EXISTS "fiscal_receipt_number",
+    DROP COLUMN IF EXISTS "fiscal_sign",
+    DROP COLUMN IF EXISTS "fiscalized_at";
@@ -0,0 +1,15 @@
+ALTER TABLE "payment_history"
+    ADD COLUMN IF NOT EXISTS "fiscal_receipt_number" VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "fiscal_sign" VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "fiscalized_at" TIMESTAMP WITH TIME ZONE;
+
+-- payments waiting to be registered with the fiscal module, written in the tx of the payment
+CREATE TABLE IF NOT EXISTS "fiscal_outbox"(
+    "payment_id" UUID PRIMARY KEY,
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "last_error" TEXT NOT NULL DEFAULT '',
+    "next_attempt_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "fiscal_outbox_next_attempt_at_idx" ON "fiscal_outbox"("next_attempt_at");
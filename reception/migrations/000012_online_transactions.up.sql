@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "online_transactions"(
+    "id" UUID PRIMARY KEY,
+    "provider" VARCHAR(255) NOT NULL,
+    "transaction_id" VARCHAR(255) NOT NULL,
+    "cashbox_id" UUID NOT NULL,
+    "amount" INTEGER NOT NULL CHECK ("amount" > 0),
+    "state" VARCHAR(255) NOT NULL DEFAULT 'created' CHECK ("state" IN ('created', 'performed', 'cancelled')),
+    "payment_id" UUID,
+    "cancel_reason" VARCHAR(255) NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "performed_at" TIMESTAMP WITH TIME ZONE,
+    "cancelled_at" TIMESTAMP WITH TIME ZONE
+);
+
+-- a provider callback repeated for the same transaction finds it instead of paying twice
+CREATE UNIQUE INDEX IF NOT EXISTS "online_transactions_provider_key" ON "online_transactions"("provider", "transaction_id");
+CREATE INDEX IF NOT EXISTS "online_transactions_cashbox_id_idx" ON "online_transactions"("cashbox_id");
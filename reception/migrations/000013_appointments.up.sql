@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "appointments"(
+    "id" UUID PRIMARY KEY,
+    "client_id" INTEGER NOT NULL,
+    "doctor_id" UUID NOT NULL,
+    "starts_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "ends_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    "status" VARCHAR(32) NOT NULL DEFAULT 'booked' CHECK ("status" IN ('booked', 'checked_in', 'cancelled')),
+    "note" TEXT NOT NULL DEFAULT '',
+    "queue_id" UUID,
+    "cancel_reason" VARCHAR(255) NOT NULL DEFAULT '',
+    "created_by" UUID,
+    "created_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    "checked_in_at" TIMESTAMP WITH TIME ZONE,
+    "cancelled_at" TIMESTAMP WITH TIME ZONE,
+    CHECK ("starts_at" < "ends_at")
+);
+
+CREATE INDEX IF NOT EXISTS "appointments_doctor_starts_idx" ON "appointments"("doctor_id", "starts_at") WHERE "status" <> 'cancelled';
+CREATE INDEX IF NOT EXISTS "appointments_client_starts_idx" ON "appointments"("client_id", "starts_at");
@@ -0,0 +1 @@
+-- the prices of the items are backfilled by 000010
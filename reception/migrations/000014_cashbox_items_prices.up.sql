@@ -0,0 +1,2 @@
+-- the prices of the items are backfilled by 000010, the version is kept for the databases
+-- migrated past it
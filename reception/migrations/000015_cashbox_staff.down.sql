@@ -0,0 +1 @@
+ALTER TABLE "cashbox" DROP COLUMN IF EXISTS "staff_id";
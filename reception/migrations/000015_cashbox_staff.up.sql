@@ -0,0 +1,2 @@
+-- staff creating the cashbox, the report of its shift lists its unpaid cashboxes
+ALTER TABLE "cashbox" ADD COLUMN IF NOT EXISTS "staff_id" UUID;
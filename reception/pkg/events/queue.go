@@ -0,0 +1,94 @@
+package events
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// QueueChannel is notified by the queues table trigger with "<service_type>:<service_id>".
+const QueueChannel = "queue_events"
+
+// QueueHub listens to queue change notifications of postgres and fans them out
+// to subscribers of the changed service. Every reception instance has its own
+// hub, so writes made through any instance reach every watcher.
+type QueueHub struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+}
+
+func NewQueueHub(dsn string) (*QueueHub, error) {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Println("queue events listener:", err.Error())
+		}
+	})
+	if err := listener.Listen(QueueChannel); err != nil {
+		return nil, err
+	}
+
+	h := &QueueHub{
+		subs: make(map[string]map[chan struct{}]struct{}),
+	}
+	go h.run(listener)
+
+	return h, nil
+}
+
+// Subscribe returns a channel signalled after every change of the service queue
+// and a func to cancel the subscription.
+func (h *QueueHub) Subscribe(serviceType, serviceId string) (<-chan struct{}, func()) {
+	key := serviceType + ":" + serviceId
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	if h.subs[key] == nil {
+		h.subs[key] = make(map[chan struct{}]struct{})
+	}
+	h.subs[key][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subs[key], ch)
+		if len(h.subs[key]) == 0 {
+			delete(h.subs, key)
+		}
+		h.mu.Unlock()
+	}
+}
+
+func (h *QueueHub) run(listener *pq.Listener) {
+	for {
+		select {
+		case n := <-listener.Notify:
+			// nil after a reconnect, events may be lost so everyone reloads
+			if n == nil {
+				h.signal("")
+				continue
+			}
+			h.signal(n.Extra)
+		case <-time.After(time.Minute):
+			go listener.Ping()
+		}
+	}
+}
+
+func (h *QueueHub) signal(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for k, subs := range h.subs {
+		if key != "" && k != key {
+			continue
+		}
+		for ch := range subs {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
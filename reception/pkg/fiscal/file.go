@@ -0,0 +1,117 @@
+package fiscal
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileProvider is a fake fiscal module for development. It appends the registered
+// receipts to receipts.jsonl in its directory, and is offline while a file named
+// offline exists there, to try the retries of the outbox.
+type FileProvider struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func NewFileProvider(dir string) (*FileProvider, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("fiscal dir %q: %w", dir, err)
+	}
+	return &FileProvider{dir: dir}, nil
+}
+
+type fileRecord struct {
+	Receipt
+	Result
+	RegisteredAt time.Time `json:"registered_at"`
+}
+
+func (p *FileProvider) Register(ctx context.Context, receipt *Receipt) (*Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(p.dir, "offline")); err == nil {
+		return nil, ErrOffline
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	path := filepath.Join(p.dir, "receipts.jsonl")
+
+	// a payment registered before gets its receipt back, like a real module does
+	scan, err := p.scan(path, func(record *fileRecord) bool {
+		return record.PaymentId == receipt.PaymentId
+	})
+	if err != nil {
+		return nil, err
+	}
+	if scan.found != nil {
+		return &scan.found.Result, nil
+	}
+
+	data, err := json.Marshal(receipt)
+	if err != nil {
+		return nil, err
+	}
+	sign := sha256.Sum256(data)
+	record := fileRecord{
+		Receipt: *receipt,
+		Result: Result{
+			ReceiptNumber: fmt.Sprintf("%08d", scan.lines+1),
+			FiscalSign:    hex.EncodeToString(sign[:6]),
+		},
+		RegisteredAt: time.Now(),
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(record); err != nil {
+		return nil, err
+	}
+	return &record.Result, nil
+}
+
+type fileScan struct {
+	lines int
+	found *fileRecord
+}
+
+func (p *FileProvider) scan(path string, match func(*fileRecord) bool) (fileScan, error) {
+	var result fileScan
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+	if err != nil {
+		return result, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var record fileRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return result, err
+		}
+		result.lines++
+		if result.found == nil && match(&record) {
+			result.found = &record
+		}
+	}
+	return result, scanner.Err()
+}
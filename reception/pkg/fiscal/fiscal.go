@@ -0,0 +1,44 @@
+package fiscal
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrOffline is returned by a provider whose fiscal device can not be reached,
+// the receipt is registered again later.
+var ErrOffline = errors.New("fiscal device is offline")
+
+// FiscalProvider registers the cash receipts of payments with the fiscal module
+// of the tax authority.
+type FiscalProvider interface {
+	Register(ctx context.Context, receipt *Receipt) (*Result, error)
+}
+
+type Item struct {
+	Name        string `json:"name"`
+	ServiceType string `json:"service_type"`
+	ServiceId   string `json:"service_id"`
+	Price       int64  `json:"price"`
+	Quantity    int64  `json:"quantity"`
+	Discount    int64  `json:"discount"`
+}
+
+// Receipt is one payment of a cashbox, a refund returns Summa to the patient.
+type Receipt struct {
+	PaymentId   string    `json:"payment_id"`
+	CashboxId   string    `json:"cashbox_id"`
+	ClientId    int64     `json:"client_id"`
+	Summa       int64     `json:"summa"`
+	PaymentType string    `json:"payment_type"`
+	Refund      bool      `json:"refund"`
+	Items       []Item    `json:"items"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Result is what the fiscal module returns for a registered receipt.
+type Result struct {
+	ReceiptNumber string `json:"receipt_number"`
+	FiscalSign    string `json:"fiscal_sign"`
+}
@@ -0,0 +1,145 @@
+package sqlfilter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// hostile are request values trying to get into the SQL text.
+var hostile = []string{
+	`x' OR '1'='1`,
+	`'; DROP TABLE doctors; --`,
+	`") UNION SELECT login, password FROM staff --`,
+	`$1`,
+	`?`,
+}
+
+func TestWhere(t *testing.T) {
+	for _, value := range hostile {
+		t.Run(value, func(t *testing.T) {
+			f := New("deleted_at IS NULL").
+				Where("name = ?", value).
+				Where("price > ? AND price < ?", 10, 20)
+
+			if want := " WHERE deleted_at IS NULL AND name = $1 AND price > $2 AND price < $3"; f.String() != want {
+				t.Errorf("String() = %q, want %q", f.String(), want)
+			}
+			if want := []interface{}{value, 10, 20}; !reflect.DeepEqual(f.Args(), want) {
+				t.Errorf("Args() = %v, want %v", f.Args(), want)
+			}
+		})
+	}
+}
+
+func TestWhereEmpty(t *testing.T) {
+	if s := New().String(); s != "" {
+		t.Errorf("String() = %q, want empty", s)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		search   string
+		columns  []string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{"empty", "", []string{"name"}, " WHERE id = $1", []interface{}{"1"}},
+		{"no columns", "abc", nil, " WHERE id = $1", []interface{}{"1"}},
+		{"one column", "abc", []string{"name"}, " WHERE id = $1 AND (name ILIKE $2)", []interface{}{"1", "%abc%"}},
+		{"columns share the arg", "abc", []string{"name", "phone"}, " WHERE id = $1 AND (name ILIKE $2 OR phone ILIKE $2)", []interface{}{"1", "%abc%"}},
+		{"quote", `x' OR '1'='1`, []string{"name"}, " WHERE id = $1 AND (name ILIKE $2)", []interface{}{"1", `%x' OR '1'='1%`}},
+		{"statement", `'; DROP TABLE doctors; --`, []string{"name"}, " WHERE id = $1 AND (name ILIKE $2)", []interface{}{"1", `%'; DROP TABLE doctors; --%`}},
+		{"wildcards", `%_`, []string{"name"}, " WHERE id = $1 AND (name ILIKE $2)", []interface{}{"1", `%\%\_%`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := New().Where("id = ?", "1").Search(tt.search, tt.columns...)
+			if f.String() != tt.wantSQL {
+				t.Errorf("String() = %q, want %q", f.String(), tt.wantSQL)
+			}
+			if !reflect.DeepEqual(f.Args(), tt.wantArgs) {
+				t.Errorf("Args() = %v, want %v", f.Args(), tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"abc", "%abc%"},
+		{"", "%%"},
+		{"50%", `%50\%%`},
+		{"a_b", `%a\_b%`},
+		{`a\b`, `%a\\b%`},
+		{`\%`, `%\\\%%`},
+		{"' OR 1=1 --", "%' OR 1=1 --%"},
+	}
+	for _, tt := range tests {
+		if got := Contains(tt.in); got != tt.want {
+			t.Errorf("Contains(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	allowed := []string{"id", "name", "phone_number"}
+
+	for _, field := range allowed {
+		column, err := Lookup(field, allowed...)
+		if err != nil || column != field {
+			t.Errorf("Lookup(%q) = %q, %v, want %q", field, column, err, field)
+		}
+	}
+
+	rejected := []string{
+		"",
+		"NAME",
+		" name",
+		"name ",
+		"name--",
+		"name; DROP TABLE doctors",
+		"name::text = name OR 1=1 --",
+		"(SELECT password FROM staff LIMIT 1)",
+		"id) OR (1=1",
+	}
+	for _, field := range rejected {
+		column, err := Lookup(field, allowed...)
+		if !errors.Is(err, ErrUnknownField) || column != "" {
+			t.Errorf("Lookup(%q) = %q, %v, want ErrUnknownField", field, column, err)
+		}
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit, page int64
+		wantSQL     string
+		wantArgs    []interface{}
+	}{
+		{"first", 10, 1, " LIMIT $2 OFFSET $3", []interface{}{"x", int64(10), int64(0)}},
+		{"third", 10, 3, " LIMIT $2 OFFSET $3", []interface{}{"x", int64(10), int64(20)}},
+		{"zero page is first", 5, 0, " LIMIT $2 OFFSET $3", []interface{}{"x", int64(5), int64(0)}},
+		{"negative page is first", 5, -7, " LIMIT $2 OFFSET $3", []interface{}{"x", int64(5), int64(0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := New().Where("name = ?", "x")
+			sql, args := f.Page(tt.limit, tt.page)
+			if sql != tt.wantSQL {
+				t.Errorf("Page() sql = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("Page() args = %v, want %v", args, tt.wantArgs)
+			}
+			if want := []interface{}{"x"}; !reflect.DeepEqual(f.Args(), want) {
+				t.Errorf("Args() after Page = %v, want %v", f.Args(), want)
+			}
+		})
+	}
+}
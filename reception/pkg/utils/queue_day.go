@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// QueueDay maps a moment to the clinic working day its queue numbers belong to.
+// The day starts at dayStart (HH:MM) in the clinic time zone, not at UTC midnight.
+type QueueDay struct {
+	location *time.Location
+	dayStart time.Duration
+}
+
+func NewQueueDay(timeZone, dayStart string) (*QueueDay, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("clinic time zone %q: %w", timeZone, err)
+	}
+
+	start, err := time.Parse("15:04", dayStart)
+	if err != nil {
+		return nil, fmt.Errorf("queue day start %q: %w", dayStart, err)
+	}
+
+	return &QueueDay{
+		location: location,
+		dayStart: time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+	}, nil
+}
+
+// Of returns the queue day of t formatted as a DATE.
+func (q *QueueDay) Of(t time.Time) string {
+	return t.In(q.location).Add(-q.dayStart).Format("2006-01-02")
+}
+
+// After returns the clinic day that is days after the day of t, formatted as a DATE.
+func (q *QueueDay) After(t time.Time, days int) string {
+	return t.In(q.location).Add(-q.dayStart).AddDate(0, 0, days).Format("2006-01-02")
+}
+
+// Location returns the clinic time zone.
+func (q *QueueDay) Location() *time.Location {
+	return q.location
+}
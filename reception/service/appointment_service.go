@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/genproto/doctor"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// appointmentError maps storage errors of appointments to grpc statuses.
+func appointmentError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this appointment")
+	}
+	if errors.Is(err, repo.ErrAppointmentConflict) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.Is(err, repo.ErrAppointmentStatus) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "something went wrong, please check appointment info")
+}
+
+// doctorSlots returns the time slots of the doctor on the clinic day.
+func (s *PatientService) doctorSlots(ctx context.Context, doctorId, date string) (*doctor.DoctorSlots, error) {
+	slots, err := s.service.DoctorService().DoctorSlotsGet(ctx, &doctor.DoctorSlotsReq{
+		DoctorId: doctorId,
+		Date:     date,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, status.Error(codes.NotFound, "doctor not found: "+doctorId)
+		case codes.InvalidArgument, codes.DeadlineExceeded, codes.Canceled:
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "something went wrong, please check doctor info")
+	}
+	return slots, nil
+}
+
+// doctorAvailable refuses patients for a doctor who is absent at the clinic time or whose
+// working day is already over, naming the substitute when one is set.
+func (s *PatientService) doctorAvailable(ctx context.Context, doctorId string, at time.Time) error {
+	availability, err := s.service.DoctorService().DoctorAvailabilityGet(ctx, &doctor.DoctorAvailabilityReq{
+		DoctorId: doctorId,
+		At:       at.In(s.queueDay.Location()).Format(repo.AppointmentTime),
+	})
+	if err != nil {
+		log.Println(err.Error())
+		switch status.Code(err) {
+		case codes.NotFound:
+			return status.Error(codes.NotFound, "doctor not found: "+doctorId)
+		case codes.InvalidArgument, codes.DeadlineExceeded, codes.Canceled:
+			return err
+		}
+		return status.Error(codes.Internal, "something went wrong, please check doctor info")
+	}
+	if availability.Available || availability.NextStart != "" {
+		return nil
+	}
+
+	message := "doctor is not available: " + availability.Reason
+	if sub := availability.Substitute; sub != nil {
+		message += ", substitute is " + sub.FirstName + " " + sub.LastName + " (" + sub.Id + ")"
+	}
+	return status.Error(codes.FailedPrecondition, message)
+}
+
+// appointmentSlot checks the appointment starts at a future slot of the doctor's working
+// hours and returns when the slot ends.
+func (s *PatientService) appointmentSlot(ctx context.Context, doctorId, startsAt string) (time.Time, time.Time, error) {
+	location := s.queueDay.Location()
+
+	start, err := time.ParseInLocation(repo.AppointmentTime, startsAt, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "starts_at must be like "+repo.AppointmentTime)
+	}
+	if !start.After(time.Now()) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "starts_at is in the past")
+	}
+
+	date := start.Format("2006-01-02")
+	slots, err := s.doctorSlots(ctx, doctorId, date)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	for _, slot := range slots.Slots {
+		if slot.StartTime != start.Format("15:04") {
+			continue
+		}
+		end, err := time.ParseInLocation(repo.AppointmentTime, date+" "+slot.EndTime, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, status.Error(codes.Internal, "something went wrong, please check doctor working hours")
+		}
+		return start, end, nil
+	}
+
+	return time.Time{}, time.Time{}, status.Error(codes.FailedPrecondition, "starts_at is not a slot of the doctor's working hours")
+}
+
+func (s *PatientService) AppointmentCreate(ctx context.Context, req *patient.Appointment) (*patient.Appointment, error) {
+	if req.ClientId <= 0 || req.DoctorId == "" {
+		return &patient.Appointment{}, status.Error(codes.InvalidArgument, "client_id and doctor_id are required")
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	startsAt, endsAt, err := s.appointmentSlot(ctx, req.DoctorId, req.StartsAt)
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	resp, err := s.storage.Appointment().AppointmentCreate(req, startsAt, endsAt)
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatientService) AppointmentGet(ctx context.Context, req *patient.AppointmentId) (*patient.Appointment, error) {
+	resp, err := s.storage.Appointment().AppointmentGet(req.Id)
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatientService) AppointmentsFind(ctx context.Context, req *patient.AppointmentsFindReq) (*patient.AppointmentsResp, error) {
+	for _, date := range []string{req.FromDate, req.ToDate} {
+		if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+			return &patient.AppointmentsResp{}, status.Error(codes.InvalidArgument, "from_date and to_date must be like 2006-01-02")
+		}
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	resp, err := s.storage.Appointment().AppointmentsFind(req)
+	if err != nil {
+		return &patient.AppointmentsResp{}, appointmentError(err)
+	}
+
+	return resp, nil
+}
+
+// AppointmentReschedule moves the booked appointment to another slot of its doctor.
+func (s *PatientService) AppointmentReschedule(ctx context.Context, req *patient.AppointmentRescheduleReq) (*patient.Appointment, error) {
+	appointment, err := s.storage.Appointment().AppointmentGet(req.Id)
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+
+	startsAt, endsAt, err := s.appointmentSlot(ctx, appointment.DoctorId, req.StartsAt)
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	resp, err := s.storage.Appointment().AppointmentReschedule(req.Id, startsAt, endsAt)
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatientService) AppointmentCancel(ctx context.Context, req *patient.AppointmentCancelReq) (*patient.Appointment, error) {
+	resp, err := s.storage.Appointment().AppointmentCancel(req)
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+
+	return resp, nil
+}
+
+// AppointmentCheckIn puts the patient of today's appointment into the queue of the doctor.
+func (s *PatientService) AppointmentCheckIn(ctx context.Context, req *patient.AppointmentId) (*patient.Appointment, error) {
+	appointment, err := s.storage.Appointment().AppointmentGet(req.Id)
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+
+	startsAt, err := time.ParseInLocation(repo.AppointmentTime, appointment.StartsAt, s.queueDay.Location())
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+	queueDay := s.queueDay.Of(time.Now())
+	if s.queueDay.Of(startsAt) != queueDay {
+		return &patient.Appointment{}, status.Error(codes.FailedPrecondition, "appointment is not today, it is on "+appointment.StartsAt)
+	}
+	if err := s.doctorAvailable(ctx, appointment.DoctorId, startsAt); err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	resp, err := s.storage.Appointment().AppointmentCheckIn(req.Id, uuid.New().String(), queueDay)
+	if err != nil {
+		return &patient.Appointment{}, appointmentError(err)
+	}
+
+	return resp, nil
+}
+
+// AppointmentSlots returns the slots of the doctor on the date with the booked ones marked.
+func (s *PatientService) AppointmentSlots(ctx context.Context, req *patient.AppointmentSlotsReq) (*patient.AppointmentSlotsResp, error) {
+	location := s.queueDay.Location()
+
+	day, err := time.ParseInLocation("2006-01-02", req.Date, location)
+	if err != nil {
+		return &patient.AppointmentSlotsResp{}, status.Error(codes.InvalidArgument, "date must be like 2006-01-02")
+	}
+
+	slots, err := s.doctorSlots(ctx, req.DoctorId, req.Date)
+	if err != nil {
+		return &patient.AppointmentSlotsResp{}, err
+	}
+
+	appointments, err := s.storage.Appointment().DoctorAppointments(req.DoctorId, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return &patient.AppointmentSlotsResp{}, appointmentError(err)
+	}
+
+	result := patient.AppointmentSlotsResp{
+		DoctorId:    req.DoctorId,
+		Date:        req.Date,
+		SlotMinutes: slots.SlotMinutes,
+		Slots:       make([]*patient.AppointmentSlot, 0, len(slots.Slots)),
+	}
+	now := time.Now()
+	for _, slot := range slots.Slots {
+		start, err := time.ParseInLocation(repo.AppointmentTime, req.Date+" "+slot.StartTime, location)
+		if err != nil {
+			return &patient.AppointmentSlotsResp{}, appointmentError(err)
+		}
+		end, err := time.ParseInLocation(repo.AppointmentTime, req.Date+" "+slot.EndTime, location)
+		if err != nil {
+			return &patient.AppointmentSlotsResp{}, appointmentError(err)
+		}
+
+		temp := &patient.AppointmentSlot{
+			StartsAt: start.Format(repo.AppointmentTime),
+			EndsAt:   end.Format(repo.AppointmentTime),
+			Free:     start.After(now),
+		}
+		for _, appointment := range appointments {
+			booked, _ := time.ParseInLocation(repo.AppointmentTime, appointment.StartsAt, location)
+			ends, _ := time.ParseInLocation(repo.AppointmentTime, appointment.EndsAt, location)
+			if booked.Before(end) && start.Before(ends) {
+				temp.Free, temp.AppointmentId = false, appointment.Id
+				break
+			}
+		}
+		result.Slots = append(result.Slots, temp)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// debtError maps storage errors of the debt section to grpc statuses.
+func debtError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this patient")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check debt info")
+}
+
+func (s *PatientService) PatientDebtCreate(ctx context.Context, req *patient.PatientDebtCreateReq) (*patient.PatientDebt, error) {
+	if req.Amount <= 0 {
+		return &patient.PatientDebt{}, status.Error(codes.InvalidArgument, "debt amount must be positive")
+	}
+	if _, err := time.Parse("2006-01-02", req.TermDate); err != nil {
+		return &patient.PatientDebt{}, status.Error(codes.InvalidArgument, "term_date must be a date like 2006-01-02")
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Debt().PatientDebtCreate(req)
+	if err != nil {
+		return &patient.PatientDebt{}, debtError(err)
+	}
+
+	return resp, nil
+}
+
+// PatientsDebtInfo returns the not paid debts of the client and their total.
+func (s *PatientService) PatientsDebtInfo(ctx context.Context, req *patient.PatientId) (*patient.PatientDebtInfoResp, error) {
+	debts, err := s.storage.Debt().PatientDebts(req.ClientId)
+	if err != nil {
+		return &patient.PatientDebtInfoResp{}, debtError(err)
+	}
+
+	resp := patient.PatientDebtInfoResp{
+		ClientId: strconv.FormatInt(req.ClientId, 10),
+		Debts:    debts,
+	}
+	for _, debt := range debts {
+		resp.Amount += debt.Amount
+	}
+
+	return &resp, nil
+}
+
+// PatientDebtsOverdue lists not paid debts whose term is before req.Date, today by default.
+func (s *PatientService) PatientDebtsOverdue(ctx context.Context, req *patient.PatientDebtsOverdueReq) (*patient.PatientDebtsResp, error) {
+	if req.Date == "" {
+		req.Date = s.queueDay.Of(time.Now())
+	} else if _, err := time.Parse("2006-01-02", req.Date); err != nil {
+		return &patient.PatientDebtsResp{}, status.Error(codes.InvalidArgument, "date must be a date like 2006-01-02")
+	}
+
+	resp, err := s.storage.Debt().PatientDebtsOverdue(req)
+	if err != nil {
+		return &patient.PatientDebtsResp{}, debtError(err)
+	}
+
+	return resp, nil
+}
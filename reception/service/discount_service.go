@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// discountError maps storage errors of the discount section to grpc statuses.
+func discountError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this discount")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check discount info")
+}
+
+func discountValidate(req *patient.Discount) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return status.Error(codes.InvalidArgument, "discount name is required")
+	}
+	switch req.Kind {
+	case repo.DiscountPercent:
+		if req.Value <= 0 || req.Value > 100 {
+			return status.Error(codes.InvalidArgument, "percent discount value must be from 1 to 100")
+		}
+	case repo.DiscountFixed:
+		if req.Value <= 0 {
+			return status.Error(codes.InvalidArgument, "fixed discount value must be positive")
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "discount kind must be percent or fixed")
+	}
+	if req.Cap < 0 {
+		return status.Error(codes.InvalidArgument, "discount cap can not be negative")
+	}
+	switch req.ServiceType {
+	case "", repo.ServiceDoctor, repo.ServiceLab, repo.ServiceAparat:
+	default:
+		return status.Error(codes.InvalidArgument, "service_type must be doctor, lab or aparat")
+	}
+	for _, date := range []string{req.ValidFrom, req.ValidTo} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return status.Error(codes.InvalidArgument, "valid_from and valid_to must be dates like 2006-01-02")
+		}
+	}
+	if req.ValidFrom != "" && req.ValidTo != "" && req.ValidFrom > req.ValidTo {
+		return status.Error(codes.InvalidArgument, "valid_from must not be after valid_to")
+	}
+	return nil
+}
+
+func (s *PatientService) DiscountCreate(ctx context.Context, req *patient.Discount) (*patient.Discount, error) {
+	if err := discountValidate(req); err != nil {
+		return &patient.Discount{}, err
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Discount().DiscountCreate(req)
+	if err != nil {
+		return &patient.Discount{}, discountError(err)
+	}
+	return resp, nil
+}
+
+func (s *PatientService) DiscountGet(ctx context.Context, req *patient.DiscountId) (*patient.Discount, error) {
+	resp, err := s.storage.Discount().DiscountGet(req)
+	if err != nil {
+		return &patient.Discount{}, discountError(err)
+	}
+	return resp, nil
+}
+
+func (s *PatientService) DiscountsFind(ctx context.Context, req *patient.DiscountsFindReq) (*patient.DiscountsResp, error) {
+	if req.Date != "" {
+		if _, err := time.Parse("2006-01-02", req.Date); err != nil {
+			return &patient.DiscountsResp{}, status.Error(codes.InvalidArgument, "date must be a date like 2006-01-02")
+		}
+	}
+
+	resp, err := s.storage.Discount().DiscountsFind(req)
+	if err != nil {
+		return &patient.DiscountsResp{}, discountError(err)
+	}
+	return resp, nil
+}
+
+func (s *PatientService) DiscountUpdate(ctx context.Context, req *patient.Discount) (*patient.Discount, error) {
+	if err := discountValidate(req); err != nil {
+		return &patient.Discount{}, err
+	}
+
+	resp, err := s.storage.Discount().DiscountUpdate(req)
+	if err != nil {
+		return &patient.Discount{}, discountError(err)
+	}
+	return resp, nil
+}
+
+func (s *PatientService) DiscountDelete(ctx context.Context, req *patient.DiscountId) (*empty.Empty, error) {
+	if err := s.storage.Discount().DiscountDelete(req); err != nil {
+		return &emptypb.Empty{}, discountError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// cashboxLine is one doctor, lab or aparat service billed in a cashbox.
+type cashboxLine struct {
+	serviceType string
+	serviceId   string
+	price       int64
+}
+
+// cashboxItems are the items of a new cashbox, a service billed again adds to the quantity of its item.
+type cashboxItems []*patient.CashboxItem
+
+func (items *cashboxItems) add(item *patient.CashboxItem) {
+	for _, existing := range *items {
+		if existing.ServiceType == item.ServiceType && existing.ServiceId == item.ServiceId {
+			existing.Quantity++
+			return
+		}
+	}
+	item.Quantity = 1
+	*items = append(*items, item)
+}
+
+// discount adds the discount of a line to its item, the item is named after its first discount.
+func (items cashboxItems) discount(line *patient.CashboxDiscount) {
+	for _, item := range items {
+		if item.ServiceType == line.ServiceType && item.ServiceId == line.ServiceId {
+			if item.DiscountId == "" {
+				item.DiscountId, item.DiscountName = line.DiscountId, line.Name
+			}
+			item.Discount += line.Amount
+			return
+		}
+	}
+}
+
+// discountApply returns the discount of every cashbox line that has one. A line gets
+// the matching discount that takes the most off it, discounts are not summed, and a
+// discount takes off at most its cap over the whole cashbox.
+func discountApply(lines []cashboxLine, discounts []*patient.Discount) []*patient.CashboxDiscount {
+	var (
+		used   = make(map[string]int64)
+		result = make([]*patient.CashboxDiscount, 0)
+	)
+	for _, line := range lines {
+		var (
+			best       *patient.Discount
+			bestAmount int64
+		)
+		for _, discount := range discounts {
+			if discount.ServiceType != "" && discount.ServiceType != line.serviceType {
+				continue
+			}
+
+			amount := discount.Value
+			if discount.Kind == repo.DiscountPercent {
+				amount = line.price * discount.Value / 100
+			}
+			if amount > line.price {
+				amount = line.price
+			}
+			if discount.Cap > 0 && amount > discount.Cap-used[discount.Id] {
+				amount = discount.Cap - used[discount.Id]
+			}
+
+			if amount > bestAmount {
+				best, bestAmount = discount, amount
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		used[best.Id] += bestAmount
+		result = append(result, &patient.CashboxDiscount{
+			DiscountId:  best.Id,
+			Name:        best.Name,
+			ServiceType: line.serviceType,
+			ServiceId:   line.serviceId,
+			Gross:       line.price,
+			Amount:      bestAmount,
+		})
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"testing"
+
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+func TestDiscountApply(t *testing.T) {
+	var (
+		tenPercent = &patient.Discount{Id: "p10", Kind: repo.DiscountPercent, Value: 10}
+		fixed15k   = &patient.Discount{Id: "f15", Kind: repo.DiscountFixed, Value: 15000}
+		fixed3k    = &patient.Discount{Id: "f3", Kind: repo.DiscountFixed, Value: 3000}
+		capped     = &patient.Discount{Id: "f30", Kind: repo.DiscountFixed, Value: 30000, Cap: 40000}
+		cappedPct  = &patient.Discount{Id: "p10c", Kind: repo.DiscountPercent, Value: 10, Cap: 5000}
+		labsOnly   = &patient.Discount{Id: "lab", Kind: repo.DiscountPercent, Value: 50, ServiceType: repo.ServiceLab}
+	)
+	doctor := func(id string, price int64) cashboxLine {
+		return cashboxLine{serviceType: repo.ServiceDoctor, serviceId: id, price: price}
+	}
+	lab := func(id string, price int64) cashboxLine {
+		return cashboxLine{serviceType: repo.ServiceLab, serviceId: id, price: price}
+	}
+
+	type applied struct {
+		discountId, serviceId string
+		amount                int64
+	}
+	tests := []struct {
+		name      string
+		lines     []cashboxLine
+		discounts []*patient.Discount
+		want      []applied
+	}{
+		{"no discounts", []cashboxLine{doctor("d1", 100000)}, nil, []applied{}},
+		{"percent", []cashboxLine{doctor("d1", 100000)}, []*patient.Discount{tenPercent},
+			[]applied{{"p10", "d1", 10000}}},
+		{"the discount taking the most, not stacked", []cashboxLine{doctor("d1", 100000)}, []*patient.Discount{tenPercent, fixed15k},
+			[]applied{{"f15", "d1", 15000}}},
+		{"percent taking the most of a dear line", []cashboxLine{doctor("d1", 200000)}, []*patient.Discount{fixed15k, tenPercent},
+			[]applied{{"p10", "d1", 20000}}},
+		{"fixed not above the price", []cashboxLine{doctor("d1", 10000)}, []*patient.Discount{fixed15k},
+			[]applied{{"f15", "d1", 10000}}},
+		{"cap over the cashbox", []cashboxLine{doctor("d1", 50000), doctor("d2", 50000), doctor("d3", 50000)}, []*patient.Discount{capped},
+			[]applied{{"f30", "d1", 30000}, {"f30", "d2", 10000}}},
+		{"another discount once the cap is used", []cashboxLine{doctor("d1", 100000), doctor("d2", 100000)}, []*patient.Discount{cappedPct, fixed3k},
+			[]applied{{"p10c", "d1", 5000}, {"f3", "d2", 3000}}},
+		{"service type", []cashboxLine{doctor("d1", 100000), lab("l1", 40000)}, []*patient.Discount{labsOnly},
+			[]applied{{"lab", "l1", 20000}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]applied, 0)
+			for _, discount := range discountApply(tt.lines, tt.discounts) {
+				got = append(got, applied{discount.DiscountId, discount.ServiceId, discount.Amount})
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("discountApply = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("discountApply = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	// payments registered in one pass of the outbox
+	fiscalBatch = 50
+	// how long a claimed payment is hidden from the other passes
+	fiscalLease = time.Minute
+	// first retry after a failure, doubled on every next one
+	fiscalRetry = 30 * time.Second
+	// a payment waits for the fiscal module this long, then it is left to the outbox
+	fiscalTimeout = 5 * time.Second
+)
+
+// fiscalize registers the payments with the fiscal module right after they are recorded.
+// The ones that fail stay in the outbox for FiscalRetry, the payment itself is not undone.
+func (s *PatientService) fiscalize(ctx context.Context, paymentIds ...string) {
+	if len(paymentIds) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, fiscalTimeout)
+	defer cancel()
+
+	s.fiscalRegister(ctx, paymentIds)
+}
+
+// FiscalRetry registers the payments left in the outbox every interval until ctx is done.
+func (s *PatientService) FiscalRetry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for s.fiscalRegister(ctx, nil) == fiscalBatch {
+		}
+	}
+}
+
+// fiscalRegister claims the due payments of the outbox, all of them when paymentIds is nil,
+// and registers them. It returns how many were claimed.
+func (s *PatientService) fiscalRegister(ctx context.Context, paymentIds []string) int {
+	receipts, err := s.storage.Fiscal().FiscalClaim(paymentIds, fiscalBatch, fiscalLease)
+	if err != nil {
+		log.Println("fiscal outbox:", err.Error())
+		return 0
+	}
+
+	for _, receipt := range receipts {
+		result, err := s.fiscal.Register(ctx, receipt)
+		if err != nil {
+			log.Println("fiscal register", receipt.PaymentId+":", err.Error())
+			if err := s.storage.Fiscal().FiscalFailed(receipt.PaymentId, err.Error(), fiscalRetry); err != nil {
+				log.Println("fiscal outbox:", err.Error())
+			}
+			continue
+		}
+
+		if err := s.storage.Fiscal().FiscalDone(receipt.PaymentId, result); err != nil {
+			// the lease runs out and the provider gives the same receipt back for the payment
+			log.Println("fiscal outbox:", err.Error())
+		}
+	}
+
+	return len(receipts)
+}
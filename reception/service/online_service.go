@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// onlineError maps storage errors of online transactions to grpc statuses.
+func onlineError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this online transaction")
+	}
+	if errors.Is(err, repo.ErrOverpayment) || errors.Is(err, repo.ErrOnlineState) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, repo.ErrOnlineMismatch) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return status.Error(codes.Internal, "something went wrong, please check online transaction info")
+}
+
+func onlineValidate(req *patient.OnlineTransactionReq) error {
+	if req.Provider == "" || req.TransactionId == "" {
+		return status.Error(codes.InvalidArgument, "provider and transaction_id are required")
+	}
+	return nil
+}
+
+// OnlineTransactionCheck is asked by the provider before the patient pays, it holds
+// the amount of the cashbox for the transaction.
+func (s *PatientService) OnlineTransactionCheck(ctx context.Context, req *patient.OnlineTransactionReq) (*patient.OnlineTransaction, error) {
+	if err := onlineValidate(req); err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	if req.CashboxId == "" || req.Amount <= 0 {
+		return &patient.OnlineTransaction{}, status.Error(codes.InvalidArgument, "cashbox_id and a positive amount are required")
+	}
+
+	resp, err := s.storage.Online().OnlineCheck(req)
+	if err != nil {
+		return &patient.OnlineTransaction{}, onlineError(err)
+	}
+	return resp, nil
+}
+
+// OnlineTransactionPerform records the payment once the provider took the money.
+func (s *PatientService) OnlineTransactionPerform(ctx context.Context, req *patient.OnlineTransactionReq) (*patient.OnlineTransaction, error) {
+	if err := onlineValidate(req); err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	resp, err := s.storage.Online().OnlinePerform(req, uuid.New().String())
+	if err != nil {
+		return &patient.OnlineTransaction{}, onlineError(err)
+	}
+
+	s.fiscalize(ctx, resp.PaymentId)
+	return resp, nil
+}
+
+// OnlineTransactionCancel cancels the transaction, the payment of a performed one is paid back.
+func (s *PatientService) OnlineTransactionCancel(ctx context.Context, req *patient.OnlineTransactionReq) (*patient.OnlineTransaction, error) {
+	if err := onlineValidate(req); err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	if req.Reason == "" {
+		req.Reason = "cancelled by " + req.Provider
+	}
+
+	refundId := uuid.New().String()
+	resp, err := s.storage.Online().OnlineCancel(req, refundId)
+	if err != nil {
+		return &patient.OnlineTransaction{}, onlineError(err)
+	}
+
+	s.fiscalize(ctx, refundId)
+	return resp, nil
+}
+
+func (s *PatientService) OnlineTransactionGet(ctx context.Context, req *patient.OnlineTransactionReq) (*patient.OnlineTransaction, error) {
+	if err := onlineValidate(req); err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	resp, err := s.storage.Online().OnlineGet(req)
+	if err != nil {
+		return &patient.OnlineTransaction{}, onlineError(err)
+	}
+	return resp, nil
+}
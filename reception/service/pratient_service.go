@@ -5,28 +5,46 @@ import (
 	"database/sql"
 	"errors"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
 	"gitlab.com/clinic-crm/reception/genproto/doctor"
 	"gitlab.com/clinic-crm/reception/genproto/lab"
 	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/events"
+	"gitlab.com/clinic-crm/reception/pkg/fiscal"
 	"gitlab.com/clinic-crm/reception/pkg/grpc_client"
+	"gitlab.com/clinic-crm/reception/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/reception/pkg/utils"
 	"gitlab.com/clinic-crm/reception/storage"
+	"gitlab.com/clinic-crm/reception/storage/repo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type PatientService struct {
-	service grpc_client.ServiceManager
-	storage storage.StorageI
+	service  grpc_client.ServiceManager
+	storage  storage.StorageI
+	queueDay *utils.QueueDay
+	queueHub *events.QueueHub
+	// days a patient gets to pay off a cashbox closed unpaid
+	debtTermDays int
+	fiscal       fiscal.FiscalProvider
 	patient.UnimplementedPatientServiceServer
 }
 
-func NewPatientService(strg storage.StorageI, service *grpc_client.ServiceManager) *PatientService {
+func NewPatientService(strg storage.StorageI, service *grpc_client.ServiceManager, queueDay *utils.QueueDay, queueHub *events.QueueHub, debtTermDays int, fiscalProvider fiscal.FiscalProvider) *PatientService {
 	return &PatientService{
 		service:                           *service,
 		storage:                           strg,
+		queueDay:                          queueDay,
+		queueHub:                          queueHub,
+		debtTermDays:                      debtTermDays,
+		fiscal:                            fiscalProvider,
 		UnimplementedPatientServiceServer: patient.UnimplementedPatientServiceServer{},
 	}
 }
@@ -50,6 +68,8 @@ func (s *PatientService) PatientGet(ctx context.Context, req *patient.GetPatient
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
 			return &patient.Patient{}, status.Error(codes.NotFound, "something went wrong, please not found this patient")
+		} else if errors.Is(err, sqlfilter.ErrUnknownField) {
+			return &patient.Patient{}, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return &patient.Patient{}, status.Error(codes.Internal, "something went wrong, please check patient info")
 	}
@@ -164,31 +184,21 @@ func (s *PatientService) DoctorReportGet(ctx context.Context, req *patient.Patie
 } //
 
 func (s *PatientService) CreatePatientQueue(ctx context.Context, req *patient.CreatePatientQueueReq) (*patient.PatientQueueResp, error) {
-	queue, err := s.storage.Patient().CheckServiceQueue(&patient.CheckQueueReq{
-		ServiceId:   req.ServiceId,
-		ServiceType: req.ServiceType,
-	})
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		log.Println(err.Error())
-		return &patient.PatientQueueResp{}, status.Error(codes.Internal, "something went wrong, please check doctor report info")
-	}
-	if queue == nil {
-		queue.QueueNumber = 0
+	if req.ServiceType == repo.ServiceDoctor {
+		if err := s.doctorAvailable(ctx, req.ServiceId, time.Now()); err != nil {
+			return &patient.PatientQueueResp{}, err
+		}
 	}
+
 	resp, err := s.storage.Patient().CreatePatientQueue(&patient.CreatePatientQueueReq{
 		Id:          req.Id,
 		ClientId:    req.ClientId,
-		QueueNumber: queue.QueueNumber + 1,
 		ServiceId:   req.ServiceId,
 		ServiceType: req.ServiceType,
-	})
-
+	}, s.queueDay.Of(time.Now()))
 	if err != nil {
 		log.Println(err.Error())
-		if errors.Is(err, sql.ErrNoRows) {
-			return &patient.PatientQueueResp{}, status.Error(codes.NotFound, "something went wrong, please not found this doctor report")
-		}
-		return &patient.PatientQueueResp{}, status.Error(codes.Internal, "something went wrong, please check doctor report info")
+		return &patient.PatientQueueResp{}, status.Error(codes.Internal, "something went wrong, please check queue info")
 	}
 
 	return resp, nil
@@ -203,7 +213,7 @@ func (s *PatientService) GetPatientQueue(ctx context.Context, req *patient.Payme
 }
 
 func (s *PatientService) CheckServiceQueue(ctx context.Context, req *patient.CheckQueueReq) (*patient.QueueNumber, error) {
-	resp, err := s.storage.Patient().CheckServiceQueue(req)
+	resp, err := s.storage.Patient().CheckServiceQueue(req, s.queueDay.Of(time.Now()))
 	if err != nil {
 		log.Println(err.Error())
 		if errors.Is(err, sql.ErrNoRows) {
@@ -215,19 +225,54 @@ func (s *PatientService) CheckServiceQueue(ctx context.Context, req *patient.Che
 	return resp, nil
 }
 
-func (s *PatientService) UpdateQueue(ctx context.Context, req *patient.UpdateQueueReq) (*patient.PatientQueueResp, error) {
-	resp, err := s.storage.Patient().UpdateQueue(req)
+func (s *PatientService) CallNext(ctx context.Context, req *patient.CallNextReq) (*patient.PatientQueueResp, error) {
+	resp, err := s.storage.Patient().CallNextQueue(req, s.queueDay.Of(time.Now()))
 	if err != nil {
-		log.Println(err.Error())
-		if errors.Is(err, sql.ErrNoRows) {
-			return &patient.PatientQueueResp{}, status.Error(codes.NotFound, "something went wrong, please not found this doctor report")
-		}
-		return &patient.PatientQueueResp{}, status.Error(codes.Internal, "something went wrong, please check doctor report info")
+		return &patient.PatientQueueResp{}, queueError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *PatientService) StartQueue(ctx context.Context, req *patient.QueueId) (*patient.PatientQueueResp, error) {
+	return s.queueTransition(req.Id, []string{repo.QueueCalled}, repo.QueueInService)
+}
+
+func (s *PatientService) CompleteQueue(ctx context.Context, req *patient.QueueId) (*patient.PatientQueueResp, error) {
+	return s.queueTransition(req.Id, []string{repo.QueueInService}, repo.QueueDone)
+}
+
+func (s *PatientService) SkipQueue(ctx context.Context, req *patient.QueueId) (*patient.PatientQueueResp, error) {
+	return s.queueTransition(req.Id, []string{repo.QueueWaiting, repo.QueueCalled, repo.QueueRecalled}, repo.QueueSkipped)
+}
+
+func (s *PatientService) RecallQueue(ctx context.Context, req *patient.QueueId) (*patient.PatientQueueResp, error) {
+	return s.queueTransition(req.Id, []string{repo.QueueSkipped, repo.QueueNoShow}, repo.QueueRecalled)
+}
+
+func (s *PatientService) NoShowQueue(ctx context.Context, req *patient.QueueId) (*patient.PatientQueueResp, error) {
+	return s.queueTransition(req.Id, []string{repo.QueueWaiting, repo.QueueCalled, repo.QueueSkipped, repo.QueueRecalled}, repo.QueueNoShow)
+}
+
+func (s *PatientService) queueTransition(id string, from []string, to string) (*patient.PatientQueueResp, error) {
+	resp, err := s.storage.Patient().QueueTransition(id, from, to)
+	if err != nil {
+		return &patient.PatientQueueResp{}, queueError(err)
 	}
 
 	return resp, nil
 }
 
+func queueError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this queue")
+	} else if errors.Is(err, repo.ErrQueueStatus) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "something went wrong, please check queue info")
+}
+
 func (s *PatientService) FindQueue(ctx context.Context, req *patient.QueueFilter) (*patient.QueuesResp, error) {
 	resp, err := s.storage.Patient().FindQueue(req)
 	if err != nil {
@@ -241,54 +286,209 @@ func (s *PatientService) FindQueue(ctx context.Context, req *patient.QueueFilter
 	return resp, nil
 }
 
-func (s *PatientService) CreateCashbox(ctx context.Context, req *patient.CreateCashboxReq) (*patient.CashboxResp, error) {
-	Summa := 0
-	for _, doctorId := range req.DoctorsIds {
-		doktor, err := s.service.DoctorService().DoctorGet(context.Background(), &doctor.GetDoctorReq{Field: "id", Value: doctorId})
+func (s *PatientService) FindQueuePatients(ctx context.Context, req *patient.QueueFilter) (*patient.QueuePatientsResp, error) {
+	resp, err := s.storage.Patient().FindQueuePatients(req)
+	if err != nil {
+		log.Println(err.Error())
+		return &patient.QueuePatientsResp{}, status.Error(codes.Internal, "something went wrong, please check find queue info")
+	}
+
+	return resp, nil
+}
+
+// WatchQueue sends the queue board of the service right away and again after
+// every change of its queue until the client goes away.
+func (s *PatientService) WatchQueue(req *patient.WatchQueueReq, stream patient.PatientService_WatchQueueServer) error {
+	if req.ServiceId == "" {
+		return status.Error(codes.InvalidArgument, "service_id is required")
+	}
+	if req.NextLimit <= 0 {
+		req.NextLimit = 5
+	}
+
+	ctx := stream.Context()
+	name, room, err := s.queueServiceInfo(ctx, req.ServiceType, req.ServiceId)
+	if err != nil {
+		return err
+	}
+
+	changed, unsubscribe := s.queueHub.Subscribe(req.ServiceType, req.ServiceId)
+	defer unsubscribe()
+
+	// the queue day rolls over without any queue change, so refresh periodically too
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		board, err := s.storage.Patient().QueueBoard(req, s.queueDay.Of(time.Now()))
 		if err != nil {
-			log.Println(err.Error())
-			if errors.Is(err, sql.ErrNoRows) {
-				return &patient.CashboxResp{}, status.Error(codes.NotFound, "something went wrong, please not found this doctor report")
-			}
-			return &patient.CashboxResp{}, status.Error(codes.Internal, "something went wrong, please check doctor report info")
+			return queueError(err)
+		}
+		board.ServiceName = name
+		board.RoomNumber = room
+		board.UpdatedAt = time.Now().Format(time.RFC3339)
+
+		if err := stream.Send(board); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// queueServiceInfo returns the name and room of the doctor, lab or aparat the queue is for.
+func (s *PatientService) queueServiceInfo(ctx context.Context, serviceType, serviceId string) (string, string, error) {
+	var (
+		name, room string
+		err        error
+	)
+	switch serviceType {
+	case repo.ServiceDoctor:
+		var resp *doctor.Doctor
+		resp, err = s.service.DoctorService().DoctorGet(ctx, &doctor.GetDoctorReq{Field: "id", Value: serviceId})
+		if err == nil {
+			name, room = resp.FirstName+" "+resp.LastName, resp.RoomNumber
+		}
+	case repo.ServiceLab:
+		var resp *lab.LabCreateRes
+		resp, err = s.service.LabService().LabGet(ctx, &lab.LabGetReq{Field: "id", Value: serviceId})
+		if err == nil {
+			name, room = resp.Name, resp.RoomNumber
+		}
+	case repo.ServiceAparat:
+		var resp *lab.AparatCreateRes
+		resp, err = s.service.LabService().AparatGet(ctx, &lab.AparatGetReq{Field: "id", Value: serviceId})
+		if err == nil {
+			name, room = resp.Name, resp.RoomNumber
+		}
+	default:
+		return "", "", status.Error(codes.InvalidArgument, "service_type must be doctor, lab or aparat")
+	}
+	if err != nil {
+		log.Println(err.Error())
+		if status.Code(err) == codes.NotFound {
+			return "", "", status.Error(codes.NotFound, "something went wrong, please not found this "+serviceType)
+		}
+		return "", "", status.Error(codes.Internal, "something went wrong, please check "+serviceType+" info")
+	}
+
+	return name, room, nil
+}
+
+func (s *PatientService) CreateCashbox(ctx context.Context, req *patient.CreateCashboxReq) (*patient.CashboxResp, error) {
+	var (
+		Summa int
+		lines []cashboxLine
+		items cashboxItems
+	)
+	// one call per service kind whatever the number of services billed
+	doctors := make(map[string]*doctor.Doctor, len(req.DoctorsIds))
+	if len(req.DoctorsIds) != 0 {
+		resp, err := s.service.DoctorService().DoctorsGetByIds(ctx, &doctor.DoctorIds{Ids: req.DoctorsIds})
+		if err := serviceIdsError(repo.ServiceDoctor, err, resp.GetMissingIds()); err != nil {
+			return &patient.CashboxResp{}, err
+		}
+		for _, doktor := range resp.Doctors {
+			doctors[doktor.Id] = doktor
+		}
+	}
+	labs := make(map[string]*lab.LabCreateRes, len(req.LabsIds))
+	if len(req.LabsIds) != 0 {
+		resp, err := s.service.LabService().LabsGetByIds(ctx, &lab.ServiceIds{Ids: req.LabsIds})
+		if err := serviceIdsError(repo.ServiceLab, err, resp.GetMissingIds()); err != nil {
+			return &patient.CashboxResp{}, err
+		}
+		for _, labaratoriya := range resp.Labs {
+			labs[labaratoriya.Id] = labaratoriya
+		}
+	}
+	aparats := make(map[string]*lab.AparatCreateRes, len(req.AparatsIds))
+	if len(req.AparatsIds) != 0 {
+		resp, err := s.service.LabService().AparatsGetByIds(ctx, &lab.ServiceIds{Ids: req.AparatsIds})
+		if err := serviceIdsError(repo.ServiceAparat, err, resp.GetMissingIds()); err != nil {
+			return &patient.CashboxResp{}, err
+		}
+		for _, aparat := range resp.Aparats {
+			aparats[aparat.Id] = aparat
 		}
+	}
+
+	for _, doctorId := range req.DoctorsIds {
+		doktor := doctors[doctorId]
 		Summa += int(doktor.Price)
+		lines = append(lines, cashboxLine{repo.ServiceDoctor, doctorId, int64(doktor.Price)})
+		items.add(&patient.CashboxItem{
+			ServiceType: repo.ServiceDoctor,
+			ServiceId:   doctorId,
+			Name:        doktor.FirstName + " " + doktor.LastName,
+			Price:       int64(doktor.Price),
+			DoctorId:    doctorId,
+		})
 	}
 
 	for _, labId := range req.LabsIds {
-		labaratoriya, err := s.service.LabService().LabGet(context.Background(), &lab.LabGetReq{Field: "id", Value: labId})
-		if err != nil {
-			log.Println(err.Error())
-			if errors.Is(err, sql.ErrNoRows) {
-				return &patient.CashboxResp{}, status.Error(codes.NotFound, "something went wrong, please not found this doctor report")
-			}
-			return &patient.CashboxResp{}, status.Error(codes.Internal, "something went wrong, please check doctor report info")
-		}
+		labaratoriya := labs[labId]
 		Summa += int(labaratoriya.Price)
+		lines = append(lines, cashboxLine{repo.ServiceLab, labId, int64(labaratoriya.Price)})
+		items.add(&patient.CashboxItem{
+			ServiceType: repo.ServiceLab,
+			ServiceId:   labId,
+			Name:        labaratoriya.Name,
+			Price:       int64(labaratoriya.Price),
+			DoctorId:    labaratoriya.DoctorId,
+		})
 	}
 
 	for _, aparatId := range req.AparatsIds {
-		aparat, err := s.service.LabService().AparatGet(context.Background(), &lab.AparatGetReq{Field: "id", Value: aparatId})
-		if err != nil {
-			log.Println(err.Error())
-			if errors.Is(err, sql.ErrNoRows) {
-				return &patient.CashboxResp{}, status.Error(codes.NotFound, "something went wrong, please not found this doctor report")
-			}
-			return &patient.CashboxResp{}, status.Error(codes.Internal, "something went wrong, please check doctor report info")
-		}
+		aparat := aparats[aparatId]
 		Summa += int(aparat.Price)
+		lines = append(lines, cashboxLine{repo.ServiceAparat, aparatId, int64(aparat.Price)})
+		items.add(&patient.CashboxItem{
+			ServiceType: repo.ServiceAparat,
+			ServiceId:   aparatId,
+			Name:        aparat.Name,
+			Price:       int64(aparat.Price),
+			DoctorId:    aparat.DoctorId,
+		})
+	}
+
+	// the campaign discounts go by the advertising channel the patient came from
+	var advertisingChannel string
+	client, err := s.storage.Patient().PatientGet(&patient.GetPatientReq{Field: "client_id", Value: strconv.FormatInt(req.ClientId, 10)})
+	if err == nil {
+		advertisingChannel = client.AdvertisingChannel
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return &patient.CashboxResp{}, discountError(err)
 	}
 
+	discounts, err := s.storage.Discount().DiscountsActive(req.ClientId, advertisingChannel, s.queueDay.Of(time.Now()))
+	if err != nil {
+		return &patient.CashboxResp{}, discountError(err)
+	}
+
+	var discount int64
+	for _, line := range discountApply(lines, discounts) {
+		items.discount(line)
+		discount += line.Amount
+	}
+	net := int64(Summa) - discount
+
 	resp, err := s.storage.Patient().CreateCashbox(&patient.CashboxResp{
 		Id:          req.Id,
 		ClientId:    req.ClientId,
-		Summa:       int64(Summa),
-		IsPayed:     req.IsPayed,
+		Summa:       net,
+		Gross:       int64(Summa),
+		Discount:    discount,
+		Items:       items,
+		IsPayed:     net == 0,
 		CashCount:   req.CashCount,
 		PaymentType: req.PaymentType,
-		DoctorsIds:  req.DoctorsIds,
-		LabsIds:     req.LabsIds,
-		AparatsIds:  req.AparatsIds,
+		StaffId:     req.StaffId,
 	})
 	if err != nil {
 		log.Println(err.Error())
@@ -321,6 +521,9 @@ func (s *PatientService) FindCashbox(ctx context.Context, req *patient.FindCashb
 		for _, client := range clientResp.Patients {
 			ClientIDs = append(ClientIDs, int(client.ClientId))
 		}
+		if len(ClientIDs) == 0 {
+			return &patient.FindCashboxResp{}, nil
+		}
 	}
 
 	resp, err := s.storage.Patient().FindCashbox(req, ClientIDs)
@@ -355,33 +558,181 @@ func (s *PatientService) UpdateCashbox(ctx context.Context, req *patient.UpdateC
 		}
 		return &patient.CashboxResp{}, status.Error(codes.Internal, "something went wrong, please check find queue info")
 	}
+
+	// closing the cashbox with less than summa paid leaves the rest as a debt
+	if req.IsPayed && !resp.IsPayed {
+		err = s.storage.Debt().CashboxDebtCreate(uuid.New().String(), resp.Id, s.queueDay.After(time.Now(), s.debtTermDays))
+		if err != nil {
+			return &patient.CashboxResp{}, debtError(err)
+		}
+	}
+
 	return resp, nil
 }
 
 func (s *PatientService) DeleteCashbox(ctx context.Context, req *patient.GetCashboxReq) (*empty.Empty, error) {
 	err := s.storage.Patient().DeleteCashbox(req)
 	if err != nil {
-		log.Println(err.Error())
-		if errors.Is(err, sql.ErrNoRows) {
-			return &emptypb.Empty{}, status.Error(codes.NotFound, "something went wrong, please not found this find queue")
-		}
-		return &emptypb.Empty{}, status.Error(codes.Internal, "something went wrong, please check find queue info")
+		return &emptypb.Empty{}, paymentError(err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *PatientService) CreatePaymentHistory(ctx context.Context, req *patient.CreatePaymentHistoryReq) (*patient.PaymentHistoryResp, error) {
+	if req.StaffId == "" {
+		return &patient.PaymentHistoryResp{}, status.Error(codes.InvalidArgument, "staff_id is required")
+	}
+	if err := paymentValidate(req.Summa, req.PaymentType); err != nil {
+		return &patient.PaymentHistoryResp{}, err
+	}
+
 	resp, err := s.storage.Patient().CreatePaymentHistory(req)
 	if err != nil {
-		log.Println(err.Error())
-		if errors.Is(err, sql.ErrNoRows) {
-			return &patient.PaymentHistoryResp{}, status.Error(codes.NotFound, "something went wrong, please not found this find queue")
+		return &patient.PaymentHistoryResp{}, paymentError(err)
+	}
+
+	s.fiscalize(ctx, resp.Id)
+	resp, err = s.storage.Patient().GetPaymentHistory(&patient.PaymentHistoryId{Id: resp.Id})
+	if err != nil {
+		return &patient.PaymentHistoryResp{}, paymentError(err)
+	}
+	return resp, nil
+}
+
+func (s *PatientService) CashboxPay(ctx context.Context, req *patient.CashboxPayReq) (*patient.CashboxResp, error) {
+	if req.StaffId == "" {
+		return &patient.CashboxResp{}, status.Error(codes.InvalidArgument, "staff_id is required")
+	}
+	if len(req.Payments) == 0 {
+		return &patient.CashboxResp{}, status.Error(codes.InvalidArgument, "at least one payment is required")
+	}
+	for _, payment := range req.Payments {
+		if err := paymentValidate(payment.Summa, payment.PaymentType); err != nil {
+			return &patient.CashboxResp{}, err
 		}
-		return &patient.PaymentHistoryResp{}, status.Error(codes.Internal, "something went wrong, please check find queue info")
+		if payment.Id == "" {
+			payment.Id = uuid.New().String()
+		}
+	}
+
+	if _, err := s.storage.Patient().CashboxPay(req); err != nil {
+		return &patient.CashboxResp{}, paymentError(err)
+	}
+
+	paymentIds := make([]string, 0, len(req.Payments))
+	for _, payment := range req.Payments {
+		paymentIds = append(paymentIds, payment.Id)
+	}
+	s.fiscalize(ctx, paymentIds...)
+
+	resp, err := s.storage.Patient().GetCashbox(&patient.GetCashboxReq{CashboxId: req.CashboxId})
+	if err != nil {
+		return &patient.CashboxResp{}, paymentError(err)
+	}
+	return resp, nil
+}
+
+// CashboxRefund refunds the whole cashbox or, with service_type and service_id, one unit of its item.
+func (s *PatientService) CashboxRefund(ctx context.Context, req *patient.CashboxRefundReq) (*patient.CashboxResp, error) {
+	if strings.TrimSpace(req.Reason) == "" {
+		return &patient.CashboxResp{}, status.Error(codes.InvalidArgument, "refund reason is required")
+	}
+	if req.StaffId == "" {
+		return &patient.CashboxResp{}, status.Error(codes.InvalidArgument, "staff_id is required")
+	}
+	if err := paymentTypeValidate(req.PaymentType); err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	if req.ServiceType != "" && req.ServiceId == "" {
+		return &patient.CashboxResp{}, status.Error(codes.InvalidArgument, "service_id is required to refund a line")
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	if _, err := s.storage.Patient().CashboxRefund(req, s.queueDay.Of(time.Now())); err != nil {
+		return &patient.CashboxResp{}, paymentError(err)
+	}
+
+	s.fiscalize(ctx, req.Id)
+	resp, err := s.storage.Patient().GetCashbox(&patient.GetCashboxReq{CashboxId: req.CashboxId})
+	if err != nil {
+		return &patient.CashboxResp{}, paymentError(err)
 	}
 	return resp, nil
 }
 
+// CashboxPaidItems returns what was paid for the services of the doctors over the clinic days.
+func (s *PatientService) CashboxPaidItems(ctx context.Context, req *patient.CashboxPaidItemsReq) (*patient.CashboxPaidItemsResp, error) {
+	location := s.queueDay.Location()
+
+	from, err := time.ParseInLocation("2006-01-02", req.FromDate, location)
+	if err != nil {
+		return &patient.CashboxPaidItemsResp{}, status.Error(codes.InvalidArgument, "from_date must be like 2006-01-02")
+	}
+	to, err := time.ParseInLocation("2006-01-02", req.ToDate, location)
+	if err != nil {
+		return &patient.CashboxPaidItemsResp{}, status.Error(codes.InvalidArgument, "to_date must be like 2006-01-02")
+	}
+	if to.Before(from) {
+		return &patient.CashboxPaidItemsResp{}, status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+
+	items, err := s.storage.Patient().CashboxPaidItems(from, to.AddDate(0, 0, 1), req.DoctorId)
+	if err != nil {
+		log.Println(err.Error())
+		return &patient.CashboxPaidItemsResp{}, status.Error(codes.Internal, "something went wrong, please check cashbox info")
+	}
+
+	return &patient.CashboxPaidItemsResp{Items: items}, nil
+}
+
+// serviceIdsError maps a failed batch lookup of doctor, lab or aparat services,
+// the ids not found make it NotFound with the ids listed.
+func serviceIdsError(serviceType string, err error, missingIds []string) error {
+	if err != nil {
+		log.Println(err.Error())
+		if status.Code(err) == codes.DeadlineExceeded || status.Code(err) == codes.Canceled {
+			return err
+		}
+		return status.Error(codes.Internal, "something went wrong, please check "+serviceType+" info")
+	}
+	if len(missingIds) != 0 {
+		return status.Error(codes.NotFound, serviceType+" not found: "+strings.Join(missingIds, ", "))
+	}
+	return nil
+}
+
+// paymentValidate checks one payment of a cashbox.
+func paymentValidate(summa int64, paymentType string) error {
+	if summa <= 0 {
+		return status.Error(codes.InvalidArgument, "payment summa must be positive")
+	}
+	return paymentTypeValidate(paymentType)
+}
+
+func paymentTypeValidate(paymentType string) error {
+	switch paymentType {
+	case repo.PaymentCash, repo.PaymentCard, repo.PaymentTransfer:
+		return nil
+	}
+	return status.Error(codes.InvalidArgument, "payment_type must be one of cash, card, transfer")
+}
+
+// paymentError maps storage errors of cashbox payments to grpc statuses.
+func paymentError(err error) error {
+	log.Println(err.Error())
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this cashbox")
+	}
+	if errors.Is(err, repo.ErrOverpayment) || errors.Is(err, repo.ErrRefund) ||
+		errors.Is(err, repo.ErrCashboxPaid) || errors.Is(err, repo.ErrShiftNotOpen) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "something went wrong, please check payment info")
+}
+
 func (s *PatientService) GetPaymentHistory(ctx context.Context, req *patient.PaymentHistoryId) (*patient.PaymentHistoryResp, error) {
 	resp, err := s.storage.Patient().GetPaymentHistory(req)
 	if err != nil {
@@ -405,15 +756,3 @@ func (s *PatientService) FindPaymentHistory(ctx context.Context, req *patient.Pa
 	}
 	return resp, nil
 }
-
-func (s *PatientService) DeletePaymentHistory(ctx context.Context, req *patient.PaymentHistoryId) (*empty.Empty, error) {
-	err := s.storage.Patient().DeletePaymentHistory(req)
-	if err != nil {
-		log.Println(err.Error())
-		if errors.Is(err, sql.ErrNoRows) {
-			return &emptypb.Empty{}, status.Error(codes.NotFound, "something went wrong, please not found this find queue")
-		}
-		return &emptypb.Empty{}, status.Error(codes.Internal, "something went wrong, please check find queue info")
-	}
-	return &emptypb.Empty{}, nil
-}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// shiftError maps storage errors of cashier shifts to grpc statuses.
+func shiftError(err error) error {
+	log.Println(err.Error())
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "something went wrong, please not found this shift")
+	case errors.Is(err, repo.ErrShiftOpen):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repo.ErrShiftNotOpen):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, "something went wrong, please check shift info")
+}
+
+func (s *PatientService) ShiftOpen(ctx context.Context, req *patient.ShiftOpenReq) (*patient.Shift, error) {
+	if req.StaffId == "" {
+		return &patient.Shift{}, status.Error(codes.InvalidArgument, "staff_id is required")
+	}
+	if req.OpeningCash < 0 {
+		return &patient.Shift{}, status.Error(codes.InvalidArgument, "opening_cash can not be negative")
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+
+	resp, err := s.storage.Shift().ShiftOpen(req)
+	if err != nil {
+		return &patient.Shift{}, shiftError(err)
+	}
+	return resp, nil
+}
+
+// ShiftClose closes the open shift of the staff, the returned shift is its Z-report.
+func (s *PatientService) ShiftClose(ctx context.Context, req *patient.ShiftCloseReq) (*patient.Shift, error) {
+	if req.StaffId == "" {
+		return &patient.Shift{}, status.Error(codes.InvalidArgument, "staff_id is required")
+	}
+	if req.CountedCash < 0 {
+		return &patient.Shift{}, status.Error(codes.InvalidArgument, "counted_cash can not be negative")
+	}
+
+	resp, err := s.storage.Shift().ShiftClose(req)
+	if err != nil {
+		return &patient.Shift{}, shiftError(err)
+	}
+	return resp, nil
+}
+
+func (s *PatientService) ShiftGet(ctx context.Context, req *patient.ShiftId) (*patient.Shift, error) {
+	resp, err := s.storage.Shift().ShiftGet(req)
+	if err != nil {
+		return &patient.Shift{}, shiftError(err)
+	}
+	return resp, nil
+}
+
+func (s *PatientService) ShiftsFind(ctx context.Context, req *patient.ShiftsFindReq) (*patient.ShiftsResp, error) {
+	resp, err := s.storage.Shift().ShiftsFind(req)
+	if err != nil {
+		return &patient.ShiftsResp{}, shiftError(err)
+	}
+	return resp, nil
+}
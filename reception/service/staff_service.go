@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Staff roles
+const (
+	RoleReceptionist  = "receptionist"
+	RoleCashier       = "cashier"
+	RoleDoctor        = "doctor"
+	RoleLabTechnician = "lab_technician"
+	RoleAdmin         = "admin"
+)
+
+func validRole(role string) bool {
+	switch role {
+	case RoleReceptionist, RoleCashier, RoleDoctor, RoleLabTechnician, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// staffError maps storage errors of the staff section to grpc statuses.
+func staffError(err error) error {
+	log.Println(err.Error())
+	var pqErr *pq.Error
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "something went wrong, please not found this staff")
+	} else if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return status.Error(codes.AlreadyExists, "staff with this login already exists")
+	}
+	return status.Error(codes.Internal, "something went wrong, please check staff info")
+}
+
+func (s *PatientService) StaffCreate(ctx context.Context, req *patient.Staff) (*patient.Staff, error) {
+	if req.Login == "" || len(req.Password) < 6 {
+		return &patient.Staff{}, status.Error(codes.InvalidArgument, "login and password (at least 6 characters) are required")
+	}
+	if !validRole(req.Role) {
+		return &patient.Staff{}, status.Error(codes.InvalidArgument, "unknown staff role")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return &patient.Staff{}, staffError(err)
+	}
+	if req.Id == "" {
+		req.Id = uuid.New().String()
+	}
+	req.Password = string(hash)
+
+	resp, err := s.storage.Staff().StaffCreate(req)
+	if err != nil {
+		return &patient.Staff{}, staffError(err)
+	}
+	resp.Password = ""
+
+	return resp, nil
+}
+
+func (s *PatientService) StaffGet(ctx context.Context, req *patient.StaffId) (*patient.Staff, error) {
+	resp, err := s.storage.Staff().StaffGet(req)
+	if err != nil {
+		return &patient.Staff{}, staffError(err)
+	}
+	resp.Password = ""
+
+	return resp, nil
+}
+
+func (s *PatientService) StaffsFind(ctx context.Context, req *patient.StaffsFindReq) (*patient.StaffsResp, error) {
+	resp, err := s.storage.Staff().StaffsFind(req)
+	if err != nil {
+		return &patient.StaffsResp{}, staffError(err)
+	}
+	for _, staff := range resp.Staffs {
+		staff.Password = ""
+	}
+
+	return resp, nil
+}
+
+func (s *PatientService) StaffUpdate(ctx context.Context, req *patient.Staff) (*patient.Staff, error) {
+	if !validRole(req.Role) {
+		return &patient.Staff{}, status.Error(codes.InvalidArgument, "unknown staff role")
+	}
+
+	resp, err := s.storage.Staff().StaffUpdate(req)
+	if err != nil {
+		return &patient.Staff{}, staffError(err)
+	}
+	resp.Password = ""
+
+	return resp, nil
+}
+
+func (s *PatientService) StaffDelete(ctx context.Context, req *patient.StaffId) (*empty.Empty, error) {
+	err := s.storage.Staff().StaffDelete(req)
+	if err != nil {
+		return &empty.Empty{}, staffError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *PatientService) StaffLogin(ctx context.Context, req *patient.StaffLoginReq) (*patient.Staff, error) {
+	resp, err := s.storage.Staff().StaffGetByLogin(req.Login)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &patient.Staff{}, status.Error(codes.Unauthenticated, "wrong login or password")
+	} else if err != nil {
+		return &patient.Staff{}, staffError(err)
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(resp.Password), []byte(req.Password)) != nil {
+		return &patient.Staff{}, status.Error(codes.Unauthenticated, "wrong login or password")
+	}
+	resp.Password = ""
+
+	return resp, nil
+}
+
+func (s *PatientService) StaffChangePassword(ctx context.Context, req *patient.StaffChangePasswordReq) (*empty.Empty, error) {
+	if len(req.NewPassword) < 6 {
+		return &empty.Empty{}, status.Error(codes.InvalidArgument, "new password must be at least 6 characters")
+	}
+
+	staff, err := s.storage.Staff().StaffGet(&patient.StaffId{Id: req.Id})
+	if err != nil {
+		return &empty.Empty{}, staffError(err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(staff.Password), []byte(req.OldPassword)) != nil {
+		return &empty.Empty{}, status.Error(codes.Unauthenticated, "wrong password")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return &empty.Empty{}, staffError(err)
+	}
+	if err := s.storage.Staff().StaffPasswordUpdate(req.Id, string(hash)); err != nil {
+		return &empty.Empty{}, staffError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// StaffRefreshTokenSet stores the id of the only valid refresh token of the staff,
+// an empty token id revokes it (logout).
+func (s *PatientService) StaffRefreshTokenSet(ctx context.Context, req *patient.StaffRefreshToken) (*empty.Empty, error) {
+	err := s.storage.Staff().StaffRefreshTokenSet(req)
+	if err != nil {
+		return &empty.Empty{}, staffError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *PatientService) StaffRefreshTokenCheck(ctx context.Context, req *patient.StaffRefreshToken) (*patient.Staff, error) {
+	resp, err := s.storage.Staff().StaffRefreshTokenCheck(req)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &patient.Staff{}, status.Error(codes.Unauthenticated, "refresh token is revoked")
+	} else if err != nil {
+		return &patient.Staff{}, staffError(err)
+	}
+	resp.Password = ""
+
+	return resp, nil
+}
+
+// CreateDefaultAdmin creates the first admin account so staff can be managed from the api.
+func (s *PatientService) CreateDefaultAdmin(login, password string) error {
+	if login == "" || password == "" {
+		return nil
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return s.storage.Staff().StaffAdminCreate(&patient.Staff{
+		Id:        uuid.New().String(),
+		FirstName: "Admin",
+		LastName:  "Admin",
+		Login:     login,
+		Password:  string(hash),
+	})
+}
@@ -0,0 +1,332 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+// advisory lock classes serializing the bookings of a doctor and of a patient
+const (
+	lockDoctorAppointments = 1
+	lockClientAppointments = 2
+)
+
+type appointmentRepo struct {
+	db       *sqlx.DB
+	location *time.Location
+}
+
+func NewAppointment(db *sqlx.DB, location *time.Location) repo.AppointmentStorageI {
+	return &appointmentRepo{
+		db:       db,
+		location: location,
+	}
+}
+
+const appointmentColumns = `
+	id,
+	client_id,
+	doctor_id,
+	starts_at,
+	ends_at,
+	status,
+	note,
+	COALESCE(queue_id::text, ''),
+	cancel_reason,
+	COALESCE(created_by::text, ''),
+	created_at::text,
+	updated_at::text,
+	COALESCE(checked_in_at::text, ''),
+	COALESCE(cancelled_at::text, '')`
+
+func (r *appointmentRepo) scanAppointment(row interface{ Scan(...interface{}) error }) (*patient.Appointment, error) {
+	var (
+		result           patient.Appointment
+		startsAt, endsAt time.Time
+	)
+	err := row.Scan(
+		&result.Id,
+		&result.ClientId,
+		&result.DoctorId,
+		&startsAt,
+		&endsAt,
+		&result.Status,
+		&result.Note,
+		&result.QueueId,
+		&result.CancelReason,
+		&result.CreatedBy,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.CheckedInAt,
+		&result.CancelledAt,
+	)
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	result.StartsAt = startsAt.In(r.location).Format(repo.AppointmentTime)
+	result.EndsAt = endsAt.In(r.location).Format(repo.AppointmentTime)
+	return &result, nil
+}
+
+// appointmentBook locks the bookings of the doctor and the client for the tx and checks
+// neither of them has another appointment overlapping the time.
+func appointmentBook(tx *sqlx.Tx, id, doctorId string, clientId int64, startsAt, endsAt time.Time) error {
+	// the doctor is always locked first, two bookings can not wait for each other
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock($1, hashtext($2))`, lockDoctorAppointments, doctorId); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock($1, $2)`, lockClientAppointments, clientId); err != nil {
+		return err
+	}
+
+	var conflict bool
+	err := tx.QueryRow(`
+		SELECT EXISTS(
+			SELECT 1 FROM appointments
+			WHERE status <> 'cancelled' AND id <> $1
+				AND (doctor_id = $2 OR client_id = $3)
+				AND starts_at < $5 AND $4 < ends_at
+		)`,
+		id, doctorId, clientId, startsAt, endsAt,
+	).Scan(&conflict)
+	if err != nil {
+		return err
+	}
+	if conflict {
+		return repo.ErrAppointmentConflict
+	}
+	return nil
+}
+
+// appointmentLocked returns the appointment locked for the tx, ErrAppointmentStatus when it is not booked.
+func (r *appointmentRepo) appointmentLocked(tx *sqlx.Tx, id string) (*patient.Appointment, error) {
+	result, err := r.scanAppointment(tx.QueryRow(`SELECT `+appointmentColumns+` FROM appointments WHERE id = $1 FOR UPDATE`, id))
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+	if result.Status != repo.AppointmentBooked {
+		return &patient.Appointment{}, repo.ErrAppointmentStatus
+	}
+	return result, nil
+}
+
+func (r *appointmentRepo) AppointmentCreate(req *patient.Appointment, startsAt, endsAt time.Time) (*patient.Appointment, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+	defer tx.Rollback()
+
+	if err := appointmentBook(tx, req.Id, req.DoctorId, req.ClientId, startsAt, endsAt); err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	query := `
+		INSERT INTO appointments(
+			id,
+			client_id,
+			doctor_id,
+			starts_at,
+			ends_at,
+			note,
+			created_by
+		)VALUES($1,$2,$3,$4,$5,$6,NULLIF($7,'')::uuid)
+		RETURNING ` + appointmentColumns
+
+	result, err := r.scanAppointment(tx.QueryRow(query, req.Id, req.ClientId, req.DoctorId, startsAt, endsAt, req.Note, req.CreatedBy))
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *appointmentRepo) AppointmentGet(id string) (*patient.Appointment, error) {
+	return r.scanAppointment(r.db.QueryRow(`SELECT `+appointmentColumns+` FROM appointments WHERE id = $1`, id))
+}
+
+func (r *appointmentRepo) AppointmentsFind(req *patient.AppointmentsFindReq) (*patient.AppointmentsResp, error) {
+	result := patient.AppointmentsResp{
+		Appointments: make([]*patient.Appointment, 0),
+	}
+
+	filter := sqlfilter.New()
+	if req.DoctorId != "" {
+		filter.Where("doctor_id = ?", req.DoctorId)
+	}
+	if req.ClientId != 0 {
+		filter.Where("client_id = ?", req.ClientId)
+	}
+	if req.Status != "" {
+		filter.Where("status = ?", req.Status)
+	}
+	if req.FromDate != "" {
+		from, err := time.ParseInLocation("2006-01-02", req.FromDate, r.location)
+		if err != nil {
+			return &patient.AppointmentsResp{}, err
+		}
+		filter.Where("starts_at >= ?", from)
+	}
+	if req.ToDate != "" {
+		to, err := time.ParseInLocation("2006-01-02", req.ToDate, r.location)
+		if err != nil {
+			return &patient.AppointmentsResp{}, err
+		}
+		filter.Where("starts_at < ?", to.AddDate(0, 0, 1))
+	}
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	query := `SELECT ` + appointmentColumns + ` FROM appointments` + filter.String() + ` ORDER BY starts_at asc` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &patient.AppointmentsResp{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := r.scanAppointment(rows)
+		if err != nil {
+			return &patient.AppointmentsResp{}, err
+		}
+		result.Appointments = append(result.Appointments, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &patient.AppointmentsResp{}, err
+	}
+
+	queryCount := `SELECT count(1) FROM appointments` + filter.String()
+	err = r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
+	if err != nil {
+		return &patient.AppointmentsResp{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *appointmentRepo) AppointmentReschedule(id string, startsAt, endsAt time.Time) (*patient.Appointment, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+	defer tx.Rollback()
+
+	appointment, err := r.appointmentLocked(tx, id)
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	if err := appointmentBook(tx, id, appointment.DoctorId, appointment.ClientId, startsAt, endsAt); err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	query := `
+		UPDATE appointments SET
+			starts_at = $2,
+			ends_at = $3,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + appointmentColumns
+
+	result, err := r.scanAppointment(tx.QueryRow(query, id, startsAt, endsAt))
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *appointmentRepo) AppointmentCancel(req *patient.AppointmentCancelReq) (*patient.Appointment, error) {
+	query := `
+		UPDATE appointments SET
+			status = 'cancelled',
+			cancel_reason = $2,
+			cancelled_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'booked'
+		RETURNING ` + appointmentColumns
+
+	result, err := r.scanAppointment(r.db.QueryRow(query, req.Id, req.Reason))
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists bool
+		if err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM appointments WHERE id = $1)`, req.Id).Scan(&exists); err != nil {
+			return &patient.Appointment{}, err
+		}
+		if exists {
+			return &patient.Appointment{}, repo.ErrAppointmentStatus
+		}
+	}
+
+	return result, err
+}
+
+func (r *appointmentRepo) AppointmentCheckIn(id, queueId, queueDay string) (*patient.Appointment, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+	defer tx.Rollback()
+
+	appointment, err := r.appointmentLocked(tx, id)
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	queue, err := createQueue(tx, &patient.CreatePatientQueueReq{
+		Id:          queueId,
+		ClientId:    appointment.ClientId,
+		ServiceId:   appointment.DoctorId,
+		ServiceType: repo.ServiceDoctor,
+	}, queueDay)
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+
+	query := `
+		UPDATE appointments SET
+			status = 'checked_in',
+			queue_id = $2,
+			checked_in_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + appointmentColumns
+
+	result, err := r.scanAppointment(tx.QueryRow(query, id, queue.Id))
+	if err != nil {
+		return &patient.Appointment{}, err
+	}
+	result.Queue = queue
+
+	return result, tx.Commit()
+}
+
+func (r *appointmentRepo) DoctorAppointments(doctorId string, from, to time.Time) ([]*patient.Appointment, error) {
+	result := make([]*patient.Appointment, 0)
+
+	query := `SELECT ` + appointmentColumns + ` FROM appointments
+		WHERE doctor_id = $1 AND status <> 'cancelled' AND starts_at < $3 AND $2 < ends_at
+		ORDER BY starts_at`
+
+	rows, err := r.db.Query(query, doctorId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := r.scanAppointment(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, temp)
+	}
+
+	return result, rows.Err()
+}
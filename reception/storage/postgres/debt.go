@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"math"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+type debtRepo struct {
+	db *sqlx.DB
+}
+
+func NewDebt(db *sqlx.DB) repo.DebtStorageI {
+	return &debtRepo{
+		db: db,
+	}
+}
+
+// debtColumns selects a debt as d joined with its patient as p.
+const debtColumns = `
+	d.id,
+	COALESCE(p.id::text,'') as patient_id,
+	d.amount,
+	d.term_date::text,
+	d.created_at,
+	d.updated_at,
+	d.client_id,
+	COALESCE(d.cashbox_id::text,'') as cashbox_id,
+	d.initial_amount,
+	COALESCE(d.paid_at::text,'') as paid_at,
+	COALESCE(p.first_name,'') as first_name,
+	COALESCE(p.last_name,'') as last_name,
+	COALESCE(p.main_phone_number,'') as phone_number
+`
+
+const debtPatientJoin = ` LEFT JOIN patients p ON p.client_id = d.client_id AND p.deleted_at IS NULL`
+
+func scanDebt(row interface{ Scan(...interface{}) error }) (*patient.PatientDebt, error) {
+	var result patient.PatientDebt
+	err := row.Scan(
+		&result.Id,
+		&result.PatientId,
+		&result.Amount,
+		&result.TermDate,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.ClientId,
+		&result.CashboxId,
+		&result.InitialAmount,
+		&result.PaidAt,
+		&result.FirstName,
+		&result.LastName,
+		&result.PhoneNumber,
+	)
+	if err != nil {
+		return &patient.PatientDebt{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *debtRepo) PatientDebtCreate(req *patient.PatientDebtCreateReq) (*patient.PatientDebt, error) {
+	query := `
+		WITH d AS (
+			INSERT INTO patient_debts(
+				id,
+				client_id,
+				cashbox_id,
+				initial_amount,
+				amount,
+				term_date
+			)
+			SELECT $1, client_id, NULLIF($3, '')::uuid, $4, $4, $5
+			FROM patients
+			WHERE id = $2 AND deleted_at IS NULL
+			RETURNING *
+		)
+		SELECT ` + debtColumns + ` FROM d` + debtPatientJoin
+
+	return scanDebt(r.db.QueryRow(query,
+		req.Id,
+		req.PatientId,
+		req.CashboxId,
+		int64(math.Round(float64(req.Amount))),
+		req.TermDate,
+	))
+}
+
+func (r *debtRepo) CashboxDebtCreate(id, cashboxId, termDate string) error {
+	query := `
+		INSERT INTO patient_debts(
+			id,
+			client_id,
+			cashbox_id,
+			initial_amount,
+			amount,
+			term_date
+		)
+		SELECT $1, c.client_id, c.id, c.summa - c.refunded - paid.summa, c.summa - c.refunded - paid.summa, $3
+		FROM cashbox c, LATERAL (
+			SELECT COALESCE(SUM(summa), 0) as summa
+			FROM payment_history
+			WHERE cashbox_id = c.id AND deleted_at IS NULL
+		) paid
+		WHERE c.id = $2 AND c.summa - c.refunded > paid.summa AND c.deleted_at IS NULL
+		ON CONFLICT (cashbox_id) WHERE deleted_at IS NULL DO NOTHING
+	`
+	_, err := r.db.Exec(query, id, cashboxId, termDate)
+	return err
+}
+
+// PatientDebts returns the debts of the client that are not paid yet, nearest term first.
+func (r *debtRepo) PatientDebts(clientId int64) ([]*patient.PatientDebt, error) {
+	query := `SELECT ` + debtColumns + ` FROM patient_debts d` + debtPatientJoin + `
+		WHERE d.client_id = $1 AND d.amount > 0 AND d.deleted_at IS NULL
+		ORDER BY d.term_date, d.created_at`
+
+	rows, err := r.db.Query(query, clientId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*patient.PatientDebt, 0)
+	for rows.Next() {
+		temp, err := scanDebt(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, temp)
+	}
+
+	return result, rows.Err()
+}
+
+// PatientDebtsOverdue returns not paid debts whose term date is before req.Date, oldest term first.
+func (r *debtRepo) PatientDebtsOverdue(req *patient.PatientDebtsOverdueReq) (*patient.PatientDebtsResp, error) {
+	result := patient.PatientDebtsResp{
+		Debts: make([]*patient.PatientDebt, 0),
+	}
+
+	filter := sqlfilter.New("d.amount > 0", "d.deleted_at IS NULL").
+		Where("d.term_date < ?::date", req.Date)
+
+	err := r.db.QueryRow(`SELECT count(1), COALESCE(SUM(d.amount), 0) FROM patient_debts d`+filter.String(),
+		filter.Args()...,
+	).Scan(&result.Count, &result.Amount)
+	if err != nil {
+		return &patient.PatientDebtsResp{}, err
+	}
+
+	limit, args := filter.Page(req.Limit, req.Page)
+	query := `SELECT ` + debtColumns + ` FROM patient_debts d` + debtPatientJoin + filter.String() +
+		` ORDER BY d.term_date, d.created_at` + limit
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &patient.PatientDebtsResp{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanDebt(rows)
+		if err != nil {
+			return &patient.PatientDebtsResp{}, err
+		}
+		result.Debts = append(result.Debts, temp)
+	}
+
+	return &result, rows.Err()
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+type discountRepo struct {
+	db *sqlx.DB
+}
+
+func NewDiscount(db *sqlx.DB) repo.DiscountStorageI {
+	return &discountRepo{
+		db: db,
+	}
+}
+
+const discountColumns = `
+	id,
+	name,
+	kind,
+	value,
+	cap,
+	COALESCE(client_id, 0),
+	COALESCE(service_type, ''),
+	COALESCE(advertising_channel, ''),
+	COALESCE(valid_from::text, ''),
+	COALESCE(valid_to::text, ''),
+	created_at,
+	updated_at
+`
+
+func scanDiscount(row interface{ Scan(...interface{}) error }) (*patient.Discount, error) {
+	var result patient.Discount
+	err := row.Scan(
+		&result.Id,
+		&result.Name,
+		&result.Kind,
+		&result.Value,
+		&result.Cap,
+		&result.ClientId,
+		&result.ServiceType,
+		&result.AdvertisingChannel,
+		&result.ValidFrom,
+		&result.ValidTo,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
+		return &patient.Discount{}, err
+	}
+	return &result, nil
+}
+
+func (r *discountRepo) DiscountCreate(req *patient.Discount) (*patient.Discount, error) {
+	query := `
+		INSERT INTO discounts(
+			id,
+			name,
+			kind,
+			value,
+			cap,
+			client_id,
+			service_type,
+			advertising_channel,
+			valid_from,
+			valid_to
+		)VALUES($1,$2,$3,$4,$5,NULLIF($6, 0),NULLIF($7, ''),NULLIF($8, ''),NULLIF($9, '')::date,NULLIF($10, '')::date)
+		RETURNING ` + discountColumns
+
+	return scanDiscount(r.db.QueryRow(query,
+		req.Id,
+		req.Name,
+		req.Kind,
+		req.Value,
+		req.Cap,
+		req.ClientId,
+		req.ServiceType,
+		req.AdvertisingChannel,
+		req.ValidFrom,
+		req.ValidTo,
+	))
+}
+
+func (r *discountRepo) DiscountGet(req *patient.DiscountId) (*patient.Discount, error) {
+	query := `SELECT ` + discountColumns + ` FROM discounts WHERE id = $1 AND deleted_at IS NULL`
+	return scanDiscount(r.db.QueryRow(query, req.Id))
+}
+
+func (r *discountRepo) DiscountsFind(req *patient.DiscountsFindReq) (*patient.DiscountsResp, error) {
+	result := patient.DiscountsResp{
+		Discounts: make([]*patient.Discount, 0),
+	}
+
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "name", "advertising_channel")
+	if req.ClientId != 0 {
+		filter.Where("client_id = ?", req.ClientId)
+	}
+	if req.Date != "" {
+		discountValidOn(filter, req.Date)
+	}
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	rows, err := r.db.Query(`SELECT `+discountColumns+` FROM discounts`+filter.String()+` ORDER BY created_at DESC`+limit, args...)
+	if err != nil {
+		return &patient.DiscountsResp{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanDiscount(rows)
+		if err != nil {
+			return &patient.DiscountsResp{}, err
+		}
+		result.Discounts = append(result.Discounts, temp)
+	}
+
+	err = r.db.QueryRow(`SELECT count(1) FROM discounts`+filter.String(), filter.Args()...).Scan(&result.Count)
+	if err != nil {
+		return &patient.DiscountsResp{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *discountRepo) DiscountUpdate(req *patient.Discount) (*patient.Discount, error) {
+	query := `
+		UPDATE
+			discounts
+		SET
+			name=$1,
+			kind=$2,
+			value=$3,
+			cap=$4,
+			client_id=NULLIF($5, 0),
+			service_type=NULLIF($6, ''),
+			advertising_channel=NULLIF($7, ''),
+			valid_from=NULLIF($8, '')::date,
+			valid_to=NULLIF($9, '')::date,
+			updated_at=NOW()
+		WHERE
+			id=$10 AND deleted_at IS NULL
+		RETURNING ` + discountColumns
+
+	return scanDiscount(r.db.QueryRow(query,
+		req.Name,
+		req.Kind,
+		req.Value,
+		req.Cap,
+		req.ClientId,
+		req.ServiceType,
+		req.AdvertisingChannel,
+		req.ValidFrom,
+		req.ValidTo,
+		req.Id,
+	))
+}
+
+func (r *discountRepo) DiscountDelete(req *patient.DiscountId) error {
+	query := `
+		UPDATE
+			discounts
+		SET
+			deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	return execAffected(r.db, query, req.Id)
+}
+
+func (r *discountRepo) DiscountsActive(clientId int64, advertisingChannel, day string) ([]*patient.Discount, error) {
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Where("(client_id IS NULL OR client_id = ?)", clientId).
+		Where("(advertising_channel IS NULL OR advertising_channel = ?)", advertisingChannel)
+	discountValidOn(filter, day)
+
+	rows, err := r.db.Query(`SELECT `+discountColumns+` FROM discounts`+filter.String()+` ORDER BY created_at`, filter.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*patient.Discount, 0)
+	for rows.Next() {
+		temp, err := scanDiscount(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, temp)
+	}
+	return result, rows.Err()
+}
+
+// discountValidOn limits the discounts to the ones valid on day.
+func discountValidOn(filter *sqlfilter.Filter, day string) {
+	filter.Where("(valid_from IS NULL OR valid_from <= ?::date)", day).
+		Where("(valid_to IS NULL OR valid_to >= ?::date)", day)
+}
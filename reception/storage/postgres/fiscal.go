@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"gitlab.com/clinic-crm/reception/pkg/fiscal"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+type fiscalRepo struct {
+	db *sqlx.DB
+}
+
+func NewFiscal(db *sqlx.DB) repo.FiscalStorageI {
+	return &fiscalRepo{
+		db: db,
+	}
+}
+
+// fiscalOutboxAdd queues the payment of the tx for the fiscal module.
+func fiscalOutboxAdd(tx *sqlx.Tx, paymentId string) error {
+	_, err := tx.Exec(`INSERT INTO fiscal_outbox(payment_id) VALUES($1) ON CONFLICT DO NOTHING`, paymentId)
+	return err
+}
+
+func (r *fiscalRepo) FiscalClaim(ids []string, limit int, lease time.Duration) ([]*fiscal.Receipt, error) {
+	if ids == nil {
+		ids = []string{}
+	}
+
+	rows, err := r.db.Query(`
+		UPDATE fiscal_outbox SET
+			attempts = attempts + 1,
+			next_attempt_at = NOW() + $3 * interval '1 second'
+		WHERE payment_id IN (
+			SELECT payment_id FROM fiscal_outbox
+			WHERE next_attempt_at <= NOW()
+				AND (cardinality($1::uuid[]) = 0 OR payment_id = ANY($1::uuid[]))
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING payment_id`, pq.Array(ids), limit, int64(lease.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		claimed = append(claimed, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return r.fiscalReceipts(claimed)
+}
+
+// fiscalReceipts returns the receipts of the payments with the items of their cashboxes,
+// a refund of one service has only that item.
+func (r *fiscalRepo) fiscalReceipts(paymentIds []string) ([]*fiscal.Receipt, error) {
+	result := make([]*fiscal.Receipt, 0, len(paymentIds))
+	if len(paymentIds) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.db.Query(`
+		SELECT
+			id,
+			cashbox_id,
+			client_id,
+			COALESCE(summa, 0),
+			COALESCE(payment_type, ''),
+			refund_reason IS NOT NULL,
+			COALESCE(service_type, ''),
+			COALESCE(service_id, ''),
+			created_at
+		FROM payment_history
+		WHERE id = ANY($1::uuid[])
+		ORDER BY created_at`, pq.Array(paymentIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		cashboxIds = make([]string, 0, len(paymentIds))
+		services   = make(map[*fiscal.Receipt][2]string, len(paymentIds))
+	)
+	for rows.Next() {
+		var (
+			temp                   fiscal.Receipt
+			serviceType, serviceId string
+		)
+		if err := rows.Scan(
+			&temp.PaymentId,
+			&temp.CashboxId,
+			&temp.ClientId,
+			&temp.Summa,
+			&temp.PaymentType,
+			&temp.Refund,
+			&serviceType,
+			&serviceId,
+			&temp.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if temp.Summa < 0 {
+			temp.Summa = -temp.Summa
+		}
+		cashboxIds = append(cashboxIds, temp.CashboxId)
+		services[&temp] = [2]string{serviceType, serviceId}
+		result = append(result, &temp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	items, err := cashboxItems(r.db, cashboxIds...)
+	if err != nil {
+		return nil, err
+	}
+	for _, receipt := range result {
+		service := services[receipt]
+		for _, item := range items[receipt.CashboxId] {
+			if service[0] != "" && (item.ServiceType != service[0] || item.ServiceId != service[1]) {
+				continue
+			}
+			temp := fiscal.Item{
+				Name:        item.Name,
+				ServiceType: item.ServiceType,
+				ServiceId:   item.ServiceId,
+				Price:       item.Price,
+				Quantity:    item.Quantity,
+				Discount:    item.Discount,
+			}
+			if service[0] != "" {
+				temp.Quantity, temp.Discount = 1, item.Discount/item.Quantity
+			}
+			receipt.Items = append(receipt.Items, temp)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *fiscalRepo) FiscalDone(paymentId string, result *fiscal.Result) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		UPDATE payment_history SET
+			fiscal_receipt_number = $2,
+			fiscal_sign = $3,
+			fiscalized_at = NOW()
+		WHERE id = $1`, paymentId, result.ReceiptNumber, result.FiscalSign)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM fiscal_outbox WHERE payment_id = $1`, paymentId)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *fiscalRepo) FiscalFailed(paymentId, reason string, retry time.Duration) error {
+	_, err := r.db.Exec(`
+		UPDATE fiscal_outbox SET
+			last_error = $2,
+			next_attempt_at = NOW() + LEAST($3 * POWER(2, attempts - 1), 3600) * interval '1 second'
+		WHERE payment_id = $1`, paymentId, reason, int64(retry.Seconds()))
+	return err
+}
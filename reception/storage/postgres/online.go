@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+type onlineRepo struct {
+	db *sqlx.DB
+}
+
+func NewOnline(db *sqlx.DB) repo.OnlineStorageI {
+	return &onlineRepo{
+		db: db,
+	}
+}
+
+const onlineColumns = `
+	id,
+	provider,
+	transaction_id,
+	cashbox_id,
+	amount,
+	state,
+	COALESCE(payment_id::text, ''),
+	cancel_reason,
+	created_at::text,
+	COALESCE(performed_at::text, ''),
+	COALESCE(cancelled_at::text, '')`
+
+// onlineHoldTTL is how long a created transaction holds its amount of the cashbox, the provider
+// completes it in minutes and a link left unpaid frees the amount after it.
+const onlineHoldTTL = 30 * time.Minute
+
+// onlineHeld is the amount of the cashbox held by the created transactions not expired yet.
+func onlineHeld(tx *sqlx.Tx, cashboxId string) (int64, error) {
+	var held int64
+	err := tx.QueryRow(`SELECT COALESCE(SUM(amount), 0) FROM online_transactions
+		WHERE cashbox_id = $1 AND state = $2 AND created_at > NOW() - $3 * interval '1 second'`,
+		cashboxId, repo.OnlineCreated, int64(onlineHoldTTL.Seconds()),
+	).Scan(&held)
+	return held, err
+}
+
+func scanOnline(row interface{ Scan(...interface{}) error }) (*patient.OnlineTransaction, error) {
+	var result patient.OnlineTransaction
+	err := row.Scan(
+		&result.Id,
+		&result.Provider,
+		&result.TransactionId,
+		&result.CashboxId,
+		&result.Amount,
+		&result.State,
+		&result.PaymentId,
+		&result.CancelReason,
+		&result.CreatedAt,
+		&result.PerformedAt,
+		&result.CancelledAt,
+	)
+	return &result, err
+}
+
+// onlineLocked returns the transaction locked for the tx.
+func onlineLocked(tx *sqlx.Tx, req *patient.OnlineTransactionReq) (*patient.OnlineTransaction, error) {
+	return scanOnline(tx.QueryRow(`SELECT `+onlineColumns+` FROM online_transactions
+		WHERE provider = $1 AND transaction_id = $2 FOR UPDATE`, req.Provider, req.TransactionId))
+}
+
+func (r *onlineRepo) OnlineCheck(req *patient.OnlineTransactionReq) (*patient.OnlineTransaction, error) {
+	var summa, refunded, paid int64
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	defer tx.Rollback()
+
+	// the cashbox is locked first, so two transactions can not take the same remaining summa
+	err = tx.QueryRow(`SELECT COALESCE(summa, 0), COALESCE(refunded, 0) FROM cashbox
+		WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, req.CashboxId).Scan(&summa, &refunded)
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	result, err := onlineLocked(tx, req)
+	if err == nil {
+		if result.CashboxId != req.CashboxId || result.Amount != req.Amount {
+			return &patient.OnlineTransaction{}, repo.ErrOnlineMismatch
+		}
+		return result, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	err = tx.QueryRow(`SELECT COALESCE(SUM(summa), 0) FROM payment_history
+		WHERE cashbox_id = $1 AND deleted_at IS NULL`, req.CashboxId).Scan(&paid)
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	// created transactions not performed yet hold their amount
+	held, err := onlineHeld(tx, req.CashboxId)
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	if paid+held+req.Amount > summa-refunded {
+		return &patient.OnlineTransaction{}, repo.ErrOverpayment
+	}
+
+	result, err = scanOnline(tx.QueryRow(`
+		INSERT INTO online_transactions(
+			id,
+			provider,
+			transaction_id,
+			cashbox_id,
+			amount
+		)VALUES($1,$2,$3,$4,$5)
+		RETURNING `+onlineColumns,
+		uuid.New().String(), req.Provider, req.TransactionId, req.CashboxId, req.Amount,
+	))
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *onlineRepo) OnlinePerform(req *patient.OnlineTransactionReq, paymentId string) (*patient.OnlineTransaction, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	defer tx.Rollback()
+
+	transaction, err := onlineLocked(tx, req)
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	switch transaction.State {
+	case repo.OnlinePerformed:
+		return transaction, nil
+	case repo.OnlineCancelled:
+		return &patient.OnlineTransaction{}, repo.ErrOnlineState
+	}
+
+	// performed first, so the payment does not find its own amount held
+	result, err := scanOnline(tx.QueryRow(`
+		UPDATE online_transactions SET
+			state = $2,
+			payment_id = $3,
+			performed_at = NOW()
+		WHERE id = $1
+		RETURNING `+onlineColumns, transaction.Id, repo.OnlinePerformed, paymentId))
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	_, err = payCashbox(tx, transaction.CashboxId, "", []*patient.CashboxPayment{{
+		Id:          paymentId,
+		Summa:       transaction.Amount,
+		PaymentType: repo.PaymentOnline,
+	}})
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *onlineRepo) OnlineCancel(req *patient.OnlineTransactionReq, paymentId string) (*patient.OnlineTransaction, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	defer tx.Rollback()
+
+	transaction, err := onlineLocked(tx, req)
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+	if transaction.State == repo.OnlineCancelled {
+		return transaction, nil
+	}
+
+	if transaction.State == repo.OnlinePerformed {
+		var clientId int64
+		err = tx.QueryRow(`SELECT client_id FROM cashbox WHERE id = $1 FOR UPDATE`, transaction.CashboxId).Scan(&clientId)
+		if err != nil {
+			return &patient.OnlineTransaction{}, err
+		}
+
+		if err := fiscalOutboxAdd(tx, paymentId); err != nil {
+			return &patient.OnlineTransaction{}, err
+		}
+		_, err = tx.Exec(`
+			INSERT INTO payment_history(
+				id,
+				client_id,
+				summa,
+				payment_type,
+				cashbox_id,
+				refund_reason
+			)VALUES($1,$2,$3,$4,$5,$6)`,
+			paymentId, clientId, -transaction.Amount, repo.PaymentOnline, transaction.CashboxId, req.Reason,
+		)
+		if err != nil {
+			return &patient.OnlineTransaction{}, err
+		}
+
+		// the debt left by the cashbox grows back by the cancelled payment
+		_, err = tx.Exec(`
+			UPDATE patient_debts SET
+				amount = LEAST(initial_amount, amount + $2),
+				paid_at = NULL,
+				updated_at = NOW()
+			WHERE cashbox_id = $1 AND deleted_at IS NULL`,
+			transaction.CashboxId, transaction.Amount,
+		)
+		if err != nil {
+			return &patient.OnlineTransaction{}, err
+		}
+
+		if err := cashboxPaidSet(tx, transaction.CashboxId); err != nil {
+			return &patient.OnlineTransaction{}, err
+		}
+	}
+
+	result, err := scanOnline(tx.QueryRow(`
+		UPDATE online_transactions SET
+			state = $2,
+			cancel_reason = $3,
+			cancelled_at = NOW()
+		WHERE id = $1
+		RETURNING `+onlineColumns, transaction.Id, repo.OnlineCancelled, req.Reason))
+	if err != nil {
+		return &patient.OnlineTransaction{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (r *onlineRepo) OnlineGet(req *patient.OnlineTransactionReq) (*patient.OnlineTransaction, error) {
+	return scanOnline(r.db.QueryRow(`SELECT `+onlineColumns+` FROM online_transactions
+		WHERE provider = $1 AND transaction_id = $2`, req.Provider, req.TransactionId))
+}
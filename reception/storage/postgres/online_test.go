@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+func TestPayCashboxOnlineHeld(t *testing.T) {
+	db := testDB(t)
+
+	tests := []struct {
+		name    string
+		heldAgo time.Duration
+		summa   int64
+		wantErr error
+	}{
+		{"held amount is not remaining", time.Minute, 30000, repo.ErrOverpayment},
+		{"the rest is remaining", time.Minute, 20000, nil},
+		{"an expired hold frees its amount", onlineHoldTTL + time.Minute, 50000, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := db.Beginx()
+			if err != nil {
+				t.Fatalf("begin: %v", err)
+			}
+			defer tx.Rollback()
+
+			cashboxId := uuid.New().String()
+			_, err = tx.Exec(`INSERT INTO cashbox(id, client_id, summa, gross) VALUES($1, 1, 50000, 50000)`, cashboxId)
+			if err != nil {
+				t.Fatalf("seed cashbox: %v", err)
+			}
+			_, err = tx.Exec(`INSERT INTO online_transactions(id, provider, transaction_id, cashbox_id, amount, created_at)
+				VALUES($1, 'simulator', $2, $3, 30000, $4)`,
+				uuid.New().String(), uuid.New().String(), cashboxId, time.Now().Add(-tt.heldAgo))
+			if err != nil {
+				t.Fatalf("seed transaction: %v", err)
+			}
+
+			_, err = payCashbox(tx, cashboxId, "", []*patient.CashboxPayment{{
+				Id:          uuid.New().String(),
+				Summa:       tt.summa,
+				PaymentType: "cash",
+			}})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("payCashbox(%d) = %v, want %v", tt.summa, err, tt.wantErr)
+			}
+		})
+	}
+}
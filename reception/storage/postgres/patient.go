@@ -3,13 +3,15 @@ package postgres
 import (
 	"database/sql"
 	"errors"
-	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/sqlfilter"
 	"gitlab.com/clinic-crm/reception/storage/repo"
 )
 
@@ -115,6 +117,11 @@ func (pr *patientRepo) PatientCreate(req *patient.Patient) (*patient.Patient, er
 func (pr *patientRepo) PatientGet(req *patient.GetPatientReq) (*patient.Patient, error) {
 	var result patient.Patient
 
+	field, err := sqlfilter.Lookup(req.Field, "id", "client_id", "main_phone_number", "passport_info")
+	if err != nil {
+		return &patient.Patient{}, err
+	}
+
 	query := `
 		SELECT
 			id,
@@ -137,10 +144,10 @@ func (pr *patientRepo) PatientGet(req *patient.GetPatientReq) (*patient.Patient,
 			created_at,
 			updated_at
 		FROM patients
-		WHERE ` + req.Field + `='` + req.Value + `' and deleted_at IS NULL
+		WHERE ` + field + `::text = $1 and deleted_at IS NULL
 	`
 
-	err := pr.db.QueryRow(query).Scan(
+	err = pr.db.QueryRow(query, req.Value).Scan(
 		&result.Id,
 		&result.ClientId,
 		&result.DoctorId,
@@ -288,22 +295,14 @@ func (pr *patientRepo) PatientsFind(req *patient.PatientsFindReq) (*patient.Pati
 		Patients: make([]*patient.Patient, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-
-	filter := "WHERE deleted_at IS NULL"
-	if req.Search != "" {
-		str := "%" + req.Search + "%"
-		filter += fmt.Sprintf(`
-			AND (first_name ILIKE '%s' OR last_name ILIKE '%s' OR patronymic ILIKE '%s' 
-				OR main_phone_number ILIKE '%s' OR other_phone_number ILIKE '%s'
-				OR advertising_channel ILIKE '%s' OR respublic ILIKE '%s' OR region ILIKE '%s' 
-				OR district ILIKE '%s' OR passport_info ILIKE '%s')
-				`,
-			str, str, str, str, str, str, str, str, str, str,
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search,
+			"first_name", "last_name", "patronymic",
+			"main_phone_number", "other_phone_number",
+			"advertising_channel", "respublic", "region",
+			"district", "passport_info",
 		)
-	}
+	limit, args := filter.Page(req.Limit, req.Page)
 
 	query := `
 	SELECT 
@@ -327,11 +326,11 @@ func (pr *patientRepo) PatientsFind(req *patient.PatientsFindReq) (*patient.Pati
 		created_at,
 		updated_at
 	FROM
-		patients 
-	` + filter + `
+		patients
+	` + filter.String() + `
 	ORDER BY created_at desc
 	` + limit
-	rows, err := pr.db.Query(query)
+	rows, err := pr.db.Query(query, args...)
 	if err != nil {
 		return &patient.PatientsResp{}, err
 	}
@@ -367,8 +366,8 @@ func (pr *patientRepo) PatientsFind(req *patient.PatientsFindReq) (*patient.Pati
 
 		result.Patients = append(result.Patients, &temp)
 	}
-	queryCount := `SELECT count(1) FROM patients ` + filter
-	err = pr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT count(1) FROM patients ` + filter.String()
+	err = pr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &patient.PatientsResp{}, err
 	}
@@ -381,19 +380,29 @@ func (pr *patientRepo) PatientsGetInfo(req *patient.PatientsGetInfoFilter) (*pat
 		Patients: make([]*patient.Patient, 0),
 	}
 
-	offset := (req.Page - 1) * req.Limit
-
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
-
-	filter := "WHERE deleted_at IS NULL"
-	strPhone := "%" + req.PhoneNumber + "%"
-	strName := "%" + req.Fullname + "%"
-
-	filter += fmt.Sprintf(`
-			AND (client_id ILIKE '%d' OR main_phone_number ILIKE '%s' OR first_name ILIKE '%s')
-			`,
-		req.ClientId, strPhone, strName,
+	// a patient matching any of the given client id, phone number or name is returned
+	var (
+		matches   []string
+		matchArgs []interface{}
 	)
+	if req.ClientId != 0 {
+		matches = append(matches, "client_id = ?")
+		matchArgs = append(matchArgs, req.ClientId)
+	}
+	if req.PhoneNumber != "" {
+		matches = append(matches, "main_phone_number ILIKE ?")
+		matchArgs = append(matchArgs, sqlfilter.Contains(req.PhoneNumber))
+	}
+	if req.Fullname != "" {
+		matches = append(matches, "concat_ws(' ', first_name, last_name, patronymic) ILIKE ?")
+		matchArgs = append(matchArgs, sqlfilter.Contains(req.Fullname))
+	}
+
+	filter := sqlfilter.New("deleted_at IS NULL")
+	if len(matches) != 0 {
+		filter.Where("("+strings.Join(matches, " OR ")+")", matchArgs...)
+	}
+	limit, args := filter.Page(int64(req.Limit), int64(req.Page))
 
 	query := `
 	SELECT 
@@ -417,11 +426,11 @@ func (pr *patientRepo) PatientsGetInfo(req *patient.PatientsGetInfoFilter) (*pat
 		created_at,
 		updated_at
 	FROM
-		patients 
-	` + filter + `
+		patients
+	` + filter.String() + `
 	ORDER BY created_at desc
 	` + limit
-	rows, err := pr.db.Query(query)
+	rows, err := pr.db.Query(query, args...)
 	if err != nil {
 		return &patient.PatientsResp{}, err
 	}
@@ -457,8 +466,8 @@ func (pr *patientRepo) PatientsGetInfo(req *patient.PatientsGetInfoFilter) (*pat
 
 		result.Patients = append(result.Patients, &temp)
 	}
-	queryCount := `SELECT count(1) FROM patients ` + filter
-	err = pr.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT count(1) FROM patients ` + filter.String()
+	err = pr.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &patient.PatientsResp{}, err
 	}
@@ -599,32 +608,28 @@ func (r *patientRepo) DoctorReportGet(req *patient.PatientId) (*patient.DoctorRe
 	return &result, nil
 }
 
-// Queue
-func (r *patientRepo) CreatePatientQueue(req *patient.CreatePatientQueueReq) (*patient.PatientQueueResp, error) {
-	var (
-		result patient.PatientQueueResp
-	)
-	query := `
-		INSERT INTO queues(
-			id,
-			client_id,
-			queue_number,
-			service_id,
-			service_type,
-			turn_passed
-		)values($1,$2,$3,$4,$5,false)
-		RETURNING
-			id,
-			client_id,
-			queue_number,
-			service_id,
-			service_type,
-			turn_passed,
-			created_at,
-			updated_at
-	`
-
-	if err := r.db.QueryRow(query, req.Id, req.ClientId, req.QueueNumber, req.ServiceId, req.ServiceType).Scan(
+const queueColumns = `
+	id,
+	client_id,
+	queue_number,
+	service_id,
+	service_type,
+	turn_passed,
+	created_at,
+	updated_at,
+	queue_day::text,
+	status,
+	COALESCE(called_at::text,'') as called_at,
+	COALESCE(in_service_at::text,'') as in_service_at,
+	COALESCE(done_at::text,'') as done_at,
+	COALESCE(skipped_at::text,'') as skipped_at,
+	COALESCE(no_show_at::text,'') as no_show_at,
+	COALESCE(recalled_at::text,'') as recalled_at
+`
+
+func scanQueue(row interface{ Scan(...interface{}) error }) (*patient.PatientQueueResp, error) {
+	var result patient.PatientQueueResp
+	err := row.Scan(
 		&result.Id,
 		&result.ClientId,
 		&result.QueueNumber,
@@ -633,66 +638,84 @@ func (r *patientRepo) CreatePatientQueue(req *patient.CreatePatientQueueReq) (*p
 		&result.TurnPassed,
 		&result.CreatedAt,
 		&result.UpdatedAt,
-	); err != nil {
+		&result.QueueDay,
+		&result.Status,
+		&result.CalledAt,
+		&result.InServiceAt,
+		&result.DoneAt,
+		&result.SkippedAt,
+		&result.NoShowAt,
+		&result.RecalledAt,
+	)
+	if err != nil {
 		return &patient.PatientQueueResp{}, err
 	}
+
 	return &result, nil
 }
 
-func (r *patientRepo) GetPatientQueue(req *patient.PaymentHistoryId) (*patient.PatientQueueResp, error) {
-	var (
-		result patient.PatientQueueResp
-	)
+// CreatePatientQueue allocates the next number of the service for the queue day
+// from queue_counters and inserts the queue in one statement. The counter row is
+// locked by the upsert, so concurrent registrations get consecutive numbers.
+func (r *patientRepo) CreatePatientQueue(req *patient.CreatePatientQueueReq, queueDay string) (*patient.PatientQueueResp, error) {
+	return createQueue(r.db, req, queueDay)
+}
+
+// createQueue gives the next queue number of the service on the queue day to the client.
+func createQueue(q sqlx.Queryer, req *patient.CreatePatientQueueReq, queueDay string) (*patient.PatientQueueResp, error) {
 	query := `
-		SELECT
+		WITH counter AS (
+			INSERT INTO queue_counters(
+				service_id,
+				service_type,
+				queue_day,
+				last_number
+			) VALUES($3, $4, $5, 1)
+			ON CONFLICT (service_id, service_type, queue_day)
+			DO UPDATE SET last_number = queue_counters.last_number + 1
+			RETURNING last_number
+		)
+		INSERT INTO queues(
 			id,
 			client_id,
 			queue_number,
 			service_id,
 			service_type,
+			queue_day,
 			turn_passed,
-			created_at,
-			updated_at
-		FROM queues 
-		WHERE id=$1
-	`
-	if err := r.db.QueryRow(query, req.Id).Scan(
-		&result.Id,
-		&result.ClientId,
-		&result.QueueNumber,
-		&result.ServiceId,
-		&result.ServiceType,
-		&result.TurnPassed,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	); err != nil {
-		return &patient.PatientQueueResp{}, err
-	}
+			status
+		)
+		SELECT $1, $2, last_number, $3, $4, $5, false, 'waiting' FROM counter
+		RETURNING ` + queueColumns
 
-	return &result, nil
+	return scanQueue(q.QueryRowx(query, req.Id, req.ClientId, req.ServiceId, req.ServiceType, queueDay))
+}
+
+func (r *patientRepo) GetPatientQueue(req *patient.PaymentHistoryId) (*patient.PatientQueueResp, error) {
+	query := `SELECT ` + queueColumns + ` FROM queues WHERE id=$1 AND deleted_at IS NULL`
+
+	return scanQueue(r.db.QueryRow(query, req.Id))
 }
 
-func (r *patientRepo) CheckServiceQueue(req *patient.CheckQueueReq) (*patient.QueueNumber, error) {
+// CheckServiceQueue returns the last queue number given out for the service on the queue day.
+func (r *patientRepo) CheckServiceQueue(req *patient.CheckQueueReq, queueDay string) (*patient.QueueNumber, error) {
 	var (
 		result int64
 	)
 
 	query := `
-		SELECT 
-			count(1) 
-		FROM queues
+		SELECT
+			COALESCE(MAX(last_number), 0)
+		FROM queue_counters
 		WHERE
-			service_id = $1 AND service_type = $2 AND
-			DATE(NOW())-DATE(created_at)=0 AND deleted_at IS NULL
+			service_id = $1 AND service_type = $2 AND queue_day = $3
 	`
-	row := r.db.QueryRow(query, req.ServiceId, req.ServiceType)
+	row := r.db.QueryRow(query, req.ServiceId, req.ServiceType, queueDay)
 
 	err := row.Scan(
 		&result,
 	)
-	if errors.Is(err, sql.ErrNoRows) {
-		return &patient.QueueNumber{}, err
-	} else if err != nil {
+	if err != nil {
 		log.Println(err.Error())
 		return &patient.QueueNumber{}, err
 	}
@@ -700,96 +723,50 @@ func (r *patientRepo) CheckServiceQueue(req *patient.CheckQueueReq) (*patient.Qu
 	return &patient.QueueNumber{QueueNumber: result}, nil
 }
 
-func (r *patientRepo) UpdateQueue(req *patient.UpdateQueueReq) (*patient.PatientQueueResp, error) {
-	var (
-		result patient.PatientQueueResp
-	)
-	query := `
-		UPDATE queues SET
-			turn_passed = NOT turn_passed,
-			updated_at = NOW()
-		WHERE
-			client_id = $1 AND service_id = $2 AND service_type = $3
-		RETURNING
-			id,
-			client_id,
-			queue_number,
-			service_id,
-			service_type,
-			turn_passed,
-			created_at,
-			updated_at
-	`
-	row := r.db.QueryRow(query, req.ClientId, req.ServiceId, req.ServiceType)
-	if err := row.Scan(
-		&result.Id,
-		&result.ClientId,
-		&result.QueueNumber,
-		&result.ServiceId,
-		&result.ServiceType,
-		&result.TurnPassed,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	); err != nil {
-		return &patient.PatientQueueResp{}, err
-	}
-	return &result, nil
-}
-
 func (r *patientRepo) FindQueue(req *patient.QueueFilter) (*patient.QueuesResp, error) {
-	var (
-		result patient.QueuesResp
-	)
-	offset := (req.Page - 1) * req.Limit
-
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
+	result := patient.QueuesResp{
+		Queues: make([]*patient.PatientQueueResp, 0),
+	}
 
-	filter := fmt.Sprintf("WHERE service_id='%s' AND service_type='%s' AND turn_passed=FALSE AND deleted_at IS NULL", req.ServiceId, req.ServiceType)
+	// the queues of a client can be found across the services
+	filter := sqlfilter.New("deleted_at IS NULL")
+	if req.ServiceId != "" || req.ClientId == 0 {
+		filter.Where("service_id = ? AND service_type = ?", req.ServiceId, req.ServiceType)
+	}
+	if req.Status != "" {
+		filter.Where("status = ?", req.Status)
+	} else {
+		filter.Where("turn_passed = FALSE")
+	}
 	if req.ClientId != 0 {
-		filter += fmt.Sprintf(` AND client_id = %d`, req.ClientId)
+		filter.Where("client_id = ?", req.ClientId)
 	}
+	if req.FromDate != "" {
+		filter.Where("queue_day >= ?::date", req.FromDate)
+	}
+	if req.ToDate != "" {
+		filter.Where("queue_day <= ?::date", req.ToDate)
+	}
+	limit, args := filter.Page(req.Limit, req.Page)
 
-	query := `
-	SELECT 
-		COALESCE(id,'95233a87-add9-4da7-a788-901ff3170ecd') as id,
-		COALESCE(client_id,0) as client_id,
-		COALESCE(queue_number,0) as queue_number,
-		COALESCE(service_id,'95233a87-add9-4da7-a788-901ff3170ecd') as service_id,
-		COALESCE(service_type,'')as service_id,
-		COALESCE(turn_passed,false) as turn_passed,
-		created_at,
-		updated_at
-	FROM queues
-		` + filter + ` ORDER BY created_at asc ` + limit
+	query := `SELECT ` + queueColumns + ` FROM queues` + filter.String() + ` ORDER BY created_at asc` + limit
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
-		if err.Error() == "pq: function coalece(uuid, unknown) does not exist" {
-			return &patient.QueuesResp{}, nil
-		}
 		return &patient.QueuesResp{}, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var temp patient.PatientQueueResp
-		if err := rows.Scan(
-			&temp.Id,
-			&temp.ClientId,
-			&temp.QueueNumber,
-			&temp.ServiceId,
-			&temp.ServiceType,
-			&temp.TurnPassed,
-			&temp.CreatedAt,
-			&temp.UpdatedAt,
-		); err != nil {
+		temp, err := scanQueue(rows)
+		if err != nil {
 			return &patient.QueuesResp{}, err
 		}
-		result.Queues = append(result.Queues, &temp)
+		result.Queues = append(result.Queues, temp)
 	}
 
-	queryCount := `SELECT count(1) FROM queues ` + filter
-	err = r.db.QueryRow(queryCount).Scan(&result.Count)
+	queryCount := `SELECT count(1) FROM queues` + filter.String()
+	err = r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count)
 	if err != nil {
 		return &patient.QueuesResp{}, err
 	}
@@ -797,262 +774,455 @@ func (r *patientRepo) FindQueue(req *patient.QueueFilter) (*patient.QueuesResp,
 	return &result, nil
 }
 
-func (r *patientRepo) CreateCashbox(req *patient.CashboxResp) (*patient.CashboxResp, error) {
-	var (
-		result                          patient.CashboxResp
-		DoctorsIds, LabsIds, AparatsIds string
-	)
+// CallNextQueue moves the first recalled or waiting queue of the service to called.
+// The counter row of the day is locked first, so two parallel calls can not pick
+// the same patient or call while another patient is still called or in service.
+func (r *patientRepo) CallNextQueue(req *patient.CallNextReq, queueDay string) (*patient.PatientQueueResp, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.PatientQueueResp{}, err
+	}
+	defer tx.Rollback()
+
+	var locked int
+	err = tx.QueryRow(`
+		SELECT 1 FROM queue_counters
+		WHERE service_id = $1 AND service_type = $2 AND queue_day = $3
+		FOR UPDATE`,
+		req.ServiceId, req.ServiceType, queueDay,
+	).Scan(&locked)
+	if err != nil {
+		return &patient.PatientQueueResp{}, err
+	}
 
-	query := `
-		INSERT INTO cashbox(
-			id,
-			client_id,
-			summa,
-			is_payed,
-			cash_count,
-			payment_type,
-			doctors_ids,
-			aparats_ids,
-			labs_ids
-		)values($1,$2,$3,$4,$5,$6,$7,$8,$9)
-		RETURNING
-			COALESCE(id,'95233a87-add9-4da7-a788-901ff3170ecd') as id,
-			COALESCE(client_id,0) as client_id,
-			COALESCE(summa,0) as summa,
-			COALESCE(is_payed,false) as is_payed,
-			COALESCE(cash_count,0) as cash_count,
-			COALESCE(payment_type,'') as payment_type,
-			doctors_ids,
-			labs_ids,
-			aparats_ids,
-			COALESCE(created_at,'1900-01-01') as created_at,
-			COALESCE(updated_at,'1900-01-01') as created_at
-	`
+	var active int
+	err = tx.QueryRow(`
+		SELECT count(1) FROM queues
+		WHERE service_id = $1 AND service_type = $2 AND queue_day = $3
+			AND status IN ('called', 'in_service') AND deleted_at IS NULL`,
+		req.ServiceId, req.ServiceType, queueDay,
+	).Scan(&active)
+	if err != nil {
+		return &patient.PatientQueueResp{}, err
+	}
+	if active > 0 {
+		return &patient.PatientQueueResp{}, repo.ErrQueueStatus
+	}
 
-	row := r.db.DB.QueryRow(query, req.Id, req.ClientId, req.Summa,
-		req.IsPayed, req.CashCount, req.PaymentType,
-		pq.Array(req.DoctorsIds), pq.Array(req.AparatsIds), pq.Array(req.LabsIds))
+	query := `
+		UPDATE queues SET
+			status = 'called',
+			called_at = NOW(),
+			updated_at = NOW()
+		WHERE id = (
+			SELECT id FROM queues
+			WHERE service_id = $1 AND service_type = $2 AND queue_day = $3
+				AND status IN ('waiting', 'recalled') AND deleted_at IS NULL
+			ORDER BY status = 'recalled' DESC, queue_number
+			LIMIT 1
+		)
+		RETURNING ` + queueColumns
 
-	if err := row.Scan(
-		&result.Id,
-		&result.ClientId,
-		&result.Summa,
-		&result.IsPayed,
-		&result.CashCount,
-		&result.PaymentType,
-		&DoctorsIds,
-		&LabsIds,
-		&AparatsIds,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	); err != nil {
-		return &patient.CashboxResp{}, err
+	result, err := scanQueue(tx.QueryRow(query, req.ServiceId, req.ServiceType, queueDay))
+	if err != nil {
+		return &patient.PatientQueueResp{}, err
 	}
 
-	result.DoctorsIds = strings.Split(string(DoctorsIds), ",")
-	result.AparatsIds = strings.Split(string(AparatsIds), ",")
-	result.LabsIds = strings.Split(string(LabsIds), ",")
-
-	return &result, nil
+	return result, tx.Commit()
 }
 
-func (r *patientRepo) FindCashbox(req *patient.FindCashboxReq, ClientIds []int) (*patient.FindCashboxResp, error) {
-
-	var (
-		result patient.FindCashboxResp
-	)
-
-	offset := (req.Page - 1) * req.Limit
+// QueueTransition moves the queue to status if it is currently in one of from
+// and stamps the transition time. repo.ErrQueueStatus is returned when the queue
+// exists but its status does not allow the transition.
+func (r *patientRepo) QueueTransition(id string, from []string, status string) (*patient.PatientQueueResp, error) {
+	stamp, ok := queueStatusStamps[status]
+	if !ok {
+		return &patient.PatientQueueResp{}, repo.ErrQueueStatus
+	}
 
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
+	query := `
+		UPDATE queues SET
+			status = $2,
+			` + stamp + ` = NOW(),
+			turn_passed = $2 IN ('done', 'no_show'),
+			updated_at = NOW()
+		WHERE id = $1 AND status = ANY($3) AND deleted_at IS NULL
+		RETURNING ` + queueColumns
 
-	filter := " WHERE deleted_at IS NULL "
-	if req.ClientId != 0 || len(ClientIds) != 0 {
-		if req.ClientId != 0 {
-			ClientIds = append(ClientIds, int(req.ClientId))
+	result, err := scanQueue(r.db.QueryRow(query, id, status, pq.Array(from)))
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists bool
+		if err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM queues WHERE id = $1 AND deleted_at IS NULL)`, id).Scan(&exists); err != nil {
+			return &patient.PatientQueueResp{}, err
 		}
-		for index, client_id := range ClientIds {
-			if index == 0 {
-				filter += fmt.Sprintf(` AND (client_id=%d `, client_id)
-			} else {
-				filter += fmt.Sprintf(` OR client_id=%d `, client_id)
-			}
+		if exists {
+			return &patient.PatientQueueResp{}, repo.ErrQueueStatus
 		}
-		filter += ` ) `
 	}
 
-	if req.FromDate != "" || req.ToDate != "" {
-		if req.FromDate == "" {
-			req.FromDate = "1000-01-01"
-		}
-		if req.ToDate == "" {
-			req.ToDate = "3000-01-01"
-		}
-		filter += fmt.Sprintf(" AND (%s <= created_at AND created_at<= %s) ", req.FromDate, req.ToDate)
+	return result, err
+}
+
+var queueStatusStamps = map[string]string{
+	repo.QueueCalled:    "called_at",
+	repo.QueueInService: "in_service_at",
+	repo.QueueDone:      "done_at",
+	repo.QueueSkipped:   "skipped_at",
+	repo.QueueNoShow:    "no_show_at",
+	repo.QueueRecalled:  "recalled_at",
+	repo.QueueCancelled: "cancelled_at",
+}
+
+// FindQueuePatients returns distinct patients queued to the service in the date range
+// with their last visit, newest visit first.
+func (r *patientRepo) FindQueuePatients(req *patient.QueueFilter) (*patient.QueuePatientsResp, error) {
+	result := patient.QueuePatientsResp{
+		Patients: make([]*patient.QueuePatient, 0),
+	}
+
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Where("service_id = ? AND service_type = ?", req.ServiceId, req.ServiceType)
+	if req.ClientId != 0 {
+		filter.Where("client_id = ?", req.ClientId)
+	}
+	dateRange(filter, req.FromDate, req.ToDate)
+
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	// counted over the same join as the page, a deleted patient is in neither
+	queryCount := `
+	SELECT count(1)
+	FROM (SELECT DISTINCT client_id FROM queues` + filter.String() + `) q
+	JOIN patients p ON p.client_id = q.client_id AND p.deleted_at IS NULL`
+	if err := r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count); err != nil {
+		return &patient.QueuePatientsResp{}, err
 	}
 
 	query := `
-		SELECT 
-			id,
+	SELECT
+		p.id,
+		p.client_id,
+		COALESCE(q.queue_number,0) as queue_number,
+		COALESCE(p.first_name,'') as first_name,
+		COALESCE(p.last_name,'') as last_name,
+		COALESCE(p.main_phone_number,'') as main_phone_number,
+		q.created_at
+	FROM (
+		SELECT DISTINCT ON (client_id)
 			client_id,
-			summa,
-			is_payed,
-			cash_count,
-			payment_type,
-			doctors_ids,
-			labs_ids,
-			aparats_ids,
-			created_at,
-			updated_at
-		FROM cashbox
-	` + filter + ` ORDER BY created_at asc ` + limit
+			queue_number,
+			created_at
+		FROM queues
+		` + filter.String() + `
+		ORDER BY client_id, created_at DESC
+	) q
+	JOIN patients p ON p.client_id = q.client_id AND p.deleted_at IS NULL
+	ORDER BY q.created_at DESC
+	` + limit
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
-		if err.Error() == "pq: function coalece(uuid, unknown) does not exist" {
-			return &patient.FindCashboxResp{}, nil
-		}
-		return &patient.FindCashboxResp{}, err
+		return &patient.QueuePatientsResp{}, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var DoctorsIds, LabsIds, AparatsIds string
-		var temp patient.CashboxResp
+		var temp patient.QueuePatient
 		if err := rows.Scan(
-			&temp.Id,
+			&temp.PatientId,
 			&temp.ClientId,
-			&temp.Summa,
-			&temp.IsPayed,
-			&temp.CashCount,
-			&temp.PaymentType,
-			&DoctorsIds,
-			&LabsIds,
-			&AparatsIds,
-			&temp.CreatedAt,
-			&temp.UpdatedAt,
+			&temp.QueueNumber,
+			&temp.FirstName,
+			&temp.LastName,
+			&temp.PhoneNumber,
+			&temp.DateLastVisit,
 		); err != nil {
-			return &patient.FindCashboxResp{}, err
+			return &patient.QueuePatientsResp{}, err
 		}
+		result.Patients = append(result.Patients, &temp)
+	}
+
+	return &result, rows.Err()
+}
 
-		temp.DoctorsIds = strings.Split(string(DoctorsIds), ",")
-		temp.AparatsIds = strings.Split(string(AparatsIds), ",")
-		temp.LabsIds = strings.Split(string(LabsIds), ",")
+// QueueBoard returns the called and in service queues of the service for the queue day
+// and up to nextLimit queues waiting after them, recalled ones first.
+func (r *patientRepo) QueueBoard(req *patient.WatchQueueReq, queueDay string) (*patient.QueueBoard, error) {
+	result := patient.QueueBoard{
+		ServiceId:   req.ServiceId,
+		ServiceType: req.ServiceType,
+		QueueDay:    queueDay,
+		Current:     make([]*patient.QueueBoardEntry, 0),
+		Next:        make([]*patient.QueueBoardEntry, 0),
+	}
+
+	query := `
+		SELECT
+			id,
+			queue_number,
+			status,
+			COALESCE(called_at::text,'') as called_at
+		FROM queues
+		WHERE service_id = $1 AND service_type = $2 AND queue_day = $3
+			AND status = ANY($4) AND deleted_at IS NULL
+		ORDER BY status = 'recalled' DESC, queue_number
+		LIMIT $5
+	`
 
-		result.Cashboxes = append(result.Cashboxes, &temp)
+	scan := func(statuses []string, limit int64) ([]*patient.QueueBoardEntry, error) {
+		rows, err := r.db.Query(query, req.ServiceId, req.ServiceType, queueDay, pq.Array(statuses), limit)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		entries := make([]*patient.QueueBoardEntry, 0)
+		for rows.Next() {
+			var temp patient.QueueBoardEntry
+			err := rows.Scan(&temp.QueueId, &temp.QueueNumber, &temp.Status, &temp.CalledAt)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, &temp)
+		}
+		return entries, rows.Err()
 	}
 
-	queryCount := `SELECT count(1) FROM cashbox ` + filter
-	err = r.db.QueryRow(queryCount).Scan(&result.Count)
+	var err error
+	result.Current, err = scan([]string{repo.QueueCalled, repo.QueueInService}, 100)
 	if err != nil {
-		fmt.Println(743)
-		return &patient.FindCashboxResp{}, err
+		return &patient.QueueBoard{}, err
+	}
+	result.Next, err = scan([]string{repo.QueueWaiting, repo.QueueRecalled}, req.NextLimit)
+	if err != nil {
+		return &patient.QueueBoard{}, err
 	}
+
 	return &result, nil
 }
 
-func (r *patientRepo) GetCashbox(req *patient.GetCashboxReq) (*patient.CashboxResp, error) {
-	var (
-		result                          patient.CashboxResp
-		DoctorsIds, LabsIds, AparatsIds string
-	)
-
-	query := `
-	SELECT 
-		id,
-		client_id,
-		summa,
-		is_payed,
-		cash_count,
-		payment_type,
-		doctors_ids,
-		labs_ids,
-		aparats_ids,
-		created_at,
-		updated_at
-	FROM cashbox
-	WHERE id=$1 AND deleted_at IS NULL
-	`
-	row := r.db.QueryRow(query, req.CashboxId)
-	if err := row.Scan(
+const cashboxColumns = `
+	id,
+	client_id,
+	COALESCE(summa, 0),
+	COALESCE(is_payed, false),
+	COALESCE(cash_count, 0),
+	COALESCE(payment_type, ''),
+	created_at,
+	updated_at,
+	COALESCE(refunded, 0),
+	COALESCE(gross, summa, 0),
+	COALESCE(discount, 0),
+	COALESCE(staff_id::text, ''),
+	` + cashboxPaid
+
+func scanCashbox(row interface{ Scan(...interface{}) error }) (*patient.CashboxResp, error) {
+	var result patient.CashboxResp
+	err := row.Scan(
 		&result.Id,
 		&result.ClientId,
 		&result.Summa,
 		&result.IsPayed,
 		&result.CashCount,
 		&result.PaymentType,
-		&DoctorsIds,
-		&LabsIds,
-		&AparatsIds,
 		&result.CreatedAt,
 		&result.UpdatedAt,
-	); err != nil {
-		return &patient.CashboxResp{}, err
-	}
-
-	result.DoctorsIds = strings.Split(string(DoctorsIds), ",")
-	result.AparatsIds = strings.Split(string(AparatsIds), ",")
-	result.LabsIds = strings.Split(string(LabsIds), ",")
-
-	return &result, nil
+		&result.Refunded,
+		&result.Gross,
+		&result.Discount,
+		&result.StaffId,
+		&result.Paid,
+	)
+	result.Remaining = result.Summa - result.Refunded - result.Paid
+	return &result, err
 }
 
-func (r *patientRepo) UpdateCashbox(req *patient.UpdateCashboxReq) (*patient.CashboxResp, error) {
+func (r *patientRepo) CreateCashbox(req *patient.CashboxResp) (*patient.CashboxResp, error) {
+	query := `
+		INSERT INTO cashbox(
+			id,
+			client_id,
+			summa,
+			is_payed,
+			cash_count,
+			payment_type,
+			gross,
+			discount,
+			staff_id
+		)values($1,$2,$3,$4,$5,$6,$7,$8,NULLIF($9, '')::uuid)
+		RETURNING ` + cashboxColumns
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	defer tx.Rollback()
+
+	result, err := scanCashbox(tx.QueryRow(query, req.Id, req.ClientId, req.Summa,
+		req.IsPayed, req.CashCount, req.PaymentType, req.Gross, req.Discount, req.StaffId))
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	queryItem := `
+		INSERT INTO cashbox_items(
+			id,
+			cashbox_id,
+			position,
+			service_type,
+			service_id,
+			name,
+			price,
+			quantity,
+			discount,
+			discount_id,
+			discount_name,
+			doctor_id
+		)VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,NULLIF($10, '')::uuid,$11,NULLIF($12, ''))
+	`
+	for i, item := range req.Items {
+		item.Id = uuid.New().String()
+		_, err := tx.Exec(queryItem, item.Id, result.Id, i+1, item.ServiceType, item.ServiceId, item.Name,
+			item.Price, item.Quantity, item.Discount, item.DiscountId, item.DiscountName, item.DoctorId)
+		if err != nil {
+			return &patient.CashboxResp{}, err
+		}
+	}
+	cashboxItemsSet(result, req.Items)
+
+	return result, tx.Commit()
+}
+
+func (r *patientRepo) FindCashbox(req *patient.FindCashboxReq, ClientIds []int) (*patient.FindCashboxResp, error) {
+
 	var (
-		result                          patient.CashboxResp
-		DoctorsIds, LabsIds, AparatsIds string
+		result patient.FindCashboxResp
 	)
 
+	filter := sqlfilter.New("deleted_at IS NULL")
+	if req.ClientId != 0 || len(ClientIds) != 0 {
+		clientIds := make([]int64, 0, len(ClientIds)+1)
+		for _, clientId := range ClientIds {
+			clientIds = append(clientIds, int64(clientId))
+		}
+		if req.ClientId != 0 {
+			clientIds = append(clientIds, req.ClientId)
+		}
+		filter.Where("client_id = ANY(?)", pq.Array(clientIds))
+	}
+	dateRange(filter, req.FromDate, req.ToDate)
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	query := `SELECT ` + cashboxColumns + ` FROM cashbox ` +
+		filter.String() + ` ORDER BY created_at asc ` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &patient.FindCashboxResp{}, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		temp, err := scanCashbox(rows)
+		if err != nil {
+			return &patient.FindCashboxResp{}, err
+		}
+		ids = append(ids, temp.Id)
+		result.Cashboxes = append(result.Cashboxes, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &patient.FindCashboxResp{}, err
+	}
+
+	items, err := cashboxItems(r.db, ids...)
+	if err != nil {
+		return &patient.FindCashboxResp{}, err
+	}
+	for _, cashbox := range result.Cashboxes {
+		cashboxItemsSet(cashbox, items[cashbox.Id])
+	}
+
+	queryCount := `
+		SELECT
+			count(1),
+			COALESCE(SUM(COALESCE(gross, summa)), 0),
+			COALESCE(SUM(discount), 0),
+			COALESCE(SUM(summa), 0)
+		FROM cashbox ` + filter.String()
+	err = r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count, &result.Gross, &result.Discount, &result.Net)
+	if err != nil {
+		return &patient.FindCashboxResp{}, err
+	}
+	return &result, nil
+}
+
+func (r *patientRepo) GetCashbox(req *patient.GetCashboxReq) (*patient.CashboxResp, error) {
+	query := `SELECT ` + cashboxColumns + ` FROM cashbox
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := scanCashbox(r.db.QueryRow(query, req.CashboxId))
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	payments, err := r.cashboxPayments(result.Id)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	result.Payments = payments
+
+	items, err := cashboxItems(r.db, result.Id)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	cashboxItemsSet(result, items[result.Id])
+
+	return result, nil
+}
+
+func (r *patientRepo) UpdateCashbox(req *patient.UpdateCashboxReq) (*patient.CashboxResp, error) {
 	query := `
 	UPDATE cashbox SET 
-		is_payed=$1,
-		payment_type=$2,
+		payment_type=COALESCE(NULLIF($1, ''), payment_type),
 		updated_at=NOW()
-	WHERE id=$3 AND deleted_at IS NULL
-	RETURNING
-		id,
-		client_id,
-		summa,
-		is_payed,
-		cash_count,
-		payment_type,
-		doctors_ids,
-		labs_ids,
-		aparats_ids,
-		created_at,
-		updated_at
-	`
+	WHERE id=$2 AND deleted_at IS NULL
+	RETURNING ` + cashboxColumns
 
-	row := r.db.QueryRow(query, req.IsPayed, req.PaymentType, req.Id)
-	if err := row.Scan(
-		&result.Id,
-		&result.ClientId,
-		&result.Summa,
-		&result.IsPayed,
-		&result.CashCount,
-		&result.PaymentType,
-		&DoctorsIds,
-		&LabsIds,
-		&AparatsIds,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	); err != nil {
+	result, err := scanCashbox(r.db.QueryRow(query, req.PaymentType, req.Id))
+	if err != nil {
 		return &patient.CashboxResp{}, err
 	}
-	result.DoctorsIds = strings.Split(string(DoctorsIds), ",")
-	result.AparatsIds = strings.Split(string(AparatsIds), ",")
-	result.LabsIds = strings.Split(string(LabsIds), ",")
 
-	return &result, nil
+	items, err := cashboxItems(r.db, result.Id)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	cashboxItemsSet(result, items[result.Id])
+
+	return result, nil
 }
 
+// DeleteCashbox deletes a cashbox nothing was paid for, paid ones are refunded
+// with CashboxRefund to keep the payments trail.
 func (r *patientRepo) DeleteCashbox(req *patient.GetCashboxReq) error {
+	var payments int64
+
+	err := r.db.QueryRow(`SELECT count(1) FROM payment_history
+		WHERE cashbox_id = $1 AND deleted_at IS NULL`, req.CashboxId).Scan(&payments)
+	if err != nil {
+		return err
+	}
+	if payments != 0 {
+		return repo.ErrCashboxPaid
+	}
+
 	query := `
 		UPDATE 
 			cashbox
 		SET
 			deleted_at = NOW()
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 	effect, err := r.db.Exec(query, req.CashboxId)
 	if err != nil {
@@ -1070,64 +1240,301 @@ func (r *patientRepo) DeleteCashbox(req *patient.GetCashboxReq) error {
 }
 
 func (r *patientRepo) CreatePaymentHistory(req *patient.CreatePaymentHistoryReq) (*patient.PaymentHistoryResp, error) {
-	var (
-		result patient.PaymentHistoryResp
-	)
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.PaymentHistoryResp{}, err
+	}
+	defer tx.Rollback()
 
-	query := `
+	result, err := payCashbox(tx, req.CashboxId, req.StaffId, []*patient.CashboxPayment{{
+		Id:          req.Id,
+		Summa:       req.Summa,
+		PaymentType: req.PaymentType,
+	}})
+	if err != nil {
+		return &patient.PaymentHistoryResp{}, err
+	}
+
+	return result[0], tx.Commit()
+}
+
+// CashboxPay records all the payments of one bill split across payment types at once.
+func (r *patientRepo) CashboxPay(req *patient.CashboxPayReq) (*patient.CashboxResp, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	defer tx.Rollback()
+
+	if _, err := payCashbox(tx, req.CashboxId, req.StaffId, req.Payments); err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	return r.GetCashbox(&patient.GetCashboxReq{CashboxId: req.CashboxId})
+}
+
+// CashboxRefund refunds the whole cashbox or one unit of an item of it. The refunded price no longer
+// has to be paid, what was paid over the rest is returned as a negative payment with the
+// reason on the open shift of the staff, and the queues of the refunded services are cancelled.
+func (r *patientRepo) CashboxRefund(req *patient.CashboxRefundReq, queueDay string) (*patient.CashboxResp, error) {
+	var clientId, summa, refunded, paid int64
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(`
+		SELECT client_id, COALESCE(summa, 0), COALESCE(refunded, 0)
+		FROM cashbox
+		WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, req.CashboxId,
+	).Scan(&clientId, &summa, &refunded)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	err = tx.QueryRow(`SELECT COALESCE(SUM(summa), 0) FROM payment_history
+		WHERE cashbox_id = $1 AND deleted_at IS NULL`, req.CashboxId).Scan(&paid)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	items, err := cashboxItems(tx, req.CashboxId)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+	lines := map[string][]string{}
+	for _, item := range items[req.CashboxId] {
+		lines[item.ServiceType] = append(lines[item.ServiceType], item.ServiceId)
+	}
+
+	// cancel is what no longer has to be paid for the cashbox
+	cancel := summa - refunded
+	if req.ServiceType != "" {
+		var itemId string
+		var price, quantity, discount, refundedQuantity int64
+		err = tx.QueryRow(`SELECT id, price, quantity, discount, refunded_quantity FROM cashbox_items
+			WHERE cashbox_id = $1 AND service_type = $2 AND service_id = $3 FOR UPDATE`,
+			req.CashboxId, req.ServiceType, req.ServiceId,
+		).Scan(&itemId, &price, &quantity, &discount, &refundedQuantity)
+		if err == sql.ErrNoRows {
+			return &patient.CashboxResp{}, repo.ErrRefund
+		}
+		if err != nil {
+			return &patient.CashboxResp{}, err
+		}
+		if refundedQuantity >= quantity {
+			return &patient.CashboxResp{}, repo.ErrRefund
+		}
+
+		// one unit of the line as it was paid, the discount is spread over the quantity
+		net := price*quantity - discount
+		if unit := net*(refundedQuantity+1)/quantity - net*refundedQuantity/quantity; unit < cancel {
+			cancel = unit
+		}
+
+		_, err = tx.Exec(`UPDATE cashbox_items SET refunded_quantity = refunded_quantity + 1 WHERE id = $1`, itemId)
+		if err != nil {
+			return &patient.CashboxResp{}, err
+		}
+	} else {
+		_, err = tx.Exec(`UPDATE cashbox_items SET refunded_quantity = quantity WHERE cashbox_id = $1`, req.CashboxId)
+		if err != nil {
+			return &patient.CashboxResp{}, err
+		}
+	}
+	if cancel <= 0 {
+		return &patient.CashboxResp{}, repo.ErrRefund
+	}
+
+	refunded += cancel
+	amount := paid - (summa - refunded)
+	if amount < 0 {
+		amount = 0
+	}
+
+	shiftId, err := shiftOpenId(tx, req.StaffId)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	// only money given back is a fiscal receipt
+	if amount > 0 {
+		if err := fiscalOutboxAdd(tx, req.Id); err != nil {
+			return &patient.CashboxResp{}, err
+		}
+	}
+
+	_, err = tx.Exec(`
 		INSERT INTO payment_history(
 			id,
 			client_id,
 			summa,
 			payment_type,
-			cashbox_id
-		)VALUES($1,$2,$3,$4,$5)
-		RETURNING
-			COALESCE(id,'aaa6662a-523a-45ca-bb93-53198f8077fe') as id,
-			COALESCE(client_id,0) as client_id,
-			COALESCE(summa,0) as summa,
-			COALESCE(payment_type,'') as payment_type,
-			COALESCE(cashbox_id,'aaa6662a-523a-45ca-bb93-53198f8077fe') as cashbox_id,
-			created_at,
-			updated_at
-	`
+			cashbox_id,
+			refund_reason,
+			staff_id,
+			service_type,
+			service_id,
+			shift_id
+		)VALUES($1,$2,$3,$4,$5,$6,$7,NULLIF($8, ''),NULLIF($9, ''),$10)`,
+		req.Id, clientId, -amount, req.PaymentType, req.CashboxId,
+		req.Reason, req.StaffId, req.ServiceType, req.ServiceId, shiftId,
+	)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
 
-	row := r.db.QueryRow(query, req.Id, req.ClientId, req.Summa, req.PaymentType, req.CashboxId)
-	if err := row.Scan(
-		&result.Id,
-		&result.ClientId,
-		&result.Summa,
-		&result.PaymentType,
-		&result.CashboxId,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	); err != nil {
-		return &patient.PaymentHistoryResp{}, nil
+	_, err = tx.Exec(`UPDATE cashbox SET refunded = $2, updated_at = NOW() WHERE id = $1`, req.CashboxId, refunded)
+	if err != nil {
+		return &patient.CashboxResp{}, err
 	}
 
-	return &result, nil
+	// the debt cannot be more than is left to pay after the refund
+	_, err = tx.Exec(`
+		UPDATE patient_debts SET
+			amount = LEAST(amount, $2),
+			paid_at = CASE WHEN $2 = 0 THEN NOW() END,
+			updated_at = NOW()
+		WHERE cashbox_id = $1 AND amount > 0 AND deleted_at IS NULL`,
+		req.CashboxId, summa-refunded-(paid-amount),
+	)
+	if err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	if err := cashboxPaidSet(tx, req.CashboxId); err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	// queues not served yet are cancelled, the one latest for a single line
+	cancelQuery := `
+		UPDATE queues SET
+			status = $1,
+			cancelled_at = NOW(),
+			updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM queues
+			WHERE client_id = $2 AND queue_day >= $3 AND status = ANY($4) AND deleted_at IS NULL
+				AND service_type = $5 AND service_id::text = ANY($6)
+			ORDER BY created_at DESC
+			LIMIT $7
+		)`
+	pending := pq.Array([]string{repo.QueueWaiting, repo.QueueCalled, repo.QueueSkipped, repo.QueueRecalled})
+	for serviceType, ids := range lines {
+		var limit interface{}
+		if req.ServiceType != "" {
+			if serviceType != req.ServiceType {
+				continue
+			}
+			ids, limit = []string{req.ServiceId}, 1
+		}
+		_, err = tx.Exec(cancelQuery, repo.QueueCancelled, clientId, queueDay, pending, serviceType, pq.Array(ids), limit)
+		if err != nil {
+			return &patient.CashboxResp{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &patient.CashboxResp{}, err
+	}
+
+	return r.GetCashbox(&patient.GetCashboxReq{CashboxId: req.CashboxId})
 }
 
 func (r *patientRepo) GetPaymentHistory(req *patient.PaymentHistoryId) (*patient.PaymentHistoryResp, error) {
-	var (
-		result patient.PaymentHistoryResp
-	)
+	query := `SELECT ` + paymentHistoryColumns + ` FROM payment_history
+		WHERE id = $1 AND deleted_at IS NULL`
 
-	query := `
+	result, err := scanPaymentHistory(r.db.QueryRow(query, req.Id))
+	if err != nil {
+		return &patient.PaymentHistoryResp{}, err
+	}
+
+	return result, nil
+}
+
+// FindPaymentHistory is the cash report, income sums the payments and refunds
+// the money returned over all the filtered rows.
+func (r *patientRepo) FindPaymentHistory(req *patient.PaymentHistoryFilter) (*patient.PaymentHistoriesResp, error) {
+	result := patient.PaymentHistoriesResp{
+		PaymentHistory: make([]*patient.PaymentHistoryResp, 0),
+	}
+
+	filter := sqlfilter.New("deleted_at IS NULL")
+	if req.ClientId != 0 {
+		filter.Where("client_id = ?", req.ClientId)
+	}
+	if req.Refunds {
+		filter.Where("refund_reason IS NOT NULL")
+	}
+	dateRange(filter, req.FromDate, req.ToDate)
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	query := `SELECT ` + paymentHistoryColumns + ` FROM payment_history
+	` + filter.String() + ` ORDER BY created_at asc ` + limit
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &patient.PaymentHistoriesResp{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		temp, err := scanPaymentHistory(rows)
+		if err != nil {
+			return &patient.PaymentHistoriesResp{}, err
+		}
+		result.PaymentHistory = append(result.PaymentHistory, temp)
+	}
+
+	queryCount := `
 		SELECT
-			id,
-			client_id,
-			summa,
-			payment_type,
-			cashbox_id,
-			created_at,
-			updated_at
-		FROM payment_history
-		WHERE id = $1 AND deleted_at IS NULL 
-	`
+			count(1),
+			COALESCE(SUM(summa) FILTER (WHERE refund_reason IS NULL), 0),
+			COALESCE(-SUM(summa) FILTER (WHERE refund_reason IS NOT NULL), 0)
+		FROM payment_history ` + filter.String()
+	err = r.db.QueryRow(queryCount, filter.Args()...).Scan(&result.Count, &result.Income, &result.Refunds)
+	if err != nil {
+		return &patient.PaymentHistoriesResp{}, err
+	}
+	return &result, nil
+}
 
-	row := r.db.QueryRow(query, req.Id)
-	if err := row.Scan(
+// cashboxPaid is the select column of what is paid for the cashbox so far.
+const cashboxPaid = `COALESCE((
+			SELECT SUM(ph.summa) FROM payment_history ph
+			WHERE ph.cashbox_id = cashbox.id AND ph.deleted_at IS NULL
+		), 0) as paid`
+
+const paymentHistoryColumns = `
+	id,
+	client_id,
+	COALESCE(summa, 0),
+	COALESCE(payment_type, ''),
+	cashbox_id,
+	created_at,
+	updated_at,
+	COALESCE(refund_reason, ''),
+	COALESCE(staff_id::text, ''),
+	COALESCE(service_type, ''),
+	COALESCE(service_id, ''),
+	COALESCE(shift_id::text, ''),
+	CASE
+		WHEN fiscal_sign IS NOT NULL THEN 'registered'
+		WHEN EXISTS(SELECT 1 FROM fiscal_outbox fo WHERE fo.payment_id = payment_history.id) THEN 'pending'
+		ELSE ''
+	END,
+	COALESCE(fiscal_receipt_number, ''),
+	COALESCE(fiscal_sign, ''),
+	COALESCE(fiscalized_at::text, '')`
+
+func scanPaymentHistory(row interface{ Scan(...interface{}) error }) (*patient.PaymentHistoryResp, error) {
+	var result patient.PaymentHistoryResp
+	err := row.Scan(
 		&result.Id,
 		&result.ClientId,
 		&result.Summa,
@@ -1135,96 +1542,285 @@ func (r *patientRepo) GetPaymentHistory(req *patient.PaymentHistoryId) (*patient
 		&result.CashboxId,
 		&result.CreatedAt,
 		&result.UpdatedAt,
-	); err != nil {
-		return &patient.PaymentHistoryResp{}, err
+		&result.RefundReason,
+		&result.StaffId,
+		&result.ServiceType,
+		&result.ServiceId,
+		&result.ShiftId,
+		&result.FiscalStatus,
+		&result.FiscalReceiptNumber,
+		&result.FiscalSign,
+		&result.FiscalizedAt,
+	)
+	return &result, err
+}
+
+func (r *patientRepo) cashboxPayments(cashboxId string) ([]*patient.PaymentHistoryResp, error) {
+	rows, err := r.db.Query(`SELECT `+paymentHistoryColumns+` FROM payment_history
+		WHERE cashbox_id = $1 AND deleted_at IS NULL ORDER BY created_at`, cashboxId)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	return &result, nil
+	result := make([]*patient.PaymentHistoryResp, 0)
+	for rows.Next() {
+		temp, err := scanPaymentHistory(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, temp)
+	}
+	return result, rows.Err()
 }
 
-func (r *patientRepo) FindPaymentHistory(req *patient.PaymentHistoryFilter) (*patient.PaymentHistoriesResp, error) {
-	var (
-		result patient.PaymentHistoriesResp
-	)
-	offset := (req.Page - 1) * req.Limit
+const cashboxItemColumns = `
+	id,
+	cashbox_id,
+	service_type,
+	service_id,
+	name,
+	price,
+	quantity,
+	discount,
+	COALESCE(discount_id::text, ''),
+	discount_name,
+	COALESCE(doctor_id, ''),
+	refunded_quantity`
+
+// cashboxItems returns the items of the cashboxes by the cashbox id.
+func cashboxItems(q sqlx.Queryer, ids ...string) (map[string][]*patient.CashboxItem, error) {
+	result := make(map[string][]*patient.CashboxItem, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
 
-	limit := fmt.Sprintf(" LIMIT %d OFFSET %d ", req.Limit, offset)
+	rows, err := q.Query(`SELECT `+cashboxItemColumns+` FROM cashbox_items
+		WHERE cashbox_id = ANY($1) ORDER BY cashbox_id, position`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	filter := " WHERE deleted_at IS NULL "
-	if req.ClientId != 0 {
-		filter += fmt.Sprintf("AND client_id=%d ", req.ClientId)
+	for rows.Next() {
+		var (
+			cashboxId string
+			temp      patient.CashboxItem
+		)
+		if err := rows.Scan(
+			&temp.Id,
+			&cashboxId,
+			&temp.ServiceType,
+			&temp.ServiceId,
+			&temp.Name,
+			&temp.Price,
+			&temp.Quantity,
+			&temp.Discount,
+			&temp.DiscountId,
+			&temp.DiscountName,
+			&temp.DoctorId,
+			&temp.RefundedQuantity,
+		); err != nil {
+			return nil, err
+		}
+		result[cashboxId] = append(result[cashboxId], &temp)
 	}
+	return result, rows.Err()
+}
 
-	if req.FromDate != "" || req.ToDate != "" {
-		if req.FromDate == "" {
-			req.FromDate = "1000-01-01"
+// cashboxItemsSet sets the items of the cashbox along with the service ids and
+// the discounts derived from them for the clients reading those.
+func cashboxItemsSet(cashbox *patient.CashboxResp, items []*patient.CashboxItem) {
+	cashbox.Items = items
+	cashbox.DoctorsIds, cashbox.LabsIds, cashbox.AparatsIds = []string{}, []string{}, []string{}
+	cashbox.Discounts = make([]*patient.CashboxDiscount, 0)
+
+	for _, item := range items {
+		for i := int64(0); i < item.Quantity; i++ {
+			switch item.ServiceType {
+			case repo.ServiceDoctor:
+				cashbox.DoctorsIds = append(cashbox.DoctorsIds, item.ServiceId)
+			case repo.ServiceLab:
+				cashbox.LabsIds = append(cashbox.LabsIds, item.ServiceId)
+			case repo.ServiceAparat:
+				cashbox.AparatsIds = append(cashbox.AparatsIds, item.ServiceId)
+			}
 		}
-		if req.ToDate == "" {
-			req.ToDate = "3000-01-01"
+		if item.Discount > 0 {
+			cashbox.Discounts = append(cashbox.Discounts, &patient.CashboxDiscount{
+				DiscountId:  item.DiscountId,
+				Name:        item.DiscountName,
+				ServiceType: item.ServiceType,
+				ServiceId:   item.ServiceId,
+				Gross:       item.Price * item.Quantity,
+				Amount:      item.Discount,
+			})
 		}
-		filter += fmt.Sprintf(" AND (%s <= created_at AND created_at<= %s) ", req.FromDate, req.ToDate)
 	}
+}
+
+func (r *patientRepo) CashboxPaidItems(from, to time.Time, doctorId string) ([]*patient.CashboxPaidItem, error) {
+	result := make([]*patient.CashboxPaidItem, 0)
 
+	// the discount of an item is spread over its quantity, the refunded quantity
+	// takes its share of the discounted amount off
 	query := `
 		SELECT
-			id,
-			client_id,
-			summa,
-			payment_type,
-			cashbox_id,
-			created_at,
-			updated_at
-		FROM payment_history
-	` + filter + ` ORDER BY created_at asc ` + limit
-
-	rows, err := r.db.Query(query)
+			i.cashbox_id,
+			i.id,
+			c.client_id,
+			paid.paid_at::text,
+			i.service_type,
+			i.service_id,
+			i.name,
+			i.quantity - i.refunded_quantity,
+			(i.price * i.quantity - i.discount) * (i.quantity - i.refunded_quantity) / i.quantity,
+			i.doctor_id
+		FROM cashbox_items i
+		JOIN cashbox c ON c.id = i.cashbox_id AND c.is_payed AND c.deleted_at IS NULL
+		JOIN LATERAL (
+			SELECT MAX(created_at) as paid_at FROM payment_history
+			WHERE cashbox_id = c.id AND summa > 0 AND deleted_at IS NULL
+		) paid ON TRUE
+		WHERE COALESCE(i.doctor_id, '') <> '' AND ($3 = '' OR i.doctor_id = $3)
+			AND i.quantity > i.refunded_quantity
+			AND paid.paid_at >= $1 AND paid.paid_at < $2
+		ORDER BY i.doctor_id, paid.paid_at, i.position`
+
+	rows, err := r.db.Query(query, from, to, doctorId)
 	if err != nil {
-		return &patient.PaymentHistoriesResp{}, err
+		return nil, err
 	}
 	defer rows.Close()
+
 	for rows.Next() {
-		var temp patient.PaymentHistoryResp
+		var temp patient.CashboxPaidItem
 		if err := rows.Scan(
-			&temp.Id,
-			&temp.ClientId,
-			&temp.Summa,
-			&temp.PaymentType,
 			&temp.CashboxId,
-			&temp.CreatedAt,
-			&temp.UpdatedAt,
+			&temp.ItemId,
+			&temp.ClientId,
+			&temp.PaidAt,
+			&temp.ServiceType,
+			&temp.ServiceId,
+			&temp.Name,
+			&temp.Quantity,
+			&temp.Amount,
+			&temp.DoctorId,
 		); err != nil {
-			return &patient.PaymentHistoriesResp{}, err
+			return nil, err
 		}
-		result.PaymentHistory = append(result.PaymentHistory, &temp)
+		result = append(result, &temp)
 	}
-	queryCount := `SELECT count(1) FROM payment_history ` + filter
-	err = r.db.QueryRow(queryCount).Scan(&result.Count)
+
+	return result, rows.Err()
+}
+
+// payCashbox records the payments of the cashbox locked for the tx on the open shift of
+// the staff. Paying more than the remaining summa returns repo.ErrOverpayment, the amounts
+// held by online transactions are not remaining. A payment of the cashbox left as a debt
+// pays the debt off.
+func payCashbox(tx *sqlx.Tx, cashboxId, staffId string, payments []*patient.CashboxPayment) ([]*patient.PaymentHistoryResp, error) {
+	var clientId, summa, refunded, paid, total int64
+
+	err := tx.QueryRow(`SELECT client_id, COALESCE(summa, 0), COALESCE(refunded, 0) FROM cashbox
+		WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, cashboxId).Scan(&clientId, &summa, &refunded)
 	if err != nil {
-		fmt.Println(743)
-		return &patient.PaymentHistoriesResp{}, err
+		return nil, err
 	}
-	return &result, nil
-}
 
-func (r *patientRepo) DeletePaymentHistory(req *patient.PaymentHistoryId) error {
-	query := `
-		UPDATE 
-			payment_history
-		SET
-			deleted_at = NOW()
-		WHERE id = $1
-	`
-	effect, err := r.db.Exec(query, req.Id)
+	err = tx.QueryRow(`SELECT COALESCE(SUM(summa), 0) FROM payment_history
+		WHERE cashbox_id = $1 AND deleted_at IS NULL`, cashboxId).Scan(&paid)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rowsCount, err := effect.RowsAffected()
+	held, err := onlineHeld(tx, cashboxId)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if rowsCount == 0 {
-		return sql.ErrNoRows
+
+	for _, payment := range payments {
+		total += payment.Summa
+	}
+	if paid+held+total > summa-refunded {
+		return nil, repo.ErrOverpayment
+	}
+
+	// online payments come with no staff and are on no shift
+	var shiftId string
+	if staffId != "" {
+		shiftId, err = shiftOpenId(tx, staffId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		INSERT INTO payment_history(
+			id,
+			client_id,
+			summa,
+			payment_type,
+			cashbox_id,
+			staff_id,
+			shift_id
+		)VALUES($1,$2,$3,$4,$5,NULLIF($6, '')::uuid,NULLIF($7, '')::uuid)
+		RETURNING` + paymentHistoryColumns
+
+	result := make([]*patient.PaymentHistoryResp, 0, len(payments))
+	for _, payment := range payments {
+		if err := fiscalOutboxAdd(tx, payment.Id); err != nil {
+			return nil, err
+		}
+		temp, err := scanPaymentHistory(tx.QueryRow(query, payment.Id, clientId, payment.Summa, payment.PaymentType, cashboxId, staffId, shiftId))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, temp)
+	}
+
+	_, err = tx.Exec(`
+		UPDATE patient_debts SET
+			amount = GREATEST(amount - $2, 0),
+			paid_at = CASE WHEN amount <= $2 THEN NOW() END,
+			updated_at = NOW()
+		WHERE cashbox_id = $1 AND amount > 0 AND deleted_at IS NULL`,
+		cashboxId, total,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, cashboxPaidSet(tx, cashboxId)
+}
+
+// cashboxPaidSet derives is_payed and payment_type of the cashbox from its payments,
+// the refunded part of the summa is not to be paid.
+func cashboxPaidSet(tx *sqlx.Tx, cashboxId string) error {
+	_, err := tx.Exec(`
+		UPDATE cashbox SET
+			is_payed = paid.summa >= COALESCE(cashbox.summa, 0) - COALESCE(cashbox.refunded, 0),
+			payment_type = COALESCE(paid.payment_types, ''),
+			updated_at = NOW()
+		FROM (
+			SELECT
+				COALESCE(SUM(summa), 0) as summa,
+				string_agg(DISTINCT payment_type, ',') FILTER (WHERE refund_reason IS NULL) as payment_types
+			FROM payment_history
+			WHERE cashbox_id = $1 AND deleted_at IS NULL
+		) paid
+		WHERE cashbox.id = $1`,
+		cashboxId,
+	)
+	return err
+}
+
+// dateRange limits created_at to the days from and to, both inclusive, empty means open.
+func dateRange(filter *sqlfilter.Filter, from, to string) {
+	if from != "" {
+		filter.Where("created_at >= ?::date", from)
+	}
+	if to != "" {
+		filter.Where("created_at < ?::date + 1", to)
 	}
-	return nil
 }
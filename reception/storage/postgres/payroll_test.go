@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+func TestCashboxPaidItemsLab(t *testing.T) {
+	db := testDB(t)
+
+	var (
+		cashboxId = uuid.New().String()
+		doctorId  = uuid.New().String()
+		labId     = uuid.New().String()
+		paidAt    = time.Date(2001, 1, 1, 10, 0, 0, 0, time.UTC)
+	)
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM payment_history WHERE cashbox_id = $1`, cashboxId)
+		db.Exec(`DELETE FROM cashbox_items WHERE cashbox_id = $1`, cashboxId)
+		db.Exec(`DELETE FROM cashbox WHERE id = $1`, cashboxId)
+	})
+
+	steps := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`INSERT INTO cashbox(id, client_id, summa, gross, is_payed, created_at) VALUES($1, 1, 50000, 50000, TRUE, $2)`,
+			[]interface{}{cashboxId, paidAt}},
+		{`INSERT INTO cashbox_items(id, cashbox_id, position, service_type, service_id, name, price, quantity, doctor_id)
+			VALUES($1, $2, 1, $3, $4, 'Blood test', 50000, 1, $5)`,
+			[]interface{}{uuid.New().String(), cashboxId, repo.ServiceLab, labId, doctorId}},
+		{`INSERT INTO payment_history(id, client_id, summa, payment_type, cashbox_id, created_at) VALUES($1, 1, 50000, 'cash', $2, $3)`,
+			[]interface{}{uuid.New().String(), cashboxId, paidAt}},
+	}
+	for _, step := range steps {
+		if _, err := db.Exec(step.query, step.args...); err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+
+	items, err := NewPatient(db).CashboxPaidItems(paidAt.Add(-time.Hour), paidAt.Add(time.Hour), doctorId)
+	if err != nil {
+		t.Fatalf("CashboxPaidItems: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("%d items, want the lab item", len(items))
+	}
+	if item := items[0]; item.ServiceType != repo.ServiceLab || item.ServiceId != labId || item.DoctorId != doctorId || item.Amount != 50000 {
+		t.Errorf("item = %+v, want the lab %s of doctor %s for 50000", item, labId, doctorId)
+	}
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+// testDB connects to the migrated database of TEST_DATABASE_URL, the test is skipped without it.
+func testDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sqlx.Connect("postgres", url)
+	if err != nil {
+		t.Fatalf("connect test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestCreatePatientQueueConcurrent(t *testing.T) {
+	db := testDB(t)
+	r := NewPatient(db)
+
+	const perDay = 50
+	var (
+		doctors = []string{uuid.New().String(), uuid.New().String()}
+		days    = []string{"2001-01-01", "2001-01-02"}
+	)
+	t.Cleanup(func() {
+		for _, doctorId := range doctors {
+			db.Exec(`DELETE FROM queues WHERE service_id = $1`, doctorId)
+			db.Exec(`DELETE FROM queue_counters WHERE service_id = $1`, doctorId)
+		}
+	})
+
+	type key struct{ doctorId, day string }
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		numbers = make(map[key][]int64)
+	)
+	for _, doctorId := range doctors {
+		for _, day := range days {
+			for i := 0; i < perDay; i++ {
+				wg.Add(1)
+				go func(doctorId, day string, clientId int64) {
+					defer wg.Done()
+					queue, err := r.CreatePatientQueue(&patient.CreatePatientQueueReq{
+						Id:          uuid.New().String(),
+						ClientId:    clientId,
+						ServiceId:   doctorId,
+						ServiceType: repo.ServiceDoctor,
+					}, day)
+					if err != nil {
+						t.Errorf("CreatePatientQueue: %v", err)
+						return
+					}
+					mu.Lock()
+					numbers[key{doctorId, day}] = append(numbers[key{doctorId, day}], queue.QueueNumber)
+					mu.Unlock()
+				}(doctorId, day, int64(i+1))
+			}
+		}
+	}
+	wg.Wait()
+
+	for _, doctorId := range doctors {
+		for _, day := range days {
+			got := numbers[key{doctorId, day}]
+			if len(got) != perDay {
+				t.Fatalf("doctor %s on %s: %d queues, want %d", doctorId, day, len(got), perDay)
+			}
+			seen := make(map[int64]bool, perDay)
+			for _, number := range got {
+				if number < 1 || number > perDay {
+					t.Errorf("doctor %s on %s: number %d out of 1..%d", doctorId, day, number, perDay)
+				}
+				if seen[number] {
+					t.Errorf("doctor %s on %s: number %d given twice", doctorId, day, number)
+				}
+				seen[number] = true
+			}
+		}
+	}
+}
+
+func TestFindQueuePatientsDeleted(t *testing.T) {
+	db := testDB(t)
+	r := NewPatient(db)
+
+	serviceId := uuid.New().String()
+	clients := []struct {
+		clientId int64
+		deleted  bool
+	}{
+		{900000001, false},
+		{900000002, false},
+		{900000003, true},
+	}
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM queues WHERE service_id = $1`, serviceId)
+		for _, client := range clients {
+			db.Exec(`DELETE FROM patients WHERE client_id = $1`, client.clientId)
+		}
+	})
+
+	for i, client := range clients {
+		_, err := db.Exec(`INSERT INTO patients(id, client_id, doctor_id, first_name, last_name, patronymic, date_of_birth,
+			main_phone_number, other_phone_number, advertising_channel, respublic, region, district, passport_info,
+			discount, condition, gender, deleted_at)
+			VALUES($1, $2, $3, 'Test', 'Patient', '', '2000-01-01', '', '', '', '', '', '', '', '', '', '',
+			CASE WHEN $4 THEN NOW() END)`,
+			uuid.New().String(), client.clientId, uuid.New().String(), client.deleted)
+		if err != nil {
+			t.Fatalf("seed patient: %v", err)
+		}
+		_, err = db.Exec(`INSERT INTO queues(id, client_id, queue_number, service_id, service_type, queue_day)
+			VALUES($1, $2, $3, $4, $5, '2001-01-01')`,
+			uuid.New().String(), client.clientId, i+1, serviceId, repo.ServiceDoctor)
+		if err != nil {
+			t.Fatalf("seed queue: %v", err)
+		}
+	}
+
+	resp, err := r.FindQueuePatients(&patient.QueueFilter{ServiceId: serviceId, ServiceType: repo.ServiceDoctor, Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("FindQueuePatients: %v", err)
+	}
+	if resp.Count != 2 || len(resp.Patients) != 2 {
+		t.Errorf("count %d, %d patients, want the 2 not deleted", resp.Count, len(resp.Patients))
+	}
+}
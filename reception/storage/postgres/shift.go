@@ -0,0 +1,287 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+type shiftRepo struct {
+	db *sqlx.DB
+}
+
+func NewShift(db *sqlx.DB) repo.ShiftStorageI {
+	return &shiftRepo{
+		db: db,
+	}
+}
+
+const shiftColumns = `
+	id,
+	staff_id,
+	opening_cash,
+	COALESCE(counted_cash, 0),
+	COALESCE(expected_cash, 0),
+	COALESCE(receipts, 0),
+	COALESCE(refunds, 0),
+	COALESCE(refunds_count, 0),
+	COALESCE(totals, '[]'),
+	COALESCE(unpaid_cashboxes, '[]'),
+	opened_at::text,
+	COALESCE(closed_at::text, '')
+`
+
+func scanShift(row interface{ Scan(...interface{}) error }) (*patient.Shift, error) {
+	var (
+		result         patient.Shift
+		totals, unpaid []byte
+	)
+	err := row.Scan(
+		&result.Id,
+		&result.StaffId,
+		&result.OpeningCash,
+		&result.CountedCash,
+		&result.ExpectedCash,
+		&result.Receipts,
+		&result.Refunds,
+		&result.RefundsCount,
+		&totals,
+		&unpaid,
+		&result.OpenedAt,
+		&result.ClosedAt,
+	)
+	if err != nil {
+		return &patient.Shift{}, err
+	}
+
+	if err := json.Unmarshal(totals, &result.Totals); err != nil {
+		return &patient.Shift{}, err
+	}
+	if err := json.Unmarshal(unpaid, &result.UnpaidCashboxes); err != nil {
+		return &patient.Shift{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *shiftRepo) ShiftOpen(req *patient.ShiftOpenReq) (*patient.Shift, error) {
+	query := `
+		INSERT INTO cashier_shifts(
+			id,
+			staff_id,
+			opening_cash
+		)VALUES($1,$2,$3)
+		ON CONFLICT (staff_id) WHERE closed_at IS NULL DO NOTHING
+		RETURNING` + shiftColumns
+
+	result, err := scanShift(r.db.QueryRow(query, req.Id, req.StaffId, req.OpeningCash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &patient.Shift{}, repo.ErrShiftOpen
+	}
+	return result, err
+}
+
+func (r *shiftRepo) ShiftClose(req *patient.ShiftCloseReq) (*patient.Shift, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return &patient.Shift{}, err
+	}
+	defer tx.Rollback()
+
+	result, err := scanShift(tx.QueryRow(`SELECT `+shiftColumns+` FROM cashier_shifts
+		WHERE staff_id = $1 AND closed_at IS NULL FOR UPDATE`, req.StaffId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return &patient.Shift{}, repo.ErrShiftNotOpen
+	}
+	if err != nil {
+		return &patient.Shift{}, err
+	}
+
+	result.CountedCash = req.CountedCash
+	if err := shiftReport(tx, result); err != nil {
+		return &patient.Shift{}, err
+	}
+
+	totals, err := json.Marshal(result.Totals)
+	if err != nil {
+		return &patient.Shift{}, err
+	}
+	unpaid, err := json.Marshal(result.UnpaidCashboxes)
+	if err != nil {
+		return &patient.Shift{}, err
+	}
+
+	query := `
+		UPDATE cashier_shifts SET
+			closed_at = NOW(),
+			counted_cash = $2,
+			expected_cash = $3,
+			receipts = $4,
+			refunds = $5,
+			refunds_count = $6,
+			totals = $7,
+			unpaid_cashboxes = $8,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING closed_at::text
+	`
+	err = tx.QueryRow(query, result.Id, result.CountedCash, result.ExpectedCash, result.Receipts,
+		result.Refunds, result.RefundsCount, totals, unpaid,
+	).Scan(&result.ClosedAt)
+	if err != nil {
+		return &patient.Shift{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+// ShiftGet returns the persisted Z-report of a closed shift, the report of an open one
+// is computed up to now.
+func (r *shiftRepo) ShiftGet(req *patient.ShiftId) (*patient.Shift, error) {
+	result, err := scanShift(r.db.QueryRow(`SELECT `+shiftColumns+` FROM cashier_shifts WHERE id = $1`, req.Id))
+	if err != nil {
+		return &patient.Shift{}, err
+	}
+
+	if result.ClosedAt == "" {
+		if err := shiftReport(r.db, result); err != nil {
+			return &patient.Shift{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *shiftRepo) ShiftsFind(req *patient.ShiftsFindReq) (*patient.ShiftsResp, error) {
+	result := patient.ShiftsResp{
+		Shifts: make([]*patient.Shift, 0),
+	}
+
+	filter := sqlfilter.New()
+	if req.StaffId != "" {
+		filter.Where("staff_id = ?", req.StaffId)
+	}
+	dateRange(filter, req.FromDate, req.ToDate)
+	limit, args := filter.Page(req.Limit, req.Page)
+
+	rows, err := r.db.Query(`SELECT `+shiftColumns+` FROM cashier_shifts`+filter.String()+` ORDER BY opened_at DESC`+limit, args...)
+	if err != nil {
+		return &patient.ShiftsResp{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanShift(rows)
+		if err != nil {
+			return &patient.ShiftsResp{}, err
+		}
+		result.Shifts = append(result.Shifts, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return &patient.ShiftsResp{}, err
+	}
+
+	for _, shift := range result.Shifts {
+		if shift.ClosedAt == "" {
+			if err := shiftReport(r.db, shift); err != nil {
+				return &patient.ShiftsResp{}, err
+			}
+		}
+	}
+
+	err = r.db.QueryRow(`SELECT count(1) FROM cashier_shifts`+filter.String(), filter.Args()...).Scan(&result.Count)
+	if err != nil {
+		return &patient.ShiftsResp{}, err
+	}
+
+	return &result, nil
+}
+
+// shiftReport computes the report of the shift from the payments taken on it, the unpaid
+// cashboxes are the ones the staff of the shift created while it was open.
+func shiftReport(q sqlx.Queryer, shift *patient.Shift) error {
+	rows, err := q.Query(`
+		SELECT COALESCE(payment_type, ''), SUM(summa), count(1)
+		FROM payment_history
+		WHERE shift_id = $1 AND refund_reason IS NULL AND deleted_at IS NULL
+		GROUP BY 1
+		ORDER BY 1`, shift.Id,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	shift.Totals = make([]*patient.ShiftPaymentTotal, 0)
+	for rows.Next() {
+		var temp patient.ShiftPaymentTotal
+		if err := rows.Scan(&temp.PaymentType, &temp.Summa, &temp.Count); err != nil {
+			return err
+		}
+		shift.Totals = append(shift.Totals, &temp)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	var cash int64
+	err = q.QueryRowx(`
+		SELECT
+			count(DISTINCT cashbox_id) FILTER (WHERE refund_reason IS NULL),
+			COALESCE(-SUM(summa) FILTER (WHERE refund_reason IS NOT NULL), 0),
+			count(1) FILTER (WHERE refund_reason IS NOT NULL),
+			COALESCE(SUM(summa) FILTER (WHERE payment_type = $2), 0)
+		FROM payment_history
+		WHERE shift_id = $1 AND deleted_at IS NULL`, shift.Id, repo.PaymentCash,
+	).Scan(&shift.Receipts, &shift.Refunds, &shift.RefundsCount, &cash)
+	if err != nil {
+		return err
+	}
+	shift.ExpectedCash = shift.OpeningCash + cash
+
+	unpaid, err := q.Query(`
+		SELECT c.id, c.client_id, c.summa - c.refunded, paid.summa
+		FROM cashbox c, LATERAL (
+			SELECT COALESCE(SUM(summa), 0) as summa
+			FROM payment_history
+			WHERE cashbox_id = c.id AND deleted_at IS NULL
+		) paid
+		JOIN cashier_shifts s ON s.id = $1
+		WHERE c.staff_id = s.staff_id
+			AND c.created_at >= s.opened_at AND c.created_at < COALESCE(s.closed_at, NOW())
+			AND NOT c.is_payed AND c.summa > c.refunded AND c.deleted_at IS NULL
+		ORDER BY c.created_at`, shift.Id,
+	)
+	if err != nil {
+		return err
+	}
+	defer unpaid.Close()
+
+	shift.UnpaidCashboxes = make([]*patient.ShiftUnpaidCashbox, 0)
+	for unpaid.Next() {
+		var temp patient.ShiftUnpaidCashbox
+		if err := unpaid.Scan(&temp.CashboxId, &temp.ClientId, &temp.Summa, &temp.Paid); err != nil {
+			return err
+		}
+		temp.Remaining = temp.Summa - temp.Paid
+		shift.UnpaidCashboxes = append(shift.UnpaidCashboxes, &temp)
+	}
+	return unpaid.Err()
+}
+
+// shiftOpenId returns the open shift of the staff, shared locked so that it is not
+// closed before the tx takes its payments.
+func shiftOpenId(tx *sqlx.Tx, staffId string) (string, error) {
+	var id string
+	err := tx.QueryRow(`SELECT id FROM cashier_shifts
+		WHERE staff_id = $1 AND closed_at IS NULL FOR SHARE`, staffId).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", repo.ErrShiftNotOpen
+	}
+	return id, err
+}
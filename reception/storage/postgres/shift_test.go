@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+)
+
+func TestShiftReportUnpaid(t *testing.T) {
+	db := testDB(t)
+	tx, err := db.Beginx()
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer tx.Rollback()
+
+	var (
+		shiftId  = uuid.New().String()
+		staffId  = uuid.New().String()
+		openedAt = time.Date(2001, 1, 1, 8, 0, 0, 0, time.UTC)
+		closedAt = openedAt.Add(8 * time.Hour)
+	)
+	_, err = tx.Exec(`INSERT INTO cashier_shifts(id, staff_id, opened_at, closed_at) VALUES($1, $2, $3, $4)`,
+		shiftId, staffId, openedAt, closedAt)
+	if err != nil {
+		t.Fatalf("seed shift: %v", err)
+	}
+
+	cashboxes := []struct {
+		name      string
+		staffId   string
+		createdAt time.Time
+		want      bool
+	}{
+		{"created on the shift", staffId, openedAt.Add(time.Hour), true},
+		{"created by another staff", uuid.New().String(), openedAt.Add(time.Hour), false},
+		{"created before the shift", staffId, openedAt.Add(-time.Hour), false},
+		{"created after the shift closed", staffId, closedAt.Add(time.Hour), false},
+	}
+	want := make(map[string]string)
+	for _, cashbox := range cashboxes {
+		id := uuid.New().String()
+		_, err = tx.Exec(`INSERT INTO cashbox(id, client_id, summa, gross, is_payed, staff_id, created_at)
+			VALUES($1, 1, 50000, 50000, FALSE, $2, $3)`, id, cashbox.staffId, cashbox.createdAt)
+		if err != nil {
+			t.Fatalf("seed cashbox: %v", err)
+		}
+		if cashbox.want {
+			want[id] = cashbox.name
+		}
+	}
+
+	shift := &patient.Shift{Id: shiftId}
+	if err := shiftReport(tx, shift); err != nil {
+		t.Fatalf("shiftReport: %v", err)
+	}
+	for _, unpaid := range shift.UnpaidCashboxes {
+		if _, ok := want[unpaid.CashboxId]; !ok {
+			t.Errorf("unpaid cashbox %s is not of the shift", unpaid.CashboxId)
+		}
+		delete(want, unpaid.CashboxId)
+	}
+	for _, name := range want {
+		t.Errorf("unpaid cashbox %s is missing", name)
+	}
+}
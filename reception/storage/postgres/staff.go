@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+	"gitlab.com/clinic-crm/reception/pkg/sqlfilter"
+	"gitlab.com/clinic-crm/reception/storage/repo"
+)
+
+type staffRepo struct {
+	db *sqlx.DB
+}
+
+func NewStaff(db *sqlx.DB) repo.StaffStorageI {
+	return &staffRepo{
+		db: db,
+	}
+}
+
+const staffColumns = `
+	id,
+	first_name,
+	last_name,
+	login,
+	password,
+	COALESCE(phone_number,'') as phone_number,
+	role,
+	COALESCE(doctor_id::text,'') as doctor_id,
+	created_at,
+	updated_at
+`
+
+func scanStaff(row interface{ Scan(...interface{}) error }) (*patient.Staff, error) {
+	var result patient.Staff
+	err := row.Scan(
+		&result.Id,
+		&result.FirstName,
+		&result.LastName,
+		&result.Login,
+		&result.Password,
+		&result.PhoneNumber,
+		&result.Role,
+		&result.DoctorId,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
+		return &patient.Staff{}, err
+	}
+
+	return &result, nil
+}
+
+func (r *staffRepo) StaffCreate(req *patient.Staff) (*patient.Staff, error) {
+	query := `
+		INSERT INTO staff(
+			id,
+			first_name,
+			last_name,
+			login,
+			password,
+			phone_number,
+			role,
+			doctor_id
+		) VALUES($1, $2, $3, $4, $5, $6, $7, NULLIF($8, '')::uuid)
+		RETURNING ` + staffColumns
+
+	return scanStaff(r.db.QueryRow(query,
+		req.Id,
+		req.FirstName,
+		req.LastName,
+		req.Login,
+		req.Password,
+		req.PhoneNumber,
+		req.Role,
+		req.DoctorId,
+	))
+}
+
+func (r *staffRepo) StaffGet(req *patient.StaffId) (*patient.Staff, error) {
+	query := `SELECT ` + staffColumns + ` FROM staff WHERE id = $1 AND deleted_at IS NULL`
+
+	return scanStaff(r.db.QueryRow(query, req.Id))
+}
+
+func (r *staffRepo) StaffGetByLogin(login string) (*patient.Staff, error) {
+	query := `SELECT ` + staffColumns + ` FROM staff WHERE login = $1 AND deleted_at IS NULL`
+
+	return scanStaff(r.db.QueryRow(query, login))
+}
+
+func (r *staffRepo) StaffsFind(req *patient.StaffsFindReq) (*patient.StaffsResp, error) {
+	result := patient.StaffsResp{
+		Staffs: make([]*patient.Staff, 0),
+	}
+
+	filter := sqlfilter.New("deleted_at IS NULL").
+		Search(req.Search, "first_name", "last_name", "login", "phone_number")
+	if req.Role != "" {
+		filter.Where("role = ?", req.Role)
+	}
+
+	err := r.db.QueryRow(`SELECT count(1) FROM staff`+filter.String(), filter.Args()...).Scan(&result.Count)
+	if err != nil {
+		return &patient.StaffsResp{}, err
+	}
+
+	limit, args := filter.Page(req.Limit, req.Page)
+	query := `SELECT ` + staffColumns + ` FROM staff` + filter.String() + ` ORDER BY created_at desc` + limit
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return &patient.StaffsResp{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		temp, err := scanStaff(rows)
+		if err != nil {
+			return &patient.StaffsResp{}, err
+		}
+		result.Staffs = append(result.Staffs, temp)
+	}
+
+	return &result, rows.Err()
+}
+
+func (r *staffRepo) StaffUpdate(req *patient.Staff) (*patient.Staff, error) {
+	query := `
+		UPDATE
+			staff
+		SET
+			first_name=$1,
+			last_name=$2,
+			login=$3,
+			phone_number=$4,
+			role=$5,
+			doctor_id=NULLIF($6, '')::uuid,
+			updated_at=NOW()
+		WHERE
+			id=$7 AND deleted_at IS NULL
+		RETURNING ` + staffColumns
+
+	return scanStaff(r.db.QueryRow(query,
+		req.FirstName,
+		req.LastName,
+		req.Login,
+		req.PhoneNumber,
+		req.Role,
+		req.DoctorId,
+		req.Id,
+	))
+}
+
+func (r *staffRepo) StaffDelete(req *patient.StaffId) error {
+	query := `
+		UPDATE
+			staff
+		SET
+			deleted_at = NOW(),
+			refresh_token_id = ''
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	return execAffected(r.db, query, req.Id)
+}
+
+func (r *staffRepo) StaffPasswordUpdate(id, password string) error {
+	query := `
+		UPDATE
+			staff
+		SET
+			password = $1,
+			refresh_token_id = '',
+			updated_at = NOW()
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+	return execAffected(r.db, query, password, id)
+}
+
+// StaffAdminCreate inserts the bootstrap admin only while no admin exists.
+func (r *staffRepo) StaffAdminCreate(req *patient.Staff) error {
+	query := `
+		INSERT INTO staff(id, first_name, last_name, login, password, role)
+		SELECT $1, $2, $3, $4, $5, 'admin'
+		WHERE NOT EXISTS (SELECT 1 FROM staff WHERE role = 'admin' AND deleted_at IS NULL)
+	`
+	_, err := r.db.Exec(query, req.Id, req.FirstName, req.LastName, req.Login, req.Password)
+	return err
+}
+
+func (r *staffRepo) StaffRefreshTokenSet(req *patient.StaffRefreshToken) error {
+	query := `
+		UPDATE
+			staff
+		SET
+			refresh_token_id = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+	return execAffected(r.db, query, req.TokenId, req.Id)
+}
+
+func (r *staffRepo) StaffRefreshTokenCheck(req *patient.StaffRefreshToken) (*patient.Staff, error) {
+	if req.TokenId == "" {
+		return &patient.Staff{}, sql.ErrNoRows
+	}
+
+	query := `SELECT ` + staffColumns + ` FROM staff WHERE id = $1 AND refresh_token_id = $2 AND deleted_at IS NULL`
+
+	return scanStaff(r.db.QueryRow(query, req.Id, req.TokenId))
+}
+
+func execAffected(db *sqlx.DB, query string, args ...interface{}) error {
+	effect, err := db.Exec(query, args...)
+	if err != nil {
+		return err
+	}
+	rowsCount, err := effect.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsCount == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
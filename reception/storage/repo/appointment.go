@@ -0,0 +1,36 @@
+package repo
+
+import (
+	"errors"
+	"time"
+
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+)
+
+// Appointment statuses
+const (
+	AppointmentBooked    = "booked"
+	AppointmentCheckedIn = "checked_in"
+	AppointmentCancelled = "cancelled"
+)
+
+// AppointmentTime is the clinic local layout of the times of appointments.
+const AppointmentTime = "2006-01-02 15:04"
+
+// ErrAppointmentConflict is returned when the doctor or the patient already has an appointment overlapping the time.
+var ErrAppointmentConflict = errors.New("doctor or patient already has an appointment at this time")
+
+// ErrAppointmentStatus is returned when the appointment is no longer booked.
+var ErrAppointmentStatus = errors.New("appointment is not booked")
+
+type AppointmentStorageI interface {
+	AppointmentCreate(req *patient.Appointment, startsAt, endsAt time.Time) (*patient.Appointment, error)
+	AppointmentGet(id string) (*patient.Appointment, error)
+	AppointmentsFind(*patient.AppointmentsFindReq) (*patient.AppointmentsResp, error)
+	AppointmentReschedule(id string, startsAt, endsAt time.Time) (*patient.Appointment, error)
+	AppointmentCancel(*patient.AppointmentCancelReq) (*patient.Appointment, error)
+	// AppointmentCheckIn turns the booked appointment into a queue entry of its doctor on the queue day.
+	AppointmentCheckIn(id, queueId, queueDay string) (*patient.Appointment, error)
+	// DoctorAppointments returns the appointments of the doctor not cancelled overlapping from-to.
+	DoctorAppointments(doctorId string, from, to time.Time) ([]*patient.Appointment, error)
+}
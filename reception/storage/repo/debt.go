@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+)
+
+type DebtStorageI interface {
+	PatientDebtCreate(*patient.PatientDebtCreateReq) (*patient.PatientDebt, error)
+	// CashboxDebtCreate records the unpaid part of a closed cashbox as a debt,
+	// nothing is created if the cashbox is fully paid or already has a debt.
+	CashboxDebtCreate(id, cashboxId, termDate string) error
+	PatientDebts(clientId int64) ([]*patient.PatientDebt, error)
+	PatientDebtsOverdue(req *patient.PatientDebtsOverdueReq) (*patient.PatientDebtsResp, error)
+}
@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+)
+
+// Discount kinds
+const (
+	DiscountPercent = "percent"
+	DiscountFixed   = "fixed"
+)
+
+type DiscountStorageI interface {
+	DiscountCreate(*patient.Discount) (*patient.Discount, error)
+	DiscountGet(*patient.DiscountId) (*patient.Discount, error)
+	DiscountsFind(*patient.DiscountsFindReq) (*patient.DiscountsResp, error)
+	DiscountUpdate(*patient.Discount) (*patient.Discount, error)
+	DiscountDelete(*patient.DiscountId) error
+	// DiscountsActive returns the discounts valid on day whose patient and campaign
+	// conditions match, the service type is left to be matched per cashbox line.
+	DiscountsActive(clientId int64, advertisingChannel, day string) ([]*patient.Discount, error)
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"time"
+
+	"gitlab.com/clinic-crm/reception/pkg/fiscal"
+)
+
+type FiscalStorageI interface {
+	// FiscalClaim takes up to limit payments due in the fiscal outbox, only the ones of ids
+	// when any are given, and hides them from other claims for lease. It returns their receipts.
+	FiscalClaim(ids []string, limit int, lease time.Duration) ([]*fiscal.Receipt, error)
+	// FiscalDone stores the fiscal receipt on the payment and removes it from the outbox.
+	FiscalDone(paymentId string, result *fiscal.Result) error
+	// FiscalFailed records the error and retries the payment after retry, doubled on every attempt.
+	FiscalFailed(paymentId, reason string, retry time.Duration) error
+}
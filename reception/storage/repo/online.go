@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"errors"
+
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+)
+
+// Online transaction states
+const (
+	OnlineCreated   = "created"
+	OnlinePerformed = "performed"
+	OnlineCancelled = "cancelled"
+)
+
+// ErrOnlineState is returned when a cancelled transaction would be performed.
+var ErrOnlineState = errors.New("online transaction is cancelled")
+
+// ErrOnlineMismatch is returned when a known transaction comes again for another cashbox or amount.
+var ErrOnlineMismatch = errors.New("online transaction does not match the cashbox or amount")
+
+// OnlineStorageI keeps the transactions of payment providers. Every call is idempotent
+// by provider and transaction_id, a repeated callback returns the transaction as it is.
+type OnlineStorageI interface {
+	// OnlineCheck creates the transaction if the cashbox can be paid the amount.
+	OnlineCheck(*patient.OnlineTransactionReq) (*patient.OnlineTransaction, error)
+	// OnlinePerform records the payment of a created transaction.
+	OnlinePerform(req *patient.OnlineTransactionReq, paymentId string) (*patient.OnlineTransaction, error)
+	// OnlineCancel cancels the transaction, a performed one is paid back with a negative payment.
+	OnlineCancel(req *patient.OnlineTransactionReq, paymentId string) (*patient.OnlineTransaction, error)
+	OnlineGet(*patient.OnlineTransactionReq) (*patient.OnlineTransaction, error)
+}
@@ -1,9 +1,52 @@
 package repo
 
 import (
+	"errors"
+	"time"
+
 	"gitlab.com/clinic-crm/reception/genproto/patient"
 )
 
+// Queue statuses
+const (
+	QueueWaiting   = "waiting"
+	QueueCalled    = "called"
+	QueueInService = "in_service"
+	QueueDone      = "done"
+	QueueSkipped   = "skipped"
+	QueueNoShow    = "no_show"
+	QueueRecalled  = "recalled"
+	QueueCancelled = "cancelled"
+)
+
+// Service types a queue can be created for
+const (
+	ServiceDoctor = "doctor"
+	ServiceLab    = "lab"
+	ServiceAparat = "aparat"
+)
+
+// Payment types a cashbox can be paid with, one bill can be split across them
+const (
+	PaymentCash     = "cash"
+	PaymentCard     = "card"
+	PaymentTransfer = "transfer"
+	// paid through a payment provider, not taken by a cashier
+	PaymentOnline = "online"
+)
+
+// ErrOverpayment is returned when payments exceed the remaining summa of the cashbox.
+var ErrOverpayment = errors.New("payment exceeds the remaining summa of the cashbox")
+
+// ErrRefund is returned when there is nothing left to refund for the cashbox or its line.
+var ErrRefund = errors.New("nothing to refund for this cashbox line")
+
+// ErrCashboxPaid is returned when a cashbox with payments would be deleted, it is refunded instead.
+var ErrCashboxPaid = errors.New("cashbox has payments, refund it instead")
+
+// ErrQueueStatus is returned when the current queue status does not allow the action.
+var ErrQueueStatus = errors.New("queue status does not allow this action")
+
 type PatientStorageI interface {
 	// Patient
 	PatientCreate(*patient.Patient) (*patient.Patient, error)
@@ -21,11 +64,14 @@ type PatientStorageI interface {
 	DoctorReportCreate(*patient.DoctorReportInfo) (*patient.DoctorReportInfo, error)
 	DoctorReportGet(*patient.PatientId) (*patient.DoctorReportInfo, error)
 
-	CreatePatientQueue(*patient.CreatePatientQueueReq) (*patient.PatientQueueResp, error)
-	CheckServiceQueue(*patient.CheckQueueReq) (*patient.QueueNumber, error)
-	UpdateQueue(*patient.UpdateQueueReq) (*patient.PatientQueueResp, error)
+	CreatePatientQueue(req *patient.CreatePatientQueueReq, queueDay string) (*patient.PatientQueueResp, error)
+	CheckServiceQueue(req *patient.CheckQueueReq, queueDay string) (*patient.QueueNumber, error)
+	CallNextQueue(req *patient.CallNextReq, queueDay string) (*patient.PatientQueueResp, error)
+	QueueTransition(id string, from []string, status string) (*patient.PatientQueueResp, error)
 	FindQueue(*patient.QueueFilter) (*patient.QueuesResp, error)
+	FindQueuePatients(*patient.QueueFilter) (*patient.QueuePatientsResp, error)
 	GetPatientQueue(*patient.PaymentHistoryId) (*patient.PatientQueueResp, error)
+	QueueBoard(req *patient.WatchQueueReq, queueDay string) (*patient.QueueBoard, error)
 
 	// CashBox
 	CreateCashbox(*patient.CashboxResp) (*patient.CashboxResp, error)
@@ -34,10 +80,14 @@ type PatientStorageI interface {
 	GetCashbox(*patient.GetCashboxReq) (*patient.CashboxResp, error)
 	UpdateCashbox(*patient.UpdateCashboxReq) (*patient.CashboxResp, error)
 	DeleteCashbox(*patient.GetCashboxReq) error
+	CashboxPay(*patient.CashboxPayReq) (*patient.CashboxResp, error)
+	CashboxRefund(req *patient.CashboxRefundReq, queueDay string) (*patient.CashboxResp, error)
+	// CashboxPaidItems returns the items with a doctor responsible of the cashboxes paid off
+	// within [from, to), of the doctor unless doctorId is empty.
+	CashboxPaidItems(from, to time.Time, doctorId string) ([]*patient.CashboxPaidItem, error)
 
 	// Payment History
 	CreatePaymentHistory(*patient.CreatePaymentHistoryReq) (*patient.PaymentHistoryResp, error)
 	GetPaymentHistory(req *patient.PaymentHistoryId) (*patient.PaymentHistoryResp, error)
 	FindPaymentHistory(req *patient.PaymentHistoryFilter) (*patient.PaymentHistoriesResp, error)
-	DeletePaymentHistory(req *patient.PaymentHistoryId) error
 }
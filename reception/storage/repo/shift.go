@@ -0,0 +1,21 @@
+package repo
+
+import (
+	"errors"
+
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+)
+
+// ErrShiftOpen is returned when the cashier opens a shift while one is still open.
+var ErrShiftOpen = errors.New("cashier already has an open shift")
+
+// ErrShiftNotOpen is returned when the cashier has no open shift to take payments on or to close.
+var ErrShiftNotOpen = errors.New("cashier has no open shift")
+
+type ShiftStorageI interface {
+	ShiftOpen(*patient.ShiftOpenReq) (*patient.Shift, error)
+	// ShiftClose closes the open shift of the staff and persists its Z-report.
+	ShiftClose(*patient.ShiftCloseReq) (*patient.Shift, error)
+	ShiftGet(*patient.ShiftId) (*patient.Shift, error)
+	ShiftsFind(*patient.ShiftsFindReq) (*patient.ShiftsResp, error)
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"gitlab.com/clinic-crm/reception/genproto/patient"
+)
+
+type StaffStorageI interface {
+	StaffCreate(*patient.Staff) (*patient.Staff, error)
+	StaffGet(*patient.StaffId) (*patient.Staff, error)
+	StaffGetByLogin(login string) (*patient.Staff, error)
+	StaffsFind(*patient.StaffsFindReq) (*patient.StaffsResp, error)
+	StaffUpdate(*patient.Staff) (*patient.Staff, error)
+	StaffDelete(*patient.StaffId) error
+	StaffPasswordUpdate(id, password string) error
+	StaffAdminCreate(*patient.Staff) error
+
+	// Refresh token
+	StaffRefreshTokenSet(*patient.StaffRefreshToken) error
+	StaffRefreshTokenCheck(*patient.StaffRefreshToken) (*patient.Staff, error)
+}
@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"time"
+
 	"github.com/jmoiron/sqlx"
 
 	"gitlab.com/clinic-crm/reception/storage/postgres"
@@ -9,18 +11,68 @@ import (
 
 type StorageI interface {
 	Patient() repo.PatientStorageI
+	Staff() repo.StaffStorageI
+	Debt() repo.DebtStorageI
+	Shift() repo.ShiftStorageI
+	Discount() repo.DiscountStorageI
+	Fiscal() repo.FiscalStorageI
+	Online() repo.OnlineStorageI
+	Appointment() repo.AppointmentStorageI
 }
 
 type storagePg struct {
-	patientRepo repo.PatientStorageI
+	patientRepo  repo.PatientStorageI
+	staffRepo    repo.StaffStorageI
+	debtRepo     repo.DebtStorageI
+	shiftRepo    repo.ShiftStorageI
+	discountRepo repo.DiscountStorageI
+	fiscalRepo   repo.FiscalStorageI
+	onlineRepo   repo.OnlineStorageI
+	appointRepo  repo.AppointmentStorageI
 }
 
-func NewStoragePg(db *sqlx.DB) StorageI {
+// NewStoragePg returns the postgres storage, location is the clinic time zone the times of appointments are in.
+func NewStoragePg(db *sqlx.DB, location *time.Location) StorageI {
 	return &storagePg{
-		patientRepo: postgres.NewPatient(db),
+		patientRepo:  postgres.NewPatient(db),
+		staffRepo:    postgres.NewStaff(db),
+		debtRepo:     postgres.NewDebt(db),
+		shiftRepo:    postgres.NewShift(db),
+		discountRepo: postgres.NewDiscount(db),
+		fiscalRepo:   postgres.NewFiscal(db),
+		onlineRepo:   postgres.NewOnline(db),
+		appointRepo:  postgres.NewAppointment(db, location),
 	}
 }
 
 func (s *storagePg) Patient() repo.PatientStorageI {
 	return s.patientRepo
 }
+
+func (s *storagePg) Staff() repo.StaffStorageI {
+	return s.staffRepo
+}
+
+func (s *storagePg) Debt() repo.DebtStorageI {
+	return s.debtRepo
+}
+
+func (s *storagePg) Shift() repo.ShiftStorageI {
+	return s.shiftRepo
+}
+
+func (s *storagePg) Discount() repo.DiscountStorageI {
+	return s.discountRepo
+}
+
+func (s *storagePg) Fiscal() repo.FiscalStorageI {
+	return s.fiscalRepo
+}
+
+func (s *storagePg) Online() repo.OnlineStorageI {
+	return s.onlineRepo
+}
+
+func (s *storagePg) Appointment() repo.AppointmentStorageI {
+	return s.appointRepo
+}